@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build demo
+
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/demo"
+	"github.com/rs/zerolog/log"
+)
+
+// useDemoConfig points the kube flags to the demo cluster kubeconfig and
+// returns a func to remove it.
+func useDemoConfig() (func(), error) {
+	path, err := demo.WriteKubeConfig("")
+	if err != nil {
+		return nil, err
+	}
+	ct := demo.ContextName
+	k8sFlags.KubeConfig, k8sFlags.Context = &path, &ct
+
+	return func() {
+		if err := os.Remove(path); err != nil {
+			log.Warn().Err(err).Msgf("Unable to remove demo kubeconfig %q", path)
+		}
+	}, nil
+}
+
+// dialDemo connects to the in-memory demo cluster.
+func dialDemo(cfg *client.Config) (client.Connection, error) {
+	conn, err := demo.NewConnection(cfg)
+	if err != nil {
+		return nil, err
+	}
+	go conn.Churn(context.Background(), demo.ChurnInterval)
+
+	return conn, nil
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build !demo
+
+package cmd
+
+import (
+	"errors"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+var errNoDemo = errors.New("demo mode is not available in this build. Rebuild k9s with the demo build tag")
+
+func useDemoConfig() (func(), error) {
+	return nil, errNoDemo
+}
+
+func dialDemo(*client.Config) (client.Connection, error) {
+	return nil, errNoDemo
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+func passphraseCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "passphrase",
+		Short: "Hash an idle lock passphrase",
+		Long:  "Hash an idle lock passphrase to be set as k9s.idleLock.passphrase in the K9s config",
+		RunE:  printPassphrase,
+	}
+}
+
+func printPassphrase(cmd *cobra.Command, args []string) error {
+	p, err := readPassphrase()
+	if err != nil {
+		return err
+	}
+	if p == "" {
+		return errors.New("passphrase must not be blank")
+	}
+	h, err := config.HashPassphrase(p)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, h)
+
+	return nil
+}
+
+func readPassphrase() (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		l, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && l == "" {
+			return "", err
+		}
+		return strings.TrimRight(l, "\r\n"), nil
+	}
+
+	fmt.Fprint(os.Stderr, "Passphrase: ")
+	raw, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+
+	return string(raw), err
+}
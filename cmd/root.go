@@ -9,6 +9,7 @@ import (
 	"os"
 	"runtime/debug"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/config/data"
 	"k8s.io/client-go/tools/clientcmd/api"
@@ -60,7 +61,7 @@ func init() {
 		return flagError{err: err}
 	})
 
-	rootCmd.AddCommand(versionCmd(), infoCmd())
+	rootCmd.AddCommand(versionCmd(), infoCmd(), passphraseCmd())
 	initK9sFlags()
 	initK8sFlags()
 }
@@ -78,6 +79,13 @@ func run(cmd *cobra.Command, args []string) error {
 	if err := config.InitLocs(); err != nil {
 		return err
 	}
+	if *k9sFlags.Demo {
+		cleanup, err := useDemoConfig()
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+	}
 	file, err := os.OpenFile(
 		*k9sFlags.LogFile,
 		os.O_CREATE|os.O_APPEND|os.O_WRONLY,
@@ -104,14 +112,19 @@ func run(cmd *cobra.Command, args []string) error {
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: file})
 	zerolog.SetGlobalLevel(parseLevel(*k9sFlags.LogLevel))
 
+	t := time.Now()
 	cfg, err := loadConfiguration()
 	if err != nil {
 		log.Error().Err(err).Msgf("Fail to load global/context configuration")
 	}
+	client.Stats.RecordPhase("startup.config", time.Since(t))
+	initT := time.Now()
 	app := view.NewApp(cfg)
 	if err := app.Init(version, *k9sFlags.RefreshRate); err != nil {
 		return err
 	}
+	client.Stats.RecordPhase("startup.init", time.Since(initT))
+	client.Stats.RecordPhase("startup", time.Since(t))
 	if err := app.Run(); err != nil {
 		return err
 	}
@@ -125,10 +138,15 @@ func run(cmd *cobra.Command, args []string) error {
 func loadConfiguration() (*config.Config, error) {
 	log.Info().Msg("🐶 K9s starting up...")
 
+	if !*k9sFlags.Demo {
+		if _, err := client.UseInClusterConfig(k8sFlags, config.AppConfigDir); err != nil {
+			log.Warn().Err(err).Msg("In-cluster config detection failed")
+		}
+	}
 	k8sCfg := client.NewConfig(k8sFlags)
 	k9sCfg := config.NewConfig(k8sCfg)
 	var errs error
-	conn, err := client.InitConnection(k8sCfg)
+	conn, err := dialConnection(k8sCfg)
 	k9sCfg.SetConnection(conn)
 	if err != nil {
 		errs = errors.Join(errs, err)
@@ -142,6 +160,11 @@ func loadConfiguration() (*config.Config, error) {
 		log.Error().Err(err).Msgf("config refine failed")
 		errs = errors.Join(errs, err)
 	}
+	if spec, err := k9sCfg.K9s.ContextProxy(k9sCfg.K9s.ActiveContextName()); err == nil && !spec.IsEmpty() {
+		if err := conn.SetProxy(spec); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
 	// Try to access server version if that fail. Connectivity issue?
 	if !conn.CheckConnectivity() {
 		errs = errors.Join(errs, fmt.Errorf("cannot connect to context: %s", k9sCfg.K9s.ActiveContextName()))
@@ -159,6 +182,14 @@ func loadConfiguration() (*config.Config, error) {
 	return k9sCfg, errs
 }
 
+func dialConnection(cfg *client.Config) (client.Connection, error) {
+	if *k9sFlags.Demo {
+		return dialDemo(cfg)
+	}
+
+	return client.InitConnection(cfg)
+}
+
 func parseLevel(level string) zerolog.Level {
 	switch level {
 	case "trace":
@@ -244,6 +275,12 @@ func initK9sFlags() {
 		"",
 		"Sets a path to a dir for a screen dumps",
 	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.Demo,
+		"demo",
+		false,
+		"Runs K9s against a built-in fake cluster (requires a build with the demo tag)",
+	)
 	rootCmd.Flags()
 }
 
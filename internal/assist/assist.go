@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+// Package assist sends resources diagnostics to a user provided LLM endpoint.
+// Nothing leaves the cluster unless the assistant is explicitly enabled and
+// everything sent goes through the redaction rules first.
+package assist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/redact"
+)
+
+const (
+	systemPrompt = "You are a Kubernetes troubleshooting assistant."
+
+	maxErrorBody = 512
+)
+
+var (
+	// credentialRX masks common credentials assignments, keeping the key.
+	credentialRX = regexp.MustCompile(`(?i)((?:password|passwd|secret|token|api[_-]?key|access[_-]?key)["']?\s*[:=]\s*["']?)[^\s"',}]+`)
+
+	// envRX masks manifests env vars values named after credentials.
+	envRX = regexp.MustCompile(`(?i)(name:\s*\S*(?:password|passwd|secret|token|key)\S*\n\s*value:\s*)\S+`)
+)
+
+// Subject tracks a resource diagnostics context.
+type Subject struct {
+	Kind   string
+	Path   string
+	YAML   string
+	Events string
+	Logs   string
+
+	// Containers tracks the containers whose logs are collected.
+	Containers []string
+}
+
+// Disclosure lists the data a prompt sends to the assistant endpoint so
+// users may review it before anything leaves k9s.
+func Disclosure(cfg config.Assist, s Subject) string {
+	tpl := cfg.Prompt(s.Kind)
+	var b strings.Builder
+	fmt.Fprintf(&b, "The following will be sent to %s:\n\n", cfg.URL)
+	if strings.Contains(tpl, ".YAML") {
+		fmt.Fprintf(&b, "- %s %s manifest\n", s.Kind, s.Path)
+	}
+	if strings.Contains(tpl, ".Events") && s.Events != "" && s.Events != "none" {
+		fmt.Fprintf(&b, "- %d recent event(s)\n", strings.Count(s.Events, "\n")+1)
+	}
+	if strings.Contains(tpl, ".Logs") && len(s.Containers) > 0 {
+		fmt.Fprintf(&b, "- last %d log lines of container(s) %s\n", cfg.GetLogLines(), strings.Join(s.Containers, ", "))
+	}
+	b.WriteString("\nConfigMap data, credentials, ips, image registries and redact rules matches are masked.")
+
+	return b.String()
+}
+
+// Asker sends a prompt to an LLM endpoint.
+type Asker interface {
+	// Ask returns the endpoint response for a given prompt.
+	Ask(ctx context.Context, prompt string) (string, error)
+}
+
+// NewAsker returns an asker for the configured endpoint.
+func NewAsker(cfg config.Assist) (Asker, error) {
+	if !cfg.IsEnabled() {
+		return nil, fmt.Errorf("no assistant endpoint enabled")
+	}
+	c := &http.Client{Timeout: cfg.GetTimeout()}
+	switch cfg.GetProvider() {
+	case config.OpenAIProvider:
+		return &openAI{cfg: cfg, client: c}, nil
+	case config.OllamaProvider:
+		return &ollama{cfg: cfg, client: c}, nil
+	default:
+		return nil, fmt.Errorf("unsupported assistant provider %q (openai|ollama)", cfg.Provider)
+	}
+}
+
+// Redactor masks sensitive values before they are sent.
+type Redactor struct {
+	rules []*regexp.Regexp
+}
+
+// NewRedactor returns a redactor for the given regular expressions.
+func NewRedactor(rules []string) (*Redactor, error) {
+	r := Redactor{rules: make([]*regexp.Regexp, 0, len(rules))}
+	for _, s := range rules {
+		rx, err := regexp.Compile(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid assist redact rule %q: %w", s, err)
+		}
+		r.rules = append(r.rules, rx)
+	}
+
+	return &r, nil
+}
+
+// Redact masks credentials and rules matches. Ips, image registries and
+// secrets data are always masked, session redaction being on or not.
+func (r *Redactor) Redact(s string) string {
+	s = envRX.ReplaceAllString(s, "${1}"+redact.Masked)
+	s = credentialRX.ReplaceAllString(s, "${1}"+redact.Masked)
+	for _, rx := range r.rules {
+		s = rx.ReplaceAllString(s, redact.Masked)
+	}
+
+	return redact.Scrub(s)
+}
+
+// Prompt renders the resource kind prompt template and redacts it.
+func Prompt(cfg config.Assist, s Subject) (string, error) {
+	tpl, err := template.New(s.Kind).Parse(cfg.Prompt(s.Kind))
+	if err != nil {
+		return "", fmt.Errorf("invalid assist prompt for %s: %w", s.Kind, err)
+	}
+	var b strings.Builder
+	if err := tpl.Execute(&b, s); err != nil {
+		return "", err
+	}
+	r, err := NewRedactor(cfg.Redact)
+	if err != nil {
+		return "", err
+	}
+
+	return r.Redact(b.String()), nil
+}
+
+func postJSON(ctx context.Context, c *http.Client, u, key string, in, out any) error {
+	bb, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(bb))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if key != "" {
+		req.Header.Set("Authorization", "Bearer "+key)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("assistant %s returned %s: %s", u, resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package assist_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/derailed/k9s/internal/assist"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactorRedact(t *testing.T) {
+	r, err := assist.NewRedactor([]string{`corp-[a-z]+\.internal`})
+	assert.NoError(t, err)
+
+	s := r.Redact(`url: https://db.corp-eu.internal
+env:
+- name: DB_PASSWORD
+  value: hunter2
+args: ["--api-key=abc123", "--verbose"]
+token: "eyJhbGciOi"`)
+	assert.Equal(t, `url: https://db.********
+env:
+- name: DB_PASSWORD
+  value: ********
+args: ["--api-key=********", "--verbose"]
+token: "********"`, s)
+
+	_, err = assist.NewRedactor([]string{"("})
+	assert.Error(t, err)
+}
+
+func TestPrompt(t *testing.T) {
+	cfg := config.Assist{
+		Prompts: map[string]string{"pod": "Why is {{.Kind}} {{.Path}} failing?\n{{.Logs}}"},
+		Redact:  []string{`10\.0\.\d+\.\d+`},
+	}
+	p, err := assist.Prompt(cfg, assist.Subject{Kind: "Pod", Path: "default/fred", Logs: "dial 10.0.3.4: refused"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Why is Pod default/fred failing?\ndial ********: refused", p)
+
+	p, err = assist.Prompt(config.Assist{}, assist.Subject{Kind: "Service", Path: "default/fred", Events: "none"})
+	assert.NoError(t, err)
+	assert.Contains(t, p, "Service default/fred")
+	assert.NotContains(t, p, "Recent logs")
+
+	cfg.Prompts["pod"] = "{{.Blee"
+	_, err = assist.Prompt(cfg, assist.Subject{Kind: "Pod"})
+	assert.Error(t, err)
+}
+
+func TestDisclosure(t *testing.T) {
+	cfg := config.Assist{URL: "http://localhost:11434", LogLines: 50}
+	s := assist.Subject{
+		Kind:       "Pod",
+		Path:       "default/fred",
+		Events:     "Warning BackOff\nNormal Pulled",
+		Containers: []string{"app", "istio-proxy"},
+	}
+
+	assert.Equal(t, `The following will be sent to http://localhost:11434:
+
+- Pod default/fred manifest
+- 2 recent event(s)
+- last 50 log lines of container(s) app, istio-proxy
+
+ConfigMap data, credentials, ips, image registries and redact rules matches are masked.`, assist.Disclosure(cfg, s))
+
+	cfg.Prompts = map[string]string{"pod": "Why is {{.Kind}} {{.Path}} failing?"}
+	assert.NotContains(t, assist.Disclosure(cfg, s), "log lines")
+}
+
+func TestRedactorScrub(t *testing.T) {
+	r, err := assist.NewRedactor(nil)
+	assert.NoError(t, err)
+
+	s := r.Redact("dial 10.0.3.4: refused")
+	assert.NotContains(t, s, "10.0.3.4")
+}
+
+func TestAsk(t *testing.T) {
+	uu := map[string]struct {
+		provider, path, resp string
+	}{
+		"openai": {
+			provider: config.OpenAIProvider,
+			path:     "/v1/chat/completions",
+			resp:     `{"choices":[{"message":{"role":"assistant","content":"Image is missing."}}]}`,
+		},
+		"ollama": {
+			provider: config.OllamaProvider,
+			path:     "/api/chat",
+			resp:     `{"message":{"role":"assistant","content":"Image is missing."},"done":true}`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var (
+				path, auth string
+				req        map[string]any
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path, auth = r.URL.Path, r.Header.Get("Authorization")
+				_ = json.NewDecoder(r.Body).Decode(&req)
+				_, _ = w.Write([]byte(u.resp))
+			}))
+			defer srv.Close()
+
+			t.Setenv("K9S_TEST_ASSIST_KEY", "s3cr3t")
+			base := srv.URL
+			if u.provider == config.OpenAIProvider {
+				base += "/v1/"
+			}
+			a, err := assist.NewAsker(config.Assist{
+				Enable:    true,
+				Provider:  u.provider,
+				URL:       base,
+				Model:     "fred",
+				APIKeyEnv: "K9S_TEST_ASSIST_KEY",
+			})
+			assert.NoError(t, err)
+
+			s, err := a.Ask(context.Background(), "why?")
+			assert.NoError(t, err)
+			assert.Equal(t, "Image is missing.", s)
+			assert.Equal(t, u.path, path)
+			assert.Equal(t, "Bearer s3cr3t", auth)
+			assert.Equal(t, "fred", req["model"])
+		})
+	}
+}
+
+func TestNewAskerDisabled(t *testing.T) {
+	_, err := assist.NewAsker(config.Assist{URL: "http://localhost:11434"})
+	assert.Error(t, err)
+
+	_, err = assist.NewAsker(config.Assist{Enable: true, URL: "http://localhost", Provider: "blee"})
+	assert.Error(t, err)
+}
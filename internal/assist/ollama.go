@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package assist
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+type (
+	ollamaRequest struct {
+		Model    string        `json:"model"`
+		Messages []chatMessage `json:"messages"`
+		Stream   bool          `json:"stream"`
+	}
+
+	ollamaResponse struct {
+		Message chatMessage `json:"message"`
+	}
+)
+
+type ollama struct {
+	cfg    config.Assist
+	client *http.Client
+}
+
+// Ask sends a prompt via the ollama chat api.
+func (o *ollama) Ask(ctx context.Context, prompt string) (string, error) {
+	req := ollamaRequest{
+		Model: o.cfg.Model,
+		Messages: []chatMessage{
+			{Role: "system", Content: systemPrompt},
+			{Role: "user", Content: prompt},
+		},
+	}
+	var resp ollamaResponse
+	u := strings.TrimSuffix(o.cfg.URL, "/") + "/api/chat"
+	if err := postJSON(ctx, o.client, u, o.cfg.APIKey(), req, &resp); err != nil {
+		return "", err
+	}
+
+	return resp.Message.Content, nil
+}
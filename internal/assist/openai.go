@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package assist
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+type (
+	chatMessage struct {
+		Role    string `json:"role"`
+		Content string `json:"content"`
+	}
+
+	openAIRequest struct {
+		Model    string        `json:"model,omitempty"`
+		Messages []chatMessage `json:"messages"`
+	}
+
+	openAIResponse struct {
+		Choices []struct {
+			Message chatMessage `json:"message"`
+		} `json:"choices"`
+	}
+)
+
+type openAI struct {
+	cfg    config.Assist
+	client *http.Client
+}
+
+// Ask sends a prompt via an OpenAI compatible chat completions api.
+func (o *openAI) Ask(ctx context.Context, prompt string) (string, error) {
+	req := openAIRequest{
+		Model: o.cfg.Model,
+		Messages: []chatMessage{
+			{Role: "system", Content: systemPrompt},
+			{Role: "user", Content: prompt},
+		},
+	}
+	var resp openAIResponse
+	u := strings.TrimSuffix(o.cfg.URL, "/") + "/chat/completions"
+	if err := postJSON(ctx, o.client, u, o.cfg.APIKey(), req, &resp); err != nil {
+		return "", err
+	}
+	if len(resp.Choices) == 0 {
+		return "", errors.New("assistant returned no choices")
+	}
+
+	return resp.Choices[0].Message.Content, nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	"github.com/rs/zerolog/log"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/discovery/cached/disk"
@@ -32,6 +34,10 @@ const (
 	cacheMXAPIKey = "metricsAPI"
 	serverVersion = "serverVersion"
 	cacheNSKey    = "validNamespaces"
+
+	// protobufContentTypes favors protobuf payloads while falling back to
+	// json for resources that don't support it.
+	protobufContentTypes = runtime.ContentTypeProtobuf + "," + runtime.ContentTypeJSON
 )
 
 var supportedMetricsAPIVersions = []string{"v1beta1"}
@@ -50,6 +56,7 @@ type APIClient struct {
 	mx                sync.RWMutex
 	cache             *cache.LRUExpireCache
 	connOK            bool
+	bastion           *Bastion
 }
 
 // NewTestAPIClient for testing ONLY!!
@@ -287,7 +294,7 @@ func (a *APIClient) CheckConnectivity() bool {
 	}()
 
 	// Need reload to pick up any kubeconfig changes.
-	cfg, err := NewConfig(a.config.flags).RESTConfig()
+	cfg, err := a.config.reload().RESTConfig()
 	if err != nil {
 		log.Error().Err(err).Msgf("restConfig load failed")
 		a.connOK = false
@@ -319,11 +326,31 @@ func (a *APIClient) Config() *Config {
 	return a.config
 }
 
-// HasMetrics checks if the cluster supports metrics.
+// HasMetrics checks if the cluster supports metrics either via
+// metrics-server or the kubelet summary API.
 func (a *APIClient) HasMetrics() bool {
+	return a.HasMetricsServer() || a.supportsSummaryAPI()
+}
+
+// HasMetricsServer checks if metrics-server is installed on the cluster.
+func (a *APIClient) HasMetricsServer() bool {
 	return a.supportsMetricsResources() == nil
 }
 
+// supportsSummaryAPI checks if the user can reach the kubelets stats summary
+// via the api server node proxy.
+func (a *APIClient) supportsSummaryAPI() bool {
+	if !a.getConnOK() {
+		return false
+	}
+	ok, err := a.CanI(ClusterScope, "v1/nodes:proxy", "", GetAccess)
+	if err != nil {
+		log.Debug().Err(err).Msgf("Kubelet summary API unavailable")
+	}
+
+	return ok
+}
+
 func (a *APIClient) getMxsClient() *versioned.Clientset {
 	a.mx.RLock()
 	defer a.mx.RUnlock()
@@ -444,6 +471,10 @@ func (a *APIClient) Dial() (kubernetes.Interface, error) {
 	if err != nil {
 		return nil, err
 	}
+	if a.config.IsLowBandwidth() {
+		cfg.AcceptContentTypes = protobufContentTypes
+		cfg.ContentType = runtime.ContentTypeProtobuf
+	}
 	if c, err := kubernetes.NewForConfig(cfg); err != nil {
 		return nil, err
 	} else {
@@ -558,6 +589,70 @@ func (a *APIClient) SwitchContext(name string) error {
 	return nil
 }
 
+// SetRateLimit sets the api client qps and burst. Live clients are
+// discarded so the new limits take effect on the next dial.
+func (a *APIClient) SetRateLimit(qps float32, burst int) {
+	if !a.config.SetRateLimit(qps, burst) {
+		return
+	}
+	log.Debug().Msgf("Client rate limit set to qps:%v burst:%d", qps, burst)
+	a.resetClients()
+}
+
+// SetLowBandwidth toggles protobuf payloads on the api clients. Live clients
+// are discarded so the setting takes effect on the next dial.
+func (a *APIClient) SetLowBandwidth(b bool) {
+	if !a.config.SetLowBandwidth(b) {
+		return
+	}
+	log.Debug().Msgf("Client low bandwidth mode set to %t", b)
+	a.resetClients()
+}
+
+// SetProxy routes api calls, port-forwards and exec streams through a proxy
+// or an ssh bastion. An empty spec closes any bastion and connects directly.
+func (a *APIClient) SetProxy(spec ProxySpec) error {
+	a.mx.Lock()
+	a.bastion.Stop()
+	a.bastion = nil
+	a.mx.Unlock()
+
+	var u *url.URL
+	switch {
+	case spec.Bastion != "":
+		b, err := StartBastion(spec.Bastion, spec.BastionArgs)
+		if err != nil {
+			return err
+		}
+		a.mx.Lock()
+		a.bastion = b
+		a.mx.Unlock()
+		u = b.URL()
+	case spec.URL != "":
+		var err error
+		if u, err = url.Parse(spec.URL); err != nil {
+			return fmt.Errorf("invalid proxy url %q: %w", spec.URL, err)
+		}
+	}
+	if a.config.SetProxy(u) {
+		log.Debug().Msgf("Client proxy set to %q", u)
+		a.resetClients()
+	}
+
+	return nil
+}
+
+func (a *APIClient) resetClients() {
+	a.mx.Lock()
+	a.nsClient = nil
+	a.mx.Unlock()
+	a.setDClient(nil)
+	a.setMxsClient(nil)
+	a.setCachedClient(nil)
+	a.setClient(nil)
+	a.setLogClient(nil)
+}
+
 func (a *APIClient) reset() {
 	a.config.reset()
 	a.cache = cache.NewLRUExpireCache(cacheSize)
@@ -6,6 +6,8 @@ package client
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
@@ -25,8 +27,12 @@ const (
 
 // Config tracks a kubernetes configuration.
 type Config struct {
-	flags *genericclioptions.ConfigFlags
-	mx    sync.RWMutex
+	flags        *genericclioptions.ConfigFlags
+	qps          float32
+	burst        int
+	proxy        *url.URL
+	lowBandwidth bool
+	mx           sync.RWMutex
 }
 
 // NewConfig returns a new k8s config or an error if the flags are invalid.
@@ -50,7 +56,126 @@ func (c *Config) CallTimeout() time.Duration {
 }
 
 func (c *Config) RESTConfig() (*restclient.Config, error) {
-	return c.clientConfig().ClientConfig()
+	cfg, err := c.clientConfig().ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg.Wrap(WrapStats)
+
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	if c.qps > 0 {
+		cfg.QPS, cfg.Burst = c.qps, c.burst
+	}
+	if c.proxy != nil {
+		cfg.Proxy = http.ProxyURL(c.proxy)
+	}
+
+	return cfg, nil
+}
+
+// ContextRESTConfig returns a rest config for a given kubeconfig context
+// without switching the active one.
+func (c *Config) ContextRESTConfig(name string) (*restclient.Config, error) {
+	raw, err := c.RawConfig()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := raw.Contexts[name]; !ok {
+		return nil, fmt.Errorf("invalid context specified: %q", name)
+	}
+	cfg, err := clientcmd.NewNonInteractiveClientConfig(raw, name, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	if c.qps > 0 {
+		cfg.QPS, cfg.Burst = c.qps, c.burst
+	}
+
+	return cfg, nil
+}
+
+// SetProxy sets the api server proxy. Nil uses the kubeconfig or
+// environment settings. Returns true if the proxy changed.
+func (c *Config) SetProxy(u *url.URL) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if sameURL(c.proxy, u) {
+		return false
+	}
+	c.proxy = u
+
+	return true
+}
+
+// ProxyURL returns the api server proxy if any.
+func (c *Config) ProxyURL() *url.URL {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return c.proxy
+}
+
+func sameURL(u1, u2 *url.URL) bool {
+	if u1 == nil || u2 == nil {
+		return u1 == u2
+	}
+
+	return u1.String() == u2.String()
+}
+
+// reload returns a fresh config honoring the client settings.
+func (c *Config) reload() *Config {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return &Config{
+		flags:        c.flags,
+		qps:          c.qps,
+		burst:        c.burst,
+		proxy:        c.proxy,
+		lowBandwidth: c.lowBandwidth,
+	}
+}
+
+// SetLowBandwidth toggles low bandwidth api calls. Returns true if the
+// setting changed.
+func (c *Config) SetLowBandwidth(b bool) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.lowBandwidth == b {
+		return false
+	}
+	c.lowBandwidth = b
+
+	return true
+}
+
+// IsLowBandwidth checks if api calls should keep payloads to a minimum.
+func (c *Config) IsLowBandwidth() bool {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return c.lowBandwidth
+}
+
+// SetRateLimit sets the client qps and burst. Zero values use the client
+// defaults. Returns true if the limits changed.
+func (c *Config) SetRateLimit(qps float32, burst int) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.qps == qps && c.burst == burst {
+		return false
+	}
+	c.qps, c.burst = qps, burst
+
+	return true
 }
 
 // Flags returns configuration flags.
@@ -5,6 +5,8 @@ package client_test
 
 import (
 	"errors"
+	"net/http"
+	"net/url"
 	"os"
 	"testing"
 	"time"
@@ -215,6 +217,33 @@ func TestConfigSwitchContext(t *testing.T) {
 	assert.Equal(t, "blee", ctx)
 }
 
+func TestConfigRESTConfigLimits(t *testing.T) {
+	cluster, kubeConfig := "duh", "./testdata/config"
+	flags := genericclioptions.ConfigFlags{
+		KubeConfig: &kubeConfig,
+		Context:    &cluster,
+	}
+
+	cfg := client.NewConfig(&flags)
+	assert.True(t, cfg.SetRateLimit(20, 40))
+	assert.False(t, cfg.SetRateLimit(20, 40))
+	u, err := url.Parse("socks5://127.0.0.1:1080")
+	assert.Nil(t, err)
+	assert.True(t, cfg.SetProxy(u))
+	assert.False(t, cfg.SetProxy(u))
+
+	rc, err := cfg.RESTConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, float32(20), rc.QPS)
+	assert.Equal(t, 40, rc.Burst)
+	pu, err := rc.Proxy(&http.Request{})
+	assert.Nil(t, err)
+	assert.Equal(t, u.String(), pu.String())
+
+	assert.True(t, cfg.SetProxy(nil))
+	assert.Nil(t, cfg.ProxyURL())
+}
+
 func TestConfigAccess(t *testing.T) {
 	context, kubeConfig := "duh", "./testdata/config"
 	flags := genericclioptions.ConfigFlags{
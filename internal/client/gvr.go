@@ -6,6 +6,7 @@ package client
 import (
 	"fmt"
 	"path"
+	"slices"
 	"strings"
 
 	"github.com/fvbommel/sortorder"
@@ -162,6 +163,21 @@ func (g GVRs) Less(i, j int) bool {
 
 // Helper...
 
+// HasVerbs checks if a resource supports all the given API verbs. Resources
+// with unknown verbs are assumed to support them.
+func HasVerbs(verbs, want []string) bool {
+	if verbs == nil {
+		return true
+	}
+	for _, v := range want {
+		if !slices.Contains(verbs, v) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Can determines the available actions for a given resource.
 func Can(verbs []string, v string) bool {
 	if verbs == nil {
@@ -49,6 +49,27 @@ func TestGVRCan(t *testing.T) {
 	}
 }
 
+func TestGVRHasVerbs(t *testing.T) {
+	uu := map[string]struct {
+		vv, want []string
+		e        bool
+	}{
+		"unknown":    {nil, []string{"patch"}, true},
+		"none":       {[]string{}, []string{"get"}, false},
+		"all":        {[]string{"get", "list", "patch"}, []string{"get", "patch"}, true},
+		"missing":    {[]string{"get", "list", "watch"}, []string{"patch"}, false},
+		"watch_only": {[]string{"list", "watch"}, []string{"get"}, false},
+		"no_want":    {[]string{"get"}, nil, true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, client.HasVerbs(u.vv, u.want))
+		})
+	}
+}
+
 func TestGVR(t *testing.T) {
 	uu := map[string]struct {
 		gvr string
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	clientcmd "k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// InClusterContext names the context synthesized from a pod service account.
+	InClusterContext = "in-cluster"
+
+	inClusterKubeConfig = "in-cluster-kubeconfig.yaml"
+	saDir               = "/var/run/secrets/kubernetes.io/serviceaccount"
+)
+
+// IsInCluster checks if k9s runs in a pod with a mounted service account.
+var IsInCluster = sync.OnceValue(func() bool {
+	return isInCluster(saDir)
+})
+
+func isInCluster(dir string) bool {
+	if os.Getenv("KUBERNETES_SERVICE_HOST") == "" || os.Getenv("KUBERNETES_SERVICE_PORT") == "" {
+		return false
+	}
+	_, err := os.Stat(filepath.Join(dir, "token"))
+
+	return err == nil
+}
+
+// HasKubeConfig checks if a kubeconfig is available via flags, env or the
+// default location.
+func HasKubeConfig(flags *genericclioptions.ConfigFlags) bool {
+	if isSet(flags.KubeConfig) {
+		return true
+	}
+	for _, p := range clientcmd.NewDefaultClientConfigLoadingRules().Precedence {
+		if _, err := os.Stat(p); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UseInClusterConfig points the flags to a kubeconfig derived from the pod
+// service account when no kubeconfig is available. The generated config
+// references the mounted token so rotated tokens are picked up.
+func UseInClusterConfig(flags *genericclioptions.ConfigFlags, dir string) (bool, error) {
+	if HasKubeConfig(flags) || !IsInCluster() {
+		return false, nil
+	}
+	cfg, err := InClusterKubeConfig(saDir)
+	if err != nil {
+		return false, err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return false, err
+	}
+	path := filepath.Join(dir, inClusterKubeConfig)
+	if err := clientcmd.WriteToFile(*cfg, path); err != nil {
+		return false, err
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		return false, err
+	}
+	flags.KubeConfig = &path
+	log.Info().Msgf("Using in-cluster service account config %q", path)
+
+	return true, nil
+}
+
+// InClusterKubeConfig builds a kubeconfig from a service account directory.
+func InClusterKubeConfig(dir string) (*api.Config, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, errors.New("not running in a cluster")
+	}
+	ns := DefaultNamespace
+	if bb, err := os.ReadFile(filepath.Join(dir, "namespace")); err == nil {
+		if n := strings.TrimSpace(string(bb)); n != "" {
+			ns = n
+		}
+	}
+
+	cfg := api.NewConfig()
+	cfg.Clusters[InClusterContext] = &api.Cluster{
+		Server:               "https://" + net.JoinHostPort(host, port),
+		CertificateAuthority: filepath.Join(dir, "ca.crt"),
+	}
+	cfg.AuthInfos[InClusterContext] = &api.AuthInfo{
+		TokenFile: filepath.Join(dir, "token"),
+	}
+	cfg.Contexts[InClusterContext] = &api.Context{
+		Cluster:   InClusterContext,
+		AuthInfo:  InClusterContext,
+		Namespace: ns,
+	}
+	cfg.CurrentContext = InClusterContext
+
+	return cfg, nil
+}
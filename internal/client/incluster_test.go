@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInClusterKubeConfig(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "namespace"), []byte("toolbox\n"), 0600))
+	t.Setenv("KUBERNETES_SERVICE_HOST", "10.96.0.1")
+	t.Setenv("KUBERNETES_SERVICE_PORT", "443")
+
+	cfg, err := client.InClusterKubeConfig(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, client.InClusterContext, cfg.CurrentContext)
+	assert.Equal(t, "https://10.96.0.1:443", cfg.Clusters[client.InClusterContext].Server)
+	assert.Equal(t, filepath.Join(dir, "ca.crt"), cfg.Clusters[client.InClusterContext].CertificateAuthority)
+	assert.Equal(t, filepath.Join(dir, "token"), cfg.AuthInfos[client.InClusterContext].TokenFile)
+	assert.Equal(t, "toolbox", cfg.Contexts[client.InClusterContext].Namespace)
+}
+
+func TestInClusterKubeConfigOutside(t *testing.T) {
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+
+	_, err := client.InClusterKubeConfig(t.TempDir())
+	assert.Error(t, err)
+}
@@ -92,6 +92,9 @@ func (m *MetricsServer) checkAccess(ns, gvr, msg string) error {
 	if !m.HasMetrics() {
 		return errors.New("no metrics-server detected on cluster")
 	}
+	if !m.HasMetricsServer() {
+		return nil
+	}
 
 	auth, err := m.CanI(ns, gvr, "", ListAccess)
 	if err != nil {
@@ -103,6 +106,30 @@ func (m *MetricsServer) checkAccess(ns, gvr, msg string) error {
 	return nil
 }
 
+// fetchSummaries retrieves all nodes kubelet summaries when metrics-server
+// is not available.
+func (m *MetricsServer) fetchSummaries(ctx context.Context) ([]Summary, error) {
+	if entry, ok := m.cache.Get(summaryCacheKey); ok {
+		ss, ok := entry.([]Summary)
+		if !ok {
+			return nil, fmt.Errorf("expected summaries but got %T", entry)
+		}
+		return ss, nil
+	}
+
+	dial, err := m.Dial()
+	if err != nil {
+		return nil, err
+	}
+	ss, err := FetchSummaries(ctx, dial)
+	if err != nil {
+		return nil, err
+	}
+	m.cache.Add(summaryCacheKey, ss, mxCacheExpiry)
+
+	return ss, nil
+}
+
 // NodesMetrics retrieves metrics for a given set of nodes.
 func (m *MetricsServer) NodesMetrics(nodes *v1.NodeList, metrics *mv1beta1.NodeMetricsList, mmx NodesMetrics) {
 	if nodes == nil || metrics == nil {
@@ -164,6 +191,16 @@ func (m *MetricsServer) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMe
 		return mxList, nil
 	}
 
+	if !m.HasMetricsServer() {
+		ss, err := m.fetchSummaries(ctx)
+		if err != nil {
+			return mx, err
+		}
+		mxList := SummaryNodeMetrics(ss)
+		m.cache.Add(key, mxList, mxCacheExpiry)
+		return mxList, nil
+	}
+
 	client, err := m.MXDial()
 	if err != nil {
 		return mx, err
@@ -235,6 +272,16 @@ func (m *MetricsServer) FetchPodsMetrics(ctx context.Context, ns string) (*mv1be
 		return mxList, nil
 	}
 
+	if !m.HasMetricsServer() {
+		ss, err := m.fetchSummaries(ctx)
+		if err != nil {
+			return mx, err
+		}
+		mxList := SummaryPodMetrics(ss, ns)
+		m.cache.Add(key, mxList, mxCacheExpiry)
+		return mxList, nil
+	}
+
 	client, err := m.MXDial()
 	if err != nil {
 		return mx, err
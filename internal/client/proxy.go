@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const bastionReadyTimeout = 10 * time.Second
+
+// ProxySpec tracks how to reach a cluster api server.
+type ProxySpec struct {
+	// URL represents an http, https or socks5 proxy url.
+	URL string
+
+	// Bastion represents an ssh bastion destination ie [user@]host[:port].
+	Bastion string
+
+	// BastionArgs represents extra ssh args.
+	BastionArgs []string
+}
+
+// IsEmpty checks if a direct connection is in use.
+func (p ProxySpec) IsEmpty() bool {
+	return p.URL == "" && p.Bastion == ""
+}
+
+// Bastion tracks an ssh dynamic port forward used as a socks5 proxy.
+type Bastion struct {
+	cmd    *exec.Cmd
+	cancel context.CancelFunc
+	url    *url.URL
+}
+
+// StartBastion opens an ssh socks tunnel through a bastion host. The user ssh
+// config, agent and known hosts are honored.
+func StartBastion(dest string, args []string) (*Bastion, error) {
+	port, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+	addr := net.JoinHostPort("127.0.0.1", strconv.Itoa(port))
+
+	host, p := dest, ""
+	if h, pp, err := net.SplitHostPort(dest); err == nil {
+		host, p = h, pp
+	}
+	aa := []string{"-N", "-D", addr, "-o", "ExitOnForwardFailure=yes", "-o", "ServerAliveInterval=30"}
+	if p != "" {
+		aa = append(aa, "-p", p)
+	}
+	aa = append(aa, args...)
+	aa = append(aa, host)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b := Bastion{
+		cmd:    exec.CommandContext(ctx, "ssh", aa...),
+		cancel: cancel,
+		url:    &url.URL{Scheme: "socks5", Host: addr},
+	}
+	log.Debug().Msgf("Starting ssh bastion %q on %s", dest, addr)
+	if err := b.cmd.Start(); err != nil {
+		cancel()
+		return nil, err
+	}
+	exited := make(chan error, 1)
+	go func() {
+		exited <- b.cmd.Wait()
+	}()
+	if err := waitForPort(addr, exited, bastionReadyTimeout); err != nil {
+		b.Stop()
+		return nil, fmt.Errorf("ssh bastion %q failed: %w", dest, err)
+	}
+
+	return &b, nil
+}
+
+// URL returns the bastion proxy url.
+func (b *Bastion) URL() *url.URL {
+	return b.url
+}
+
+// Stop closes the ssh tunnel.
+func (b *Bastion) Stop() {
+	if b == nil {
+		return
+	}
+	log.Debug().Msgf("Stopping ssh bastion on %s", b.url.Host)
+	b.cancel()
+}
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = l.Close()
+	}()
+
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+func waitForPort(addr string, exited <-chan error, timeout time.Duration) error {
+	deadline := time.After(timeout)
+	for {
+		select {
+		case err := <-exited:
+			if err == nil {
+				err = errors.New("ssh exited")
+			}
+			return err
+		case <-deadline:
+			return fmt.Errorf("tunnel not ready after %s", timeout)
+		case <-time.After(100 * time.Millisecond):
+			if c, err := net.DialTimeout("tcp", addr, time.Second); err == nil {
+				_ = c.Close()
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"sort"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const allRules = "*"
+
+// AccessRules tracks a user resource rules per namespace.
+type AccessRules map[string][]authorizationv1.ResourceRule
+
+// FetchRules retrieves the user resource rules in a given namespace.
+func FetchRules(ctx context.Context, dial kubernetes.Interface, ns string) ([]authorizationv1.ResourceRule, error) {
+	review := authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: ns},
+	}
+	res, err := dial.AuthorizationV1().SelfSubjectRulesReviews().Create(ctx, &review, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Status.ResourceRules, nil
+}
+
+// Can checks if a verb is allowed on a resource in a given namespace.
+func (a AccessRules) Can(ns string, gvr GVR, verb string) bool {
+	for _, r := range a[ns] {
+		if allows(r, gvr, verb) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Namespaces returns all namespaces where a verb is allowed on a resource.
+func (a AccessRules) Namespaces(gvr GVR, verb string) []string {
+	nss := make([]string, 0, len(a))
+	for ns := range a {
+		if a.Can(ns, gvr, verb) {
+			nss = append(nss, ns)
+		}
+	}
+	sort.Strings(nss)
+
+	return nss
+}
+
+func allows(r authorizationv1.ResourceRule, gvr GVR, verb string) bool {
+	// Named rules do not grant collection access.
+	if len(r.ResourceNames) > 0 {
+		return false
+	}
+
+	return matches(r.Verbs, verb) && matches(r.APIGroups, gvr.G()) && matches(r.Resources, gvr.R())
+}
+
+func matches(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == allRules || v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessRulesCan(t *testing.T) {
+	rules := client.AccessRules{
+		"fred": {
+			{Verbs: []string{"get", "list", "watch"}, APIGroups: []string{""}, Resources: []string{"pods", "services"}},
+			{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"s1"}},
+		},
+		"blee": {
+			{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}},
+		},
+	}
+
+	uu := map[string]struct {
+		ns, gvr, verb string
+		e             bool
+	}{
+		"pods": {
+			ns: "fred", gvr: "v1/pods", verb: "list", e: true,
+		},
+		"verb": {
+			ns: "fred", gvr: "v1/pods", verb: "delete",
+		},
+		"group": {
+			ns: "fred", gvr: "apps/v1/deployments", verb: "list",
+		},
+		"named": {
+			ns: "fred", gvr: "v1/secrets", verb: "get",
+		},
+		"wildcard": {
+			ns: "blee", gvr: "apps/v1/deployments", verb: "delete", e: true,
+		},
+		"unknown-ns": {
+			ns: "zorg", gvr: "v1/pods", verb: "list",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rules.Can(u.ns, client.NewGVR(u.gvr), u.verb))
+		})
+	}
+	assert.Equal(t, []string{"blee", "fred"}, rules.Namespaces(client.NewGVR("v1/pods"), "list"))
+	assert.Equal(t, []string{"blee"}, rules.Namespaces(client.NewGVR("v1/secrets"), "list"))
+}
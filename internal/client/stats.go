@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LatencyBuckets tracks api call latency histogram upper bounds.
+var LatencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+}
+
+// Stats tracks k9s api usage.
+var Stats = NewAPIStats()
+
+// APIStats tracks api server calls latencies and k9s internal timings.
+type APIStats struct {
+	calls, errors, throttled int64
+	received                 int64
+	total, max               time.Duration
+	buckets                  []int64
+	phases                   map[string]time.Duration
+	mx                       sync.RWMutex
+}
+
+// APIStatsSnapshot represents api stats at a given point in time.
+type APIStatsSnapshot struct {
+	Calls     int64             `json:"calls"`
+	Errors    int64             `json:"errors"`
+	Throttled int64             `json:"throttled"`
+	Received  int64             `json:"receivedBytes"`
+	Avg       string            `json:"avgLatency"`
+	Max       string            `json:"maxLatency"`
+	Latencies map[string]int64  `json:"latencies"`
+	Phases    map[string]string `json:"timings,omitempty"`
+}
+
+// NewAPIStats returns a new instance.
+func NewAPIStats() *APIStats {
+	return &APIStats{
+		buckets: make([]int64, len(LatencyBuckets)+1),
+		phases:  make(map[string]time.Duration),
+	}
+}
+
+// Observe records an api call.
+func (s *APIStats) Observe(d time.Duration, status int, err error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.calls++
+	if err != nil || status >= http.StatusInternalServerError {
+		s.errors++
+	}
+	if status == http.StatusTooManyRequests {
+		s.throttled++
+	}
+	s.total += d
+	if d > s.max {
+		s.max = d
+	}
+	s.buckets[sort.Search(len(LatencyBuckets), func(i int) bool {
+		return d <= LatencyBuckets[i]
+	})]++
+}
+
+// Receive records api response payload bytes, watch streams included.
+func (s *APIStats) Receive(n int64) {
+	atomic.AddInt64(&s.received, n)
+}
+
+// Received returns the api response payload bytes received so far.
+func (s *APIStats) Received() int64 {
+	return atomic.LoadInt64(&s.received)
+}
+
+// RecordPhase records how long an internal phase took. ie discovery, startup...
+func (s *APIStats) RecordPhase(n string, d time.Duration) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.phases[n] = d
+}
+
+// Snapshot returns the current stats.
+func (s *APIStats) Snapshot() APIStatsSnapshot {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	snap := APIStatsSnapshot{
+		Calls:     s.calls,
+		Errors:    s.errors,
+		Throttled: s.throttled,
+		Received:  s.Received(),
+		Max:       s.max.Round(time.Millisecond).String(),
+		Latencies: make(map[string]int64, len(s.buckets)),
+		Phases:    make(map[string]string, len(s.phases)),
+	}
+	snap.Avg = time.Duration(0).String()
+	if s.calls > 0 {
+		snap.Avg = (s.total / time.Duration(s.calls)).Round(time.Millisecond).String()
+	}
+	for i, c := range s.buckets {
+		snap.Latencies[bucketName(i)] = c
+	}
+	for k, v := range s.phases {
+		snap.Phases[k] = v.Round(time.Millisecond).String()
+	}
+
+	return snap
+}
+
+func bucketName(i int) string {
+	if i == len(LatencyBuckets) {
+		return ">" + LatencyBuckets[i-1].String()
+	}
+
+	return "<=" + LatencyBuckets[i].String()
+}
+
+// statsRoundTripper records api calls latencies.
+type statsRoundTripper struct {
+	rt    http.RoundTripper
+	stats *APIStats
+}
+
+// RoundTrip records a request latency.
+func (s *statsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	t := time.Now()
+	resp, err := s.rt.RoundTrip(req)
+	if resp != nil && resp.Body != nil {
+		resp.Body = &countingBody{ReadCloser: resp.Body, stats: s.stats}
+	}
+	// Watches are long lived and would skew latencies.
+	if req.URL.Query().Get("watch") == strconv.FormatBool(true) {
+		return resp, err
+	}
+	var status int
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	if status == http.StatusTooManyRequests {
+		Throttle.Throttled(time.Now())
+	}
+	s.stats.Observe(time.Since(t), status, err)
+
+	return resp, err
+}
+
+// WrapStats instruments a transport to track api calls.
+func WrapStats(rt http.RoundTripper) http.RoundTripper {
+	return &statsRoundTripper{rt: rt, stats: Stats}
+}
+
+// countingBody tracks the bytes read off a response body.
+type countingBody struct {
+	io.ReadCloser
+	stats *APIStats
+}
+
+// Read reads from the body and records the bytes read.
+func (c *countingBody) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.stats.Receive(int64(n))
+
+	return n, err
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIStatsSnapshot(t *testing.T) {
+	s := client.NewAPIStats()
+	s.Observe(10*time.Millisecond, http.StatusOK, nil)
+	s.Observe(300*time.Millisecond, http.StatusTooManyRequests, nil)
+	s.Observe(10*time.Second, 0, errors.New("boom"))
+	s.RecordPhase("discovery", 1500*time.Millisecond)
+
+	snap := s.Snapshot()
+	assert.Equal(t, int64(3), snap.Calls)
+	assert.Equal(t, int64(1), snap.Errors)
+	assert.Equal(t, int64(1), snap.Throttled)
+	assert.Equal(t, "3.437s", snap.Avg)
+	assert.Equal(t, "10s", snap.Max)
+	assert.Equal(t, int64(1), snap.Latencies["<=50ms"])
+	assert.Equal(t, int64(1), snap.Latencies["<=500ms"])
+	assert.Equal(t, int64(1), snap.Latencies[">5s"])
+	assert.Equal(t, int64(0), snap.Latencies["<=1s"])
+	assert.Equal(t, "1.5s", snap.Phases["discovery"])
+}
+
+func TestWrapStatsReceived(t *testing.T) {
+	rt := client.WrapStats(roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("hello k9s"))}, nil
+	}))
+	before := client.Stats.Received()
+
+	resp, err := rt.RoundTrip(&http.Request{URL: &url.URL{Path: "/api/v1/pods"}})
+	assert.NoError(t, err)
+	bb, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, "hello k9s", string(bb))
+	assert.Equal(t, int64(9), client.Stats.Received()-before)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
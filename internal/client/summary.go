@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	// MaxSummaryFetches caps the number of concurrent kubelet summary calls.
+	MaxSummaryFetches = 8
+
+	summaryCacheKey = "summaries"
+	summaryWindow   = 30 * time.Second
+)
+
+// Summary represents a kubelet stats summary. Only the usage fields
+// metrics-server relies on are tracked.
+type Summary struct {
+	Node SummaryNode  `json:"node"`
+	Pods []SummaryPod `json:"pods"`
+}
+
+// SummaryNode represents a node stats summary.
+type SummaryNode struct {
+	NodeName string      `json:"nodeName"`
+	CPU      *SummaryCPU `json:"cpu,omitempty"`
+	Memory   *SummaryMEM `json:"memory,omitempty"`
+}
+
+// SummaryPod represents a pod stats summary.
+type SummaryPod struct {
+	PodRef     SummaryPodRef      `json:"podRef"`
+	Containers []SummaryContainer `json:"containers"`
+}
+
+// SummaryPodRef identifies a pod in a stats summary.
+type SummaryPodRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// SummaryContainer represents a container stats summary.
+type SummaryContainer struct {
+	Name   string      `json:"name"`
+	CPU    *SummaryCPU `json:"cpu,omitempty"`
+	Memory *SummaryMEM `json:"memory,omitempty"`
+}
+
+// SummaryCPU tracks cpu usage.
+type SummaryCPU struct {
+	Time           metav1.Time `json:"time"`
+	UsageNanoCores *uint64     `json:"usageNanoCores,omitempty"`
+}
+
+// SummaryMEM tracks memory usage.
+type SummaryMEM struct {
+	Time            metav1.Time `json:"time"`
+	WorkingSetBytes *uint64     `json:"workingSetBytes,omitempty"`
+}
+
+// FetchSummary retrieves a node stats summary from the kubelet via the
+// api server node proxy.
+func FetchSummary(ctx context.Context, dial kubernetes.Interface, node string) (*Summary, error) {
+	raw, err := dial.CoreV1().RESTClient().Get().
+		Resource("nodes").
+		Name(node).
+		SubResource("proxy", "stats", "summary").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var s Summary
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// FetchSummaries retrieves the stats summary of all ready nodes. Nodes
+// whose kubelet can't be reached are skipped.
+func FetchSummaries(ctx context.Context, dial kubernetes.Interface) ([]Summary, error) {
+	nos, err := dial.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mx  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, MaxSummaryFetches)
+		ss  = make([]Summary, 0, len(nos.Items))
+	)
+	for i := range nos.Items {
+		if !nodeReady(&nos.Items[i]) {
+			continue
+		}
+		wg.Add(1)
+		go func(n string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			s, err := FetchSummary(ctx, dial, n)
+			if err != nil {
+				log.Warn().Err(err).Msgf("Unable to fetch kubelet summary for node %q", n)
+				return
+			}
+			mx.Lock()
+			ss = append(ss, *s)
+			mx.Unlock()
+		}(nos.Items[i].Name)
+	}
+	wg.Wait()
+
+	return ss, nil
+}
+
+// SummaryNodeMetrics converts kubelet summaries to node metrics.
+func SummaryNodeMetrics(ss []Summary) *mv1beta1.NodeMetricsList {
+	mx := mv1beta1.NodeMetricsList{
+		Items: make([]mv1beta1.NodeMetrics, 0, len(ss)),
+	}
+	for _, s := range ss {
+		if s.Node.CPU == nil || s.Node.Memory == nil {
+			continue
+		}
+		mx.Items = append(mx.Items, mv1beta1.NodeMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: s.Node.NodeName},
+			Timestamp:  s.Node.CPU.Time,
+			Window:     metav1.Duration{Duration: summaryWindow},
+			Usage:      summaryUsage(s.Node.CPU, s.Node.Memory),
+		})
+	}
+
+	return &mx
+}
+
+// SummaryPodMetrics converts kubelet summaries to pod metrics for a given
+// namespace.
+func SummaryPodMetrics(ss []Summary, ns string) *mv1beta1.PodMetricsList {
+	mx := new(mv1beta1.PodMetricsList)
+	for _, s := range ss {
+		for _, p := range s.Pods {
+			if !IsAllNamespaces(ns) && p.PodRef.Namespace != ns {
+				continue
+			}
+			pmx := mv1beta1.PodMetrics{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: p.PodRef.Namespace,
+					Name:      p.PodRef.Name,
+				},
+				Window:     metav1.Duration{Duration: summaryWindow},
+				Containers: make([]mv1beta1.ContainerMetrics, 0, len(p.Containers)),
+			}
+			for _, c := range p.Containers {
+				if c.CPU == nil || c.Memory == nil {
+					continue
+				}
+				pmx.Timestamp = c.CPU.Time
+				pmx.Containers = append(pmx.Containers, mv1beta1.ContainerMetrics{
+					Name:  c.Name,
+					Usage: summaryUsage(c.CPU, c.Memory),
+				})
+			}
+			mx.Items = append(mx.Items, pmx)
+		}
+	}
+
+	return mx
+}
+
+func summaryUsage(cpu *SummaryCPU, mem *SummaryMEM) v1.ResourceList {
+	var c, m uint64
+	if cpu.UsageNanoCores != nil {
+		c = *cpu.UsageNanoCores
+	}
+	if mem.WorkingSetBytes != nil {
+		m = *mem.WorkingSetBytes
+	}
+
+	return v1.ResourceList{
+		v1.ResourceCPU:    *resource.NewScaledQuantity(int64(c), resource.Nano),
+		v1.ResourceMemory: *resource.NewQuantity(int64(m), resource.BinarySI),
+	}
+}
+
+func nodeReady(no *v1.Node) bool {
+	for _, c := range no.Status.Conditions {
+		if c.Type == v1.NodeReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+const summaryJSON = `{
+  "node": {
+    "nodeName": "n1",
+    "cpu": {"time": "2024-01-01T00:00:00Z", "usageNanoCores": 250000000},
+    "memory": {"time": "2024-01-01T00:00:00Z", "workingSetBytes": 2147483648}
+  },
+  "pods": [
+    {
+      "podRef": {"name": "p1", "namespace": "default"},
+      "containers": [
+        {
+          "name": "c1",
+          "cpu": {"time": "2024-01-01T00:00:00Z", "usageNanoCores": 10000000},
+          "memory": {"time": "2024-01-01T00:00:00Z", "workingSetBytes": 20971520}
+        },
+        {
+          "name": "c2",
+          "cpu": {"time": "2024-01-01T00:00:00Z", "usageNanoCores": 5000000},
+          "memory": {"time": "2024-01-01T00:00:00Z", "workingSetBytes": 10485760}
+        }
+      ]
+    },
+    {
+      "podRef": {"name": "p2", "namespace": "kube-system"},
+      "containers": [
+        {
+          "name": "c1",
+          "cpu": {"time": "2024-01-01T00:00:00Z", "usageNanoCores": 1000000},
+          "memory": {"time": "2024-01-01T00:00:00Z", "workingSetBytes": 1048576}
+        }
+      ]
+    }
+  ]
+}`
+
+func TestSummaryNodeMetrics(t *testing.T) {
+	var s client.Summary
+	assert.NoError(t, json.Unmarshal([]byte(summaryJSON), &s))
+
+	mx := client.SummaryNodeMetrics([]client.Summary{s, {}})
+	assert.Equal(t, 1, len(mx.Items))
+	assert.Equal(t, "n1", mx.Items[0].Name)
+	assert.Equal(t, int64(250), mx.Items[0].Usage.Cpu().MilliValue())
+	assert.Equal(t, int64(2048), client.ToMB(mx.Items[0].Usage.Memory().Value()))
+}
+
+func TestSummaryPodMetrics(t *testing.T) {
+	var s client.Summary
+	assert.NoError(t, json.Unmarshal([]byte(summaryJSON), &s))
+
+	uu := map[string]struct {
+		ns   string
+		pods int
+		cpu  int64
+		mem  int64
+	}{
+		"all": {
+			ns:   client.BlankNamespace,
+			pods: 2,
+			cpu:  16,
+			mem:  31,
+		},
+		"ns": {
+			ns:   "default",
+			pods: 1,
+			cpu:  15,
+			mem:  30,
+		},
+		"none": {
+			ns: "fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			mx := client.SummaryPodMetrics([]client.Summary{s}, u.ns)
+			assert.Equal(t, u.pods, len(mx.Items))
+
+			mmx := make(client.PodsMetrics)
+			client.NewMetricsServer(nil).PodsMetrics(mx, mmx)
+			var cpu, mem int64
+			for _, m := range mmx {
+				cpu += m.CurrentCPU
+				mem += m.CurrentMEM
+			}
+			assert.Equal(t, u.cpu, cpu)
+			assert.Equal(t, u.mem, mem)
+		})
+	}
+}
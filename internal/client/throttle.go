@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	maxThrottleFactor = 8
+	throttleCoolDown  = time.Minute
+)
+
+// Throttle tracks api server push backs to adapt k9s refresh rates.
+var Throttle = NewThrottler()
+
+// Throttler backs off refresh rates when the api server throttles k9s.
+type Throttler struct {
+	adaptive bool
+	factor   time.Duration
+	last     time.Time
+	mx       sync.Mutex
+}
+
+// NewThrottler returns a new instance.
+func NewThrottler() *Throttler {
+	return &Throttler{factor: 1}
+}
+
+// SetAdaptive toggles adaptive refresh rates.
+func (t *Throttler) SetAdaptive(b bool) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.adaptive, t.factor = b, 1
+}
+
+// Throttled records an api server push back.
+func (t *Throttler) Throttled(now time.Time) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if t.factor < maxThrottleFactor {
+		t.factor *= 2
+	}
+	t.last = now
+}
+
+// Scale returns a refresh rate adjusted for recent api server push backs.
+func (t *Throttler) Scale(d time.Duration) time.Duration {
+	return t.scale(d, time.Now())
+}
+
+func (t *Throttler) scale(d time.Duration, now time.Time) time.Duration {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if !t.adaptive {
+		return d
+	}
+	if now.Sub(t.last) > throttleCoolDown {
+		t.factor = 1
+	}
+
+	return d * t.factor
+}
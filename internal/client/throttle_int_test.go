@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThrottlerScale(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		adaptive bool
+		hits     int
+		at       time.Time
+		e        time.Duration
+	}{
+		"off": {
+			hits: 2,
+			at:   now,
+			e:    2 * time.Second,
+		},
+		"quiet": {
+			adaptive: true,
+			at:       now,
+			e:        2 * time.Second,
+		},
+		"throttled": {
+			adaptive: true,
+			hits:     2,
+			at:       now,
+			e:        8 * time.Second,
+		},
+		"capped": {
+			adaptive: true,
+			hits:     10,
+			at:       now,
+			e:        16 * time.Second,
+		},
+		"cooled": {
+			adaptive: true,
+			hits:     2,
+			at:       now.Add(2 * throttleCoolDown),
+			e:        2 * time.Second,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			th := NewThrottler()
+			th.SetAdaptive(u.adaptive)
+			for i := 0; i < u.hits; i++ {
+				th.Throttled(now)
+			}
+			assert.Equal(t, u.e, th.scale(2*time.Second, u.at))
+		})
+	}
+}
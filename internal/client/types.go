@@ -117,9 +117,21 @@ type Connection interface {
 	// DynDial connects to dynamic client.
 	DynDial() (dynamic.Interface, error)
 
-	// HasMetrics checks if metrics server is available.
+	// HasMetrics checks if cluster metrics are available.
 	HasMetrics() bool
 
+	// HasMetricsServer checks if metrics server is available.
+	HasMetricsServer() bool
+
+	// SetRateLimit sets the client qps and burst.
+	SetRateLimit(qps float32, burst int)
+
+	// SetProxy sets the api server proxy or ssh bastion.
+	SetProxy(ProxySpec) error
+
+	// SetLowBandwidth toggles low bandwidth api calls.
+	SetLowBandwidth(bool)
+
 	// ValidNamespaceNames returns all available namespace names.
 	ValidNamespaceNames() (NamespaceNames, error)
 
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// MergePatch represents a json merge patch.
+	MergePatch = "merge"
+
+	// JSONPatch represents a json patch.
+	JSONPatch = "json"
+
+	// StrategicPatch represents a strategic merge patch.
+	StrategicPatch = "strategic"
+)
+
+// Actions represents a collection of quick actions.
+type Actions struct {
+	Actions map[string]Action `yaml:"actions"`
+}
+
+// Action describes a resource quick action. An action either patches the
+// resource or toggles a boolean field.
+type Action struct {
+	ShortCut    string   `yaml:"shortCut"`
+	Override    bool     `yaml:"override"`
+	Description string   `yaml:"description"`
+	Scopes      []string `yaml:"scopes"`
+	Confirm     bool     `yaml:"confirm"`
+	Patch       string   `yaml:"patch"`
+	PatchType   string   `yaml:"patchType"`
+	Subresource string   `yaml:"subresource"`
+	Toggle      string   `yaml:"toggle"`
+}
+
+// Validate checks an action is well formed.
+func (a Action) Validate() error {
+	if (a.Patch == "") == (a.Toggle == "") {
+		return fmt.Errorf("action %q must specify either a patch or a toggle", a.ShortCut)
+	}
+
+	return nil
+}
+
+// GetPatchType returns the action patch type.
+func (a Action) GetPatchType() string {
+	if a.PatchType == "" {
+		return MergePatch
+	}
+
+	return a.PatchType
+}
+
+// NewActions returns a new instance.
+func NewActions() Actions {
+	return Actions{
+		Actions: make(map[string]Action),
+	}
+}
+
+// Load K9s quick actions.
+func (a Actions) Load(path string) error {
+	if err := a.LoadActions(AppActionsFile); err != nil {
+		return err
+	}
+
+	return a.LoadActions(path)
+}
+
+// LoadActions loads quick actions from a given file.
+func (a Actions) LoadActions(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.ActionsSchema, bb); err != nil {
+		return fmt.Errorf("validation failed for %q: %w", path, err)
+	}
+
+	var aa Actions
+	if err := yaml.Unmarshal(bb, &aa); err != nil {
+		return err
+	}
+	var errs error
+	for k, v := range aa.Actions {
+		if err := v.Validate(); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		a.Actions[k] = v
+	}
+
+	return errs
+}
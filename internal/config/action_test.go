@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionsLoad(t *testing.T) {
+	a := config.NewActions()
+	assert.Error(t, a.LoadActions("testdata/actions/actions.yaml"))
+
+	assert.Equal(t, 2, len(a.Actions))
+
+	s, ok := a.Actions["suspend"]
+	assert.True(t, ok)
+	assert.Equal(t, "Shift-Z", s.ShortCut)
+	assert.Equal(t, "spec.suspend", s.Toggle)
+	assert.True(t, s.Confirm)
+	assert.Equal(t, config.MergePatch, s.GetPatchType())
+
+	p, ok := a.Actions["pause"]
+	assert.True(t, ok)
+	assert.Equal(t, `{"spec":{"paused":true}}`, p.Patch)
+	assert.Equal(t, []string{"dp"}, p.Scopes)
+}
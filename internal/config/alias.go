@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"slices"
 	"sync"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/config/json"
 	"github.com/rs/zerolog/log"
@@ -24,14 +26,19 @@ type ShortNames map[string][]string
 
 // Aliases represents a collection of aliases.
 type Aliases struct {
-	Alias Alias `yaml:"aliases"`
-	mx    sync.RWMutex
+	Alias      Alias    `yaml:"aliases"`
+	Precedence []string `yaml:"precedence,omitempty"`
+	pinned     map[string]struct{}
+	collisions map[string][]string
+	mx         sync.RWMutex
 }
 
 // NewAliases return a new alias.
 func NewAliases() *Aliases {
 	return &Aliases{
-		Alias: make(Alias, 50),
+		Alias:      make(Alias, 50),
+		pinned:     make(map[string]struct{}),
+		collisions: make(map[string][]string),
 	}
 }
 
@@ -86,6 +93,74 @@ func (a *Aliases) Clear() {
 	for k := range a.Alias {
 		delete(a.Alias, k)
 	}
+	for k := range a.pinned {
+		delete(a.pinned, k)
+	}
+	for k := range a.collisions {
+		delete(a.collisions, k)
+	}
+	a.Precedence = nil
+}
+
+// IsPinned checks if an alias is a builtin or was set in an aliases file.
+func (a *Aliases) IsPinned(alias string) bool {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	_, ok := a.pinned[alias]
+
+	return ok
+}
+
+// Collisions returns all the resources claiming a given alias or nil if
+// the alias is unique.
+func (a *Aliases) Collisions(alias string) []string {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	return append([]string(nil), a.collisions[alias]...)
+}
+
+// Preferred returns the precedence rule ranking a resource or -1 if none.
+// Rules name either an api group or a full gvr.
+func (a *Aliases) Preferred(gvr string) int {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	return a.rank(gvr)
+}
+
+func (a *Aliases) rank(gvr string) int {
+	g := client.NewGVR(gvr).G()
+	for i, p := range a.Precedence {
+		if p == g || p == gvr {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// precedes checks if a resource wins an alias over another per the
+// precedence rules.
+func (a *Aliases) precedes(gvr, cur string) bool {
+	r1, r2 := a.rank(gvr), a.rank(cur)
+
+	return r1 >= 0 && (r2 < 0 || r1 < r2)
+}
+
+func (a *Aliases) collide(alias, cur, gvr string) {
+	if a.collisions == nil {
+		a.collisions = make(map[string][]string)
+	}
+	cc, ok := a.collisions[alias]
+	if !ok {
+		cc = []string{cur}
+	}
+	if !slices.Contains(cc, gvr) {
+		cc = append(cc, gvr)
+	}
+	a.collisions[alias] = cc
 }
 
 // Get retrieves an alias.
@@ -108,10 +183,18 @@ func (a *Aliases) Define(gvr string, aliases ...string) {
 	}
 
 	for _, alias := range aliases {
-		if _, ok := a.Alias[alias]; ok {
+		cur, ok := a.Alias[alias]
+		if !ok {
+			a.Alias[alias] = gvr
+			continue
+		}
+		if _, ok := a.pinned[alias]; ok || cur == gvr {
 			continue
 		}
-		a.Alias[alias] = gvr
+		a.collide(alias, cur, gvr)
+		if a.precedes(gvr, cur) {
+			a.Alias[alias] = gvr
+		}
 	}
 }
 
@@ -158,16 +241,28 @@ func (a *Aliases) LoadFile(path string) error {
 	defer a.mx.Unlock()
 	for k, v := range aa.Alias {
 		a.Alias[k] = v
+		a.pin(k)
 	}
+	// Files loaded last, ie context ones, rank first.
+	a.Precedence = append(aa.Precedence, a.Precedence...)
 
 	return nil
 }
 
 func (a *Aliases) declare(key string, aliases ...string) {
 	a.Alias[key] = key
+	a.pin(key)
 	for _, alias := range aliases {
 		a.Alias[alias] = key
+		a.pin(alias)
+	}
+}
+
+func (a *Aliases) pin(alias string) {
+	if a.pinned == nil {
+		a.pinned = make(map[string]struct{})
 	}
+	a.pinned[alias] = struct{}{}
 }
 
 func (a *Aliases) loadDefaultAliases() {
@@ -190,6 +285,13 @@ func (a *Aliases) loadDefaultAliases() {
 	a.declare("pulses", "pulse", "pu", "hz")
 	a.declare("xrays", "xray", "x")
 	a.declare("workloads", "workload", "wk")
+	a.declare("finalizers", "finalizer", "fin", "stuck")
+	a.declare("changes", "change", "chg")
+	a.declare("inventory", "inv")
+	a.declare("fleet", "fleets", "fl")
+	a.declare("tlsexpiry", "tlsexp", "certexpiry")
+	a.declare("dnsrecords", "dnsrecord", "dnsrec", "extdns")
+	a.declare("templates", "template", "tpl", "create")
 }
 
 // Save alias to disk.
@@ -106,12 +106,69 @@ func TestAliasDefine(t *testing.T) {
 	}
 }
 
+func TestAliasCollisions(t *testing.T) {
+	uu := map[string]struct {
+		precedence []string
+		gvr        string
+		collisions []string
+	}{
+		"first-wins": {
+			gvr:        "a.io/v1/cfgs",
+			collisions: []string{"a.io/v1/cfgs", "b.io/v1/cfgs"},
+		},
+		"group-rule": {
+			precedence: []string{"b.io"},
+			gvr:        "b.io/v1/cfgs",
+			collisions: []string{"a.io/v1/cfgs", "b.io/v1/cfgs"},
+		},
+		"gvr-rule": {
+			precedence: []string{"c.io/v1/cfgs", "b.io"},
+			gvr:        "c.io/v1/cfgs",
+			collisions: []string{"a.io/v1/cfgs", "b.io/v1/cfgs", "c.io/v1/cfgs"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := config.NewAliases()
+			a.Precedence = u.precedence
+			for _, c := range u.collisions {
+				a.Define(c, "cfg")
+			}
+			gvr, ok := a.Get("cfg")
+			assert.True(t, ok)
+			assert.Equal(t, u.gvr, gvr)
+			assert.Equal(t, u.collisions, a.Collisions("cfg"))
+			assert.False(t, a.IsPinned("cfg"))
+		})
+	}
+}
+
+func TestAliasPinned(t *testing.T) {
+	f := path.Join(t.TempDir(), "aliases.yaml")
+	raw := "aliases:\n  cfg: a.io/v1/cfgs\nprecedence:\n  - b.io\n"
+	assert.NoError(t, os.WriteFile(f, []byte(raw), data.DefaultFileMod))
+
+	a := config.NewAliases()
+	assert.NoError(t, a.LoadFile(f))
+	a.Define("b.io/v1/cfgs", "cfg", "bcfg")
+
+	gvr, _ := a.Get("cfg")
+	assert.Equal(t, "a.io/v1/cfgs", gvr)
+	assert.True(t, a.IsPinned("cfg"))
+	assert.Nil(t, a.Collisions("cfg"))
+	assert.False(t, a.IsPinned("bcfg"))
+	assert.Equal(t, 0, a.Preferred("b.io/v1/cfgs"))
+	assert.Equal(t, -1, a.Preferred("a.io/v1/cfgs"))
+}
+
 func TestAliasesLoad(t *testing.T) {
 	config.AppConfigDir = "testdata/aliases"
 	a := config.NewAliases()
 
 	assert.Nil(t, a.Load(path.Join(config.AppConfigDir, "plain.yaml")))
-	assert.Equal(t, 54, len(a.Alias))
+	assert.Equal(t, 77, len(a.Alias))
 }
 
 func TestAliasesSave(t *testing.T) {
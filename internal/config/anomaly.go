@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// DefaultAnomalySensitivity tracks the default number of standard
+// deviations off baseline flagging an anomaly.
+const DefaultAnomalySensitivity = 3.0
+
+// Anomalies tracks namespaces activity baselines learning options.
+type Anomalies struct {
+	// Enable learns namespaces baselines and highlights deviating namespaces.
+	Enable bool `json:"enable" yaml:"enable,omitempty"`
+
+	// Sensitivity tracks how many standard deviations off baseline flag an anomaly.
+	Sensitivity float64 `json:"sensitivity" yaml:"sensitivity,omitempty"`
+}
+
+// GetSensitivity returns the anomaly threshold in standard deviations.
+func (a Anomalies) GetSensitivity() float64 {
+	if a.Sensitivity <= 0 {
+		return DefaultAnomalySensitivity
+	}
+
+	return a.Sensitivity
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnomaliesGetSensitivity(t *testing.T) {
+	uu := map[string]struct {
+		a config.Anomalies
+		e float64
+	}{
+		"default": {
+			e: config.DefaultAnomalySensitivity,
+		},
+		"negative": {
+			a: config.Anomalies{Sensitivity: -1},
+			e: config.DefaultAnomalySensitivity,
+		},
+		"custom": {
+			a: config.Anomalies{Sensitivity: 2.5},
+			e: 2.5,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.a.GetSensitivity())
+		})
+	}
+}
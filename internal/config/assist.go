@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// OpenAIProvider represents an OpenAI compatible chat completions api
+	// ie OpenAI, vLLM, llama.cpp or LM Studio.
+	OpenAIProvider = "openai"
+
+	// OllamaProvider represents an ollama chat api.
+	OllamaProvider = "ollama"
+
+	// AssistDefaultPrompt tracks the fallback prompt template key.
+	AssistDefaultPrompt = "default"
+
+	defaultAssistTimeout  = time.Minute
+	defaultAssistLogLines = 100
+
+	defaultAssistPrompt = `You are a Kubernetes expert helping an operator troubleshoot a cluster.
+Explain what is wrong with the following {{.Kind}} {{.Path}} if anything, the likely root causes and how to fix them.
+Be concise.
+
+Manifest:
+{{.YAML}}
+Events:
+{{.Events}}
+{{- if .Logs}}
+Recent logs:
+{{.Logs}}
+{{- end}}`
+)
+
+// Assist tracks a user provided LLM endpoint used to diagnose resources.
+type Assist struct {
+	// Enable opts in sending resources manifests, events and logs to the endpoint.
+	Enable bool `json:"enable" yaml:"enable,omitempty"`
+
+	// Provider tracks the endpoint api flavor ie openai or ollama.
+	Provider string `json:"provider" yaml:"provider,omitempty"`
+
+	// URL tracks the endpoint base url.
+	URL string `json:"url" yaml:"url,omitempty"`
+
+	// Model tracks the model name.
+	Model string `json:"model" yaml:"model,omitempty"`
+
+	// APIKeyEnv tracks the env var holding the api key. Keys are never stored in the config.
+	APIKeyEnv string `json:"apiKeyEnv" yaml:"apiKeyEnv,omitempty"`
+
+	// Timeout tracks how long to wait on a response.
+	Timeout string `json:"timeout" yaml:"timeout,omitempty"`
+
+	// LogLines tracks the number of pod logs lines sent per container.
+	LogLines int `json:"logLines" yaml:"logLines,omitempty"`
+
+	// Redact lists regular expressions masked before anything is sent.
+	Redact []string `json:"redact" yaml:"redact,omitempty"`
+
+	// Prompts tracks go templates prompts keyed by lower case resource kind.
+	Prompts map[string]string `json:"prompts" yaml:"prompts,omitempty"`
+}
+
+// IsEnabled checks if the assistant is opted in and configured.
+func (a Assist) IsEnabled() bool {
+	return a.Enable && a.URL != ""
+}
+
+// GetProvider returns the endpoint api flavor.
+func (a Assist) GetProvider() string {
+	if a.Provider == "" {
+		return OpenAIProvider
+	}
+
+	return a.Provider
+}
+
+// GetTimeout returns how long to wait on a response.
+func (a Assist) GetTimeout() time.Duration {
+	d, err := time.ParseDuration(a.Timeout)
+	if err != nil || d <= 0 {
+		return defaultAssistTimeout
+	}
+
+	return d
+}
+
+// GetLogLines returns the number of logs lines to send per container.
+func (a Assist) GetLogLines() int64 {
+	if a.LogLines <= 0 {
+		return defaultAssistLogLines
+	}
+
+	return int64(a.LogLines)
+}
+
+// APIKey returns the endpoint api key if any.
+func (a Assist) APIKey() string {
+	if a.APIKeyEnv == "" {
+		return ""
+	}
+
+	return os.Getenv(a.APIKeyEnv)
+}
+
+// Prompt returns the prompt template for a given resource kind.
+func (a Assist) Prompt(kind string) string {
+	if p, ok := a.Prompts[strings.ToLower(kind)]; ok {
+		return p
+	}
+	if p, ok := a.Prompts[AssistDefaultPrompt]; ok {
+		return p
+	}
+
+	return defaultAssistPrompt
+}
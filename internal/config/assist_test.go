@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssistDefaults(t *testing.T) {
+	cfg := config.Assist{URL: "http://localhost:11434"}
+
+	assert.False(t, cfg.IsEnabled())
+	assert.Equal(t, config.OpenAIProvider, cfg.GetProvider())
+	assert.Equal(t, time.Minute, cfg.GetTimeout())
+	assert.Equal(t, int64(100), cfg.GetLogLines())
+	assert.Equal(t, "", cfg.APIKey())
+
+	cfg.Enable, cfg.APIKeyEnv = true, "K9S_TEST_ASSIST_KEY"
+	t.Setenv("K9S_TEST_ASSIST_KEY", "s3cr3t")
+	assert.True(t, cfg.IsEnabled())
+	assert.Equal(t, "s3cr3t", cfg.APIKey())
+}
+
+func TestAssistPrompt(t *testing.T) {
+	cfg := config.Assist{
+		Prompts: map[string]string{
+			"pod":                      "pod {{.Path}}",
+			config.AssistDefaultPrompt: "any {{.Path}}",
+		},
+	}
+
+	assert.Equal(t, "pod {{.Path}}", cfg.Prompt("Pod"))
+	assert.Equal(t, "any {{.Path}}", cfg.Prompt("Deployment"))
+	assert.Contains(t, config.Assist{}.Prompt("Pod"), "Kubernetes expert")
+}
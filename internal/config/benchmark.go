@@ -6,6 +6,7 @@ package config
 import (
 	"net/http"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
@@ -24,6 +25,7 @@ type (
 		Defaults   Benchmark              `yaml:"defaults"`
 		Services   map[string]BenchConfig `yam':"services"`
 		Containers map[string]BenchConfig `yam':"containers"`
+		Ingresses  map[string]BenchConfig `yaml:"ingresses"`
 	}
 
 	// Auth basic auth creds.
@@ -50,11 +52,12 @@ type (
 
 	// BenchConfig represents a service benchmark.
 	BenchConfig struct {
-		Name string
-		C    int  `yaml:"concurrency"`
-		N    int  `yaml:"requests"`
-		Auth Auth `yaml:"auth"`
-		HTTP HTTP `yaml:"http"`
+		Name     string
+		C        int           `yaml:"concurrency"`
+		N        int           `yaml:"requests"`
+		Duration time.Duration `yaml:"duration"`
+		Auth     Auth          `yaml:"auth"`
+		HTTP     HTTP          `yaml:"http"`
 	}
 )
 
@@ -6,6 +6,7 @@ package config
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -191,3 +192,15 @@ func TestBenchContainerLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestBenchIngressLoad(t *testing.T) {
+	b, err := NewBench("testdata/benchmarks/b_ingresses.yaml")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(b.Benchmarks.Ingresses))
+
+	ing := b.Benchmarks.Ingresses["default/nginx"]
+	assert.Equal(t, 5, ing.C)
+	assert.Equal(t, 30*time.Second, ing.Duration)
+	assert.Equal(t, "GET", ing.HTTP.Method)
+	assert.Equal(t, "/health", ing.HTTP.Path)
+}
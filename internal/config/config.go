@@ -42,6 +42,26 @@ func (c *Config) ContextHotkeysPath() string {
 	return AppContextHotkeysFile(ct.ClusterName, c.K9s.activeContextName)
 }
 
+// ContextActionsPath returns a context specific quick actions file spec.
+func (c *Config) ContextActionsPath() string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextActionsFile(ct.GetClusterName(), c.K9s.activeContextName)
+}
+
+// ContextOpenersPath returns a context specific openers file spec.
+func (c *Config) ContextOpenersPath() string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextOpenersFile(ct.GetClusterName(), c.K9s.activeContextName)
+}
+
 // ContextAliasesPath returns a context specific aliases file spec.
 func (c *Config) ContextAliasesPath() string {
 	ct, err := c.K9s.ActiveContext()
@@ -52,6 +72,64 @@ func (c *Config) ContextAliasesPath() string {
 	return AppContextAliasesFile(ct.GetClusterName(), c.K9s.activeContextName)
 }
 
+// ContextDiscoveryPath returns a context specific discovery cache file spec
+// when running air-gapped.
+func (c *Config) ContextDiscoveryPath() string {
+	if !c.K9s.AirGapped {
+		return ""
+	}
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextDiscoveryFile(ct.GetClusterName(), c.K9s.activeContextName)
+}
+
+// RateLimit returns the active context api server budget.
+func (c *Config) RateLimit() data.RateLimit {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil || ct.RateLimit == nil {
+		return data.RateLimit{}
+	}
+
+	return *ct.RateLimit
+}
+
+// Login returns the active context login command if any.
+func (c *Config) Login() *data.Login {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return nil
+	}
+
+	return ct.Login
+}
+
+// LowBandwidth returns the active context low bandwidth settings if any.
+func (c *Config) LowBandwidth() *data.LowBandwidth {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return nil
+	}
+
+	return ct.LowBandwidth
+}
+
+// ToggleLowBandwidth toggles the active context low bandwidth mode.
+func (c *Config) ToggleLowBandwidth() (bool, error) {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return false, err
+	}
+	if ct.LowBandwidth == nil {
+		ct.LowBandwidth = new(data.LowBandwidth)
+	}
+	ct.LowBandwidth.Enabled = !ct.LowBandwidth.Enabled
+
+	return ct.LowBandwidth.Enabled, nil
+}
+
 // ContextPluginsPath returns a context specific plugins file spec.
 func (c *Config) ContextPluginsPath() (string, error) {
 	ct, err := c.K9s.ActiveContext()
@@ -62,6 +140,26 @@ func (c *Config) ContextPluginsPath() (string, error) {
 	return AppContextPluginsFile(ct.GetClusterName(), c.K9s.activeContextName), nil
 }
 
+// ContextStashDir returns a context specific data stash directory.
+func (c *Config) ContextStashDir() (string, error) {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return "", err
+	}
+
+	return AppContextStashDir(ct.GetClusterName(), c.K9s.activeContextName), nil
+}
+
+// ContextBaselinesFile returns a context specific activity baselines file.
+func (c *Config) ContextBaselinesFile() (string, error) {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return "", err
+	}
+
+	return AppContextBaselinesFile(ct.GetClusterName(), c.K9s.activeContextName), nil
+}
+
 // Refine the configuration based on cli args.
 func (c *Config) Refine(flags *genericclioptions.ConfigFlags, k9sFlags *Flags, cfg *client.Config) error {
 	if flags == nil {
@@ -13,13 +13,17 @@ import (
 
 // Context tracks K9s context configuration.
 type Context struct {
-	ClusterName        string       `yaml:"cluster,omitempty"`
-	ReadOnly           *bool        `yaml:"readOnly,omitempty"`
-	Skin               string       `yaml:"skin,omitempty"`
-	Namespace          *Namespace   `yaml:"namespace"`
-	View               *View        `yaml:"view"`
-	FeatureGates       FeatureGates `yaml:"featureGates"`
-	PortForwardAddress string       `yaml:"portForwardAddress"`
+	ClusterName        string        `yaml:"cluster,omitempty"`
+	ReadOnly           *bool         `yaml:"readOnly,omitempty"`
+	Skin               string        `yaml:"skin,omitempty"`
+	Namespace          *Namespace    `yaml:"namespace"`
+	View               *View         `yaml:"view"`
+	FeatureGates       FeatureGates  `yaml:"featureGates"`
+	PortForwardAddress string        `yaml:"portForwardAddress"`
+	RateLimit          *RateLimit    `yaml:"rateLimit,omitempty"`
+	Proxy              *Proxy        `yaml:"proxy,omitempty"`
+	Login              *Login        `yaml:"login,omitempty"`
+	LowBandwidth       *LowBandwidth `yaml:"lowBandwidth,omitempty"`
 	mx                 sync.RWMutex
 }
 
@@ -93,4 +97,11 @@ func (c *Context) Validate(conn client.Connection, ks KubeSettings) {
 		c.View = NewView()
 	}
 	c.View.Validate()
+
+	if c.RateLimit != nil {
+		c.RateLimit.Validate()
+	}
+	if c.LowBandwidth != nil {
+		c.LowBandwidth.Validate()
+	}
 }
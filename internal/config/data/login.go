@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+import "strings"
+
+// Login tracks a context interactive login command ie tsh kube login, kubelogin...
+type Login struct {
+	Command     string   `yaml:"command"`
+	Args        []string `yaml:"args,omitempty"`
+	Interactive bool     `yaml:"interactive,omitempty"`
+}
+
+// IsEmpty checks if a login command is configured.
+func (l *Login) IsEmpty() bool {
+	return l == nil || l.Command == ""
+}
+
+// String returns the login command line.
+func (l *Login) String() string {
+	if l.IsEmpty() {
+		return ""
+	}
+
+	return strings.TrimSpace(l.Command + " " + strings.Join(l.Args, " "))
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginString(t *testing.T) {
+	uu := map[string]struct {
+		l     *data.Login
+		empty bool
+		e     string
+	}{
+		"nil": {
+			empty: true,
+		},
+		"blank": {
+			l:     &data.Login{Args: []string{"fred"}},
+			empty: true,
+		},
+		"plain": {
+			l: &data.Login{Command: "tsh", Args: []string{"kube", "login", "fred"}},
+			e: "tsh kube login fred",
+		},
+		"no-args": {
+			l: &data.Login{Command: "kubelogin"},
+			e: "kubelogin",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.empty, u.l.IsEmpty())
+			assert.Equal(t, u.e, u.l.String())
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+import "time"
+
+const (
+	// DefaultLowBandwidthRefreshRate tracks the slowest refresh rate in
+	// seconds used in low bandwidth mode.
+	DefaultLowBandwidthRefreshRate = 10
+
+	// DefaultLowBandwidthEvictAfter tracks how long idle watches are kept
+	// in low bandwidth mode.
+	DefaultLowBandwidthEvictAfter = 2 * time.Minute
+)
+
+// LowBandwidth tracks a context low bandwidth mode for edge clusters
+// reached over slow links.
+type LowBandwidth struct {
+	Enabled     bool   `yaml:"enabled"`
+	RefreshRate int    `yaml:"refreshRate,omitempty"`
+	EvictAfter  string `yaml:"evictAfter,omitempty"`
+}
+
+// IsEnabled checks if low bandwidth mode is on.
+func (l *LowBandwidth) IsEnabled() bool {
+	return l != nil && l.Enabled
+}
+
+// Validate ensures the settings are sound.
+func (l *LowBandwidth) Validate() {
+	if l.RefreshRate < 0 {
+		l.RefreshRate = 0
+	}
+	if d, err := time.ParseDuration(l.EvictAfter); err != nil || d < 0 {
+		l.EvictAfter = ""
+	}
+}
+
+// GetRefreshRate returns a refresh rate in seconds slowed down to the low
+// bandwidth rate when enabled.
+func (l *LowBandwidth) GetRefreshRate(rate int) int {
+	if !l.IsEnabled() {
+		return rate
+	}
+	floor := l.RefreshRate
+	if floor == 0 {
+		floor = DefaultLowBandwidthRefreshRate
+	}
+
+	return max(rate, floor)
+}
+
+// GetEvictAfter returns how long idle watches are kept. Low bandwidth mode
+// drops them sooner to cut down watch traffic.
+func (l *LowBandwidth) GetEvictAfter(d time.Duration) time.Duration {
+	if !l.IsEnabled() {
+		return d
+	}
+	evict := DefaultLowBandwidthEvictAfter
+	if e, err := time.ParseDuration(l.EvictAfter); err == nil && e > 0 {
+		evict = e
+	}
+	if d > 0 && d < evict {
+		return d
+	}
+
+	return evict
+}
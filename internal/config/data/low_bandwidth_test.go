@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLowBandwidthValidate(t *testing.T) {
+	l := data.LowBandwidth{RefreshRate: -1, EvictAfter: "blee"}
+	l.Validate()
+
+	assert.Equal(t, data.LowBandwidth{}, l)
+}
+
+func TestLowBandwidthGetRefreshRate(t *testing.T) {
+	uu := map[string]struct {
+		l       *data.LowBandwidth
+		rate, e int
+	}{
+		"none": {
+			rate: 2,
+			e:    2,
+		},
+		"disabled": {
+			l:    &data.LowBandwidth{RefreshRate: 30},
+			rate: 2,
+			e:    2,
+		},
+		"default": {
+			l:    &data.LowBandwidth{Enabled: true},
+			rate: 2,
+			e:    data.DefaultLowBandwidthRefreshRate,
+		},
+		"custom": {
+			l:    &data.LowBandwidth{Enabled: true, RefreshRate: 30},
+			rate: 2,
+			e:    30,
+		},
+		"slower": {
+			l:    &data.LowBandwidth{Enabled: true},
+			rate: 60,
+			e:    60,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.l.GetRefreshRate(u.rate))
+		})
+	}
+}
+
+func TestLowBandwidthGetEvictAfter(t *testing.T) {
+	uu := map[string]struct {
+		l    *data.LowBandwidth
+		d, e time.Duration
+	}{
+		"none": {
+			d: time.Hour,
+			e: time.Hour,
+		},
+		"never": {
+			l: &data.LowBandwidth{Enabled: true},
+			e: data.DefaultLowBandwidthEvictAfter,
+		},
+		"custom": {
+			l: &data.LowBandwidth{Enabled: true, EvictAfter: "5m"},
+			d: time.Hour,
+			e: 5 * time.Minute,
+		},
+		"sooner": {
+			l: &data.LowBandwidth{Enabled: true},
+			d: time.Minute,
+			e: time.Minute,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.l.GetEvictAfter(u.d))
+		})
+	}
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+import "github.com/derailed/k9s/internal/client"
+
+// Proxy tracks how to reach a context api server. When set, the bastion
+// takes precedence over the proxy url.
+type Proxy struct {
+	URL        string   `yaml:"url,omitempty"`
+	SSHBastion string   `yaml:"sshBastion,omitempty"`
+	SSHArgs    []string `yaml:"sshArgs,omitempty"`
+}
+
+// Spec returns the client proxy specification.
+func (p *Proxy) Spec() client.ProxySpec {
+	if p == nil {
+		return client.ProxySpec{}
+	}
+
+	return client.ProxySpec{
+		URL:         p.URL,
+		Bastion:     p.SSHBastion,
+		BastionArgs: p.SSHArgs,
+	}
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+// RateLimit tracks a context api server budget. Zero values use the
+// client defaults.
+type RateLimit struct {
+	QPS        float32 `yaml:"qps,omitempty"`
+	Burst      int     `yaml:"burst,omitempty"`
+	MaxWatches int     `yaml:"maxWatches,omitempty"`
+	Adaptive   bool    `yaml:"adaptive,omitempty"`
+}
+
+// Validate ensures the rate limits are sound.
+func (r *RateLimit) Validate() {
+	if r.QPS < 0 {
+		r.QPS = 0
+	}
+	if r.Burst < 0 {
+		r.Burst = 0
+	}
+	if r.QPS > 0 && r.Burst == 0 {
+		r.Burst = int(r.QPS)
+	}
+	if r.MaxWatches < 0 {
+		r.MaxWatches = 0
+	}
+}
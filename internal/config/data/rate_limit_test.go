@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitValidate(t *testing.T) {
+	uu := map[string]struct {
+		rl, e data.RateLimit
+	}{
+		"empty": {},
+		"negatives": {
+			rl: data.RateLimit{QPS: -1, Burst: -2, MaxWatches: -3},
+		},
+		"burst": {
+			rl: data.RateLimit{QPS: 10},
+			e:  data.RateLimit{QPS: 10, Burst: 10},
+		},
+		"full": {
+			rl: data.RateLimit{QPS: 5, Burst: 20, MaxWatches: 10, Adaptive: true},
+			e:  data.RateLimit{QPS: 5, Burst: 20, MaxWatches: 10, Adaptive: true},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.rl.Validate()
+			assert.Equal(t, u.e, u.rl)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// Export tracks clean export field rules. Rules are [Kind:]path[=value] ie
+// Service:spec.clusterIP or spec.replicas=1.
+type Export struct {
+	// Strip lists extra fields to drop from clean exports.
+	Strip []string `json:"strip" yaml:"strip,omitempty"`
+
+	// Keep lists default rules to disable.
+	Keep []string `json:"keep" yaml:"keep,omitempty"`
+}
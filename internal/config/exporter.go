@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// DefaultExporterJob tracks the default pushgateway job name.
+const DefaultExporterJob = "k9s"
+
+// Exporter tracks metrics exporter options.
+type Exporter struct {
+	PushGateway string `json:"pushGateway" yaml:"pushGateway,omitempty"`
+	StatsD      string `json:"statsd" yaml:"statsd,omitempty"`
+	Job         string `json:"job" yaml:"job,omitempty"`
+}
+
+// IsEnabled checks if an export endpoint is configured.
+func (e Exporter) IsEnabled() bool {
+	return e.PushGateway != "" || e.StatsD != ""
+}
+
+// JobName returns the pushgateway job name.
+func (e Exporter) JobName() string {
+	if e.Job == "" {
+		return DefaultExporterJob
+	}
+
+	return e.Job
+}
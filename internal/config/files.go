@@ -42,6 +42,10 @@ var (
 	// hotkeysTpl tracks hotkeys default config template
 	hotkeysTpl []byte
 
+	//go:embed templates/tour.yaml
+	// tourTpl tracks the stock guided tour
+	tourTpl []byte
+
 	//go:embed templates/stock-skin.yaml
 	// stockSkinTpl tracks stock skin template
 	stockSkinTpl []byte
@@ -63,6 +67,9 @@ var (
 	// AppContextsDir tracks contexts data directory.
 	AppContextsDir string
 
+	// AppTemplatesDir tracks resource templates directory.
+	AppTemplatesDir string
+
 	// AppConfigFile tracks k9s config file.
 	AppConfigFile string
 
@@ -80,6 +87,21 @@ var (
 
 	// AppHotKeysFile tracks hotkeys config file.
 	AppHotKeysFile string
+
+	// AppActionsFile tracks quick actions config file.
+	AppActionsFile string
+
+	// AppOpenersFile tracks openers config file.
+	AppOpenersFile string
+
+	// AppTourFile tracks custom guided tour file.
+	AppTourFile string
+
+	// AppLocalesDir tracks custom locale catalogs directory.
+	AppLocalesDir string
+
+	// AppTourStateFile tracks the last completed guided tour.
+	AppTourStateFile string
 )
 
 // InitLogLoc initializes K9s logs location.
@@ -140,12 +162,18 @@ func initK9sEnvLocs() error {
 	if err := data.EnsureFullPath(AppContextsDir, data.DefaultDirMod); err != nil {
 		log.Warn().Err(err).Msgf("Unable to create clusters dir: %s", AppContextsDir)
 	}
+	AppTemplatesDir = filepath.Join(AppConfigDir, "templates")
 
 	AppConfigFile = filepath.Join(AppConfigDir, data.MainConfigFile)
 	AppHotKeysFile = filepath.Join(AppConfigDir, "hotkeys.yaml")
 	AppAliasesFile = filepath.Join(AppConfigDir, "aliases.yaml")
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
+	AppActionsFile = filepath.Join(AppConfigDir, "actions.yaml")
+	AppOpenersFile = filepath.Join(AppConfigDir, "openers.yaml")
+	AppTourFile = filepath.Join(AppConfigDir, "tour.yaml")
+	AppLocalesDir = filepath.Join(AppConfigDir, "locales")
+	AppTourStateFile = filepath.Join(AppConfigDir, "tour-completed")
 
 	return nil
 }
@@ -167,11 +195,16 @@ func initXDGLocs() error {
 	AppAliasesFile = filepath.Join(AppConfigDir, "aliases.yaml")
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
+	AppActionsFile = filepath.Join(AppConfigDir, "actions.yaml")
+	AppOpenersFile = filepath.Join(AppConfigDir, "openers.yaml")
+	AppTourFile = filepath.Join(AppConfigDir, "tour.yaml")
+	AppLocalesDir = filepath.Join(AppConfigDir, "locales")
 
 	AppSkinsDir = filepath.Join(AppConfigDir, "skins")
 	if err := data.EnsureFullPath(AppSkinsDir, data.DefaultDirMod); err != nil {
 		log.Warn().Err(err).Msgf("No skins dir detected")
 	}
+	AppTemplatesDir = filepath.Join(AppConfigDir, "templates")
 
 	AppDumpsDir, err = xdg.StateFile(filepath.Join(AppName, "screen-dumps"))
 	if err != nil {
@@ -183,6 +216,11 @@ func initXDGLocs() error {
 		log.Warn().Err(err).Msgf("No benchmarks dir detected")
 	}
 
+	AppTourStateFile, err = xdg.StateFile(filepath.Join(AppName, "tour-completed"))
+	if err != nil {
+		log.Warn().Err(err).Msgf("No tour state file detected")
+	}
+
 	dataDir, err := xdg.DataFile(AppName)
 	if err != nil {
 		return err
@@ -215,6 +253,31 @@ func AppContextHotkeysFile(cluster, context string) string {
 	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "hotkeys.yaml")
 }
 
+// AppContextActionsFile generates a valid context specific quick actions file path.
+func AppContextActionsFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "actions.yaml")
+}
+
+// AppContextOpenersFile generates a valid context specific openers file path.
+func AppContextOpenersFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "openers.yaml")
+}
+
+// AppContextDiscoveryFile generates a valid context specific discovery cache file path.
+func AppContextDiscoveryFile(cluster, context string) string {
+	return filepath.Join(AppContextDir(cluster, context), "discovery.json")
+}
+
+// AppContextBaselinesFile generates a valid context specific activity baselines file path.
+func AppContextBaselinesFile(cluster, context string) string {
+	return filepath.Join(AppContextDir(cluster, context), "baselines.json")
+}
+
+// AppContextStashDir generates a valid context specific data stash directory.
+func AppContextStashDir(cluster, context string) string {
+	return filepath.Join(AppContextDir(cluster, context), "stash")
+}
+
 // AppContextConfig generates a valid context config file path.
 func AppContextConfig(cluster, context string) string {
 	return filepath.Join(AppContextDir(cluster, context), data.MainConfigFile)
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// Fleet tracks the contexts probed by the fleet view.
+type Fleet struct {
+	// Contexts lists the probed contexts. All contexts are probed when empty.
+	Contexts []string `json:"contexts" yaml:"contexts,omitempty"`
+}
+
+// Select returns the probed contexts among the given ones.
+func (f Fleet) Select(all []string) []string {
+	if len(f.Contexts) == 0 {
+		return all
+	}
+	known := make(map[string]struct{}, len(all))
+	for _, n := range all {
+		known[n] = struct{}{}
+	}
+	ss := make([]string, 0, len(f.Contexts))
+	for _, n := range f.Contexts {
+		if _, ok := known[n]; ok {
+			ss = append(ss, n)
+		}
+	}
+
+	return ss
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFleetSelect(t *testing.T) {
+	uu := map[string]struct {
+		f   config.Fleet
+		all []string
+		e   []string
+	}{
+		"all": {
+			all: []string{"c1", "c2"},
+			e:   []string{"c1", "c2"},
+		},
+		"selected": {
+			f:   config.Fleet{Contexts: []string{"c2", "c3"}},
+			all: []string{"c1", "c2"},
+			e:   []string{"c2"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.f.Select(u.all))
+		})
+	}
+}
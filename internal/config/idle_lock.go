@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	passphraseScheme = "scrypt"
+	saltLen          = 16
+	hashLen          = 32
+)
+
+// IdleLock tracks inactivity lock options.
+type IdleLock struct {
+	Timeout string `json:"timeout" yaml:"timeout,omitempty"`
+
+	// Passphrase holds the salted hash of the passphrase required to resume
+	// as produced by `k9s passphrase`.
+	Passphrase string `json:"passphrase" yaml:"passphrase,omitempty"`
+}
+
+// IsEnabled checks if the session should lock when idle.
+func (l IdleLock) IsEnabled() bool {
+	return l.GetTimeout() > 0
+}
+
+// GetTimeout returns the inactivity delay before locking or 0 if disabled.
+func (l IdleLock) GetTimeout() time.Duration {
+	d, err := time.ParseDuration(l.Timeout)
+	if err != nil || d < 0 {
+		return 0
+	}
+
+	return d
+}
+
+// CheckPassphrase checks a passphrase against the configured hash.
+func (l IdleLock) CheckPassphrase(p string) bool {
+	tokens := strings.Split(l.Passphrase, "$")
+	if len(tokens) != 3 || tokens[0] != passphraseScheme {
+		return false
+	}
+	salt, err := hex.DecodeString(tokens[1])
+	if err != nil {
+		return false
+	}
+	want, err := hex.DecodeString(tokens[2])
+	if err != nil {
+		return false
+	}
+	got, err := passphraseKey(p, salt)
+	if err != nil {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(got, want) == 1
+}
+
+// HashPassphrase returns a salted hash of the given passphrase.
+func HashPassphrase(p string) (string, error) {
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key, err := passphraseKey(p, salt)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s$%x$%x", passphraseScheme, salt, key), nil
+}
+
+func passphraseKey(p string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(p), salt, 1<<15, 8, 1, hashLen)
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdleLockTimeout(t *testing.T) {
+	uu := map[string]struct {
+		timeout string
+		e       time.Duration
+	}{
+		"none":     {},
+		"bad":      {timeout: "fred"},
+		"negative": {timeout: "-1m"},
+		"ok":       {timeout: "15m", e: 15 * time.Minute},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l := config.IdleLock{Timeout: u.timeout}
+			assert.Equal(t, u.e, l.GetTimeout())
+			assert.Equal(t, u.e > 0, l.IsEnabled())
+		})
+	}
+}
+
+func TestIdleLockPassphrase(t *testing.T) {
+	h, err := config.HashPassphrase("fred")
+	assert.NoError(t, err)
+	assert.NotContains(t, h, "fred")
+
+	l := config.IdleLock{Passphrase: h}
+	assert.True(t, l.CheckPassphrase("fred"))
+	assert.False(t, l.CheckPassphrase("blee"))
+
+	h1, err := config.HashPassphrase("fred")
+	assert.NoError(t, err)
+	assert.NotEqual(t, h, h1)
+
+	for _, p := range []string{"", "fred", "scrypt$zz$00", "bcrypt$00$00"} {
+		assert.False(t, config.IdleLock{Passphrase: p}.CheckPassphrase("fred"), p)
+	}
+}
@@ -28,6 +28,15 @@ const (
 	// HotkeysSchema describes hotkeys schema.
 	HotkeysSchema = "hotkeys.json"
 
+	// ActionsSchema describes quick actions schema.
+	ActionsSchema = "actions.json"
+
+	// OpenersSchema describes openers schema.
+	OpenersSchema = "openers.json"
+
+	// TourSchema describes guided tour schema.
+	TourSchema = "tour.json"
+
 	// K9sSchema describes k9s config schema.
 	K9sSchema = "k9s.json"
 
@@ -57,6 +66,15 @@ var (
 	//go:embed schemas/hotkeys.json
 	hotkeysSchema string
 
+	//go:embed schemas/actions.json
+	actionsSchema string
+
+	//go:embed schemas/openers.json
+	openersSchema string
+
+	//go:embed schemas/tour.json
+	tourSchema string
+
 	//go:embed schemas/skin.json
 	skinSchema string
 )
@@ -77,6 +95,9 @@ func NewValidator() *Validator {
 			ViewsSchema:   gojsonschema.NewStringLoader(viewsSchema),
 			PluginsSchema: gojsonschema.NewStringLoader(pluginSchema),
 			HotkeysSchema: gojsonschema.NewStringLoader(hotkeysSchema),
+			ActionsSchema: gojsonschema.NewStringLoader(actionsSchema),
+			OpenersSchema: gojsonschema.NewStringLoader(openersSchema),
+			TourSchema:    gojsonschema.NewStringLoader(tourSchema),
 			SkinSchema:    gojsonschema.NewStringLoader(skinSchema),
 		},
 	}
@@ -18,19 +18,38 @@ import (
 
 // K9s tracks K9s configuration options.
 type K9s struct {
-	LiveViewAutoRefresh bool       `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
-	ScreenDumpDir       string     `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
-	RefreshRate         int        `json:"refreshRate" yaml:"refreshRate"`
-	MaxConnRetry        int        `json:"maxConnRetry" yaml:"maxConnRetry"`
-	ReadOnly            bool       `json:"readOnly" yaml:"readOnly"`
-	NoExitOnCtrlC       bool       `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
-	UI                  UI         `json:"ui" yaml:"ui"`
-	SkipLatestRevCheck  bool       `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
-	DisablePodCounting  bool       `json:"disablePodCounting" yaml:"disablePodCounting"`
-	ShellPod            ShellPod   `json:"shellPod" yaml:"shellPod"`
-	ImageScans          ImageScans `json:"imageScans" yaml:"imageScans"`
-	Logger              Logger     `json:"logger" yaml:"logger"`
-	Thresholds          Threshold  `json:"thresholds" yaml:"thresholds"`
+	LiveViewAutoRefresh bool          `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
+	ScreenDumpDir       string        `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
+	RefreshRate         int           `json:"refreshRate" yaml:"refreshRate"`
+	MaxConnRetry        int           `json:"maxConnRetry" yaml:"maxConnRetry"`
+	ReadOnly            bool          `json:"readOnly" yaml:"readOnly"`
+	SupportMode         bool          `json:"supportMode" yaml:"supportMode,omitempty"`
+	AirGapped           bool          `json:"airGapped" yaml:"airGapped,omitempty"`
+	NamespaceScoped     bool          `json:"namespaceScoped" yaml:"namespaceScoped,omitempty"`
+	NoExitOnCtrlC       bool          `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
+	UI                  UI            `json:"ui" yaml:"ui"`
+	SkipLatestRevCheck  bool          `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
+	DisablePodCounting  bool          `json:"disablePodCounting" yaml:"disablePodCounting"`
+	ShellPod            ShellPod      `json:"shellPod" yaml:"shellPod"`
+	ImageScans          ImageScans    `json:"imageScans" yaml:"imageScans"`
+	Logger              Logger        `json:"logger" yaml:"logger"`
+	Thresholds          Threshold     `json:"thresholds" yaml:"thresholds"`
+	PulseAlarms         PulseAlarms   `json:"pulseAlarms" yaml:"pulseAlarms,omitempty"`
+	Exporter            Exporter      `json:"exporter" yaml:"exporter,omitempty"`
+	Clipboard           string        `json:"clipboard" yaml:"clipboard,omitempty"`
+	Tracing             Tracing       `json:"tracing" yaml:"tracing,omitempty"`
+	Notifications       Notifications `json:"notifications" yaml:"notifications,omitempty"`
+	IdleLock            IdleLock      `json:"idleLock" yaml:"idleLock,omitempty"`
+	Memory              Memory        `json:"memory" yaml:"memory,omitempty"`
+	Export              Export        `json:"export" yaml:"export,omitempty"`
+	Pipe                Pipe          `json:"pipe" yaml:"pipe,omitempty"`
+	StatusBar           StatusBar     `json:"statusBar" yaml:"statusBar,omitempty"`
+	Provenance          Provenance    `json:"provenance" yaml:"provenance,omitempty"`
+	Fleet               Fleet         `json:"fleet" yaml:"fleet,omitempty"`
+	Anomalies           Anomalies     `json:"anomalies" yaml:"anomalies,omitempty"`
+	Assist              Assist        `json:"assist" yaml:"assist,omitempty"`
+	Runbooks            Runbooks      `json:"runbooks" yaml:"runbooks,omitempty"`
+	StashSecrets        bool          `json:"stashSecrets" yaml:"stashSecrets,omitempty"`
 	manualRefreshRate   int
 	manualHeadless      *bool
 	manualLogoless      *bool
@@ -98,6 +117,9 @@ func (k *K9s) Merge(k1 *K9s) {
 	k.RefreshRate = k1.RefreshRate
 	k.MaxConnRetry = k1.MaxConnRetry
 	k.ReadOnly = k1.ReadOnly
+	k.SupportMode = k1.SupportMode
+	k.AirGapped = k1.AirGapped
+	k.NamespaceScoped = k1.NamespaceScoped
 	k.NoExitOnCtrlC = k1.NoExitOnCtrlC
 	k.UI = k1.UI
 	k.SkipLatestRevCheck = k1.SkipLatestRevCheck
@@ -108,6 +130,22 @@ func (k *K9s) Merge(k1 *K9s) {
 	if k1.Thresholds != nil {
 		k.Thresholds = k1.Thresholds
 	}
+	k.PulseAlarms = k1.PulseAlarms
+	k.Exporter = k1.Exporter
+	k.Clipboard = k1.Clipboard
+	k.Tracing = k1.Tracing
+	k.Notifications = k1.Notifications
+	k.IdleLock = k1.IdleLock
+	k.Memory = k1.Memory
+	k.Export = k1.Export
+	k.Pipe = k1.Pipe
+	k.StatusBar = k1.StatusBar
+	k.Provenance = k1.Provenance
+	k.Fleet = k1.Fleet
+	k.Anomalies = k1.Anomalies
+	k.Assist = k1.Assist
+	k.Runbooks = k1.Runbooks
+	k.StashSecrets = k1.StashSecrets
 }
 
 // AppScreenDumpDir fetch screen dumps dir.
@@ -230,6 +268,23 @@ func (k *K9s) ActivateContext(n string) (*data.Context, error) {
 	return k.getActiveConfig().Context, nil
 }
 
+// ContextProxy returns a given context proxy settings.
+func (k *K9s) ContextProxy(n string) (client.ProxySpec, error) {
+	ct, err := k.ks.GetContext(n)
+	if err != nil {
+		return client.ProxySpec{}, err
+	}
+	cfg, err := k.dir.Load(n, ct)
+	if err != nil {
+		return client.ProxySpec{}, err
+	}
+	if cfg.Context == nil {
+		return client.ProxySpec{}, nil
+	}
+
+	return cfg.Context.Proxy.Spec(), nil
+}
+
 // Reload reloads the context config from disk.
 func (k *K9s) Reload() error {
 	ct, err := k.ks.GetContext(k.getActiveContextName())
@@ -296,15 +351,32 @@ func (k *K9s) IsCrumbsless() bool {
 
 // GetRefreshRate returns the current refresh rate.
 func (k *K9s) GetRefreshRate() int {
+	rate := k.RefreshRate
 	if k.manualRefreshRate != 0 {
-		return k.manualRefreshRate
+		rate = k.manualRefreshRate
+	}
+	if cfg := k.getActiveConfig(); cfg != nil && cfg.Context != nil {
+		rate = cfg.Context.LowBandwidth.GetRefreshRate(rate)
 	}
 
-	return k.RefreshRate
+	return rate
+}
+
+// IsSupportMode checks if the restricted support profile is enabled.
+func (k *K9s) IsSupportMode() bool {
+	return k.SupportMode
 }
 
-// IsReadOnly returns the readonly setting.
+// TrimMenus checks if actions should be hidden when RBAC denies them.
+func (k *K9s) TrimMenus() bool {
+	return k.SupportMode || client.IsInCluster()
+}
+
+// IsReadOnly returns the readonly setting. The support profile is always read-only.
 func (k *K9s) IsReadOnly() bool {
+	if k.SupportMode {
+		return true
+	}
 	ro := k.ReadOnly
 	if cfg := k.getActiveConfig(); cfg != nil && cfg.Context.ReadOnly != nil {
 		ro = *cfg.Context.ReadOnly
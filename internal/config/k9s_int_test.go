@@ -11,9 +11,10 @@ import (
 
 func Test_k9sOverrides(t *testing.T) {
 	var (
-		true = true
-		cmd  = "po"
-		dir  = "/tmp/blee"
+		true  = true
+		false = !true
+		cmd   = "po"
+		dir   = "/tmp/blee"
 	)
 
 	uu := map[string]struct {
@@ -86,6 +87,15 @@ func Test_k9sOverrides(t *testing.T) {
 			ll:   true,
 			cl:   true,
 		},
+		"support": {
+			k: &K9s{
+				RefreshRate:    10,
+				SupportMode:    true,
+				manualReadOnly: &false,
+			},
+			rate: 10,
+			ro:   true,
+		},
 	}
 
 	for k := range uu {
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "time"
+
+// Memory tracks the informer caches memory budget.
+type Memory struct {
+	MaxCachedObjects int    `json:"maxCachedObjects" yaml:"maxCachedObjects,omitempty"`
+	EvictAfter       string `json:"evictAfter" yaml:"evictAfter,omitempty"`
+}
+
+// GetMaxCachedObjects returns the max number of objects cached per resource
+// or 0 if unbounded.
+func (m Memory) GetMaxCachedObjects() int {
+	if m.MaxCachedObjects < 0 {
+		return 0
+	}
+
+	return m.MaxCachedObjects
+}
+
+// GetEvictAfter returns how long an unvisited resource cache is kept around
+// or 0 if caches are never evicted.
+func (m Memory) GetEvictAfter() time.Duration {
+	d, err := time.ParseDuration(m.EvictAfter)
+	if err != nil || d < 0 {
+		return 0
+	}
+
+	return d
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryBudget(t *testing.T) {
+	uu := map[string]struct {
+		m     config.Memory
+		max   int
+		evict time.Duration
+	}{
+		"none": {},
+		"bad": {
+			m: config.Memory{MaxCachedObjects: -1, EvictAfter: "fred"},
+		},
+		"negative": {
+			m: config.Memory{EvictAfter: "-1m"},
+		},
+		"ok": {
+			m:     config.Memory{MaxCachedObjects: 5_000, EvictAfter: "10m"},
+			max:   5_000,
+			evict: 10 * time.Minute,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.max, u.m.GetMaxCachedObjects())
+			assert.Equal(t, u.evict, u.m.GetEvictAfter())
+		})
+	}
+}
@@ -135,6 +135,11 @@ func (m mockConnection) DialLogs() (kubernetes.Interface, error) {
 func (m mockConnection) SwitchContext(ctx string) error {
 	return nil
 }
+func (m mockConnection) SetRateLimit(float32, int) {}
+func (m mockConnection) SetLowBandwidth(bool)      {}
+func (m mockConnection) SetProxy(client.ProxySpec) error {
+	return nil
+}
 func (m mockConnection) CachedDiscovery() (*disk.CachedDiscoveryClient, error) {
 	return nil, nil
 }
@@ -150,6 +155,9 @@ func (m mockConnection) DynDial() (dynamic.Interface, error) {
 func (m mockConnection) HasMetrics() bool {
 	return false
 }
+func (m mockConnection) HasMetricsServer() bool {
+	return false
+}
 func (m mockConnection) ValidNamespaceNames() (client.NamespaceNames, error) {
 	return nil, nil
 }
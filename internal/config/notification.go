@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	defaultNotifyRateLimit = 5 * time.Minute
+	defaultNotifyMaxPerMin = 5
+)
+
+// Notifications tracks events to desktop notifications options.
+type Notifications struct {
+	RateLimit string       `json:"rateLimit" yaml:"rateLimit,omitempty"`
+	MaxPerMin int          `json:"maxPerMinute" yaml:"maxPerMinute,omitempty"`
+	Rules     []NotifyRule `json:"rules" yaml:"rules,omitempty"`
+}
+
+// NotifyRule tracks which events raise a notification. Blank fields match
+// any event.
+type NotifyRule struct {
+	Name       string   `json:"name" yaml:"name"`
+	Type       string   `json:"type" yaml:"type,omitempty"`
+	Kinds      []string `json:"kinds" yaml:"kinds,omitempty"`
+	Reasons    []string `json:"reasons" yaml:"reasons,omitempty"`
+	Namespaces []string `json:"namespaces" yaml:"namespaces,omitempty"`
+	Message    string   `json:"message" yaml:"message,omitempty"`
+}
+
+// IsEnabled checks if notification rules are configured.
+func (n Notifications) IsEnabled() bool {
+	return len(n.Rules) > 0
+}
+
+// GetRateLimit returns the min delay between notifications for the same object.
+func (n Notifications) GetRateLimit() time.Duration {
+	d, err := time.ParseDuration(n.RateLimit)
+	if err != nil || d <= 0 {
+		return defaultNotifyRateLimit
+	}
+
+	return d
+}
+
+// GetMaxPerMin returns the max number of notifications sent per minute.
+func (n Notifications) GetMaxPerMin() int {
+	if n.MaxPerMin <= 0 {
+		return defaultNotifyMaxPerMin
+	}
+
+	return n.MaxPerMin
+}
+
+// Matches checks if an event is covered by the rule.
+func (r NotifyRule) Matches(typ, kind, ns, reason, msg string) bool {
+	if r.Type != "" && !strings.EqualFold(r.Type, typ) {
+		return false
+	}
+	if !matchAny(r.Kinds, kind) || !matchAny(r.Reasons, reason) || !matchAny(r.Namespaces, ns) {
+		return false
+	}
+	if r.Message == "" {
+		return true
+	}
+	ok, err := regexp.MatchString(r.Message, msg)
+
+	return err == nil && ok
+}
+
+func matchAny(ss []string, s string) bool {
+	return len(ss) == 0 || slices.ContainsFunc(ss, func(v string) bool {
+		return strings.EqualFold(v, s)
+	})
+}
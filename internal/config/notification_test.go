@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifyRuleMatches(t *testing.T) {
+	r := config.NotifyRule{
+		Name:    "node",
+		Type:    "Warning",
+		Kinds:   []string{"Node"},
+		Reasons: []string{"NodeNotReady", "Rebooted"},
+		Message: "not ready|reboot",
+	}
+
+	uu := map[string]struct {
+		typ, kind, ns, reason, msg string
+		e                          bool
+	}{
+		"match": {
+			typ: "Warning", kind: "Node", reason: "NodeNotReady", msg: "Node n1 status is now: not ready", e: true,
+		},
+		"case": {
+			typ: "warning", kind: "node", reason: "rebooted", msg: "reboot detected", e: true,
+		},
+		"type": {
+			typ: "Normal", kind: "Node", reason: "NodeNotReady", msg: "not ready",
+		},
+		"kind": {
+			typ: "Warning", kind: "Pod", reason: "NodeNotReady", msg: "not ready",
+		},
+		"message": {
+			typ: "Warning", kind: "Node", reason: "NodeNotReady", msg: "all good",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, r.Matches(u.typ, u.kind, u.ns, u.reason, u.msg))
+		})
+	}
+}
+
+func TestNotifyRuleMatchesAny(t *testing.T) {
+	var r config.NotifyRule
+
+	assert.True(t, r.Matches("Normal", "Pod", "default", "Pulled", "blee"))
+}
+
+func TestNotificationsDefaults(t *testing.T) {
+	var n config.Notifications
+
+	assert.False(t, n.IsEnabled())
+	assert.Equal(t, 5*time.Minute, n.GetRateLimit())
+	assert.Equal(t, 5, n.GetMaxPerMin())
+}
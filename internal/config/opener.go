@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+)
+
+// Openers represents a collection of open with handlers.
+type Openers struct {
+	Openers map[string]Opener `yaml:"openers"`
+}
+
+// Opener describes how to open a resource in an external tool. An opener
+// either browses to a url or launches a command in the background. Url
+// openers without a shortcut are only listed in the links menu.
+type Opener struct {
+	ShortCut    string   `yaml:"shortCut"`
+	Override    bool     `yaml:"override"`
+	Description string   `yaml:"description"`
+	Scopes      []string `yaml:"scopes"`
+	URL         string   `yaml:"url"`
+	Command     string   `yaml:"command"`
+	Args        []string `yaml:"args"`
+}
+
+// Validate checks an opener is well formed.
+func (o Opener) Validate() error {
+	if (o.URL == "") == (o.Command == "") {
+		return fmt.Errorf("opener %q must specify either a url or a command", o.ShortCut)
+	}
+	if o.ShortCut == "" && o.URL == "" {
+		return fmt.Errorf("opener command %q must specify a shortcut", o.Command)
+	}
+
+	return nil
+}
+
+// NewOpeners returns a new instance.
+func NewOpeners() Openers {
+	return Openers{
+		Openers: make(map[string]Opener),
+	}
+}
+
+// Load K9s openers.
+func (o Openers) Load(path string) error {
+	if err := o.LoadOpeners(AppOpenersFile); err != nil {
+		return err
+	}
+
+	return o.LoadOpeners(path)
+}
+
+// LoadOpeners loads openers from a given file.
+func (o Openers) LoadOpeners(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.OpenersSchema, bb); err != nil {
+		return fmt.Errorf("validation failed for %q: %w", path, err)
+	}
+
+	var oo Openers
+	if err := yaml.Unmarshal(bb, &oo); err != nil {
+		return err
+	}
+	var errs error
+	for k, v := range oo.Openers {
+		if err := v.Validate(); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		o.Openers[k] = v
+	}
+
+	return errs
+}
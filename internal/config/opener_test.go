@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenersLoad(t *testing.T) {
+	o := config.NewOpeners()
+	assert.Error(t, o.LoadOpeners("testdata/openers/openers.yaml"))
+
+	assert.Equal(t, 3, len(o.Openers))
+
+	c, ok := o.Openers["console"]
+	assert.True(t, ok)
+	assert.Equal(t, "Shift-O", c.ShortCut)
+	assert.Equal(t, []string{"all"}, c.Scopes)
+	assert.Empty(t, c.Command)
+
+	v, ok := o.Openers["code"]
+	assert.True(t, ok)
+	assert.Equal(t, "code", v.Command)
+	assert.Equal(t, []string{"--new-window", "$FILE"}, v.Args)
+
+	g, ok := o.Openers["grafana"]
+	assert.True(t, ok)
+	assert.Empty(t, g.ShortCut)
+	assert.NoError(t, g.Validate())
+}
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "time"
+
+const defaultPipeTimeout = 10 * time.Second
+
+// Pipe tracks the commands text buffers can be piped to ie yq or grep.
+type Pipe struct {
+	Commands []string `json:"commands" yaml:"commands,omitempty"`
+	Timeout  string   `json:"timeout" yaml:"timeout,omitempty"`
+}
+
+// GetTimeout returns how long a piped command may run.
+func (p Pipe) GetTimeout() time.Duration {
+	d, err := time.ParseDuration(p.Timeout)
+	if err != nil || d <= 0 {
+		return defaultPipeTimeout
+	}
+
+	return d
+}
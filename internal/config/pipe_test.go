@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipeTimeout(t *testing.T) {
+	uu := map[string]struct {
+		p config.Pipe
+		e time.Duration
+	}{
+		"none": {
+			e: 10 * time.Second,
+		},
+		"bad": {
+			p: config.Pipe{Timeout: "fred"},
+			e: 10 * time.Second,
+		},
+		"negative": {
+			p: config.Pipe{Timeout: "-1s"},
+			e: 10 * time.Second,
+		},
+		"ok": {
+			p: config.Pipe{Timeout: "1m"},
+			e: time.Minute,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.p.GetTimeout())
+		})
+	}
+}
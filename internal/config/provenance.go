@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "strings"
+
+// ProvenanceImageVar tracks the verify command placeholder for an image digest reference.
+const ProvenanceImageVar = "$IMAGE"
+
+// Provenance tracks how image signatures are checked.
+type Provenance struct {
+	// Signatures looks up cosign signatures and attestations when set.
+	Signatures bool `json:"signatures" yaml:"signatures,omitempty"`
+
+	// Verify is a command verifying an image signature ie cosign verify $IMAGE.
+	Verify string `json:"verify" yaml:"verify,omitempty"`
+}
+
+// VerifyArgs returns the verify command arguments for a given image reference.
+// The command is run without a shell so the reference is never interpreted.
+func (p Provenance) VerifyArgs(ref string) []string {
+	args := strings.Fields(p.Verify)
+	for i := range args {
+		args[i] = strings.ReplaceAll(args[i], ProvenanceImageVar, ref)
+	}
+
+	return args
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvenanceVerifyArgs(t *testing.T) {
+	uu := map[string]struct {
+		verify, ref string
+		e           []string
+	}{
+		"plain": {
+			verify: "cosign verify --key k8s://fred/cosign $IMAGE",
+			ref:    "nginx@sha256:aaa",
+			e:      []string{"cosign", "verify", "--key", "k8s://fred/cosign", "nginx@sha256:aaa"},
+		},
+		"no-shell": {
+			verify: "cosign verify $IMAGE",
+			ref:    "nginx;reboot $(id)",
+			e:      []string{"cosign", "verify", "nginx;reboot $(id)"},
+		},
+		"empty": {e: []string{}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := config.Provenance{Verify: u.verify}
+			assert.Equal(t, u.e, p.VerifyArgs(u.ref))
+		})
+	}
+}
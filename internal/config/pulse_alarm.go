@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "github.com/derailed/k9s/internal/client"
+
+const (
+	// AlarmFailed checks a resource failed count.
+	AlarmFailed = "failed"
+
+	// AlarmPercent checks cpu or memory usage percentage.
+	AlarmPercent = "percent"
+)
+
+// PulseAlarm tracks a pulse metric threshold.
+type PulseAlarm struct {
+	Resource string `json:"resource" yaml:"resource"`
+	Metric   string `json:"metric" yaml:"metric,omitempty"`
+	Above    int64  `json:"above" yaml:"above"`
+	Bell     bool   `json:"bell" yaml:"bell,omitempty"`
+}
+
+// PulseAlarms tracks a collection of pulse alarms.
+type PulseAlarms []PulseAlarm
+
+// Matches checks if the alarm applies to a given pulse resource.
+func (a PulseAlarm) Matches(gvr string) bool {
+	return a.Resource == gvr || a.Resource == client.NewGVR(gvr).R()
+}
+
+// MetricFor returns the alarm metric. Defaults to usage percentage for
+// cpu/mem and failed counts otherwise.
+func (a PulseAlarm) MetricFor(gvr string) string {
+	if a.Metric != "" {
+		return a.Metric
+	}
+	if gvr == "cpu" || gvr == "mem" {
+		return AlarmPercent
+	}
+
+	return AlarmFailed
+}
+
+// Exceeded checks if a value is past the alarm threshold.
+func (a PulseAlarm) Exceeded(v int64) bool {
+	return v > a.Above
+}
+
+// For returns the alarms applying to a given pulse resource.
+func (aa PulseAlarms) For(gvr string) PulseAlarms {
+	var mm PulseAlarms
+	for _, a := range aa {
+		if a.Matches(gvr) {
+			mm = append(mm, a)
+		}
+	}
+
+	return mm
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPulseAlarmsFor(t *testing.T) {
+	aa := config.PulseAlarms{
+		{Resource: "pods", Above: 5},
+		{Resource: "v1/pods", Above: 10, Bell: true},
+		{Resource: "cpu", Above: 90},
+	}
+
+	assert.Equal(t, 2, len(aa.For("v1/pods")))
+	assert.Equal(t, 1, len(aa.For("cpu")))
+	assert.Equal(t, 0, len(aa.For("apps/v1/deployments")))
+}
+
+func TestPulseAlarmMetricFor(t *testing.T) {
+	uu := map[string]struct {
+		a   config.PulseAlarm
+		gvr string
+		e   string
+	}{
+		"pods":     {a: config.PulseAlarm{Resource: "pods"}, gvr: "v1/pods", e: config.AlarmFailed},
+		"cpu":      {a: config.PulseAlarm{Resource: "cpu"}, gvr: "cpu", e: config.AlarmPercent},
+		"explicit": {a: config.PulseAlarm{Resource: "mem", Metric: config.AlarmFailed}, gvr: "mem", e: config.AlarmFailed},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.a.MetricFor(u.gvr))
+		})
+	}
+}
+
+func TestPulseAlarmExceeded(t *testing.T) {
+	a := config.PulseAlarm{Resource: "pods", Above: 5}
+
+	assert.False(t, a.Exceeded(5))
+	assert.True(t, a.Exceeded(6))
+}
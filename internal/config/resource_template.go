@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// BuiltinTemplate tracks templates shipped with k9s.
+	BuiltinTemplate = "builtin"
+
+	// UserTemplate tracks templates located in the user templates dir.
+	UserTemplate = "user"
+)
+
+var (
+	//go:embed templates/resources/*.yaml
+	// resourceTpls tracks stock resource creation templates.
+	resourceTpls embed.FS
+
+	tplVarRX = regexp.MustCompile(`\$\{([A-Z][A-Z0-9_]*)(?:=([^}]*))?\}`)
+)
+
+// TemplateVar represents a template variable and its default value.
+type TemplateVar struct {
+	Name    string
+	Default string
+}
+
+// ResourceTemplate represents a manifest template used to create resources.
+// Variables are declared as ${VAR} or ${VAR=default}.
+type ResourceTemplate struct {
+	Name   string
+	Source string
+	Body   string
+}
+
+// Vars returns the template variables in order of appearance.
+func (t ResourceTemplate) Vars() []TemplateVar {
+	mm := tplVarRX.FindAllStringSubmatch(t.Body, -1)
+	vv, seen := make([]TemplateVar, 0, len(mm)), make(map[string]int, len(mm))
+	for _, m := range mm {
+		if i, ok := seen[m[1]]; ok {
+			if vv[i].Default == "" {
+				vv[i].Default = m[2]
+			}
+			continue
+		}
+		seen[m[1]] = len(vv)
+		vv = append(vv, TemplateVar{Name: m[1], Default: m[2]})
+	}
+
+	return vv
+}
+
+// Render substitutes template variables. Missing values fall back to the
+// variable default.
+func (t ResourceTemplate) Render(vals map[string]string) (string, error) {
+	defs := make(map[string]string)
+	for _, v := range t.Vars() {
+		defs[v.Name] = v.Default
+	}
+
+	var errs error
+	s := tplVarRX.ReplaceAllStringFunc(t.Body, func(m string) string {
+		name := tplVarRX.FindStringSubmatch(m)[1]
+		if v, ok := vals[name]; ok && v != "" {
+			return v
+		}
+		if d := defs[name]; d != "" {
+			return d
+		}
+		errs = errors.Join(errs, fmt.Errorf("no value specified for %s", name))
+		return m
+	})
+
+	return s, errs
+}
+
+// LoadResourceTemplates returns the stock templates along with the ones
+// found in the given directory. User templates override stock ones by name.
+func LoadResourceTemplates(dir string) ([]ResourceTemplate, error) {
+	tt := make(map[string]ResourceTemplate)
+	ee, err := resourceTpls.ReadDir("templates/resources")
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range ee {
+		bb, err := resourceTpls.ReadFile("templates/resources/" + e.Name())
+		if err != nil {
+			return nil, err
+		}
+		n := strings.TrimSuffix(e.Name(), filepath.Ext(e.Name()))
+		tt[n] = ResourceTemplate{Name: n, Source: BuiltinTemplate, Body: string(bb)}
+	}
+
+	ff, err := os.ReadDir(dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	for _, f := range ff {
+		ext := filepath.Ext(f.Name())
+		if f.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		bb, err := os.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		n := strings.TrimSuffix(f.Name(), ext)
+		tt[n] = ResourceTemplate{Name: n, Source: UserTemplate, Body: string(bb)}
+	}
+
+	rr := make([]ResourceTemplate, 0, len(tt))
+	for _, t := range tt {
+		rr = append(rr, t)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Name < rr[j].Name
+	})
+
+	return rr, nil
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadResourceTemplates(t *testing.T) {
+	tt, err := config.LoadResourceTemplates("testdata/templates")
+	assert.Nil(t, err)
+
+	nn := make(map[string]string, len(tt))
+	for _, tpl := range tt {
+		nn[tpl.Name] = tpl.Source
+	}
+	assert.Equal(t, config.UserTemplate, nn["deployment"])
+	assert.Equal(t, config.UserTemplate, nn["fred"])
+	assert.Equal(t, config.BuiltinTemplate, nn["job"])
+	assert.Equal(t, config.BuiltinTemplate, nn["debug-pod"])
+	_, ok := nn["README"]
+	assert.False(t, ok)
+}
+
+func TestResourceTemplateVars(t *testing.T) {
+	tpl := config.ResourceTemplate{
+		Body: "name: ${NAME}\nimage: ${IMAGE=nginx:stable}\nlabel: ${NAME=blee}\nenv: $HOME",
+	}
+
+	assert.Equal(t, []config.TemplateVar{
+		{Name: "NAME", Default: "blee"},
+		{Name: "IMAGE", Default: "nginx:stable"},
+	}, tpl.Vars())
+}
+
+func TestResourceTemplateRender(t *testing.T) {
+	tpl := config.ResourceTemplate{
+		Body: "name: ${NAME}\nnamespace: ${NAMESPACE}\nimage: ${IMAGE=nginx:stable}",
+	}
+
+	uu := map[string]struct {
+		vals map[string]string
+		e    string
+		err  bool
+	}{
+		"defaults": {
+			vals: map[string]string{"NAME": "fred", "NAMESPACE": "blee"},
+			e:    "name: fred\nnamespace: blee\nimage: nginx:stable",
+		},
+		"override": {
+			vals: map[string]string{"NAME": "fred", "NAMESPACE": "blee", "IMAGE": "busybox"},
+			e:    "name: fred\nnamespace: blee\nimage: busybox",
+		},
+		"missing": {
+			vals: map[string]string{"NAME": "fred"},
+			e:    "name: fred\nnamespace: ${NAMESPACE}\nimage: nginx:stable",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := tpl.Render(u.vals)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
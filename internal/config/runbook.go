@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Runbook tracks operational notes attached to resources. Blank fields
+// match any resource.
+type Runbook struct {
+	Name string `json:"name" yaml:"name"`
+
+	// Resources lists resources as gvrs or plural names ie apps/v1/deployments or pods.
+	Resources []string `json:"resources" yaml:"resources,omitempty"`
+
+	// Namespaces lists the namespaces the runbook applies to.
+	Namespaces []string `json:"namespaces" yaml:"namespaces,omitempty"`
+
+	// Selector tracks a label selector ie team=payments,tier!=db.
+	Selector string `json:"selector" yaml:"selector,omitempty"`
+
+	// File tracks a local Markdown file. ~ and env vars are expanded.
+	File string `json:"file" yaml:"file,omitempty"`
+
+	// URL tracks a remote Markdown or text document.
+	URL string `json:"url" yaml:"url,omitempty"`
+}
+
+// Runbooks tracks a collection of runbooks.
+type Runbooks []Runbook
+
+// For returns the runbooks targeting a given resource, regardless of labels.
+func (rr Runbooks) For(gvr string) Runbooks {
+	var hits Runbooks
+	for _, r := range rr {
+		if r.Targets(gvr) {
+			hits = append(hits, r)
+		}
+	}
+
+	return hits
+}
+
+// Targets checks if the runbook applies to a given resource.
+func (r Runbook) Targets(gvr string) bool {
+	res := gvr
+	if i := strings.LastIndex(gvr, "/"); i >= 0 {
+		res = gvr[i+1:]
+	}
+
+	return matchAny(r.Resources, gvr) || matchAny(r.Resources, res)
+}
+
+// Matches checks if the runbook applies to a given resource instance.
+func (r Runbook) Matches(gvr, ns string, ll map[string]string) bool {
+	if !r.Targets(gvr) || !matchAny(r.Namespaces, ns) {
+		return false
+	}
+	if r.Selector == "" {
+		return true
+	}
+	sel, err := labels.Parse(r.Selector)
+
+	return err == nil && sel.Matches(labels.Set(ll))
+}
+
+// Path returns the runbook file path with ~ and env vars expanded.
+func (r Runbook) Path() string {
+	p := os.ExpandEnv(r.File)
+	if p != "~" && !strings.HasPrefix(p, "~/") {
+		return p
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		p = filepath.Join(home, strings.TrimPrefix(p, "~"))
+	}
+
+	return p
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunbookMatches(t *testing.T) {
+	ll := map[string]string{"team": "payments", "tier": "api"}
+	uu := map[string]struct {
+		r      config.Runbook
+		gvr    string
+		ns     string
+		e      bool
+		target bool
+	}{
+		"all": {
+			gvr:    "v1/pods",
+			ns:     "default",
+			e:      true,
+			target: true,
+		},
+		"gvr": {
+			r:      config.Runbook{Resources: []string{"apps/v1/deployments"}},
+			gvr:    "apps/v1/deployments",
+			ns:     "default",
+			e:      true,
+			target: true,
+		},
+		"name": {
+			r:      config.Runbook{Resources: []string{"Deployments"}, Selector: "team=payments,tier!=db"},
+			gvr:    "apps/v1/deployments",
+			ns:     "default",
+			e:      true,
+			target: true,
+		},
+		"other-resource": {
+			r:   config.Runbook{Resources: []string{"pods"}},
+			gvr: "apps/v1/deployments",
+			ns:  "default",
+		},
+		"selector": {
+			r:      config.Runbook{Selector: "team=checkout"},
+			gvr:    "v1/pods",
+			ns:     "default",
+			target: true,
+		},
+		"bad-selector": {
+			r:      config.Runbook{Selector: "team=="},
+			gvr:    "v1/pods",
+			ns:     "default",
+			target: true,
+		},
+		"namespace": {
+			r:      config.Runbook{Namespaces: []string{"prod"}},
+			gvr:    "v1/pods",
+			ns:     "default",
+			target: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.r.Matches(u.gvr, u.ns, ll))
+			assert.Equal(t, u.target, u.r.Targets(u.gvr))
+		})
+	}
+}
+
+func TestRunbooksFor(t *testing.T) {
+	rr := config.Runbooks{
+		{Name: "pods", Resources: []string{"pods"}},
+		{Name: "dps", Resources: []string{"deployments"}},
+		{Name: "all"},
+	}
+
+	hits := rr.For("v1/pods")
+	assert.Equal(t, 2, len(hits))
+	assert.Equal(t, "pods", hits[0].Name)
+	assert.Equal(t, "all", hits[1].Name)
+}
+
+func TestRunbookPath(t *testing.T) {
+	t.Setenv("HOME", "/home/fred")
+	t.Setenv("RUNBOOKS", "/srv/runbooks")
+
+	assert.Equal(t, filepath.Join("/home/fred", "runbooks", "pg.md"), config.Runbook{File: "~/runbooks/pg.md"}.Path())
+	assert.Equal(t, "/srv/runbooks/pg.md", config.Runbook{File: "$RUNBOOKS/pg.md"}.Path())
+	assert.Equal(t, "/home/fred", config.Runbook{File: "~"}.Path())
+	assert.Equal(t, "~blee/pg.md", config.Runbook{File: "~blee/pg.md"}.Path())
+}
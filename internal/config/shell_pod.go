@@ -7,7 +7,11 @@ import (
 	v1 "k8s.io/api/core/v1"
 )
 
-const defaultDockerShellImage = "busybox:1.35.0"
+const (
+	defaultDockerShellImage  = "busybox:1.35.0"
+	defaultWindowsShellImage = "mcr.microsoft.com/oss/kubernetes/windows-host-process-containers-base-image:v1.0.0"
+	windowsOS                = "windows"
+)
 
 // Limits represents resource limits.
 type Limits map[v1.ResourceName]string
@@ -15,6 +19,7 @@ type Limits map[v1.ResourceName]string
 // ShellPod represents k9s shell configuration.
 type ShellPod struct {
 	Image            string                    `json:"image" yaml:"image"`
+	WindowsImage     string                    `json:"windowsImage,omitempty" yaml:"windowsImage,omitempty"`
 	Command          []string                  `json:"command,omitempty" yaml:"command,omitempty"`
 	Args             []string                  `json:"args,omitempty" yaml:"args,omitempty"`
 	Namespace        string                    `json:"namespace" yaml:"namespace"`
@@ -46,6 +51,18 @@ func (s ShellPod) Validate() ShellPod {
 	return s
 }
 
+// ImageFor returns the shell image to use on a node with the given os.
+func (s ShellPod) ImageFor(os string) string {
+	if os != windowsOS {
+		return s.Image
+	}
+	if s.WindowsImage != "" {
+		return s.WindowsImage
+	}
+
+	return defaultWindowsShellImage
+}
+
 func defaultLimits() Limits {
 	return Limits{
 		v1.ResourceCPU:    "100m",
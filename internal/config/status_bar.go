@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "time"
+
+const (
+	// WidgetCluster shows the active context and cluster.
+	WidgetCluster = "cluster"
+
+	// WidgetLatency shows the api server average and max latencies.
+	WidgetLatency = "latency"
+
+	// WidgetPortForwards shows the count of open port-forwards.
+	WidgetPortForwards = "portForwards"
+
+	// WidgetAlerts shows the count of raised alerts.
+	WidgetAlerts = "alerts"
+
+	// WidgetUser shows the current user and impersonation if any.
+	WidgetUser = "user"
+
+	// WidgetGitBranch shows the git branch of the directory being browsed.
+	WidgetGitBranch = "gitBranch"
+
+	// WidgetBandwidth shows the api server download rate and total.
+	WidgetBandwidth = "bandwidth"
+
+	// WidgetCommand shows the first line of a command output.
+	WidgetCommand = "command"
+
+	defaultWidgetInterval = 5 * time.Second
+	minWidgetInterval     = time.Second
+)
+
+// StatusBar tracks the widgets shown in the status bar.
+type StatusBar struct {
+	Widgets []Widget `json:"widgets" yaml:"widgets,omitempty"`
+}
+
+// Widget represents a status bar widget.
+type Widget struct {
+	Kind     string `json:"kind" yaml:"kind"`
+	Label    string `json:"label,omitempty" yaml:"label,omitempty"`
+	Command  string `json:"command,omitempty" yaml:"command,omitempty"`
+	Interval string `json:"interval,omitempty" yaml:"interval,omitempty"`
+}
+
+// GetInterval returns how often the widget is refreshed.
+func (w Widget) GetInterval() time.Duration {
+	d, err := time.ParseDuration(w.Interval)
+	if err != nil || d <= 0 {
+		return defaultWidgetInterval
+	}
+
+	return max(d, minWidgetInterval)
+}
+
+// GetLabel returns the widget label.
+func (w Widget) GetLabel() string {
+	if w.Label != "" {
+		return w.Label
+	}
+	switch w.Kind {
+	case WidgetCluster:
+		return "Ctx"
+	case WidgetLatency:
+		return "API"
+	case WidgetPortForwards:
+		return "PF"
+	case WidgetAlerts:
+		return "Alerts"
+	case WidgetUser:
+		return "User"
+	case WidgetGitBranch:
+		return "Git"
+	case WidgetBandwidth:
+		return "BW"
+	default:
+		return w.Command
+	}
+}
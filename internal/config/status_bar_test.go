@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWidgetInterval(t *testing.T) {
+	uu := map[string]struct {
+		w config.Widget
+		e time.Duration
+	}{
+		"none": {
+			e: 5 * time.Second,
+		},
+		"bad": {
+			w: config.Widget{Interval: "fred"},
+			e: 5 * time.Second,
+		},
+		"too-fast": {
+			w: config.Widget{Interval: "10ms"},
+			e: time.Second,
+		},
+		"ok": {
+			w: config.Widget{Interval: "1m"},
+			e: time.Minute,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.w.GetInterval())
+		})
+	}
+}
+
+func TestWidgetLabel(t *testing.T) {
+	uu := map[string]struct {
+		w config.Widget
+		e string
+	}{
+		"builtin": {
+			w: config.Widget{Kind: config.WidgetLatency},
+			e: "API",
+		},
+		"custom": {
+			w: config.Widget{Kind: config.WidgetAlerts, Label: "Fred"},
+			e: "Fred",
+		},
+		"command": {
+			w: config.Widget{Kind: config.WidgetCommand, Command: "date +%H"},
+			e: "date +%H",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.w.GetLabel())
+		})
+	}
+}
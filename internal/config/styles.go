@@ -113,6 +113,7 @@ type (
 		HighlightColor Color `json:"highlightColor" yaml:"highlightColor"`
 		KillColor      Color `json:"killColor" yaml:"killColor"`
 		CompletedColor Color `json:"completedColor" yaml:"completedColor"`
+		AnomalyColor   Color `json:"anomalyColor" yaml:"anomalyColor,omitempty"`
 	}
 
 	// Log tracks Log styles.
@@ -320,6 +321,7 @@ func newStatus() Status {
 		HighlightColor: "aqua",
 		KillColor:      "mediumpurple",
 		CompletedColor: "lightslategray",
+		AnomalyColor:   "hotpink",
 	}
 }
 
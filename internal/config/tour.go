@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+)
+
+// Tour represents a guided tour of K9s.
+type Tour struct {
+	Name  string     `yaml:"name"`
+	Steps []TourStep `yaml:"steps"`
+}
+
+// TourStep represents a tour stop. An optional command is run before
+// the step is shown so the tour can navigate to the relevant view.
+type TourStep struct {
+	Title   string `yaml:"title"`
+	Text    string `yaml:"text"`
+	Command string `yaml:"command"`
+}
+
+type tourFile struct {
+	Tour Tour `yaml:"tour"`
+}
+
+// NewTour returns the stock tour.
+func NewTour() *Tour {
+	t, err := parseTour(tourTpl)
+	if err != nil {
+		panic(fmt.Errorf("stock tour is invalid: %w", err))
+	}
+
+	return t
+}
+
+// LoadTour loads a custom tour from the given file or returns the stock
+// tour if no such file exists.
+func LoadTour(path string) (*Tour, error) {
+	bb, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return NewTour(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := data.JSONValidator.Validate(json.TourSchema, bb); err != nil {
+		return nil, fmt.Errorf("validation failed for %q: %w", path, err)
+	}
+
+	return parseTour(bb)
+}
+
+func parseTour(bb []byte) (*Tour, error) {
+	var f tourFile
+	if err := yaml.Unmarshal(bb, &f); err != nil {
+		return nil, err
+	}
+
+	return &f.Tour, nil
+}
+
+// TourCompleted checks if the named tour was completed.
+func TourCompleted(path, name string) bool {
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+
+	return strings.TrimSpace(string(bb)) == name
+}
+
+// MarkTourCompleted records the named tour as completed.
+func MarkTourCompleted(path, name string) error {
+	if err := data.EnsureFullPath(filepath.Dir(path), data.DefaultDirMod); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, []byte(name+"\n"), data.DefaultFileMod)
+}
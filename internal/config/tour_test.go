@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTour(t *testing.T) {
+	tour := config.NewTour()
+
+	assert.Equal(t, "k9s-basics", tour.Name)
+	assert.Equal(t, 7, len(tour.Steps))
+	assert.Equal(t, "pods", tour.Steps[1].Command)
+}
+
+func TestLoadTour(t *testing.T) {
+	uu := map[string]struct {
+		path  string
+		name  string
+		steps int
+		err   bool
+	}{
+		"custom": {
+			path:  "testdata/tours/tour.yaml",
+			name:  "acme-onboarding",
+			steps: 2,
+		},
+		"stock": {
+			path:  "testdata/tours/none.yaml",
+			name:  "k9s-basics",
+			steps: 7,
+		},
+		"invalid": {
+			path: "testdata/tours/invalid.yaml",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tour, err := config.LoadTour(u.path)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.name, tour.Name)
+			assert.Equal(t, u.steps, len(tour.Steps))
+		})
+	}
+}
+
+func TestTourCompleted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "k9s", "tour-completed")
+
+	assert.False(t, config.TourCompleted(path, "fred"))
+	assert.NoError(t, config.MarkTourCompleted(path, "fred"))
+	assert.True(t, config.TourCompleted(path, "fred"))
+	assert.False(t, config.TourCompleted(path, "blee"))
+}
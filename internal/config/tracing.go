@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// JaegerProvider represents a jaeger query api.
+	JaegerProvider = "jaeger"
+
+	// TempoProvider represents a grafana tempo api.
+	TempoProvider = "tempo"
+
+	// TraceIDVar tracks the trace id placeholder in trace links.
+	TraceIDVar = "$TRACE_ID"
+
+	defaultTraceLimit    = 20
+	defaultTraceLookback = time.Hour
+)
+
+// Tracing tracks a tracing backend configuration.
+type Tracing struct {
+	Provider string `json:"provider" yaml:"provider,omitempty"`
+	URL      string `json:"url" yaml:"url,omitempty"`
+	UIURL    string `json:"uiURL" yaml:"uiURL,omitempty"`
+	Limit    int    `json:"limit" yaml:"limit,omitempty"`
+	Lookback string `json:"lookback" yaml:"lookback,omitempty"`
+}
+
+// IsEnabled checks if a tracing backend is configured.
+func (t Tracing) IsEnabled() bool {
+	return t.URL != ""
+}
+
+// GetProvider returns the tracing provider.
+func (t Tracing) GetProvider() string {
+	if t.Provider == "" {
+		return JaegerProvider
+	}
+
+	return t.Provider
+}
+
+// GetLimit returns the max number of traces to fetch.
+func (t Tracing) GetLimit() int {
+	if t.Limit <= 0 {
+		return defaultTraceLimit
+	}
+
+	return t.Limit
+}
+
+// GetLookback returns how far back to look for traces.
+func (t Tracing) GetLookback() time.Duration {
+	d, err := time.ParseDuration(t.Lookback)
+	if err != nil || d <= 0 {
+		return defaultTraceLookback
+	}
+
+	return d
+}
+
+// TraceURL returns a link to view a given trace or blank if none.
+func (t Tracing) TraceURL(id string) string {
+	u := t.UIURL
+	if u == "" {
+		if t.GetProvider() != JaegerProvider {
+			return ""
+		}
+		u = t.URL + "/trace/" + TraceIDVar
+	}
+
+	return strings.ReplaceAll(u, TraceIDVar, id)
+}
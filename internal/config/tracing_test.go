@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracingTraceURL(t *testing.T) {
+	uu := map[string]struct {
+		cfg config.Tracing
+		e   string
+	}{
+		"jaeger": {
+			cfg: config.Tracing{URL: "http://jaeger:16686"},
+			e:   "http://jaeger:16686/trace/t1",
+		},
+		"tempo": {
+			cfg: config.Tracing{Provider: config.TempoProvider, URL: "http://tempo:3200"},
+		},
+		"custom": {
+			cfg: config.Tracing{Provider: config.TempoProvider, URL: "http://tempo:3200", UIURL: "http://grafana/explore?traceId=$TRACE_ID"},
+			e:   "http://grafana/explore?traceId=t1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.cfg.TraceURL("t1"))
+		})
+	}
+}
+
+func TestTracingDefaults(t *testing.T) {
+	var cfg config.Tracing
+
+	assert.False(t, cfg.IsEnabled())
+	assert.Equal(t, config.JaegerProvider, cfg.GetProvider())
+	assert.Equal(t, 20, cfg.GetLimit())
+	assert.Equal(t, time.Hour, cfg.GetLookback())
+
+	cfg.Lookback = "15m"
+	assert.Equal(t, 15*time.Minute, cfg.GetLookback())
+}
@@ -34,4 +34,13 @@ type UI struct {
 
 	// DefaultsToFullScreen toggles fullscreen on views like logs, yaml, details.
 	DefaultsToFullScreen bool `json:"defaultsToFullScreen" yaml:"defaultsToFullScreen"`
+
+	// SkipTour disables the guided tour on first launch.
+	SkipTour bool `json:"skipTour" yaml:"skipTour"`
+
+	// Redact masks secrets, registries, ips and node names on screen and in dumps.
+	Redact bool `json:"redact" yaml:"redact"`
+
+	// Locale sets the ui language ie fr. Defaults to English.
+	Locale string `json:"locale" yaml:"locale,omitempty"`
 }
@@ -82,6 +82,73 @@ func (a *Alias) AsGVR(c string) (client.GVR, string, bool) {
 	return client.NoGVR, "", false
 }
 
+// Ambiguous returns the resources sharing an alias when neither a builtin
+// resource nor a precedence rule settles which one to use.
+func (a *Alias) Ambiguous(alias string) client.GVRs {
+	if a.IsPinned(alias) {
+		return nil
+	}
+	cc := a.Collisions(alias)
+	if len(cc) < 2 {
+		return nil
+	}
+	gvrs := make(client.GVRs, 0, len(cc))
+	for _, c := range cc {
+		if a.Preferred(c) >= 0 {
+			return nil
+		}
+		gvr := client.NewGVR(c)
+		if meta, err := MetaAccess.MetaFor(gvr); err == nil && !IsCRD(meta) {
+			return nil
+		}
+		gvrs = append(gvrs, gvr)
+	}
+	sort.Sort(gvrs)
+
+	return gvrs
+}
+
+// AliasResolution details what an alias resolves to.
+type AliasResolution struct {
+	Alias      string   `json:"alias"`
+	GVR        string   `json:"gvr"`
+	Source     string   `json:"source"`
+	Kind       string   `json:"kind,omitempty"`
+	Namespaced bool     `json:"namespaced"`
+	ShortNames []string `json:"shortNames,omitempty"`
+	Ambiguous  bool     `json:"ambiguous"`
+	Candidates []string `json:"candidates,omitempty"`
+	Precedence []string `json:"precedence,omitempty"`
+}
+
+// Resolve explains what a given alias resolves to.
+func (a *Alias) Resolve(alias string) (AliasResolution, error) {
+	gvr, _, ok := a.AsGVR(alias)
+	if !ok {
+		return AliasResolution{}, fmt.Errorf("no resource found for alias %q", alias)
+	}
+	r := AliasResolution{
+		Alias:      alias,
+		GVR:        gvr.String(),
+		Source:     "discovery",
+		Ambiguous:  len(a.Ambiguous(alias)) > 0,
+		Candidates: a.Collisions(alias),
+	}
+	if a.IsPinned(alias) {
+		r.Source = "aliases"
+	}
+	for _, c := range r.Candidates {
+		if a.Preferred(c) >= 0 {
+			r.Precedence = append(r.Precedence, c)
+		}
+	}
+	if meta, err := MetaAccess.MetaFor(gvr); err == nil {
+		r.Kind, r.Namespaced, r.ShortNames = meta.Kind, meta.Namespaced, meta.ShortNames
+	}
+
+	return r, nil
+}
+
 // Get fetch a resource.
 func (a *Alias) Get(_ context.Context, _ string) (runtime.Object, error) {
 	return nil, errors.New("NYI!!")
@@ -106,7 +173,7 @@ func (a *Alias) load(path string) error {
 		if err != nil {
 			return err
 		}
-		if IsK9sMeta(meta) {
+		if IsK9sMeta(meta) || !NSScope.CanList(gvr) {
 			continue
 		}
 
@@ -127,6 +194,9 @@ func (a *Alias) load(path string) error {
 	}
 
 	for _, gvr := range crdGVRS {
+		if !NSScope.CanList(gvr) {
+			continue
+		}
 		meta, err := MetaAccess.MetaFor(gvr)
 		if err != nil {
 			return err
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const apfMetricsTTL = 10 * time.Second
+
+var (
+	_ Accessor = (*FlowControl)(nil)
+
+	apfLabelRX = regexp.MustCompile(`(\w+)="((?:[^"\\]|\\.)*)"`)
+
+	apfMetricsCache struct {
+		sync.Mutex
+		mx  *render.APFMetrics
+		at  time.Time
+		ctx string
+	}
+)
+
+// FlowControl represents api server flow schemas and priority levels along
+// with their live queue and rejection metrics.
+type FlowControl struct {
+	Resource
+}
+
+// List returns a collection of flow control resources.
+func (f *FlowControl) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := f.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	mx := f.metrics(ctx)
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		res = append(res, &render.FlowControlRes{Raw: u, MX: mx})
+	}
+
+	return res, nil
+}
+
+// metrics returns the api server flow control metrics or nil if the metrics
+// endpoint is not accessible.
+func (f *FlowControl) metrics(ctx context.Context) *render.APFMetrics {
+	apfMetricsCache.Lock()
+	defer apfMetricsCache.Unlock()
+
+	cx := f.Client().ActiveContext()
+	if apfMetricsCache.ctx == cx && time.Since(apfMetricsCache.at) < apfMetricsTTL {
+		return apfMetricsCache.mx
+	}
+	apfMetricsCache.mx, apfMetricsCache.at, apfMetricsCache.ctx = nil, time.Now(), cx
+
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return nil
+	}
+	raw, err := dial.CoreV1().RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		log.Debug().Err(err).Msg("APF metrics not accessible")
+		return nil
+	}
+	apfMetricsCache.mx = ParseAPFMetrics(raw)
+
+	return apfMetricsCache.mx
+}
+
+// ParseAPFMetrics extracts the flow control queue, execution and rejection
+// counts per flow schema and priority level from api server metrics.
+func ParseAPFMetrics(raw []byte) *render.APFMetrics {
+	mx := render.NewAPFMetrics()
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		l := scanner.Text()
+		if !strings.HasPrefix(l, "apiserver_flowcontrol_") {
+			continue
+		}
+		name, rest, ok := strings.Cut(l, "{")
+		if !ok {
+			continue
+		}
+		labels, val, ok := strings.Cut(rest, "} ")
+		ff := strings.Fields(val)
+		if !ok || len(ff) == 0 {
+			continue
+		}
+		v, err := strconv.ParseFloat(ff[0], 64)
+		if err != nil {
+			continue
+		}
+		ll := make(map[string]string, 3)
+		for _, m := range apfLabelRX.FindAllStringSubmatch(labels, -1) {
+			ll[m[1]] = m[2]
+		}
+		add := func(s *render.APFStats) {
+			switch name {
+			case "apiserver_flowcontrol_current_inqueue_requests":
+				s.InQueue += int64(v)
+			case "apiserver_flowcontrol_current_executing_requests":
+				s.Executing += int64(v)
+			case "apiserver_flowcontrol_rejected_requests_total":
+				s.Rejected += int64(v)
+			}
+		}
+		if fs, ok := ll["flow_schema"]; ok {
+			s := mx.FlowSchemas[fs]
+			add(&s)
+			mx.FlowSchemas[fs] = s
+		}
+		if pl, ok := ll["priority_level"]; ok {
+			s := mx.PriorityLevels[pl]
+			add(&s)
+			mx.PriorityLevels[pl] = s
+		}
+	}
+
+	return mx
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAPFMetrics(t *testing.T) {
+	raw := `# HELP apiserver_flowcontrol_current_inqueue_requests [BETA] Number of requests currently pending in queues
+# TYPE apiserver_flowcontrol_current_inqueue_requests gauge
+apiserver_flowcontrol_current_inqueue_requests{flow_schema="service-accounts",priority_level="workload-low"} 3
+apiserver_flowcontrol_current_inqueue_requests{flow_schema="global-default",priority_level="global-default"} 0
+apiserver_flowcontrol_current_executing_requests{flow_schema="service-accounts",priority_level="workload-low"} 2
+apiserver_flowcontrol_current_executing_requests{flow_schema="kube-scheduler",priority_level="workload-high"} 1
+apiserver_flowcontrol_rejected_requests_total{flow_schema="service-accounts",priority_level="workload-low",reason="queue-full"} 7
+apiserver_flowcontrol_rejected_requests_total{flow_schema="service-accounts",priority_level="workload-low",reason="time-out"} 1
+apiserver_flowcontrol_rejected_requests_total{flow_schema="kube-scheduler",priority_level="workload-low",reason="time-out"} 2
+apiserver_flowcontrol_nominal_limit_seats{priority_level="workload-low"} 98
+apiserver_request_total{code="429",resource="pods"} 12
+`
+
+	mx := ParseAPFMetrics([]byte(raw))
+	assert.Equal(t, map[string]render.APFStats{
+		"service-accounts": {InQueue: 3, Executing: 2, Rejected: 8},
+		"global-default":   {},
+		"kube-scheduler":   {Executing: 1, Rejected: 2},
+	}, mx.FlowSchemas)
+	assert.Equal(t, map[string]render.APFStats{
+		"workload-low":   {InQueue: 3, Executing: 2, Rejected: 10},
+		"workload-high":  {Executing: 1},
+		"global-default": {},
+	}, mx.PriorityLevels)
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*APIPath)(nil)
+
+// APIPath browses the API server paths.
+type APIPath struct {
+	NonResource
+}
+
+// List returns the paths served by the API server or the resources served
+// by a group version if the context carries one.
+func (a *APIPath) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	prefix, _ := ctx.Value(internal.KeyAPIPath).(string)
+	if gv, ok := GroupVersionPath(prefix); ok {
+		return a.resources(prefix, gv)
+	}
+
+	raw, err := a.Raw(ctx, "/")
+	if err != nil {
+		return nil, err
+	}
+	var root struct {
+		Paths []string `json:"paths"`
+	}
+	if err := json.Unmarshal(raw, &root); err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(root.Paths))
+	for _, p := range root.Paths {
+		res := render.APIPathRes{Path: p}
+		if _, ok := GroupVersionPath(p); ok {
+			res.Kind = "GroupVersion"
+		}
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// Raw issues a GET request on the given path. JSON responses are indented.
+func (a *APIPath) Raw(ctx context.Context, p string) ([]byte, error) {
+	dial, err := a.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := dial.Discovery().RESTClient().Get().RequestURI(p).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var buff bytes.Buffer
+	if err := json.Indent(&buff, raw, "", "  "); err != nil {
+		return raw, nil
+	}
+
+	return buff.Bytes(), nil
+}
+
+func (a *APIPath) resources(prefix, gv string) ([]runtime.Object, error) {
+	dial, err := a.Client().CachedDiscovery()
+	if err != nil {
+		return nil, err
+	}
+	rl, err := dial.ServerResourcesForGroupVersion(gv)
+	if err != nil {
+		return nil, fmt.Errorf("unable to discover %q: %w", gv, err)
+	}
+	oo := make([]runtime.Object, 0, len(rl.APIResources))
+	for _, r := range rl.APIResources {
+		oo = append(oo, render.APIPathRes{
+			Path:       path.Join(prefix, r.Name),
+			Kind:       r.Kind,
+			Namespaced: r.Namespaced,
+			Verbs:      r.Verbs,
+		})
+	}
+
+	return oo, nil
+}
+
+// GroupVersionPath returns the group version served under a path ie
+// /apis/apps/v1 or /api/v1.
+func GroupVersionPath(p string) (string, bool) {
+	tt := strings.Split(strings.Trim(p, "/"), "/")
+	switch {
+	case len(tt) == 2 && tt[0] == "api":
+		return tt[1], true
+	case len(tt) == 3 && tt[0] == "apis":
+		return tt[1] + "/" + tt[2], true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupVersionPath(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		gv   string
+		ok   bool
+	}{
+		"core":    {path: "/api/v1", gv: "v1", ok: true},
+		"group":   {path: "/apis/apps/v1", gv: "apps/v1", ok: true},
+		"trailer": {path: "/apis/metrics.k8s.io/v1beta1/", gv: "metrics.k8s.io/v1beta1", ok: true},
+		"api":     {path: "/api"},
+		"no-ver":  {path: "/apis/apps"},
+		"res":     {path: "/api/v1/pods"},
+		"health":  {path: "/healthz"},
+		"root":    {path: "/"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gv, ok := GroupVersionPath(u.path)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.gv, gv)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/assist"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/redact"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// AssistSubject collects a resource manifest, events and, for pods, recent
+// containers logs to be sent to the assistant. Secrets are never collected
+// and ConfigMaps data are masked.
+func AssistSubject(ctx context.Context, f Factory, gvr client.GVR, path string, logLines int64) (assist.Subject, error) {
+	var s assist.Subject
+	if gvr == SecGVR {
+		return s, errors.New("secrets are never sent to the assistant")
+	}
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return s, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return s, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	s.Kind, s.Path = u.GetKind(), path
+	if gvr == CmGVR {
+		u = u.DeepCopy()
+		redact.MaskData(u.Object)
+	}
+	if s.YAML, err = ToYAML(u, false); err != nil {
+		return s, err
+	}
+
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return s, err
+	}
+	ns := u.GetNamespace()
+	ee, err := dial.CoreV1().Events(ns).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.AndSelectors(
+			fields.OneTermEqualSelector("involvedObject.name", u.GetName()),
+			fields.OneTermEqualSelector("involvedObject.kind", u.GetKind()),
+		).String(),
+	})
+	if err != nil {
+		return s, err
+	}
+	s.Events = "none"
+	if hits := recentEvents(ee.Items, time.Now(), func(*v1.Event) bool { return true }); len(hits) > 0 {
+		s.Events = strings.Join(hits, "\n")
+	}
+
+	if gvr != PodGVR {
+		return s, nil
+	}
+	cc, _, _ := unstructured.NestedSlice(u.Object, "spec", "containers")
+	var b strings.Builder
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		co, _ := m["name"].(string)
+		s.Containers = append(s.Containers, co)
+		bb, err := dial.CoreV1().Pods(ns).GetLogs(u.GetName(), &v1.PodLogOptions{
+			Container: co,
+			TailLines: &logLines,
+		}).DoRaw(ctx)
+		fmt.Fprintf(&b, "[%s]\n", co)
+		if err != nil {
+			fmt.Fprintf(&b, "logs unavailable: %s\n", err)
+			continue
+		}
+		b.Write(bb)
+	}
+	s.Logs = b.String()
+
+	return s, nil
+}
@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	// CertificateRequestGVR tracks cert-manager certificate requests.
+	CertificateRequestGVR = client.NewGVR("cert-manager.io/v1/certificaterequests")
+
+	// OrderGVR tracks cert-manager ACME orders.
+	OrderGVR = client.NewGVR("acme.cert-manager.io/v1/orders")
+
+	// ChallengeGVR tracks cert-manager ACME challenges.
+	ChallengeGVR = client.NewGVR("acme.cert-manager.io/v1/challenges")
+)
+
+// certManagerChildren tracks the resources each cert-manager kind spawns.
+var certManagerChildren = map[string]client.GVR{
+	"Certificate":        CertificateRequestGVR,
+	"CertificateRequest": OrderGVR,
+	"Order":              ChallengeGVR,
+}
+
+var _ Accessor = (*CertManagerChild)(nil)
+
+// CertManagerChild represents cert-manager resources spawned by another
+// ie certificate requests, orders and challenges.
+type CertManagerChild struct {
+	Resource
+}
+
+// List returns a collection of resources. When drilling down from a parent,
+// only the resources it owns are listed.
+func (c *CertManagerChild) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := c.Resource.List(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	uid, _ := ctx.Value(internal.KeyUID).(string)
+	if uid == "" {
+		return oo, nil
+	}
+
+	ll := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok && IsOwnedBy(u, uid) {
+			ll = append(ll, o)
+		}
+	}
+
+	return ll, nil
+}
+
+// CertManagerChildGVR returns the resource a cert-manager kind spawns.
+func CertManagerChildGVR(kind string) (client.GVR, bool) {
+	gvr, ok := certManagerChildren[kind]
+
+	return gvr, ok
+}
+
+// IsOwnedBy checks if a resource is owned by the given uid.
+func IsOwnedBy(u *unstructured.Unstructured, uid string) bool {
+	for _, ref := range u.GetOwnerReferences() {
+		if string(ref.UID) == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CertTrace tracks a cert-manager resource status along with the status of
+// the resources it spawned.
+type CertTrace struct {
+	Kind     string      `json:"kind"`
+	Name     string      `json:"name"`
+	Status   string      `json:"status"`
+	Reason   string      `json:"reason,omitempty"`
+	Age      string      `json:"age"`
+	Children []CertTrace `json:"children,omitempty"`
+}
+
+// CertManagerTrace walks a cert-manager resource down to its challenges and
+// reports each resource status and reason.
+func CertManagerTrace(ctx context.Context, f Factory, gvr client.GVR, path string) (string, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	children := func(p *unstructured.Unstructured) []*unstructured.Unstructured {
+		cgvr, ok := CertManagerChildGVR(p.GetKind())
+		if !ok {
+			return nil
+		}
+		if _, err := MetaAccess.MetaFor(cgvr); err != nil {
+			return nil
+		}
+		oo, err := f.List(cgvr.String(), p.GetNamespace(), true, labels.Everything())
+		if err != nil {
+			return nil
+		}
+		uu := make([]*unstructured.Unstructured, 0, len(oo))
+		for _, o := range oo {
+			if c, ok := o.(*unstructured.Unstructured); ok && IsOwnedBy(c, string(p.GetUID())) {
+				uu = append(uu, c)
+			}
+		}
+		return uu
+	}
+
+	raw, err := yaml.Marshal(TraceCertManager(u, children, time.Now()))
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// TraceCertManager returns a cert-manager resource trace. Children are
+// listed most recent first.
+func TraceCertManager(u *unstructured.Unstructured, children func(*unstructured.Unstructured) []*unstructured.Unstructured, now time.Time) CertTrace {
+	status, reason := render.CertManagerStatus(u)
+	t := CertTrace{
+		Kind:   u.GetKind(),
+		Name:   u.GetName(),
+		Status: status,
+		Reason: reason,
+		Age:    duration.HumanDuration(now.Sub(u.GetCreationTimestamp().Time)),
+	}
+	cc := children(u)
+	sort.Slice(cc, func(i, j int) bool {
+		return cc[i].GetCreationTimestamp().After(cc[j].GetCreationTimestamp().Time)
+	})
+	for _, c := range cc {
+		t.Children = append(t.Children, TraceCertManager(c, children, now))
+	}
+
+	return t
+}
+
+// RenewCertificate forces a certificate re-issuance by flagging it as
+// issuing, as cmctl renew does.
+func RenewCertificate(ctx context.Context, f Factory, path string) error {
+	ns, n := client.Namespaced(path)
+	auth, err := f.Client().CanI(ns, CertificateGVR.String()+":status", n, []string{client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update %s status", path)
+	}
+	dial, err := f.Client().DynDial()
+	if err != nil {
+		return err
+	}
+	ri := dial.Resource(CertificateGVR.GVR()).Namespace(ns)
+	u, err := ri.Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	cc, err := issuingConditions(u, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedSlice(u.Object, cc, "status", "conditions"); err != nil {
+		return err
+	}
+	_, err = ri.UpdateStatus(ctx, u, metav1.UpdateOptions{})
+
+	return err
+}
+
+// issuingConditions returns a certificate conditions flagged as issuing.
+func issuingConditions(u *unstructured.Unstructured, now time.Time) ([]interface{}, error) {
+	cc, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	issuing := map[string]interface{}{
+		"type":               render.CertManagerIssuing,
+		"status":             "True",
+		"reason":             "ManuallyTriggered",
+		"message":            "Certificate re-issuance manually triggered",
+		"lastTransitionTime": now.UTC().Format(time.RFC3339),
+	}
+	for i, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok || m["type"] != render.CertManagerIssuing {
+			continue
+		}
+		if m["status"] == "True" {
+			return nil, fmt.Errorf("certificate %s is already being issued", u.GetName())
+		}
+		cc[i] = issuing
+		return cc, nil
+	}
+
+	return append(cc, issuing), nil
+}
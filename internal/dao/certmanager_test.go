@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func makeCertManagerRes(kind, n, uid, owner string, age time.Duration, status map[string]interface{}) *unstructured.Unstructured {
+	u := unstructured.Unstructured{Object: map[string]interface{}{"kind": kind}}
+	u.SetName(n)
+	u.SetNamespace("default")
+	u.SetUID(types.UID(uid))
+	u.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-age)))
+	if owner != "" {
+		u.SetOwnerReferences([]metav1.OwnerReference{{UID: types.UID(owner)}})
+	}
+	if status != nil {
+		u.Object["status"] = status
+	}
+
+	return &u
+}
+
+func TestTraceCertManager(t *testing.T) {
+	failed := map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "Ready", "status": "False", "reason": "Failed", "message": "order failed"},
+		},
+	}
+	cert := makeCertManagerRes("Certificate", "fred", "c1", "", time.Hour, failed)
+	uu := []*unstructured.Unstructured{
+		makeCertManagerRes("CertificateRequest", "fred-1", "cr1", "c1", 50*time.Minute, failed),
+		makeCertManagerRes("CertificateRequest", "fred-2", "cr2", "c1", 10*time.Minute, nil),
+		makeCertManagerRes("Order", "fred-1-1", "o1", "cr1", 50*time.Minute, map[string]interface{}{"state": "invalid", "reason": "authorization failed"}),
+		makeCertManagerRes("Challenge", "fred-1-1-1", "ch1", "o1", 50*time.Minute, map[string]interface{}{"state": "invalid", "reason": "404 Not Found"}),
+		makeCertManagerRes("CertificateRequest", "blee-1", "cr3", "c2", time.Minute, nil),
+	}
+	children := func(p *unstructured.Unstructured) []*unstructured.Unstructured {
+		var cc []*unstructured.Unstructured
+		for _, u := range uu {
+			if IsOwnedBy(u, string(p.GetUID())) {
+				cc = append(cc, u)
+			}
+		}
+		return cc
+	}
+
+	assert.Equal(t, CertTrace{
+		Kind:   "Certificate",
+		Name:   "fred",
+		Status: "Failed",
+		Reason: "order failed",
+		Age:    "60m",
+		Children: []CertTrace{
+			{Kind: "CertificateRequest", Name: "fred-2", Status: "Pending", Age: "10m"},
+			{
+				Kind:   "CertificateRequest",
+				Name:   "fred-1",
+				Status: "Failed",
+				Reason: "order failed",
+				Age:    "50m",
+				Children: []CertTrace{
+					{
+						Kind:   "Order",
+						Name:   "fred-1-1",
+						Status: "invalid",
+						Reason: "authorization failed",
+						Age:    "50m",
+						Children: []CertTrace{
+							{Kind: "Challenge", Name: "fred-1-1-1", Status: "invalid", Reason: "404 Not Found", Age: "50m"},
+						},
+					},
+				},
+			},
+		},
+	}, TraceCertManager(cert, children, time.Now()))
+}
+
+func TestIssuingConditions(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	issuing := map[string]interface{}{
+		"type":               "Issuing",
+		"status":             "True",
+		"reason":             "ManuallyTriggered",
+		"message":            "Certificate re-issuance manually triggered",
+		"lastTransitionTime": "2024-01-01T00:00:00Z",
+	}
+	ready := map[string]interface{}{"type": "Ready", "status": "True"}
+
+	uu := map[string]struct {
+		cc  []interface{}
+		e   []interface{}
+		err string
+	}{
+		"append": {
+			cc: []interface{}{ready},
+			e:  []interface{}{ready, issuing},
+		},
+		"replace": {
+			cc: []interface{}{ready, map[string]interface{}{"type": "Issuing", "status": "False"}},
+			e:  []interface{}{ready, issuing},
+		},
+		"issuing": {
+			cc:  []interface{}{map[string]interface{}{"type": "Issuing", "status": "True"}},
+			err: "certificate fred is already being issued",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cert := makeCertManagerRes("Certificate", "fred", "c1", "", time.Hour, map[string]interface{}{"conditions": u.cc})
+			cc, err := issuingConditions(cert, now)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, cc)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Change)(nil)
+
+// ChangeTracker tracks recent resource changes.
+type ChangeTracker interface {
+	// Changes returns recent changes, most recent first.
+	Changes() []watch.Change
+}
+
+// Change tracks recently created, updated or deleted resources.
+type Change struct {
+	NonResource
+}
+
+// List returns the recent changes observed on watched resources.
+func (c *Change) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	ct, ok := c.getFactory().(ChangeTracker)
+	if !ok {
+		return nil, fmt.Errorf("expecting a change tracker but got %T", c.getFactory())
+	}
+
+	cc := ct.Changes()
+	oo := make([]runtime.Object, 0, len(cc))
+	for _, ch := range cc {
+		if client.IsNamespaced(ns) && ch.Namespace != ns {
+			continue
+		}
+		oo = append(oo, render.ChangeRes{
+			Seq:       ch.Seq,
+			Time:      ch.Time,
+			Type:      string(ch.Type),
+			GVR:       ch.GVR,
+			Namespace: ch.Namespace,
+			Name:      ch.Name,
+			Manager:   ch.Manager,
+			Operation: ch.Operation,
+			Fields:    ch.Fields,
+		})
+	}
+
+	return oo, nil
+}
+
+// Get returns the changed resource given a change row identifier.
+func (c *Change) Get(ctx context.Context, id string) (runtime.Object, error) {
+	gvr, path, ok := render.ParseChangeID(id)
+	if !ok {
+		return nil, fmt.Errorf("invalid change id %q", id)
+	}
+
+	return c.getFactory().Get(gvr, path, true, labels.Everything())
+}
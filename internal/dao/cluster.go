@@ -38,7 +38,7 @@ var (
 	_ RefScanner = (*DaemonSet)(nil)
 	_ RefScanner = (*Job)(nil)
 	_ RefScanner = (*CronJob)(nil)
-	// _ RefScanner = (*Pod)(nil)
+	_ RefScanner = (*Pod)(nil)
 )
 
 func scanners() map[string]RefScanner {
@@ -71,7 +71,10 @@ func ScanForRefs(ctx context.Context, f Factory) (Refs, error) {
 		log.Error().Msgf("expecting Context Wait Key")
 	}
 
-	ss := scanners()
+	return scanRefs(ctx, f, scanners(), gvr, fqn, wait)
+}
+
+func scanRefs(ctx context.Context, f Factory, ss map[string]RefScanner, gvr client.GVR, fqn string, wait bool) (Refs, error) {
 	var wg sync.WaitGroup
 	wg.Add(len(ss))
 	out := make(chan Refs)
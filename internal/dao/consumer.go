@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// ScanConsumers returns the resources referencing a given configmap or secret.
+// Unlike references scans, bare pods are included since they may consume them
+// directly.
+func ScanConsumers(ctx context.Context, f Factory, gvr client.GVR, path string) (Refs, error) {
+	ss := scanners()
+	ss["v1/pods"] = &Pod{}
+
+	return scanRefs(ctx, f, ss, gvr, path, true)
+}
+
+// SplitRestartable splits references into restartable workloads and others.
+func SplitRestartable(f Factory, refs Refs) (Refs, Refs) {
+	rr, oo := make(Refs, 0, len(refs)), make(Refs, 0, len(refs))
+	for _, ref := range refs {
+		acc, err := AccessorFor(f, client.NewGVR(ref.GVR))
+		if err != nil {
+			oo = append(oo, ref)
+			continue
+		}
+		if _, ok := acc.(Restartable); ok {
+			rr = append(rr, ref)
+		} else {
+			oo = append(oo, ref)
+		}
+	}
+
+	return rr, oo
+}
+
+// RestartConsumers performs a rollout restart on the given workloads.
+func RestartConsumers(ctx context.Context, f Factory, refs Refs) (int, error) {
+	var (
+		count int
+		errs  error
+	)
+	for _, ref := range refs {
+		acc, err := AccessorFor(f, client.NewGVR(ref.GVR))
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		r, ok := acc.(Restartable)
+		if !ok {
+			errs = errors.Join(errs, fmt.Errorf("%s is not restartable", ref.GVR))
+			continue
+		}
+		if err := r.Restart(ctx, ref.FQN); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s %s: %w", ref.GVR, ref.FQN, err))
+			continue
+		}
+		count++
+	}
+
+	return count, errs
+}
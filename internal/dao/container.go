@@ -5,6 +5,7 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/derailed/k9s/internal"
@@ -65,16 +66,67 @@ func (c *Container) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan,
 	return po.TailLogs(ctx, opts)
 }
 
+// Restartable checks if a given pod container can be restarted in place.
+func (c *Container) Restartable(fqn, co string) error {
+	po, err := c.fetchPod(fqn)
+	if err != nil {
+		return err
+	}
+
+	return CanRestartContainer(po, co)
+}
+
+// CanRestartContainer checks if signaling a container main process would
+// have the kubelet restart it.
+func CanRestartContainer(po *v1.Pod, co string) error {
+	var (
+		spec   *v1.Container
+		isInit bool
+	)
+	for i, cc := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+		for j := range cc {
+			if cc[j].Name == co {
+				spec, isInit = &cc[j], i == 0
+			}
+		}
+	}
+	if spec == nil {
+		return fmt.Errorf("no container %q found in pod %s", co, client.FQN(po.Namespace, po.Name))
+	}
+	if render.ContainerType(spec, isInit) == render.InitContainer {
+		return fmt.Errorf("init container %q can not be restarted", co)
+	}
+	if !isInit && po.Spec.RestartPolicy == v1.RestartPolicyNever {
+		return fmt.Errorf("pod restart policy is %s", v1.RestartPolicyNever)
+	}
+	if po.Spec.ShareProcessNamespace != nil && *po.Spec.ShareProcessNamespace {
+		return errors.New("pod shares its process namespace, pid 1 is not the container process")
+	}
+	if cs := getContainerStatus(co, po.Status); cs == nil || cs.State.Running == nil {
+		return fmt.Errorf("container %q is not running", co)
+	}
+
+	return nil
+}
+
+// ContainerKillArgs returns the kubectl arguments to signal a container main process.
+func ContainerKillArgs(fqn, co, sig string) []string {
+	ns, po := client.Namespaced(fqn)
+
+	return []string{"exec", "-n", ns, po, "-c", co, "--", "kill", "-" + sig, "1"}
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
 func makeContainerRes(co v1.Container, po *v1.Pod, cmx *mv1beta1.ContainerMetrics, isInit bool) render.ContainerRes {
 	return render.ContainerRes{
-		Container: &co,
-		Status:    getContainerStatus(co.Name, po.Status),
-		MX:        cmx,
-		IsInit:    isInit,
-		Age:       po.GetCreationTimestamp(),
+		Container:   &co,
+		Status:      getContainerStatus(co.Name, po.Status),
+		MX:          cmx,
+		IsInit:      isInit,
+		Age:         po.GetCreationTimestamp(),
+		PodSecurity: po.Spec.SecurityContext,
 	}
 }
 
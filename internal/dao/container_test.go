@@ -13,6 +13,7 @@ import (
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,6 +37,83 @@ func TestContainerList(t *testing.T) {
 	assert.Equal(t, 1, len(oo))
 }
 
+func TestCanRestartContainer(t *testing.T) {
+	always, yes := v1.ContainerRestartPolicyAlways, true
+	running := v1.ContainerState{Running: &v1.ContainerStateRunning{}}
+	uu := map[string]struct {
+		po  v1.Pod
+		co  string
+		err string
+	}{
+		"app": {
+			po: v1.Pod{
+				Spec:   v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+				Status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{{Name: "c1", State: running}}},
+			},
+			co: "c1",
+		},
+		"sidecar": {
+			po: v1.Pod{
+				Spec:   v1.PodSpec{InitContainers: []v1.Container{{Name: "i1", RestartPolicy: &always}}},
+				Status: v1.PodStatus{InitContainerStatuses: []v1.ContainerStatus{{Name: "i1", State: running}}},
+			},
+			co: "i1",
+		},
+		"init": {
+			po: v1.Pod{
+				Spec: v1.PodSpec{InitContainers: []v1.Container{{Name: "i1"}}},
+			},
+			co:  "i1",
+			err: `init container "i1" can not be restarted`,
+		},
+		"missing": {
+			po:  v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"}},
+			co:  "c1",
+			err: `no container "c1" found in pod ns1/p1`,
+		},
+		"never": {
+			po: v1.Pod{
+				Spec: v1.PodSpec{RestartPolicy: v1.RestartPolicyNever, Containers: []v1.Container{{Name: "c1"}}},
+			},
+			co:  "c1",
+			err: "pod restart policy is Never",
+		},
+		"shared-pid": {
+			po: v1.Pod{
+				Spec: v1.PodSpec{ShareProcessNamespace: &yes, Containers: []v1.Container{{Name: "c1"}}},
+			},
+			co:  "c1",
+			err: "pod shares its process namespace, pid 1 is not the container process",
+		},
+		"not-running": {
+			po: v1.Pod{
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+			},
+			co:  "c1",
+			err: `container "c1" is not running`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := dao.CanRestartContainer(&u.po, u.co)
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.Equal(t, u.err, err.Error())
+		})
+	}
+}
+
+func TestContainerKillArgs(t *testing.T) {
+	assert.Equal(t,
+		[]string{"exec", "-n", "ns1", "p1", "-c", "c1", "--", "kill", "-TERM", "1"},
+		dao.ContainerKillArgs("ns1/p1", "c1", "TERM"),
+	)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -55,6 +133,10 @@ func (c *conn) RestConfig() (*restclient.Config, error)               { return n
 func (c *conn) MXDial() (*versioned.Clientset, error)                 { return nil, nil }
 func (c *conn) DynDial() (dynamic.Interface, error)                   { return nil, nil }
 func (c *conn) HasMetrics() bool                                      { return false }
+func (c *conn) HasMetricsServer() bool                                { return false }
+func (c *conn) SetRateLimit(float32, int)                             {}
+func (c *conn) SetProxy(client.ProxySpec) error                       { return nil }
+func (c *conn) SetLowBandwidth(bool)                                  {}
 func (c *conn) CheckConnectivity() bool                               { return false }
 func (c *conn) IsNamespaced(n string) bool                            { return false }
 func (c *conn) SupportsResource(group string) bool                    { return false }
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+)
+
+// ContextDiff returns a line diff of a resource clean manifests across two
+// kubeconfig contexts. A resource missing in one context diffs as empty.
+func ContextDiff(ctx context.Context, cfg *client.Config, gvr client.GVR, fqn, ctx1, ctx2 string, rr ExportRules) ([]string, error) {
+	before, err := contextManifest(ctx, cfg, gvr, fqn, ctx1, rr)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ctx1, err)
+	}
+	after, err := contextManifest(ctx, cfg, gvr, fqn, ctx2, rr)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ctx2, err)
+	}
+	if before == "" && after == "" {
+		return nil, fmt.Errorf("%s %s not found in either context", gvr.R(), fqn)
+	}
+
+	return DiffManifests(before, after)
+}
+
+// DiffManifests returns a line diff between two manifests. Removed lines are
+// prefixed with -, added lines with + and common lines with a space.
+func DiffManifests(before, after string) ([]string, error) {
+	a, b := splitLines(before), splitLines(after)
+	if len(a) > MaxDiffLines || len(b) > MaxDiffLines {
+		return nil, fmt.Errorf("manifests exceed the %d lines diff limit", MaxDiffLines)
+	}
+
+	return diffLines(a, b), nil
+}
+
+func splitLines(s string) []string {
+	s = strings.TrimSuffix(s, "\n")
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, "\n")
+}
+
+func contextManifest(ctx context.Context, cfg *client.Config, gvr client.GVR, fqn, kctx string, rr ExportRules) (string, error) {
+	rc, err := cfg.ContextRESTConfig(kctx)
+	if err != nil {
+		return "", err
+	}
+	dial, err := dynamic.NewForConfig(rc)
+	if err != nil {
+		return "", err
+	}
+
+	ns, n := client.Namespaced(fqn)
+	var ri dynamic.ResourceInterface = dial.Resource(gvr.GVR())
+	if ns != "" && !client.IsClusterScoped(ns) {
+		ri = dial.Resource(gvr.GVR()).Namespace(ns)
+	}
+	u, err := ri.Get(ctx, n, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	rr.Clean(u.Object)
+	bb, err := yaml.Marshal(u.Object)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bb), nil
+}
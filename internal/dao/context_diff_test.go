@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffManifests(t *testing.T) {
+	uu := map[string]struct {
+		a, b string
+		e    []string
+		err  bool
+	}{
+		"same": {
+			a: "kind: ConfigMap\nmetadata:\n  name: fred\n",
+			b: "kind: ConfigMap\nmetadata:\n  name: fred\n",
+			e: []string{"  kind: ConfigMap", "  metadata:", "    name: fred"},
+		},
+		"changed": {
+			a: "data:\n  a: b\nkind: ConfigMap\n",
+			b: "data:\n  a: c\nkind: ConfigMap\n",
+			e: []string{"  data:", "-   a: b", "+   a: c", "  kind: ConfigMap"},
+		},
+		"missing": {
+			b: "kind: ConfigMap\n",
+			e: []string{"+ kind: ConfigMap"},
+		},
+		"toast": {
+			a:   strings.Repeat("a: b\n", MaxDiffLines+1),
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dd, err := DiffManifests(u.a, u.b)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, dd)
+		})
+	}
+}
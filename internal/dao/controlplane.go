@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// controlPlaneComponent describes how to reach a control plane component.
+type controlPlaneComponent struct {
+	name, port, probe string
+	elected           bool
+}
+
+// controlPlaneComponents tracks the kubeadm control plane static pods.
+var controlPlaneComponents = []controlPlaneComponent{
+	{name: "kube-apiserver"},
+	{name: "kube-scheduler", port: "https:10259", probe: "healthz", elected: true},
+	{name: "kube-controller-manager", port: "https:10257", probe: "healthz", elected: true},
+	{name: "etcd", port: "2381", probe: "health"},
+}
+
+func (c controlPlaneComponent) diagPods(ctx context.Context, dial kubernetes.Interface, pp []v1.Pod) []DiagPod {
+	dd := make([]DiagPod, 0, len(pp))
+	for i := range pp {
+		po := NewDiagPod(&pp[i])
+		if c.probe != "" {
+			po.Probes = map[string]string{
+				c.probe: probeProxy(ctx, dial, ProxyPath("pods", pp[i].Namespace, po.Name, c.port, c.probe)),
+			}
+		}
+		dd = append(dd, po)
+	}
+
+	return dd
+}
+
+// ControlPlane tracks the control plane health.
+type ControlPlane struct {
+	APIServer  map[string]string       `json:"apiserver"`
+	Components []ControlPlaneComponent `json:"components"`
+}
+
+// ControlPlaneComponent tracks a control plane component health.
+type ControlPlaneComponent struct {
+	Name   string    `json:"name"`
+	Leader string    `json:"leader,omitempty"`
+	Pods   []DiagPod `json:"pods,omitempty"`
+	Flags  []string  `json:"flags,omitempty"`
+	Note   string    `json:"note,omitempty"`
+}
+
+// ControlPlaneReport checks the api server livez/readyz endpoints along with
+// the control plane pods, flags and leader elections when they are visible.
+func ControlPlaneReport(ctx context.Context, f Factory) (string, error) {
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+
+	cp := ControlPlane{APIServer: make(map[string]string, 2)}
+	for _, p := range []string{"/livez", "/readyz"} {
+		raw, err := dial.CoreV1().RESTClient().Get().AbsPath(p).Param("verbose", "true").DoRaw(ctx)
+		if err != nil {
+			cp.APIServer[strings.TrimPrefix(p, "/")] = "error: " + err.Error()
+			continue
+		}
+		cp.APIServer[strings.TrimPrefix(p, "/")] = strings.TrimSpace(string(raw))
+	}
+
+	now := time.Now()
+	for _, c := range controlPlaneComponents {
+		comp := ControlPlaneComponent{Name: c.name}
+		pp, err := dial.CoreV1().Pods(netDiagNS).List(ctx, metav1.ListOptions{LabelSelector: "component=" + c.name})
+		switch {
+		case err != nil:
+			comp.Note = "error: " + err.Error()
+		case len(pp.Items) == 0:
+			comp.Note = "no pods visible ie managed or embedded control plane"
+		default:
+			comp.Flags = ComponentFlags(&pp.Items[0])
+			comp.Pods = c.diagPods(ctx, dial, pp.Items)
+		}
+		if c.elected {
+			if l, err := dial.CoordinationV1().Leases(netDiagNS).Get(ctx, c.name, metav1.GetOptions{}); err == nil {
+				comp.Leader = LeaseHolder(l, now)
+			}
+		}
+		cp.Components = append(cp.Components, comp)
+	}
+
+	raw, err := yaml.Marshal(cp)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// ComponentFlags returns the flags a control plane pod runs with.
+func ComponentFlags(po *v1.Pod) []string {
+	if len(po.Spec.Containers) == 0 {
+		return nil
+	}
+	c := po.Spec.Containers[0]
+	ff := make([]string, 0, len(c.Command)+len(c.Args))
+	for _, a := range append(append([]string{}, c.Command...), c.Args...) {
+		if strings.HasPrefix(a, "--") {
+			ff = append(ff, a)
+		}
+	}
+
+	return ff
+}
+
+// LeaseHolder describes a leader election lease holder.
+func LeaseHolder(l *coordinationv1.Lease, now time.Time) string {
+	if l.Spec.HolderIdentity == nil || *l.Spec.HolderIdentity == "" {
+		return "<none>"
+	}
+	h := *l.Spec.HolderIdentity
+	if l.Spec.RenewTime != nil {
+		h += " (renewed " + duration.HumanDuration(now.Sub(l.Spec.RenewTime.Time)) + " ago)"
+	}
+
+	return h
+}
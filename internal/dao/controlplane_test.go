@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestComponentFlags(t *testing.T) {
+	uu := map[string]struct {
+		po v1.Pod
+		e  []string
+	}{
+		"none": {},
+		"command": {
+			po: v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{
+				Command: []string{"kube-scheduler", "--bind-address=127.0.0.1", "--leader-elect=true"},
+			}}}},
+			e: []string{"--bind-address=127.0.0.1", "--leader-elect=true"},
+		},
+		"args": {
+			po: v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{
+				Command: []string{"etcd"},
+				Args:    []string{"--data-dir=/var/lib/etcd", "blee"},
+			}}}},
+			e: []string{"--data-dir=/var/lib/etcd"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ComponentFlags(&u.po))
+		})
+	}
+}
+
+func TestLeaseHolder(t *testing.T) {
+	now := time.Now()
+	holder := func(s string) *string { return &s }
+	uu := map[string]struct {
+		spec coordinationv1.LeaseSpec
+		e    string
+	}{
+		"none": {
+			e: "<none>",
+		},
+		"empty": {
+			spec: coordinationv1.LeaseSpec{HolderIdentity: holder("")},
+			e:    "<none>",
+		},
+		"no-renew": {
+			spec: coordinationv1.LeaseSpec{HolderIdentity: holder("cp-1_abc")},
+			e:    "cp-1_abc",
+		},
+		"renewed": {
+			spec: coordinationv1.LeaseSpec{
+				HolderIdentity: holder("cp-1_abc"),
+				RenewTime:      &metav1.MicroTime{Time: now.Add(-5 * time.Second)},
+			},
+			e: "cp-1_abc (renewed 5s ago)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, LeaseHolder(&coordinationv1.Lease{Spec: u.spec}, now))
+		})
+	}
+}
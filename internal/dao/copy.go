@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"sigs.k8s.io/yaml"
+)
+
+// CopyManifest returns the clean manifests of the given resources retargeted
+// to a namespace and a name. Blank values keep the original ones.
+func CopyManifest(f Factory, gvr client.GVR, paths []string, rr ExportRules, ns, name string) (string, error) {
+	meta, err := MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return "", err
+	}
+
+	docs := make([]string, 0, len(paths))
+	for _, path := range paths {
+		u, err := getUnstructured(f, gvr, path)
+		if err != nil {
+			return "", err
+		}
+		o := u.DeepCopy().Object
+		rr.Clean(o)
+		Retarget(o, meta.Namespaced, ns, name)
+		bb, err := yaml.Marshal(o)
+		if err != nil {
+			return "", err
+		}
+		docs = append(docs, string(bb))
+	}
+
+	return strings.Join(docs, "---\n"), nil
+}
+
+// Retarget points a resource to a new namespace and name. Blank values keep
+// the current ones.
+func Retarget(o map[string]interface{}, namespaced bool, ns, name string) {
+	m, ok := o["metadata"].(map[string]interface{})
+	if !ok {
+		m = make(map[string]interface{})
+		o["metadata"] = m
+	}
+	if name != "" {
+		m["name"] = name
+	}
+	if !namespaced {
+		delete(m, "namespace")
+		return
+	}
+	if ns != "" {
+		m["namespace"] = ns
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetarget(t *testing.T) {
+	uu := map[string]struct {
+		o          map[string]interface{}
+		namespaced bool
+		ns, name   string
+		e          map[string]interface{}
+	}{
+		"keep": {
+			o:          map[string]interface{}{"metadata": map[string]interface{}{"name": "fred", "namespace": "blee"}},
+			namespaced: true,
+			e:          map[string]interface{}{"metadata": map[string]interface{}{"name": "fred", "namespace": "blee"}},
+		},
+		"namespace": {
+			o:          map[string]interface{}{"metadata": map[string]interface{}{"name": "fred", "namespace": "blee"}},
+			namespaced: true,
+			ns:         "zorg",
+			e:          map[string]interface{}{"metadata": map[string]interface{}{"name": "fred", "namespace": "zorg"}},
+		},
+		"rename": {
+			o:          map[string]interface{}{"metadata": map[string]interface{}{"name": "fred", "namespace": "blee"}},
+			namespaced: true,
+			ns:         "zorg",
+			name:       "bozo",
+			e:          map[string]interface{}{"metadata": map[string]interface{}{"name": "bozo", "namespace": "zorg"}},
+		},
+		"cluster-scoped": {
+			o:    map[string]interface{}{"metadata": map[string]interface{}{"name": "fred"}},
+			ns:   "zorg",
+			name: "bozo",
+			e:    map[string]interface{}{"metadata": map[string]interface{}{"name": "bozo"}},
+		},
+		"no-meta": {
+			o:          map[string]interface{}{},
+			namespaced: true,
+			ns:         "zorg",
+			e:          map[string]interface{}{"metadata": map[string]interface{}{"namespace": "zorg"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			Retarget(u.o, u.namespaced, u.ns, u.name)
+			assert.Equal(t, u.e, u.o)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	dataSection       = "data"
+	binaryDataSection = "binaryData"
+)
+
+var _ Accessor = (*DataKey)(nil)
+
+// DataKey represents the keys of a configmap or secret.
+type DataKey struct {
+	NonResource
+}
+
+// List returns a collection of data keys.
+func (d *DataKey) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	u, err := d.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kk, err := DataKeys(u)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(kk))
+	for _, k := range kk {
+		oo = append(oo, k)
+	}
+
+	return oo, nil
+}
+
+// Get returns a given data key.
+func (d *DataKey) Get(ctx context.Context, key string) (runtime.Object, error) {
+	u, err := d.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kk, err := DataKeys(u)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range kk {
+		if k.Key == key {
+			return k, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no key %q found in %s", key, u.GetName())
+}
+
+func (d *DataKey) fetch(ctx context.Context) (*unstructured.Unstructured, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", d.gvr)
+	}
+	gvr, ok := ctx.Value(internal.KeyGVR).(client.GVR)
+	if !ok {
+		return nil, fmt.Errorf("no context gvr for %q", d.gvr)
+	}
+
+	o, err := d.getFactory().Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return u, nil
+}
+
+// DataKeys returns the decoded keys of a configmap or secret.
+func DataKeys(u *unstructured.Unstructured) ([]render.DataKeyRes, error) {
+	kk := make([]render.DataKeyRes, 0, 10)
+	for _, section := range []string{dataSection, binaryDataSection} {
+		m, _, _ := unstructured.NestedStringMap(u.Object, section)
+		for k := range m {
+			bb, err := DataKeyValue(u, k)
+			if err != nil {
+				return nil, err
+			}
+			kk = append(kk, render.DataKeyRes{
+				Key:    k,
+				Source: section,
+				Type:   DetectDataType(bb),
+				Binary: IsBinaryData(bb),
+				Value:  bb,
+			})
+		}
+	}
+	sort.Slice(kk, func(i, j int) bool {
+		return kk[i].Key < kk[j].Key
+	})
+
+	return kk, nil
+}
+
+// DataKeyValue returns the raw content of a configmap or secret key.
+func DataKeyValue(u *unstructured.Unstructured, key string) ([]byte, error) {
+	if v, ok, _ := unstructured.NestedString(u.Object, dataSection, key); ok {
+		if u.GetKind() != "Secret" {
+			return []byte(v), nil
+		}
+		return base64.StdEncoding.DecodeString(v)
+	}
+	if v, ok, _ := unstructured.NestedString(u.Object, binaryDataSection, key); ok {
+		return base64.StdEncoding.DecodeString(v)
+	}
+
+	return nil, fmt.Errorf("no key %q found in %s", key, u.GetName())
+}
+
+// SetDataKeyValue sets the content of a configmap or secret key. Configmap
+// binary content is stored as binaryData.
+func SetDataKeyValue(u *unstructured.Unstructured, key string, bb []byte) error {
+	section, val := dataSection, base64.StdEncoding.EncodeToString(bb)
+	if u.GetKind() != "Secret" {
+		if IsBinaryData(bb) {
+			section = binaryDataSection
+		} else {
+			val = string(bb)
+		}
+	}
+	for _, s := range []string{dataSection, binaryDataSection} {
+		unstructured.RemoveNestedField(u.Object, s, key)
+	}
+
+	return unstructured.SetNestedField(u.Object, val, section, key)
+}
+
+// IsBinaryData checks if content can't be displayed as text.
+func IsBinaryData(bb []byte) bool {
+	return !utf8.Valid(bb) || bytes.IndexByte(bb, 0) >= 0
+}
+
+// DetectDataType sniffs the content type of a data key.
+func DetectDataType(bb []byte) string {
+	if len(bb) == 0 {
+		return "empty"
+	}
+	t, _, _ := strings.Cut(http.DetectContentType(bb), ";")
+	if t != "text/plain" {
+		return t
+	}
+
+	s := bytes.TrimSpace(bb)
+	switch {
+	case bytes.HasPrefix(s, []byte("-----BEGIN CERTIFICATE")):
+		return "x509/pem"
+	case bytes.HasPrefix(s, []byte("-----BEGIN")) && bytes.Contains(s, []byte("PRIVATE KEY")):
+		return "key/pem"
+	case bytes.HasPrefix(s, []byte("#!")):
+		return "text/script"
+	case len(s) > 0 && (s[0] == '{' || s[0] == '[') && json.Valid(s):
+		return "application/json"
+	}
+
+	return t
+}
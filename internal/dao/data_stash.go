@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefaultStashSize tracks the number of versions kept per object.
+	DefaultStashSize = 10
+
+	stashTimeFmt  = "20060102T150405.000000000Z"
+	stashFileMode = 0600
+)
+
+// DataOp represents a data key operation.
+type DataOp string
+
+const (
+	// DataAdded tracks a new key.
+	DataAdded DataOp = "+"
+
+	// DataRemoved tracks a deleted key.
+	DataRemoved DataOp = "-"
+
+	// DataUpdated tracks a modified key.
+	DataUpdated DataOp = "~"
+)
+
+// DataChange represents a configmap or secret key change.
+type DataChange struct {
+	Key           string
+	Op            DataOp
+	Before, After string
+}
+
+// DataSnapshot represents a configmap or secret data payload.
+type DataSnapshot struct {
+	ResourceVersion string            `json:"resourceVersion,omitempty"`
+	Data            map[string]string `json:"data,omitempty"`
+	BinaryData      map[string]string `json:"binaryData,omitempty"`
+}
+
+// SnapshotData captures a configmap or secret data payload.
+func SnapshotData(u *unstructured.Unstructured) DataSnapshot {
+	s := DataSnapshot{ResourceVersion: u.GetResourceVersion()}
+	s.Data, _, _ = unstructured.NestedStringMap(u.Object, "data")
+	s.BinaryData, _, _ = unstructured.NestedStringMap(u.Object, "binaryData")
+
+	return s
+}
+
+// Apply replaces the object data payload with the snapshot's.
+func (s DataSnapshot) Apply(u *unstructured.Unstructured) error {
+	unstructured.RemoveNestedField(u.Object, "stringData")
+	for k, m := range map[string]map[string]string{"data": s.Data, "binaryData": s.BinaryData} {
+		if len(m) == 0 {
+			unstructured.RemoveNestedField(u.Object, k)
+			continue
+		}
+		if err := unstructured.SetNestedStringMap(u.Object, m, k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DataValues returns the effective key/values of a configmap or secret.
+// Secret values are decoded and binary configmap values are kept encoded.
+func DataValues(u *unstructured.Unstructured) (map[string]string, error) {
+	s := SnapshotData(u)
+	vv := make(map[string]string, len(s.Data)+len(s.BinaryData))
+	if u.GetKind() != "Secret" {
+		for k, v := range s.Data {
+			vv[k] = v
+		}
+		for k, v := range s.BinaryData {
+			vv[k] = v
+		}
+		return vv, nil
+	}
+
+	for k, v := range s.Data {
+		bb, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil, fmt.Errorf("secret key %q is not base64 encoded: %w", k, err)
+		}
+		vv[k] = string(bb)
+	}
+	sd, _, _ := unstructured.NestedStringMap(u.Object, "stringData")
+	for k, v := range sd {
+		vv[k] = v
+	}
+
+	return vv, nil
+}
+
+// DiffData computes the key changes between two data payloads.
+func DiffData(before, after map[string]string) []DataChange {
+	cc := make([]DataChange, 0, len(after))
+	for k, v := range after {
+		old, ok := before[k]
+		switch {
+		case !ok:
+			cc = append(cc, DataChange{Key: k, Op: DataAdded, After: v})
+		case old != v:
+			cc = append(cc, DataChange{Key: k, Op: DataUpdated, Before: old, After: v})
+		}
+	}
+	for k, v := range before {
+		if _, ok := after[k]; !ok {
+			cc = append(cc, DataChange{Key: k, Op: DataRemoved, Before: v})
+		}
+	}
+	sort.Slice(cc, func(i, j int) bool {
+		return cc[i].Key < cc[j].Key
+	})
+
+	return cc
+}
+
+// FormatDataDiff renders data changes. When redact is set values are
+// replaced by their sizes.
+func FormatDataDiff(cc []DataChange, redact bool) string {
+	val := func(s string) string {
+		if redact {
+			return fmt.Sprintf("<%d bytes>", len(s))
+		}
+		if strings.Contains(s, "\n") {
+			return fmt.Sprintf("<%d lines>", strings.Count(s, "\n")+1)
+		}
+		return fmt.Sprintf("%q", s)
+	}
+
+	var b strings.Builder
+	for i, c := range cc {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		switch c.Op {
+		case DataAdded:
+			fmt.Fprintf(&b, "%s %s: %s", c.Op, c.Key, val(c.After))
+		case DataRemoved:
+			fmt.Fprintf(&b, "%s %s: %s", c.Op, c.Key, val(c.Before))
+		default:
+			fmt.Fprintf(&b, "%s %s: %s -> %s", c.Op, c.Key, val(c.Before), val(c.After))
+		}
+	}
+
+	return b.String()
+}
+
+// DataVersion represents a stashed data payload.
+type DataVersion struct {
+	Path  string
+	Taken time.Time
+}
+
+// DataStash keeps previous configmap and secret payloads on disk.
+type DataStash struct {
+	dir     string
+	size    int
+	secrets bool
+}
+
+// NewDataStash returns a new stash. Secret payloads are only stashed when
+// secrets is set since they land on disk unencrypted.
+func NewDataStash(dir string, size int, secrets bool) *DataStash {
+	if size <= 0 {
+		size = DefaultStashSize
+	}
+
+	return &DataStash{dir: dir, size: size, secrets: secrets}
+}
+
+// Enabled checks if the given resource payloads can be stashed.
+func (s *DataStash) Enabled(gvr client.GVR) bool {
+	return gvr != SecGVR || s.secrets
+}
+
+// Save stashes a new version of the given object payload and evicts
+// the oldest ones.
+func (s *DataStash) Save(gvr client.GVR, path string, snap DataSnapshot) error {
+	if !s.Enabled(gvr) {
+		return fmt.Errorf("stashing %s is disabled", gvr.R())
+	}
+	dir := s.objectDir(gvr, path)
+	if err := data.EnsureFullPath(dir, data.DefaultDirMod); err != nil {
+		return err
+	}
+	raw, err := yaml.Marshal(snap)
+	if err != nil {
+		return err
+	}
+	file := filepath.Join(dir, time.Now().UTC().Format(stashTimeFmt)+".yaml")
+	if err := os.WriteFile(file, raw, stashFileMode); err != nil {
+		return err
+	}
+
+	vv, err := s.Versions(gvr, path)
+	if err != nil {
+		return err
+	}
+	for _, v := range vv[min(len(vv), s.size):] {
+		if err := os.Remove(v.Path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Versions lists the stashed versions of an object, most recent first.
+func (s *DataStash) Versions(gvr client.GVR, path string) ([]DataVersion, error) {
+	ee, err := os.ReadDir(s.objectDir(gvr, path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	vv := make([]DataVersion, 0, len(ee))
+	for _, e := range ee {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".yaml" {
+			continue
+		}
+		t, err := time.Parse(stashTimeFmt, strings.TrimSuffix(e.Name(), ".yaml"))
+		if err != nil {
+			continue
+		}
+		vv = append(vv, DataVersion{
+			Path:  filepath.Join(s.objectDir(gvr, path), e.Name()),
+			Taken: t,
+		})
+	}
+	sort.Slice(vv, func(i, j int) bool {
+		return vv[i].Taken.After(vv[j].Taken)
+	})
+
+	return vv, nil
+}
+
+// Load reads a stashed version.
+func (s *DataStash) Load(v DataVersion) (DataSnapshot, error) {
+	var snap DataSnapshot
+	raw, err := os.ReadFile(v.Path)
+	if err != nil {
+		return snap, err
+	}
+	if err := yaml.Unmarshal(raw, &snap); err != nil {
+		return snap, fmt.Errorf("invalid stash file %s: %w", v.Path, err)
+	}
+
+	return snap, nil
+}
+
+func (s *DataStash) objectDir(gvr client.GVR, path string) string {
+	ns, n := client.Namespaced(path)
+	if client.IsClusterScoped(ns) {
+		ns = "_"
+	}
+
+	return filepath.Join(s.dir, data.SanitizeFileName(gvr.R()), data.SanitizeFileName(ns), data.SanitizeFileName(n))
+}
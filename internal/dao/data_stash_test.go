@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"os"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDiffData(t *testing.T) {
+	uu := map[string]struct {
+		before, after map[string]string
+		e             []DataChange
+	}{
+		"same": {
+			before: map[string]string{"a": "1"},
+			after:  map[string]string{"a": "1"},
+			e:      []DataChange{},
+		},
+		"mixed": {
+			before: map[string]string{"a": "1", "b": "2", "c": "3"},
+			after:  map[string]string{"a": "1", "b": "20", "d": "4"},
+			e: []DataChange{
+				{Key: "b", Op: DataUpdated, Before: "2", After: "20"},
+				{Key: "c", Op: DataRemoved, Before: "3"},
+				{Key: "d", Op: DataAdded, After: "4"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, DiffData(u.before, u.after))
+		})
+	}
+}
+
+func TestFormatDataDiff(t *testing.T) {
+	cc := []DataChange{
+		{Key: "b", Op: DataUpdated, Before: "2", After: "20"},
+		{Key: "c", Op: DataRemoved, Before: "a\nb"},
+	}
+
+	assert.Equal(t, "~ b: \"2\" -> \"20\"\n- c: <2 lines>", FormatDataDiff(cc, false))
+	assert.Equal(t, "~ b: <1 bytes> -> <2 bytes>\n- c: <3 bytes>", FormatDataDiff(cc, true))
+}
+
+func TestDataValuesSecret(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":       "Secret",
+		"data":       map[string]interface{}{"user": "ZnJlZA==", "pwd": "YmxlZQ=="},
+		"stringData": map[string]interface{}{"pwd": "zorg"},
+	}}
+
+	vv, err := DataValues(&u)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"user": "fred", "pwd": "zorg"}, vv)
+}
+
+func TestDataSnapshotApply(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":       "ConfigMap",
+		"data":       map[string]interface{}{"a": "1"},
+		"binaryData": map[string]interface{}{"b": "YmxlZQ=="},
+	}}
+	snap := DataSnapshot{Data: map[string]string{"a": "2"}}
+
+	assert.Nil(t, snap.Apply(&u))
+	vv, err := DataValues(&u)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"a": "2"}, vv)
+}
+
+func TestDataStash(t *testing.T) {
+	s, gvr := NewDataStash(t.TempDir(), 2, false), client.NewGVR("v1/configmaps")
+	for _, v := range []string{"1", "2", "3"} {
+		assert.Nil(t, s.Save(gvr, "ns1/fred", DataSnapshot{Data: map[string]string{"a": v}}))
+	}
+
+	vv, err := s.Versions(gvr, "ns1/fred")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(vv))
+
+	snap, err := s.Load(vv[0])
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"a": "3"}, snap.Data)
+
+	vv, err = s.Versions(gvr, "ns1/blee")
+	assert.Nil(t, err)
+	assert.Empty(t, vv)
+}
+
+func TestDataStashSecrets(t *testing.T) {
+	dir := t.TempDir()
+	snap := DataSnapshot{Data: map[string]string{"a": "MQ=="}}
+
+	s := NewDataStash(dir, 2, false)
+	assert.False(t, s.Enabled(SecGVR))
+	assert.True(t, s.Enabled(CmGVR))
+	assert.Error(t, s.Save(SecGVR, "ns1/fred", snap))
+	vv, err := s.Versions(SecGVR, "ns1/fred")
+	assert.Nil(t, err)
+	assert.Empty(t, vv)
+
+	s = NewDataStash(dir, 2, true)
+	assert.Nil(t, s.Save(SecGVR, "ns1/fred", snap))
+	vv, err = s.Versions(SecGVR, "ns1/fred")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(vv))
+	fi, err := os.Stat(vv[0].Path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(stashFileMode), fi.Mode().Perm())
+}
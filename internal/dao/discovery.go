@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/derailed/k9s/internal/config/data"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// DiscoveryStaleAge tracks the age past which a discovery cache is deemed stale.
+const DiscoveryStaleAge = 7 * 24 * time.Hour
+
+// DiscoveryCache persists a cluster preferred resources and CRDs metadata.
+type DiscoveryCache struct {
+	Discovered time.Time                     `json:"discovered"`
+	Resources  map[string]metav1.APIResource `json:"resources"`
+}
+
+// NewDiscoveryCache returns a new discovery cache from resource metas.
+func NewDiscoveryCache(m ResourceMetas, t time.Time) *DiscoveryCache {
+	dc := DiscoveryCache{
+		Discovered: t,
+		Resources:  make(map[string]metav1.APIResource, len(m)),
+	}
+	for gvr, meta := range m {
+		dc.Resources[gvr.String()] = meta
+	}
+
+	return &dc
+}
+
+// LoadDiscoveryCache loads a discovery cache from disk.
+func LoadDiscoveryCache(path string) (*DiscoveryCache, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var dc DiscoveryCache
+	if err := json.Unmarshal(raw, &dc); err != nil {
+		return nil, err
+	}
+
+	return &dc, nil
+}
+
+// Save persists the discovery cache.
+func (d *DiscoveryCache) Save(path string) error {
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, raw, data.DefaultFileMod)
+}
+
+// DiscoveryStatus tracks the discovery cache state.
+type DiscoveryStatus struct {
+	Enabled      bool              `json:"enabled"`
+	Path         string            `json:"path,omitempty"`
+	Discovered   string            `json:"discovered,omitempty"`
+	Age          string            `json:"age,omitempty"`
+	Stale        bool              `json:"stale"`
+	Resources    int               `json:"resources"`
+	FailedGroups map[string]string `json:"failedGroups,omitempty"`
+}
+
+// NewDiscoveryStatus returns a discovery cache status.
+func NewDiscoveryStatus(path string, discovered time.Time, count int, now time.Time) DiscoveryStatus {
+	st := DiscoveryStatus{
+		Enabled:   path != "",
+		Path:      path,
+		Resources: count,
+	}
+	if discovered.IsZero() {
+		return st
+	}
+	st.Discovered = discovered.Format(time.RFC3339)
+	st.Age = duration.HumanDuration(now.Sub(discovered))
+	st.Stale = IsStaleDiscovery(discovered, now)
+
+	return st
+}
+
+// IsStaleDiscovery checks if a discovery is past its shelf life.
+func IsStaleDiscovery(discovered, now time.Time) bool {
+	return now.Sub(discovered) > DiscoveryStaleAge
+}
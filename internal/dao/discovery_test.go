@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscoveryCacheRoundTrip(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := dao.ResourceMetas{
+		client.NewGVR("v1/pods"):          {Name: "pods", Kind: "Pod", Namespaced: true},
+		client.NewGVR("fred.io/v1/blees"): {Name: "blees", Kind: "Blee", Group: "fred.io", Version: "v1", Categories: []string{"crd"}},
+	}
+	path := filepath.Join(t.TempDir(), "ct", "discovery.json")
+
+	assert.NoError(t, dao.NewDiscoveryCache(m, now).Save(path))
+	dc, err := dao.LoadDiscoveryCache(path)
+	assert.NoError(t, err)
+	assert.True(t, now.Equal(dc.Discovered))
+	assert.Equal(t, 2, len(dc.Resources))
+	assert.Equal(t, m[client.NewGVR("fred.io/v1/blees")], dc.Resources["fred.io/v1/blees"])
+}
+
+func TestNewDiscoveryStatus(t *testing.T) {
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	uu := map[string]struct {
+		path       string
+		discovered time.Time
+		e          dao.DiscoveryStatus
+	}{
+		"disabled": {
+			e: dao.DiscoveryStatus{Resources: 10},
+		},
+		"fresh": {
+			path:       "/tmp/d.json",
+			discovered: now.Add(-time.Hour),
+			e: dao.DiscoveryStatus{
+				Enabled:    true,
+				Path:       "/tmp/d.json",
+				Discovered: "2024-01-09T23:00:00Z",
+				Age:        "60m",
+				Resources:  10,
+			},
+		},
+		"stale": {
+			path:       "/tmp/d.json",
+			discovered: now.Add(-8 * 24 * time.Hour),
+			e: dao.DiscoveryStatus{
+				Enabled:    true,
+				Path:       "/tmp/d.json",
+				Discovered: "2024-01-02T00:00:00Z",
+				Age:        "8d",
+				Stale:      true,
+				Resources:  10,
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.NewDiscoveryStatus(u.path, u.discovered, 10, now))
+		})
+	}
+}
@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// MaxDNSLookups caps the number of concurrent DNS lookups.
+	MaxDNSLookups = 8
+
+	// DNSLookupInterval tracks how often a hostname is looked up.
+	DNSLookupInterval = 30 * time.Second
+
+	// DNSLookupTimeout bounds a DNS lookup.
+	DNSLookupTimeout = 5 * time.Second
+
+	extDNSHostnameAnn   = "external-dns.alpha.kubernetes.io/hostname"
+	extDNSTargetAnn     = "external-dns.alpha.kubernetes.io/target"
+	extDNSTTLAnn        = "external-dns.alpha.kubernetes.io/ttl"
+	extDNSHostSourceAnn = "external-dns.alpha.kubernetes.io/ingress-hostname-source"
+)
+
+var _ Accessor = (*DNSRecord)(nil)
+
+// DNSRecord tracks the DNS records external-dns publishes for ingresses and
+// services.
+type DNSRecord struct {
+	NonResource
+
+	mx      sync.Mutex
+	sem     chan struct{}
+	lookups map[string]*dnsLookup
+}
+
+type dnsLookup struct {
+	addrs []string
+	err   error
+	at    time.Time
+	busy  bool
+}
+
+// List returns the records published by ingresses and annotated services.
+// When lookups are enabled, hostnames are resolved in the background so
+// stale or pending lookups are returned while they run.
+func (d *DNSRecord) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	rr, err := d.records(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	if on, _ := ctx.Value(internal.KeyDNSLookup).(bool); on {
+		d.mx.Lock()
+		defer d.mx.Unlock()
+		if d.lookups == nil {
+			d.lookups, d.sem = make(map[string]*dnsLookup), make(chan struct{}, MaxDNSLookups)
+		}
+		for i := range rr {
+			d.check(&rr[i])
+		}
+	}
+
+	oo := make([]runtime.Object, 0, len(rr))
+	for i := range rr {
+		oo = append(oo, &rr[i])
+	}
+
+	return oo, nil
+}
+
+func (d *DNSRecord) records(ns string) ([]render.DNSRecordRes, error) {
+	var rr []render.DNSRecordRes
+	err := d.each(IngGVR, ns, func(u *unstructured.Unstructured) error {
+		var ing netv1.Ingress
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ing); err != nil {
+			return err
+		}
+		rr = append(rr, IngressDNSRecords(&ing)...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = d.each(SvcGVR, ns, func(u *unstructured.Unstructured) error {
+		var svc v1.Service
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &svc); err != nil {
+			return err
+		}
+		rr = append(rr, ServiceDNSRecords(&svc)...)
+		return nil
+	})
+
+	return rr, err
+}
+
+func (d *DNSRecord) each(gvr client.GVR, ns string, f func(*unstructured.Unstructured) error) error {
+	oo, err := d.getFactory().List(gvr.String(), ns, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		if err := f(u); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// check fills in a record lookup results. Callers must hold the lock.
+func (d *DNSRecord) check(r *render.DNSRecordRes) {
+	if len(r.Targets) == 0 || strings.HasPrefix(r.Hostname, "*.") {
+		return
+	}
+	r.Checked = true
+	l := d.lookup(r.Hostname)
+	if l == nil {
+		r.Resolving = true
+		return
+	}
+	r.Resolved, r.LookupErr = l.addrs, l.err
+	for _, t := range r.Targets {
+		if net.ParseIP(t) != nil {
+			r.TargetIPs = append(r.TargetIPs, t)
+			continue
+		}
+		tl := d.lookup(t)
+		if tl == nil {
+			r.Resolving = true
+			continue
+		}
+		r.TargetIPs = append(r.TargetIPs, tl.addrs...)
+	}
+}
+
+// lookup returns a host last lookup if any, refreshing it in the background
+// when stale. Callers must hold the lock.
+func (d *DNSRecord) lookup(host string) *dnsLookup {
+	l, ok := d.lookups[host]
+	if !ok {
+		l = &dnsLookup{}
+		d.lookups[host] = l
+	}
+	if !l.busy && (l.at.IsZero() || time.Since(l.at) > DNSLookupInterval) {
+		l.busy = true
+		go d.resolve(host)
+	}
+	if l.at.IsZero() {
+		return nil
+	}
+
+	return l
+}
+
+func (d *DNSRecord) resolve(host string) {
+	d.sem <- struct{}{}
+	defer func() { <-d.sem }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), DNSLookupTimeout)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	sort.Strings(addrs)
+
+	d.mx.Lock()
+	defer d.mx.Unlock()
+	l := d.lookups[host]
+	l.addrs, l.err, l.at, l.busy = addrs, err, time.Now(), false
+}
+
+// IngressDNSRecords returns the records external-dns publishes for an
+// ingress ie its rules and tls hosts along with its hostname annotation.
+func IngressDNSRecords(ing *netv1.Ingress) []render.DNSRecordRes {
+	var hh []string
+	source := ing.Annotations[extDNSHostSourceAnn]
+	if source != "annotation-only" {
+		for _, r := range ing.Spec.Rules {
+			hh = append(hh, r.Host)
+		}
+		for _, tls := range ing.Spec.TLS {
+			hh = append(hh, tls.Hosts...)
+		}
+	}
+	if source != "defined-hosts-only" {
+		hh = append(hh, splitAnnotation(ing.Annotations[extDNSHostnameAnn])...)
+	}
+
+	tt := splitAnnotation(ing.Annotations[extDNSTargetAnn])
+	if len(tt) == 0 {
+		for _, lb := range ing.Status.LoadBalancer.Ingress {
+			tt = append(tt, lbTarget(lb.IP, lb.Hostname))
+		}
+	}
+
+	return dnsRecords(IngGVR, "Ingress", &ing.ObjectMeta, hh, tt)
+}
+
+// ServiceDNSRecords returns the records external-dns publishes for a service
+// annotated with a hostname.
+func ServiceDNSRecords(svc *v1.Service) []render.DNSRecordRes {
+	hh := splitAnnotation(svc.Annotations[extDNSHostnameAnn])
+	if len(hh) == 0 {
+		return nil
+	}
+
+	tt := splitAnnotation(svc.Annotations[extDNSTargetAnn])
+	if len(tt) == 0 {
+		for _, lb := range svc.Status.LoadBalancer.Ingress {
+			tt = append(tt, lbTarget(lb.IP, lb.Hostname))
+		}
+	}
+
+	return dnsRecords(SvcGVR, "Service", &svc.ObjectMeta, hh, tt)
+}
+
+func dnsRecords(gvr client.GVR, kind string, m *metav1.ObjectMeta, hh, tt []string) []render.DNSRecordRes {
+	var (
+		rr   []render.DNSRecordRes
+		seen = make(map[string]struct{}, len(hh))
+	)
+	for _, h := range hh {
+		h = strings.TrimSuffix(strings.TrimSpace(h), ".")
+		if _, ok := seen[h]; ok || h == "" {
+			continue
+		}
+		seen[h] = struct{}{}
+		rr = append(rr, render.DNSRecordRes{
+			GVR:       gvr.String(),
+			Namespace: m.Namespace,
+			Name:      m.Name,
+			Kind:      kind,
+			Hostname:  h,
+			TTL:       m.Annotations[extDNSTTLAnn],
+			Targets:   tt,
+		})
+	}
+
+	return rr
+}
+
+func lbTarget(ip, host string) string {
+	if ip != "" {
+		return ip
+	}
+
+	return host
+}
+
+func splitAnnotation(s string) []string {
+	var ss []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			ss = append(ss, v)
+		}
+	}
+
+	return ss
+}
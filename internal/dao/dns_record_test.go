@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIngressDNSRecords(t *testing.T) {
+	uu := map[string]struct {
+		ann map[string]string
+		e   []string
+		tt  []string
+	}{
+		"defaults": {
+			e:  []string{"a.example.com", "b.example.com"},
+			tt: []string{"10.0.0.1", "lb.example.com"},
+		},
+		"annotated": {
+			ann: map[string]string{
+				extDNSHostnameAnn: "c.example.com., a.example.com",
+				extDNSTargetAnn:   "1.2.3.4",
+			},
+			e:  []string{"a.example.com", "b.example.com", "c.example.com"},
+			tt: []string{"1.2.3.4"},
+		},
+		"annotation-only": {
+			ann: map[string]string{
+				extDNSHostnameAnn:   "c.example.com",
+				extDNSHostSourceAnn: "annotation-only",
+			},
+			e:  []string{"c.example.com"},
+			tt: []string{"10.0.0.1", "lb.example.com"},
+		},
+		"defined-hosts-only": {
+			ann: map[string]string{
+				extDNSHostnameAnn:   "c.example.com",
+				extDNSHostSourceAnn: "defined-hosts-only",
+			},
+			e:  []string{"a.example.com", "b.example.com"},
+			tt: []string{"10.0.0.1", "lb.example.com"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ing := netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred", Annotations: u.ann},
+				Spec: netv1.IngressSpec{
+					Rules: []netv1.IngressRule{{Host: "a.example.com"}, {Host: "b.example.com"}},
+					TLS:   []netv1.IngressTLS{{Hosts: []string{"a.example.com"}}},
+				},
+				Status: netv1.IngressStatus{
+					LoadBalancer: netv1.IngressLoadBalancerStatus{
+						Ingress: []netv1.IngressLoadBalancerIngress{{IP: "10.0.0.1"}, {Hostname: "lb.example.com"}},
+					},
+				},
+			}
+			rr := IngressDNSRecords(&ing)
+			hh := make([]string, 0, len(rr))
+			for _, r := range rr {
+				assert.Equal(t, "networking.k8s.io/v1/ingresses", r.GVR)
+				assert.Equal(t, u.tt, r.Targets)
+				hh = append(hh, r.Hostname)
+			}
+			assert.Equal(t, u.e, hh)
+		})
+	}
+}
+
+func TestServiceDNSRecords(t *testing.T) {
+	svc := v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "fred",
+			Annotations: map[string]string{extDNSHostnameAnn: "a.example.com", extDNSTTLAnn: "60"},
+		},
+		Status: v1.ServiceStatus{
+			LoadBalancer: v1.LoadBalancerStatus{
+				Ingress: []v1.LoadBalancerIngress{{IP: "10.0.0.1"}},
+			},
+		},
+	}
+
+	assert.Equal(t, []render.DNSRecordRes{
+		{
+			GVR:       "v1/services",
+			Namespace: "default",
+			Name:      "fred",
+			Kind:      "Service",
+			Hostname:  "a.example.com",
+			TTL:       "60",
+			Targets:   []string{"10.0.0.1"},
+		},
+	}, ServiceDNSRecords(&svc))
+
+	svc.Annotations = nil
+	assert.Empty(t, ServiceDNSRecords(&svc))
+}
@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/port"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const envoyDumpTimeout = 10 * time.Second
+
+// envoyAdminPorts tracks the admin port of well known Envoy sidecars.
+var envoyAdminPorts = map[string]int32{
+	"istio-proxy":       15000,
+	"osm-envoy":         15000,
+	"envoy-sidecar":     19000,
+	"consul-dataplane":  19000,
+	"kuma-sidecar":      9901,
+	"aws-appmesh-envoy": 9901,
+	"envoy":             9901,
+}
+
+// EnvoyDump tracks the admin panes of an Envoy sidecar keyed by pane name.
+type EnvoyDump map[string]string
+
+// Panes returns the sorted pane names.
+func (e EnvoyDump) Panes() []string {
+	pp := make([]string, 0, len(e))
+	for k := range e {
+		pp = append(pp, k)
+	}
+	sort.Strings(pp)
+
+	return pp
+}
+
+// EnvoyAdmin returns the Envoy sidecar container and its admin port.
+func EnvoyAdmin(po *v1.Pod) (string, int32, bool) {
+	for _, cc := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+		for _, c := range cc {
+			for _, p := range c.Ports {
+				if p.Name == "envoy-admin" || (p.Name == "admin" && strings.Contains(c.Image, "envoy")) {
+					return c.Name, p.ContainerPort, true
+				}
+			}
+			if p, ok := envoyAdminPorts[c.Name]; ok {
+				return c.Name, p, true
+			}
+		}
+	}
+
+	return "", 0, false
+}
+
+// FetchEnvoyDump pulls the config dump, clusters and listeners from an Envoy
+// sidecar admin endpoint through a temporary port-forward.
+func FetchEnvoyDump(f Factory, path string) (EnvoyDump, error) {
+	po, err := fetchEnvoyPod(f, path)
+	if err != nil {
+		return nil, err
+	}
+	co, adminPort, ok := EnvoyAdmin(po)
+	if !ok {
+		return nil, fmt.Errorf("no envoy sidecar found on pod %s", path)
+	}
+
+	pf := NewPortForwarder(f)
+	fwd, err := pf.Start(path, port.NewPortTunnel("localhost", co, "0", strconv.Itoa(int(adminPort))))
+	if err != nil {
+		return nil, err
+	}
+	defer pf.Stop()
+
+	errChan := make(chan error, 1)
+	go func() { errChan <- fwd.ForwardPorts() }()
+	select {
+	case <-fwd.Ready:
+	case err := <-errChan:
+		return nil, fmt.Errorf("port-forward to %s:%d failed: %w", co, adminPort, err)
+	case <-time.After(envoyDumpTimeout):
+		return nil, fmt.Errorf("port-forward to %s:%d timed out", co, adminPort)
+	}
+	pp, err := fwd.GetPorts()
+	if err != nil || len(pp) == 0 {
+		return nil, fmt.Errorf("unable to resolve local port for %s:%d", co, adminPort)
+	}
+
+	base := fmt.Sprintf("http://localhost:%d", pp[0].Local)
+	ctx, cancel := context.WithTimeout(context.Background(), envoyDumpTimeout)
+	defer cancel()
+	dump := make(EnvoyDump)
+	raw, err := envoyGet(ctx, base+"/config_dump")
+	if err != nil {
+		return nil, err
+	}
+	if err := dump.addConfigDump(raw); err != nil {
+		return nil, err
+	}
+	for pane, ep := range map[string]string{"Clusters": "/clusters", "Listeners": "/listeners"} {
+		raw, err := envoyGet(ctx, base+ep+"?format=json")
+		if err != nil {
+			return nil, err
+		}
+		if err := dump.add(pane, raw); err != nil {
+			return nil, err
+		}
+	}
+
+	return dump, nil
+}
+
+// addConfigDump splits a config dump into a pane per config type.
+func (e EnvoyDump) addConfigDump(raw []byte) error {
+	var dump struct {
+		Configs []json.RawMessage `json:"configs"`
+	}
+	if err := json.Unmarshal(raw, &dump); err != nil {
+		return fmt.Errorf("invalid envoy config dump: %w", err)
+	}
+	for _, c := range dump.Configs {
+		var t struct {
+			Type string `json:"@type"`
+		}
+		if err := json.Unmarshal(c, &t); err != nil {
+			return err
+		}
+		if err := e.add("Config "+EnvoyConfigType(t.Type), c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e EnvoyDump) add(pane string, raw []byte) error {
+	bb, err := yaml.JSONToYAML(raw)
+	if err != nil {
+		return err
+	}
+	e[pane] = string(bb)
+
+	return nil
+}
+
+// EnvoyConfigType returns a short name for a config dump type ie
+// type.googleapis.com/envoy.admin.v3.ClustersConfigDump -> Clusters.
+func EnvoyConfigType(t string) string {
+	if i := strings.LastIndex(t, "."); i >= 0 {
+		t = t[i+1:]
+	}
+	if s := strings.TrimSuffix(t, "ConfigDump"); s != "" {
+		return s
+	}
+
+	return t
+}
+
+func envoyGet(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("envoy admin %s returned %s", url, resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func fetchEnvoyPod(f Factory, path string) (*v1.Pod, error) {
+	var res Pod
+	res.Init(f, client.NewGVR("v1/pods"))
+
+	return res.GetInstance(path)
+}
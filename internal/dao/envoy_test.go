@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestEnvoyAdmin(t *testing.T) {
+	uu := map[string]struct {
+		cc   []v1.Container
+		co   string
+		port int32
+		ok   bool
+	}{
+		"none": {
+			cc: []v1.Container{{Name: "app"}},
+		},
+		"istio": {
+			cc:   []v1.Container{{Name: "app"}, {Name: "istio-proxy"}},
+			co:   "istio-proxy",
+			port: 15000,
+			ok:   true,
+		},
+		"named-port": {
+			cc: []v1.Container{
+				{Name: "proxy", Image: "envoyproxy/envoy:v1.30", Ports: []v1.ContainerPort{{Name: "admin", ContainerPort: 8001}}},
+			},
+			co:   "proxy",
+			port: 8001,
+			ok:   true,
+		},
+		"admin-not-envoy": {
+			cc: []v1.Container{
+				{Name: "app", Image: "fred:1.0", Ports: []v1.ContainerPort{{Name: "admin", ContainerPort: 8001}}},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co, port, ok := EnvoyAdmin(&v1.Pod{Spec: v1.PodSpec{Containers: u.cc}})
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.co, co)
+			assert.Equal(t, u.port, port)
+		})
+	}
+}
+
+func TestEnvoyConfigType(t *testing.T) {
+	uu := map[string]string{
+		"type.googleapis.com/envoy.admin.v3.ClustersConfigDump":  "Clusters",
+		"type.googleapis.com/envoy.admin.v3.BootstrapConfigDump": "Bootstrap",
+		"type.googleapis.com/envoy.admin.v3.EcdsConfigDump":      "Ecds",
+		"ConfigDump": "ConfigDump",
+		"":           "",
+	}
+
+	for k, e := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, e, EnvoyConfigType(k))
+		})
+	}
+}
+
+func TestEnvoyDumpAddConfigDump(t *testing.T) {
+	raw := `{"configs":[
+  {"@type":"type.googleapis.com/envoy.admin.v3.ListenersConfigDump","version_info":"1"},
+  {"@type":"type.googleapis.com/envoy.admin.v3.BootstrapConfigDump","bootstrap":{"node":{"id":"fred"}}}
+]}`
+
+	dump := make(EnvoyDump)
+	assert.NoError(t, dump.addConfigDump([]byte(raw)))
+	assert.Equal(t, []string{"Config Bootstrap", "Config Listeners"}, dump.Panes())
+	assert.Contains(t, dump["Config Bootstrap"], "id: fred")
+	assert.Error(t, dump.addConfigDump([]byte("blee")))
+}
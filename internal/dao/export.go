@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultExportRules tracks server populated fields and defaulted values
+// dropped from clean exports. Rules are [Kind:]path[=value] where path is a
+// dotted field path, * matches any key or list item and [key] escapes keys
+// containing dots. A value restricts the rule to fields matching that value.
+var DefaultExportRules = []string{
+	"status",
+	"metadata.managedFields",
+	"metadata.uid",
+	"metadata.resourceVersion",
+	"metadata.creationTimestamp",
+	"metadata.generation",
+	"metadata.selfLink",
+	"metadata.ownerReferences",
+	"metadata.deletionTimestamp",
+	"metadata.deletionGracePeriodSeconds",
+	"metadata.annotations[kubectl.kubernetes.io/last-applied-configuration]",
+	"metadata.annotations[deployment.kubernetes.io/revision]",
+	"spec.template.metadata.creationTimestamp",
+	"spec.revisionHistoryLimit=10",
+	"spec.progressDeadlineSeconds=600",
+	"spec.template.spec.dnsPolicy=ClusterFirst",
+	"spec.template.spec.restartPolicy=Always",
+	"spec.template.spec.schedulerName=default-scheduler",
+	"spec.template.spec.securityContext={}",
+	"spec.template.spec.terminationGracePeriodSeconds=30",
+	"spec.template.spec.containers.*.terminationMessagePath=/dev/termination-log",
+	"spec.template.spec.containers.*.terminationMessagePolicy=File",
+	"spec.template.spec.containers.*.resources={}",
+	"Pod:spec.nodeName",
+	"Pod:spec.dnsPolicy=ClusterFirst",
+	"Pod:spec.restartPolicy=Always",
+	"Pod:spec.schedulerName=default-scheduler",
+	"Pod:spec.securityContext={}",
+	"Pod:spec.terminationGracePeriodSeconds=30",
+	"Pod:spec.containers.*.terminationMessagePath=/dev/termination-log",
+	"Pod:spec.containers.*.terminationMessagePolicy=File",
+	"Pod:spec.containers.*.resources={}",
+	"Service:spec.clusterIP",
+	"Service:spec.clusterIPs",
+	"Service:spec.ipFamilies",
+	"Service:spec.ipFamilyPolicy=SingleStack",
+	"Service:spec.internalTrafficPolicy=Cluster",
+	"Service:spec.sessionAffinity=None",
+	"PersistentVolumeClaim:spec.volumeName",
+	"PersistentVolumeClaim:metadata.annotations[pv.kubernetes.io/bind-completed]",
+	"PersistentVolumeClaim:metadata.annotations[pv.kubernetes.io/bound-by-controller]",
+	"metadata.annotations={}",
+}
+
+// ExportRule represents a field stripped from clean exports.
+type ExportRule struct {
+	Kind  string
+	Path  []string
+	Value string
+}
+
+// ExportRules represents a collection of clean export rules.
+type ExportRules []ExportRule
+
+// NewExportRules returns the default export rules along with custom strip
+// rules, minus the rules listed in keep.
+func NewExportRules(strip, keep []string) ExportRules {
+	rr := make(ExportRules, 0, len(DefaultExportRules)+len(strip))
+	for _, s := range append(slices.Clone(DefaultExportRules), strip...) {
+		if slices.Contains(keep, s) {
+			continue
+		}
+		rr = append(rr, ParseExportRule(s))
+	}
+
+	return rr
+}
+
+// ParseExportRule parses a [Kind:]path[=value] rule.
+func ParseExportRule(s string) ExportRule {
+	var r ExportRule
+	if i := strings.IndexAny(s, ":.[="); i > 0 && s[i] == ':' {
+		r.Kind, s = s[:i], s[i+1:]
+	}
+	var key strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '[':
+			if j := strings.IndexByte(s[i:], ']'); j > 0 {
+				r.Path, i = appendKey(r.Path, &key), i+j
+				r.Path = append(r.Path, s[i-j+1:i])
+				continue
+			}
+			key.WriteByte(c)
+		case '.':
+			r.Path = appendKey(r.Path, &key)
+		case '=':
+			r.Path, r.Value = appendKey(r.Path, &key), s[i+1:]
+			return r
+		default:
+			key.WriteByte(c)
+		}
+	}
+	r.Path = appendKey(r.Path, &key)
+
+	return r
+}
+
+func appendKey(path []string, key *strings.Builder) []string {
+	if key.Len() == 0 {
+		return path
+	}
+	defer key.Reset()
+
+	return append(path, key.String())
+}
+
+// Clean strips the fields matching the rules from a resource.
+func (rr ExportRules) Clean(o map[string]interface{}) {
+	kind, _ := o["kind"].(string)
+	for _, r := range rr {
+		if r.Kind != "" && !strings.EqualFold(r.Kind, kind) {
+			continue
+		}
+		stripField(o, r.Path, r.Value)
+	}
+}
+
+// CleanYAML strips the fields matching the rules from a resource manifest.
+func (rr ExportRules) CleanYAML(raw string) (string, error) {
+	var o map[string]interface{}
+	if err := yaml.Unmarshal([]byte(raw), &o); err != nil {
+		return "", err
+	}
+	rr.Clean(o)
+	bb, err := yaml.Marshal(o)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bb), nil
+}
+
+// ExportTemplate turns a clean manifest into a resource template where the
+// name and namespace are template variables.
+func ExportTemplate(raw string) (string, error) {
+	var o map[string]interface{}
+	if err := yaml.Unmarshal([]byte(raw), &o); err != nil {
+		return "", err
+	}
+	m, ok := o["metadata"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("no metadata found")
+	}
+	if n, ok := m["name"].(string); ok {
+		m["name"] = "${NAME=" + n + "}"
+	}
+	if _, ok := m["namespace"]; ok {
+		m["namespace"] = "${NAMESPACE}"
+	}
+	bb, err := yaml.Marshal(o)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bb), nil
+}
+
+func stripField(o interface{}, path []string, val string) {
+	if len(path) == 0 {
+		return
+	}
+	switch t := o.(type) {
+	case map[string]interface{}:
+		for k, v := range t {
+			if path[0] != "*" && path[0] != k {
+				continue
+			}
+			if len(path) > 1 {
+				stripField(v, path[1:], val)
+				continue
+			}
+			if val == "" || matchValue(v, val) {
+				delete(t, k)
+			}
+		}
+	case []interface{}:
+		if path[0] != "*" {
+			return
+		}
+		for _, v := range t {
+			stripField(v, path[1:], val)
+		}
+	}
+}
+
+func matchValue(v interface{}, val string) bool {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		return val == "{}" && len(t) == 0
+	case []interface{}:
+		return val == "[]" && len(t) == 0
+	default:
+		return fmt.Sprintf("%v", v) == val
+	}
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExportRule(t *testing.T) {
+	uu := map[string]struct {
+		rule string
+		e    ExportRule
+	}{
+		"plain": {
+			rule: "status",
+			e:    ExportRule{Path: []string{"status"}},
+		},
+		"nested": {
+			rule: "metadata.uid",
+			e:    ExportRule{Path: []string{"metadata", "uid"}},
+		},
+		"kind": {
+			rule: "Service:spec.clusterIP",
+			e:    ExportRule{Kind: "Service", Path: []string{"spec", "clusterIP"}},
+		},
+		"value": {
+			rule: "spec.containers.*.terminationMessagePath=/dev/termination-log",
+			e:    ExportRule{Path: []string{"spec", "containers", "*", "terminationMessagePath"}, Value: "/dev/termination-log"},
+		},
+		"escaped": {
+			rule: "metadata.annotations[kubectl.kubernetes.io/last-applied-configuration]",
+			e:    ExportRule{Path: []string{"metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration"}},
+		},
+		"escaped-value": {
+			rule: "Pod:metadata.labels[app.kubernetes.io/name]=fred",
+			e:    ExportRule{Kind: "Pod", Path: []string{"metadata", "labels", "app.kubernetes.io/name"}, Value: "fred"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ParseExportRule(u.rule))
+		})
+	}
+}
+
+func TestExportRulesCleanYAML(t *testing.T) {
+	uu := map[string]struct {
+		strip, keep []string
+		raw, e      string
+	}{
+		"deployment": {
+			raw: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  annotations:
+    deployment.kubernetes.io/revision: "3"
+  creationTimestamp: "2024-01-01T00:00:00Z"
+  generation: 3
+  managedFields:
+  - manager: kubectl
+  name: fred
+  namespace: blee
+  resourceVersion: "100"
+  uid: 1234
+spec:
+  progressDeadlineSeconds: 600
+  replicas: 2
+  revisionHistoryLimit: 5
+  template:
+    metadata:
+      creationTimestamp: null
+    spec:
+      containers:
+      - image: nginx
+        name: nginx
+        resources: {}
+        terminationMessagePath: /dev/termination-log
+        terminationMessagePolicy: File
+      dnsPolicy: ClusterFirst
+      terminationGracePeriodSeconds: 60
+status:
+  replicas: 2
+`,
+			e: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: fred
+  namespace: blee
+spec:
+  replicas: 2
+  revisionHistoryLimit: 5
+  template:
+    metadata: {}
+    spec:
+      containers:
+      - image: nginx
+        name: nginx
+      terminationGracePeriodSeconds: 60
+`,
+		},
+		"service": {
+			raw: `apiVersion: v1
+kind: Service
+metadata:
+  name: fred
+spec:
+  clusterIP: 10.0.0.1
+  clusterIPs:
+  - 10.0.0.1
+  sessionAffinity: ClientIP
+`,
+			e: `apiVersion: v1
+kind: Service
+metadata:
+  name: fred
+spec:
+  sessionAffinity: ClientIP
+`,
+		},
+		"custom": {
+			strip: []string{"ConfigMap:metadata.labels[app.kubernetes.io/managed-by]"},
+			keep:  []string{"metadata.uid"},
+			raw: `apiVersion: v1
+data:
+  a: b
+kind: ConfigMap
+metadata:
+  labels:
+    app: fred
+    app.kubernetes.io/managed-by: Helm
+  name: fred
+  uid: 1234
+`,
+			e: `apiVersion: v1
+data:
+  a: b
+kind: ConfigMap
+metadata:
+  labels:
+    app: fred
+  name: fred
+  uid: 1234
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := NewExportRules(u.strip, u.keep).CleanYAML(u.raw)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func TestExportTemplate(t *testing.T) {
+	s, err := ExportTemplate(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: fred
+  namespace: blee
+`)
+
+	assert.NoError(t, err)
+	assert.Equal(t, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ${NAME=fred}
+  namespace: ${NAMESPACE}
+`, s)
+}
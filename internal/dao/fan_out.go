@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// MaxFanOut caps the number of resources loaded concurrently.
+	MaxFanOut = 4
+
+	// PartialWait bounds how long a fan out waits on slow loads before
+	// returning what's loaded.
+	PartialWait = 750 * time.Millisecond
+)
+
+// LoadFunc loads the resources for a given key.
+type LoadFunc func(ctx context.Context, key string) ([]runtime.Object, error)
+
+// FanOut loads several resources concurrently. Loads outlasting the partial
+// wait keep going in the background and are collected by a later call so
+// callers render what's loaded while the rest streams in.
+type FanOut struct {
+	wait  time.Duration
+	sem   chan struct{}
+	loads map[string]*fanLoad
+	last  map[string][]runtime.Object
+	mx    sync.Mutex
+}
+
+type fanLoad struct {
+	done chan struct{}
+	oo   []runtime.Object
+	err  error
+}
+
+// NewFanOut returns a fan out running at most n loads at once.
+func NewFanOut(n int, wait time.Duration) *FanOut {
+	return &FanOut{
+		wait:  wait,
+		sem:   make(chan struct{}, n),
+		loads: make(map[string]*fanLoad),
+		last:  make(map[string][]runtime.Object),
+	}
+}
+
+// Load loads all keys and returns their resources in order. Keys still
+// loading yield their previous results and are reported as pending.
+func (f *FanOut) Load(ctx context.Context, kk []string, fn LoadFunc) ([][]runtime.Object, int, error) {
+	ll := make([]*fanLoad, 0, len(kk))
+	for _, k := range kk {
+		ll = append(ll, f.start(ctx, k, fn))
+	}
+	all := make(chan struct{})
+	go func() {
+		defer close(all)
+		for _, l := range ll {
+			<-l.done
+		}
+	}()
+	select {
+	case <-all:
+	case <-time.After(f.wait):
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	}
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	var (
+		pending int
+		errs    []error
+		res     = make([][]runtime.Object, len(kk))
+	)
+	for i, l := range ll {
+		k := kk[i]
+		select {
+		case <-l.done:
+			if f.loads[k] == l {
+				delete(f.loads, k)
+			}
+			if l.err != nil {
+				errs = append(errs, l.err)
+				continue
+			}
+			f.last[k], res[i] = l.oo, l.oo
+		default:
+			pending++
+			res[i] = f.last[k]
+		}
+	}
+
+	return res, pending, errors.Join(errs...)
+}
+
+func (f *FanOut) start(ctx context.Context, k string, fn LoadFunc) *fanLoad {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if l, ok := f.loads[k]; ok {
+		return l
+	}
+
+	l := fanLoad{done: make(chan struct{})}
+	f.loads[k] = &l
+	go func() {
+		defer close(l.done)
+		select {
+		case f.sem <- struct{}{}:
+		case <-ctx.Done():
+			l.err = ctx.Err()
+			return
+		}
+		defer func() { <-f.sem }()
+		l.oo, l.err = fn(ctx, k)
+	}()
+
+	return &l
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestFanOutPartial(t *testing.T) {
+	f := dao.NewFanOut(2, 50*time.Millisecond)
+	release := make(chan struct{})
+	load := func(_ context.Context, k string) ([]runtime.Object, error) {
+		if k == "slow" {
+			<-release
+		}
+		return []runtime.Object{&unstructured.Unstructured{}}, nil
+	}
+
+	rr, pending, err := f.Load(context.Background(), []string{"fast", "slow"}, load)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, pending)
+	assert.Len(t, rr[0], 1)
+	assert.Empty(t, rr[1])
+
+	close(release)
+	time.Sleep(10 * time.Millisecond)
+	rr, pending, err = f.Load(context.Background(), []string{"fast", "slow"}, load)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, pending)
+	assert.Len(t, rr[0], 1)
+	assert.Len(t, rr[1], 1)
+}
+
+func TestFanOutBounded(t *testing.T) {
+	var running, peak int32
+	load := func(_ context.Context, _ string) ([]runtime.Object, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil, nil
+	}
+
+	f := dao.NewFanOut(2, time.Second)
+	_, pending, err := f.Load(context.Background(), []string{"a", "b", "c", "d", "e"}, load)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, pending)
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(2))
+}
+
+func TestFanOutErrors(t *testing.T) {
+	f := dao.NewFanOut(2, time.Second)
+	load := func(_ context.Context, k string) ([]runtime.Object, error) {
+		if k == "bad" {
+			return nil, errors.New("boom")
+		}
+		return []runtime.Object{&unstructured.Unstructured{}}, nil
+	}
+
+	rr, _, err := f.Load(context.Background(), []string{"good", "bad"}, load)
+
+	assert.Error(t, err)
+	assert.Len(t, rr[0], 1)
+	assert.Empty(t, rr[1])
+}
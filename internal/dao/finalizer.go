@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// scanPageSize tracks the page size used when scanning a resource.
+	scanPageSize = 500
+
+	// scanMaxObjects caps the number of objects scanned per resource.
+	scanMaxObjects = 10_000
+)
+
+var _ Accessor = (*Finalizer)(nil)
+
+// Finalizer tracks resources stuck in a terminating state.
+type Finalizer struct {
+	NonResource
+}
+
+// List returns all terminating resources with pending finalizers.
+func (f *Finalizer) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo := make([]runtime.Object, 0, 10)
+	for _, gvr := range MetaAccess.AllGVRs() {
+		meta, err := MetaAccess.MetaFor(gvr)
+		if err != nil || !isFinalizable(gvr, meta) {
+			continue
+		}
+		rns := ns
+		if !meta.Namespaced {
+			if client.IsNamespaced(ns) {
+				continue
+			}
+			rns = client.ClusterScope
+		}
+		uu, err := scanResource(ctx, f.getFactory(), gvr, rns)
+		if err != nil {
+			log.Debug().Err(err).Msgf("Finalizer scan skipped %q", gvr)
+			continue
+		}
+		for _, u := range uu {
+			if isStuck(u) {
+				oo = append(oo, render.FinalizerRes{GVR: gvr.String(), Object: u})
+			}
+		}
+	}
+
+	return oo, nil
+}
+
+// Get returns a terminating resource given its row identifier.
+func (f *Finalizer) Get(ctx context.Context, id string) (runtime.Object, error) {
+	gvr, path, ok := render.ParseResourceID(id)
+	if !ok {
+		return nil, fmt.Errorf("invalid finalizer id %q", id)
+	}
+	o, err := f.getFactory().Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return render.FinalizerRes{GVR: gvr, Object: u}, nil
+}
+
+// Finalizers returns the pending finalizers for a given resource.
+func (f *Finalizer) Finalizers(ctx context.Context, id string) ([]string, error) {
+	o, err := f.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.(render.FinalizerRes).Object.GetFinalizers(), nil
+}
+
+type jsonPatchOp struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value string `json:"value,omitempty"`
+}
+
+// RemoveFinalizer strips a given finalizer off a resource. The patch guards
+// against concurrent updates by asserting the finalizer is still at the same
+// position before removal.
+func (f *Finalizer) RemoveFinalizer(ctx context.Context, id, finalizer string) error {
+	gvr, path, ok := render.ParseResourceID(id)
+	if !ok {
+		return fmt.Errorf("invalid finalizer id %q", id)
+	}
+	ff, err := f.Finalizers(ctx, id)
+	if err != nil {
+		return err
+	}
+	idx := -1
+	for i, fin := range ff {
+		if fin == finalizer {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return fmt.Errorf("finalizer %q not found on %s", finalizer, path)
+	}
+
+	ns, n := client.Namespaced(path)
+	auth, err := f.Client().CanI(ns, gvr, n, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", path)
+	}
+
+	fpath := fmt.Sprintf("/metadata/finalizers/%d", idx)
+	patch, err := json.Marshal([]jsonPatchOp{
+		{Op: "test", Path: fpath, Value: finalizer},
+		{Op: "remove", Path: fpath},
+	})
+	if err != nil {
+		return err
+	}
+	dial, err := f.Client().DynDial()
+	if err != nil {
+		return err
+	}
+	res := dial.Resource(client.NewGVR(gvr).GVR())
+	if client.IsClusterScoped(ns) {
+		_, err = res.Patch(ctx, n, types.JSONPatchType, patch, metav1.PatchOptions{})
+	} else {
+		_, err = res.Namespace(ns).Patch(ctx, n, types.JSONPatchType, patch, metav1.PatchOptions{})
+	}
+
+	return err
+}
+
+// DiagnoseNamespace reports why a namespace might be stuck terminating.
+func (f *Finalizer) DiagnoseNamespace(ctx context.Context, ns string) (string, error) {
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	nns, err := dial.CoreV1().Namespaces().Get(ctx, ns, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "namespace: %s\n", nns.Name)
+	fmt.Fprintf(&b, "phase: %s\n", nns.Status.Phase)
+	if ts := nns.DeletionTimestamp; ts != nil {
+		fmt.Fprintf(&b, "terminating: %s\n", duration.HumanDuration(time.Since(ts.Time)))
+	}
+	if len(nns.Spec.Finalizers) > 0 {
+		b.WriteString("finalizers:\n")
+		for _, fin := range nns.Spec.Finalizers {
+			fmt.Fprintf(&b, "  - %s\n", fin)
+		}
+	}
+	if nns.Status.Phase != v1.NamespaceTerminating {
+		b.WriteString("diagnosis: namespace is not terminating\n")
+		return b.String(), nil
+	}
+
+	b.WriteString("conditions:\n")
+	for _, c := range nns.Status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		fmt.Fprintf(&b, "  - type: %s\n    reason: %s\n    message: %s\n", c.Type, c.Reason, c.Message)
+	}
+
+	oo, err := f.List(ctx, ns)
+	if err != nil {
+		return "", err
+	}
+	counts := make(map[string]int)
+	for _, o := range oo {
+		res, ok := o.(render.FinalizerRes)
+		if !ok {
+			continue
+		}
+		for _, fin := range res.Object.GetFinalizers() {
+			counts[res.GVR+" ("+fin+")"]++
+		}
+	}
+	if len(counts) == 0 {
+		b.WriteString("blockers: none found. Check aggregated APIs for discovery failures\n")
+		return b.String(), nil
+	}
+	kk := make([]string, 0, len(counts))
+	for k := range counts {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	b.WriteString("blockers:\n")
+	for _, k := range kk {
+		fmt.Fprintf(&b, "  - %s: %d\n", k, counts[k])
+	}
+
+	return b.String(), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// scanResource lists a resource in bounded pages straight from the api
+// server, so scanning all kinds does not spin up an informer per kind.
+func scanResource(ctx context.Context, f Factory, gvr client.GVR, ns string) ([]*unstructured.Unstructured, error) {
+	dial, err := f.Client().DynDial()
+	if err != nil {
+		return nil, err
+	}
+	if client.IsClusterScoped(ns) || client.IsAllNamespaces(ns) {
+		ns = client.BlankNamespace
+	}
+	ri := dial.Resource(gvr.GVR()).Namespace(ns)
+
+	var (
+		uu   []*unstructured.Unstructured
+		opts = metav1.ListOptions{Limit: scanPageSize}
+	)
+	for {
+		ll, err := scanPage(ctx, f, ri, opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range ll.Items {
+			uu = append(uu, &ll.Items[i])
+		}
+		if ll.GetContinue() == "" {
+			return uu, nil
+		}
+		if len(uu) >= scanMaxObjects {
+			log.Warn().Msgf("Scan of %q capped at %d objects", gvr, len(uu))
+			return uu, nil
+		}
+		opts.Continue = ll.GetContinue()
+	}
+}
+
+func scanPage(ctx context.Context, f Factory, ri dynamic.ResourceInterface, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.Client().Config().CallTimeout())
+	defer cancel()
+
+	return ri.List(ctx, opts)
+}
+
+func isFinalizable(gvr client.GVR, meta metav1.APIResource) bool {
+	if !IsK8sMeta(meta) || gvr.String() == "v1/events" {
+		return false
+	}
+
+	return inList(meta.Verbs, client.ListVerb) && inList(meta.Verbs, client.WatchVerb)
+}
+
+func isStuck(u *unstructured.Unstructured) bool {
+	return u.GetDeletionTimestamp() != nil && len(u.GetFinalizers()) > 0
+}
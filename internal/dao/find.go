@@ -0,0 +1,372 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/metadata"
+)
+
+const (
+	// FindLimit caps the number of matches listed per resource kind. Kinds
+	// are listed in pages of that size.
+	FindLimit = 500
+
+	findTTL = 30 * time.Second
+)
+
+// Find scopes.
+const (
+	FindScopeAll        = ""
+	FindScopeNamespaced = "namespaced"
+	FindScopeCluster    = "cluster"
+)
+
+var _ Accessor = (*Finder)(nil)
+
+// FindQuery describes a search across resource kinds.
+type FindQuery struct {
+	// Raw tracks the query as entered.
+	Raw string
+
+	// Name matches resource names. Either a regex or an exact name.
+	Name      *regexp.Regexp
+	ExactName string
+
+	// Labels filters resources server side.
+	Labels labels.Selector
+
+	// Namespace scopes the search in place of the active namespace.
+	Namespace string
+
+	// Kinds restricts the search to the given kinds. Kinds are resolved into
+	// GVRs by the caller.
+	Kinds []string
+	GVRs  []client.GVR
+
+	// Scope restricts the search to namespaced or cluster resources.
+	Scope string
+}
+
+// ParseFindQuery parses a query in the shape of name~re|name=n [label sel]
+// [ns=xxx] [kind=k1,k2] [scope=namespaced|cluster]. A bare term matches names.
+func ParseFindQuery(s string) (FindQuery, error) {
+	q := FindQuery{Raw: strings.TrimSpace(s)}
+	ff := strings.Fields(s)
+	for i := 0; i < len(ff); i++ {
+		f := ff[i]
+		switch {
+		case f == "label" || f == "-l":
+			if i+1 >= len(ff) {
+				return q, errors.New("missing label selector")
+			}
+			i++
+			if err := q.setLabels(ff[i]); err != nil {
+				return q, err
+			}
+		case strings.HasPrefix(f, "label="):
+			if err := q.setLabels(strings.TrimPrefix(f, "label=")); err != nil {
+				return q, err
+			}
+		case strings.HasPrefix(f, "name~"):
+			if err := q.setName(strings.TrimPrefix(f, "name~")); err != nil {
+				return q, err
+			}
+		case strings.HasPrefix(f, "name="):
+			q.ExactName = strings.TrimPrefix(f, "name=")
+		case strings.HasPrefix(f, "ns="):
+			q.Namespace = strings.TrimPrefix(f, "ns=")
+		case strings.HasPrefix(f, "kind="):
+			q.Kinds = append(q.Kinds, strings.Split(strings.TrimPrefix(f, "kind="), ",")...)
+		case strings.HasPrefix(f, "scope="):
+			switch sc := strings.TrimPrefix(f, "scope="); sc {
+			case FindScopeNamespaced, FindScopeCluster:
+				q.Scope = sc
+			default:
+				return q, fmt.Errorf("invalid scope %q. Use namespaced or cluster", sc)
+			}
+		case strings.Contains(f, "="):
+			return q, fmt.Errorf("unknown find term %q", f)
+		default:
+			if err := q.setName(f); err != nil {
+				return q, err
+			}
+		}
+	}
+	if q.Name == nil && q.ExactName == "" && q.Labels == nil {
+		return q, errors.New("find requires a name or a label selector")
+	}
+
+	return q, nil
+}
+
+func (q *FindQuery) setName(s string) error {
+	rx, err := regexp.Compile("(?i)" + s)
+	if err != nil {
+		return fmt.Errorf("invalid name pattern %q: %w", s, err)
+	}
+	q.Name = rx
+
+	return nil
+}
+
+func (q *FindQuery) setLabels(s string) error {
+	sel, err := labels.Parse(s)
+	if err != nil {
+		return fmt.Errorf("invalid label selector %q: %w", s, err)
+	}
+	q.Labels = sel
+
+	return nil
+}
+
+// Matches checks if a resource name matches the query.
+func (q FindQuery) Matches(n string) bool {
+	if q.ExactName != "" && n != q.ExactName {
+		return false
+	}
+
+	return q.Name == nil || q.Name.MatchString(n)
+}
+
+// InScope checks if a resource kind should be searched in a given namespace.
+func (q FindQuery) InScope(namespaced bool, ns string) bool {
+	switch q.Scope {
+	case FindScopeNamespaced:
+		return namespaced
+	case FindScopeCluster:
+		return !namespaced
+	default:
+		return namespaced || !client.IsNamespaced(ns)
+	}
+}
+
+func (q FindQuery) listOptions() metav1.ListOptions {
+	opts := metav1.ListOptions{Limit: FindLimit}
+	if q.Labels != nil {
+		opts.LabelSelector = q.Labels.String()
+	}
+	if q.ExactName != "" {
+		opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", q.ExactName).String()
+	}
+
+	return opts
+}
+
+// Finder searches resources across kinds.
+type Finder struct {
+	NonResource
+
+	fan   *FanOut
+	once  sync.Once
+	hits  map[string]findHits
+	hitMx sync.Mutex
+}
+
+type findHits struct {
+	oo []runtime.Object
+	at time.Time
+}
+
+// List returns the resources matching the context query. Kinds are listed
+// concurrently and slow ones are picked up on a later refresh.
+func (f *Finder) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	q, ok := ctx.Value(internal.KeyQuery).(FindQuery)
+	if !ok {
+		return nil, fmt.Errorf("expecting a find query but got %T", ctx.Value(internal.KeyQuery))
+	}
+	f.once.Do(func() {
+		f.fan = NewFanOut(MaxFanOut, PartialWait)
+		f.hits = make(map[string]findHits)
+	})
+	if q.Namespace != "" {
+		ns = q.Namespace
+	}
+	ns = client.CleanseNamespace(ns)
+
+	gvrs := f.candidates(q, ns)
+	kk := make([]string, 0, len(gvrs))
+	for _, gvr := range gvrs {
+		kk = append(kk, f.Client().ActiveContext()+":"+client.FQN(ns, gvr.String())+"?"+q.Raw)
+	}
+	f.prune()
+	rr, pending, err := f.fan.Load(ctx, kk, func(ctx context.Context, k string) ([]runtime.Object, error) {
+		return f.search(ctx, k, gvrs[slices.Index(kk, k)], ns, q)
+	})
+	if pending > 0 {
+		log.Debug().Msgf("Find %d kinds still loading", pending)
+	}
+
+	oo := make([]runtime.Object, 0, 50)
+	for _, r := range rr {
+		oo = append(oo, r...)
+	}
+	if err != nil {
+		if len(oo) == 0 {
+			return nil, err
+		}
+		log.Warn().Err(err).Msg("Find partially loaded")
+	}
+
+	return oo, nil
+}
+
+// Get returns a resource given its row identifier.
+func (f *Finder) Get(ctx context.Context, id string) (runtime.Object, error) {
+	gvr, path, ok := render.ParseResourceID(id)
+	if !ok {
+		return nil, fmt.Errorf("invalid find id %q", id)
+	}
+
+	return f.getFactory().Get(gvr, path, true, labels.Everything())
+}
+
+// candidates lists the resource kinds to search.
+func (f *Finder) candidates(q FindQuery, ns string) []client.GVR {
+	if len(q.GVRs) > 0 {
+		return q.GVRs
+	}
+	gvrs := make([]client.GVR, 0, 100)
+	for _, gvr := range MetaAccess.AllGVRs() {
+		meta, err := MetaAccess.MetaFor(gvr)
+		if err != nil || !IsK8sMeta(meta) || !slices.Contains(meta.Verbs, client.ListVerb) {
+			continue
+		}
+		if gvr.R() == "events" || !q.InScope(meta.Namespaced, ns) {
+			continue
+		}
+		gvrs = append(gvrs, gvr)
+	}
+
+	return gvrs
+}
+
+func (f *Finder) search(ctx context.Context, k string, gvr client.GVR, ns string, q FindQuery) ([]runtime.Object, error) {
+	f.hitMx.Lock()
+	h, ok := f.hits[k]
+	f.hitMx.Unlock()
+	if ok {
+		return h.oo, nil
+	}
+
+	meta, err := MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+	list, err := f.lister(gvr, meta, ns)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		oo   []runtime.Object
+		opts = q.listOptions()
+	)
+	for {
+		ll, err := list(ctx, opts)
+		if kerrors.IsForbidden(err) || kerrors.IsNotFound(err) || kerrors.IsMethodNotSupported(err) {
+			log.Debug().Err(err).Msgf("Find skipped %q", gvr)
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i := range ll.Items {
+			if q.Matches(ll.Items[i].GetName()) {
+				oo = append(oo, render.InventoryRes{GVR: gvr.String(), Object: &ll.Items[i]})
+			}
+		}
+		if len(oo) >= FindLimit || ll.GetContinue() == "" {
+			break
+		}
+		opts.Continue = ll.GetContinue()
+	}
+	f.hitMx.Lock()
+	f.hits[k] = findHits{oo: oo, at: time.Now()}
+	f.hitMx.Unlock()
+
+	return oo, nil
+}
+
+// findLister lists a page of resources.
+type findLister func(context.Context, metav1.ListOptions) (*unstructured.UnstructuredList, error)
+
+// lister returns a resource lister. In low bandwidth mode only object
+// metadata is pulled from the api server.
+func (f *Finder) lister(gvr client.GVR, meta metav1.APIResource, ns string) (findLister, error) {
+	if !meta.Namespaced {
+		ns = client.BlankNamespace
+	}
+	if f.Client().Config().IsLowBandwidth() {
+		cfg, err := f.Client().RestConfig()
+		if err != nil {
+			return nil, err
+		}
+		dial, err := metadata.NewForConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		ri := dial.Resource(gvr.GVR()).Namespace(ns)
+
+		return func(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+			ll, err := ri.List(ctx, opts)
+			if err != nil {
+				return nil, err
+			}
+			return metaToUnstructured(ll, meta.Kind)
+		}, nil
+	}
+	dial, err := f.Client().DynDial()
+	if err != nil {
+		return nil, err
+	}
+
+	return dial.Resource(gvr.GVR()).Namespace(ns).List, nil
+}
+
+// metaToUnstructured converts a metadata only list into unstructured objects.
+func metaToUnstructured(ll *metav1.PartialObjectMetadataList, kind string) (*unstructured.UnstructuredList, error) {
+	uu := unstructured.UnstructuredList{Items: make([]unstructured.Unstructured, 0, len(ll.Items))}
+	uu.SetContinue(ll.GetContinue())
+	for i := range ll.Items {
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&ll.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		u := unstructured.Unstructured{Object: m}
+		u.SetKind(kind)
+		uu.Items = append(uu.Items, u)
+	}
+
+	return &uu, nil
+}
+
+// prune evicts stale matches so results refresh periodically.
+func (f *Finder) prune() {
+	f.hitMx.Lock()
+	defer f.hitMx.Unlock()
+
+	for k, h := range f.hits {
+		if time.Since(h.at) > findTTL {
+			delete(f.hits, k)
+		}
+	}
+}
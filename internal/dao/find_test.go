@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFindQuery(t *testing.T) {
+	uu := map[string]struct {
+		q                    string
+		err                  bool
+		name, labels, ns     string
+		exact, scope         string
+		kinds                []string
+		matches, nonMatching string
+	}{
+		"bare": {
+			q:           "Fred",
+			name:        "(?i)Fred",
+			matches:     "my-fred-1",
+			nonMatching: "blee",
+		},
+		"full": {
+			q:           "name~^fr label app=blee,tier!=web ns=zorg kind=po,dp scope=namespaced",
+			name:        "(?i)^fr",
+			labels:      "app=blee,tier!=web",
+			ns:          "zorg",
+			kinds:       []string{"po", "dp"},
+			scope:       FindScopeNamespaced,
+			matches:     "fred",
+			nonMatching: "alfred",
+		},
+		"exact": {
+			q:           "name=fred",
+			exact:       "fred",
+			matches:     "fred",
+			nonMatching: "fred-1",
+		},
+		"labels-only": {
+			q:       "label=app=blee",
+			labels:  "app=blee",
+			matches: "anything",
+		},
+		"empty": {
+			q:   "ns=zorg",
+			err: true,
+		},
+		"bad-scope": {
+			q:   "fred scope=toast",
+			err: true,
+		},
+		"bad-term": {
+			q:   "fred owner=blee",
+			err: true,
+		},
+		"bad-regex": {
+			q:   "name~fr[",
+			err: true,
+		},
+		"missing-selector": {
+			q:   "fred label",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q, err := ParseFindQuery(u.q)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if u.name != "" {
+				assert.Equal(t, u.name, q.Name.String())
+			}
+			if u.labels != "" {
+				assert.Equal(t, u.labels, q.Labels.String())
+			}
+			assert.Equal(t, u.exact, q.ExactName)
+			assert.Equal(t, u.ns, q.Namespace)
+			assert.Equal(t, u.scope, q.Scope)
+			assert.Equal(t, u.kinds, q.Kinds)
+			assert.True(t, q.Matches(u.matches))
+			if u.nonMatching != "" {
+				assert.False(t, q.Matches(u.nonMatching))
+			}
+		})
+	}
+}
+
+func TestFindQueryInScope(t *testing.T) {
+	uu := map[string]struct {
+		scope      string
+		namespaced bool
+		ns         string
+		e          bool
+	}{
+		"all-ns":            {namespaced: true, e: true},
+		"all-cluster":       {e: true},
+		"ns-cluster":        {ns: "fred"},
+		"ns-namespaced":     {ns: "fred", namespaced: true, e: true},
+		"cluster-only":      {scope: FindScopeCluster, ns: "fred", e: true},
+		"cluster-only-miss": {scope: FindScopeCluster, namespaced: true},
+		"namespaced-only":   {scope: FindScopeNamespaced},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q := FindQuery{Scope: u.scope}
+			assert.Equal(t, u.e, q.InScope(u.namespaced, u.ns))
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// MaxFleetProbes caps the number of clusters probed concurrently.
+	MaxFleetProbes = 8
+
+	// FleetProbeInterval tracks how often a cluster is probed.
+	FleetProbeInterval = 30 * time.Second
+
+	// FleetProbeTimeout bounds a cluster probe.
+	FleetProbeTimeout = 10 * time.Second
+)
+
+var _ Accessor = (*Fleet)(nil)
+
+// Fleet tracks the health of the clusters across kubeconfig contexts.
+type Fleet struct {
+	NonResource
+
+	mx     sync.Mutex
+	sem    chan struct{}
+	probes map[string]*fleetProbe
+}
+
+type fleetProbe struct {
+	health *render.ClusterHealth
+	busy   bool
+}
+
+// List returns a health summary per context. Clusters are probed in the
+// background so stale or pending summaries are returned while probes run.
+func (f *Fleet) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	cfg := f.getFactory().Client().Config()
+	ctxs, err := cfg.Contexts()
+	if err != nil {
+		return nil, err
+	}
+	current, err := cfg.CurrentContextName()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(ctxs))
+	for n := range ctxs {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	if fl, ok := ctx.Value(internal.KeyFleet).(config.Fleet); ok {
+		names = fl.Select(names)
+	}
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if f.probes == nil {
+		f.probes, f.sem = make(map[string]*fleetProbe), make(chan struct{}, MaxFleetProbes)
+	}
+	oo := make([]runtime.Object, 0, len(names))
+	for _, n := range names {
+		co := ctxs[n]
+		p, ok := f.probes[n]
+		if !ok {
+			p = &fleetProbe{}
+			f.probes[n] = p
+		}
+		if !p.busy && (p.health == nil || time.Since(p.health.ProbedAt) > FleetProbeInterval) {
+			p.busy = true
+			go f.probe(cfg, n, co.Cluster)
+		}
+		h := render.ClusterHealth{Context: n, Cluster: co.Cluster, Probing: true}
+		if p.health != nil {
+			h = *p.health
+		}
+		h.Current = n == current
+		oo = append(oo, &h)
+	}
+
+	return oo, nil
+}
+
+func (f *Fleet) probe(cfg *client.Config, name, cluster string) {
+	f.sem <- struct{}{}
+	defer func() { <-f.sem }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), FleetProbeTimeout)
+	defer cancel()
+	h := ProbeCluster(ctx, cfg, name)
+	h.Cluster = cluster
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	f.probes[name].health, f.probes[name].busy = h, false
+}
+
+// ProbeCluster checks a context api server reachability along with its
+// nodes readiness and failing workloads.
+func ProbeCluster(ctx context.Context, cfg *client.Config, name string) *render.ClusterHealth {
+	h := render.ClusterHealth{Context: name, ProbedAt: time.Now()}
+	rc, err := cfg.ContextRESTConfig(name)
+	if err != nil {
+		h.Err = err
+		return &h
+	}
+	rc.Timeout = FleetProbeTimeout
+	dial, err := kubernetes.NewForConfig(rc)
+	if err != nil {
+		h.Err = err
+		return &h
+	}
+
+	t := time.Now()
+	v, err := dial.Discovery().ServerVersion()
+	if err != nil {
+		h.Err = err
+		return &h
+	}
+	h.Latency, h.Version = time.Since(t), v.GitVersion
+
+	// Serve lists from the api server cache to keep probes cheap.
+	opts := metav1.ListOptions{ResourceVersion: "0"}
+	nn, err := dial.CoreV1().Nodes().List(ctx, opts)
+	if err != nil {
+		h.Err = err
+		return &h
+	}
+	dps, err := dial.AppsV1().Deployments(client.NamespaceAll).List(ctx, opts)
+	if err != nil {
+		h.Err = err
+		return &h
+	}
+	sts, err := dial.AppsV1().StatefulSets(client.NamespaceAll).List(ctx, opts)
+	if err != nil {
+		h.Err = err
+		return &h
+	}
+	dss, err := dial.AppsV1().DaemonSets(client.NamespaceAll).List(ctx, opts)
+	if err != nil {
+		h.Err = err
+		return &h
+	}
+	h.Nodes, h.ReadyNodes = len(nn.Items), ReadyNodes(nn.Items)
+	h.Workloads = len(dps.Items) + len(sts.Items) + len(dss.Items)
+	h.FailingWorkloads = FailingWorkloads(dps.Items, sts.Items, dss.Items)
+
+	return &h
+}
+
+// ReadyNodes counts the nodes reporting ready.
+func ReadyNodes(nn []v1.Node) int {
+	var ready int
+	for i := range nn {
+		for _, c := range nn[i].Status.Conditions {
+			if c.Type == v1.NodeReady && c.Status == v1.ConditionTrue {
+				ready++
+				break
+			}
+		}
+	}
+
+	return ready
+}
+
+// FailingWorkloads counts the workloads with fewer ready replicas than desired.
+func FailingWorkloads(dps []appsv1.Deployment, sts []appsv1.StatefulSet, dss []appsv1.DaemonSet) int {
+	var failing int
+	for i := range dps {
+		if dps[i].Spec.Replicas != nil && dps[i].Status.ReadyReplicas < *dps[i].Spec.Replicas {
+			failing++
+		}
+	}
+	for i := range sts {
+		if sts[i].Spec.Replicas != nil && sts[i].Status.ReadyReplicas < *sts[i].Spec.Replicas {
+			failing++
+		}
+	}
+	for i := range dss {
+		if dss[i].Status.NumberReady < dss[i].Status.DesiredNumberScheduled {
+			failing++
+		}
+	}
+
+	return failing
+}
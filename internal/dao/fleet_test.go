@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestReadyNodes(t *testing.T) {
+	nn := []v1.Node{
+		{Status: v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}}}},
+		{Status: v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionFalse}}}},
+		{},
+	}
+
+	assert.Equal(t, 1, ReadyNodes(nn))
+}
+
+func TestFailingWorkloads(t *testing.T) {
+	two := int32(2)
+	dps := []appsv1.Deployment{
+		{Spec: appsv1.DeploymentSpec{Replicas: &two}, Status: appsv1.DeploymentStatus{ReadyReplicas: 2}},
+		{Spec: appsv1.DeploymentSpec{Replicas: &two}, Status: appsv1.DeploymentStatus{ReadyReplicas: 1}},
+	}
+	sts := []appsv1.StatefulSet{
+		{Spec: appsv1.StatefulSetSpec{Replicas: &two}},
+	}
+	dss := []appsv1.DaemonSet{
+		{Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberReady: 3}},
+		{Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberReady: 2}},
+	}
+
+	assert.Equal(t, 3, FailingWorkloads(dps, sts, dss))
+}
@@ -103,6 +103,30 @@ func (g *Generic) ToYAML(path string, showManaged bool) (string, error) {
 	return raw, nil
 }
 
+// Update replaces a resource.
+func (g *Generic) Update(ctx context.Context, u *unstructured.Unstructured) error {
+	ns, n := u.GetNamespace(), u.GetName()
+	auth, err := g.Client().CanI(ns, g.gvrStr(), n, []string{client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update %s", client.FQN(ns, n))
+	}
+
+	dial, err := g.dynClient()
+	if err != nil {
+		return err
+	}
+	if ns == "" {
+		_, err = dial.Update(ctx, u, metav1.UpdateOptions{})
+		return err
+	}
+	_, err = dial.Namespace(ns).Update(ctx, u, metav1.UpdateOptions{})
+
+	return err
+}
+
 // Delete deletes a resource.
 func (g *Generic) Delete(ctx context.Context, path string, propagation *metav1.DeletionPropagation, grace Grace) error {
 	ns, n := client.Namespaced(path)
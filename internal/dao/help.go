@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Help)(nil)
+
+// Help represents the help index.
+type Help struct {
+	NonResource
+}
+
+// List returns the help index entries.
+func (h *Help) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	hh, ok := ctx.Value(internal.KeyHelp).([]render.HelpRes)
+	if !ok {
+		return nil, fmt.Errorf("expecting []render.HelpRes but got %T", ctx.Value(internal.KeyHelp))
+	}
+	oo := make([]runtime.Object, 0, len(hh))
+	for _, h := range hh {
+		oo = append(oo, h)
+	}
+
+	return oo, nil
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// OwnerAnnotation tracks the team owning a resource.
+	OwnerAnnotation = "k9s.io/owner"
+
+	// OnCallAnnotation tracks who to page about a resource.
+	OnCallAnnotation = "k9s.io/oncall"
+
+	// RunbookAnnotation tracks a resource runbook url.
+	RunbookAnnotation = "k9s.io/runbook"
+
+	// DashboardAnnotation tracks a resource dashboard url.
+	DashboardAnnotation = "k9s.io/dashboard"
+)
+
+// hintAnnotations tracks the recognized hints annotations, by display order.
+var hintAnnotations = []struct {
+	label, key string
+}{
+	{"Owner", OwnerAnnotation},
+	{"On-call", OnCallAnnotation},
+	{"Runbook", RunbookAnnotation},
+	{"Dashboard", DashboardAnnotation},
+}
+
+// Hint tracks operational metadata embedded in a resource annotations.
+type Hint struct {
+	Label string
+	Value string
+}
+
+// URL returns the hint value when it is a web link.
+func (h Hint) URL() (string, bool) {
+	u, err := url.Parse(h.Value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", false
+	}
+
+	return h.Value, true
+}
+
+// AnnotationHints returns the k9s hints found in annotations.
+func AnnotationHints(aa map[string]string) []Hint {
+	var hh []Hint
+	for _, a := range hintAnnotations {
+		if v := strings.TrimSpace(aa[a.key]); v != "" {
+			hh = append(hh, Hint{Label: a.label, Value: v})
+		}
+	}
+
+	return hh
+}
+
+// ResourceHints returns a resource k9s hints.
+func ResourceHints(f Factory, gvr client.GVR, path string) ([]Hint, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return nil, err
+	}
+
+	return AnnotationHints(m.GetAnnotations()), nil
+}
+
+// RenderHints renders hints as a describe header.
+func RenderHints(hh []Hint) string {
+	if len(hh) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("K9s Hints:\n")
+	for _, h := range hh {
+		fmt.Fprintf(&b, "  %-12s%s\n", h.Label+":", h.Value)
+	}
+	b.WriteString("\n")
+
+	return b.String()
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnotationHints(t *testing.T) {
+	hh := AnnotationHints(map[string]string{
+		DashboardAnnotation: "https://grafana.example.com/d/payments",
+		OwnerAnnotation:     "team-payments",
+		RunbookAnnotation:   " ",
+		"k9s.io/blee":       "duh",
+	})
+
+	assert.Equal(t, []Hint{
+		{Label: "Owner", Value: "team-payments"},
+		{Label: "Dashboard", Value: "https://grafana.example.com/d/payments"},
+	}, hh)
+	assert.Equal(t, "K9s Hints:\n  Owner:      team-payments\n  Dashboard:  https://grafana.example.com/d/payments\n\n", RenderHints(hh))
+	assert.Equal(t, "", RenderHints(nil))
+}
+
+func TestHintURL(t *testing.T) {
+	uu := map[string]struct {
+		v  string
+		ok bool
+	}{
+		"https": {v: "https://wiki.example.com/runbooks/pg", ok: true},
+		"http":  {v: "http://grafana:3000/d/x", ok: true},
+		"text":  {v: "#payments-oncall"},
+		"mail":  {v: "mailto:oncall@example.com"},
+		"bad":   {v: "https://"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, ok := Hint{Value: u.v}.URL()
+			assert.Equal(t, u.ok, ok)
+			if ok {
+				assert.Equal(t, u.v, s)
+			}
+		})
+	}
+}
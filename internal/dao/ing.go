@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	netv1 "k8s.io/api/networking/v1"
+)
+
+// IngressURL returns the url of the first ingress rule. The host falls back
+// to the load balancer address when the rule does not specify one.
+func IngressURL(ing *netv1.Ingress) string {
+	var host, path string
+	if len(ing.Spec.Rules) > 0 {
+		r := ing.Spec.Rules[0]
+		host = r.Host
+		if r.HTTP != nil && len(r.HTTP.Paths) > 0 {
+			path = r.HTTP.Paths[0].Path
+		}
+	}
+	if host == "" {
+		for _, lb := range ing.Status.LoadBalancer.Ingress {
+			if host = lb.IP; host == "" {
+				host = lb.Hostname
+			}
+			break
+		}
+	}
+	if host == "" {
+		return ""
+	}
+	if path == "" {
+		path = "/"
+	}
+
+	scheme := "http"
+	for _, tls := range ing.Spec.TLS {
+		for _, h := range tls.Hosts {
+			if h == host {
+				scheme = "https"
+			}
+		}
+	}
+
+	return scheme + "://" + host + path
+}
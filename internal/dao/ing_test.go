@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	netv1 "k8s.io/api/networking/v1"
+)
+
+func TestIngressURL(t *testing.T) {
+	uu := map[string]struct {
+		ing netv1.Ingress
+		e   string
+	}{
+		"empty": {},
+		"host": {
+			ing: netv1.Ingress{
+				Spec: netv1.IngressSpec{
+					Rules: []netv1.IngressRule{{Host: "fred.com"}},
+				},
+			},
+			e: "http://fred.com/",
+		},
+		"path": {
+			ing: netv1.Ingress{
+				Spec: netv1.IngressSpec{
+					Rules: []netv1.IngressRule{
+						{
+							Host: "fred.com",
+							IngressRuleValue: netv1.IngressRuleValue{
+								HTTP: &netv1.HTTPIngressRuleValue{
+									Paths: []netv1.HTTPIngressPath{{Path: "/blee"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			e: "http://fred.com/blee",
+		},
+		"tls": {
+			ing: netv1.Ingress{
+				Spec: netv1.IngressSpec{
+					TLS:   []netv1.IngressTLS{{Hosts: []string{"fred.com"}}},
+					Rules: []netv1.IngressRule{{Host: "fred.com"}},
+				},
+			},
+			e: "https://fred.com/",
+		},
+		"load-balancer": {
+			ing: netv1.Ingress{
+				Spec: netv1.IngressSpec{
+					Rules: []netv1.IngressRule{{}},
+				},
+				Status: netv1.IngressStatus{
+					LoadBalancer: netv1.IngressLoadBalancerStatus{
+						Ingress: []netv1.IngressLoadBalancerIngress{{IP: "10.0.0.1"}},
+					},
+				},
+			},
+			e: "http://10.0.0.1/",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IngressURL(&u.ing))
+		})
+	}
+}
@@ -0,0 +1,451 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const rootCAConfigMap = "kube-root-ca.crt"
+
+var _ Accessor = (*Inventory)(nil)
+
+// DefaultCloneGVRs lists the resource kinds cloned unless specified otherwise.
+var DefaultCloneGVRs = []string{
+	"v1/configmaps",
+	"v1/secrets",
+	"v1/serviceaccounts",
+	"v1/services",
+	"apps/v1/deployments",
+	"apps/v1/statefulsets",
+	"apps/v1/daemonsets",
+	"batch/v1/cronjobs",
+	"networking.k8s.io/v1/ingresses",
+}
+
+// CloneOptions describes a namespace clone.
+type CloneOptions struct {
+	// Target names the destination namespace.
+	Target string
+
+	// GVRs lists the resource kinds to copy over.
+	GVRs []string
+
+	// From/To rewrites resource names by substring replacement.
+	From, To string
+}
+
+// Rename returns the resource name in the target namespace.
+func (c CloneOptions) Rename(n string) string {
+	if c.From == "" {
+		return n
+	}
+
+	return strings.ReplaceAll(n, c.From, c.To)
+}
+
+// Inventory tracks all resources living in a namespace.
+type Inventory struct {
+	NonResource
+}
+
+// List returns all resources in the given namespace.
+func (i *Inventory) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	if !client.IsNamespaced(ns) {
+		return nil, errors.New("inventory requires a namespace")
+	}
+	rr := i.scan(ctx, ns, nil)
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// Get returns a resource given its row identifier.
+func (i *Inventory) Get(ctx context.Context, id string) (runtime.Object, error) {
+	gvr, path, ok := render.ParseResourceID(id)
+	if !ok {
+		return nil, fmt.Errorf("invalid inventory id %q", id)
+	}
+	o, err := i.getFactory().Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return render.InventoryRes{GVR: gvr, Object: u}, nil
+}
+
+// Report summarizes resource counts per kind in a namespace.
+func (i *Inventory) Report(ns string) string {
+	return summarize(ns, i.scan(context.Background(), ns, nil))
+}
+
+// EmptyPreview summarizes the resources deleted by emptying a namespace.
+func (i *Inventory) EmptyPreview(ns string) (string, int) {
+	rr := i.deletables(ns)
+
+	return summarize(ns, rr), len(rr)
+}
+
+// Empty deletes all top level resources in a namespace. Owned resources are
+// garbage collected and cluster provisioned defaults are left untouched.
+func (i *Inventory) Empty(ctx context.Context, ns string) (int, error) {
+	dial, err := i.Client().DynDial()
+	if err != nil {
+		return 0, err
+	}
+	var (
+		count int
+		errs  []error
+		prop  = metav1.DeletePropagationBackground
+		opts  = metav1.DeleteOptions{PropagationPolicy: &prop}
+	)
+	for _, r := range i.deletables(ns) {
+		n := r.Object.GetName()
+		auth, err := i.Client().CanI(ns, r.GVR, n, []string{client.DeleteVerb})
+		if err != nil || !auth {
+			errs = append(errs, fmt.Errorf("user is not authorized to delete %s/%s", r.GVR, n))
+			continue
+		}
+		err = dial.Resource(client.NewGVR(r.GVR).GVR()).Namespace(ns).Delete(ctx, n, opts)
+		if err != nil && !kerrors.IsNotFound(err) {
+			errs = append(errs, err)
+			continue
+		}
+		count++
+	}
+
+	return count, errors.Join(errs...)
+}
+
+// Clone copies top level resources of the given kinds to another namespace.
+func (i *Inventory) Clone(ctx context.Context, ns string, opts CloneOptions) (int, error) {
+	if opts.Target == "" || opts.Target == ns {
+		return 0, fmt.Errorf("invalid clone target namespace %q", opts.Target)
+	}
+	if err := i.ensureNamespace(ctx, opts.Target); err != nil {
+		return 0, err
+	}
+	dial, err := i.Client().DynDial()
+	if err != nil {
+		return 0, err
+	}
+
+	rr := i.scan(ctx, ns, opts.GVRs)
+	renames := make(refRenames)
+	for _, r := range rr {
+		n := r.Object.GetName()
+		if nn := opts.Rename(n); nn != n {
+			renames.add(r.Object.GetKind(), n, nn)
+		}
+	}
+
+	var (
+		count int
+		errs  []error
+	)
+	for _, r := range rr {
+		if isOwned(r.Object) || isNamespaceDefault(r) {
+			continue
+		}
+		u := r.Object.DeepCopy()
+		sanitizeForCreate(r.GVR, u)
+		rewriteRefs(u.Object, ns, opts.Target, renames)
+		u.SetNamespace(opts.Target)
+		u.SetName(opts.Rename(r.Object.GetName()))
+
+		auth, err := i.Client().CanI(opts.Target, r.GVR, "", []string{client.CreateVerb})
+		if err != nil || !auth {
+			errs = append(errs, fmt.Errorf("user is not authorized to create %s in %s", r.GVR, opts.Target))
+			continue
+		}
+		_, err = dial.Resource(client.NewGVR(r.GVR).GVR()).Namespace(opts.Target).Create(ctx, u, metav1.CreateOptions{})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s/%s: %w", r.GVR, u.GetName(), err))
+			continue
+		}
+		count++
+	}
+
+	return count, errors.Join(errs...)
+}
+
+func (i *Inventory) ensureNamespace(ctx context.Context, ns string) error {
+	dial, err := i.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.CoreV1().Namespaces().Get(ctx, ns, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !kerrors.IsNotFound(err) {
+		return err
+	}
+	_, err = dial.CoreV1().Namespaces().Create(ctx, &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}}, metav1.CreateOptions{})
+
+	return err
+}
+
+func (i *Inventory) deletables(ns string) []render.InventoryRes {
+	rr := i.scan(context.Background(), ns, nil)
+	dd := make([]render.InventoryRes, 0, len(rr))
+	for _, r := range rr {
+		if isOwned(r.Object) || isNamespaceDefault(r) || r.Object.GetDeletionTimestamp() != nil {
+			continue
+		}
+		dd = append(dd, r)
+	}
+
+	return dd
+}
+
+// scan collects namespaced resources. When gvrs is set only those kinds are
+// considered.
+func (i *Inventory) scan(ctx context.Context, ns string, gvrs []string) []render.InventoryRes {
+	allow := make(map[string]struct{}, len(gvrs))
+	for _, g := range gvrs {
+		allow[g] = struct{}{}
+	}
+
+	rr := make([]render.InventoryRes, 0, 50)
+	for _, gvr := range MetaAccess.AllGVRs() {
+		if _, ok := allow[gvr.String()]; len(allow) > 0 && !ok {
+			continue
+		}
+		meta, err := MetaAccess.MetaFor(gvr)
+		if err != nil || !meta.Namespaced || !isFinalizable(gvr, meta) {
+			continue
+		}
+		uu, err := scanResource(ctx, i.getFactory(), gvr, ns)
+		if err != nil {
+			log.Debug().Err(err).Msgf("Inventory scan skipped %q", gvr)
+			continue
+		}
+		for _, u := range uu {
+			rr = append(rr, render.InventoryRes{GVR: gvr.String(), Object: u})
+		}
+	}
+
+	return rr
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func summarize(ns string, rr []render.InventoryRes) string {
+	counts := make(map[string]int)
+	for _, r := range rr {
+		counts[r.GVR]++
+	}
+	kk := make([]string, 0, len(counts))
+	for k := range counts {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "namespace: %s\n", ns)
+	fmt.Fprintf(&b, "total: %d\n", len(rr))
+	if len(kk) == 0 {
+		return b.String()
+	}
+	b.WriteString("resources:\n")
+	for _, k := range kk {
+		fmt.Fprintf(&b, "  %s: %d\n", k, counts[k])
+	}
+
+	return b.String()
+}
+
+func isOwned(u *unstructured.Unstructured) bool {
+	return len(u.GetOwnerReferences()) > 0
+}
+
+// isNamespaceDefault checks for resources provisioned by the cluster for
+// every namespace.
+func isNamespaceDefault(r render.InventoryRes) bool {
+	n := r.Object.GetName()
+	switch r.GVR {
+	case SaGVR.String():
+		return n == defaultServiceAccount
+	case CmGVR.String():
+		return n == rootCAConfigMap
+	case SecGVR.String():
+		t, _, _ := unstructured.NestedString(r.Object.Object, "type")
+		return t == string(v1.SecretTypeServiceAccountToken)
+	case "v1/endpoints", "discovery.k8s.io/v1/endpointslices":
+		return true
+	}
+
+	return false
+}
+
+// sanitizeForCreate strips server populated fields so a resource can be
+// created anew.
+func sanitizeForCreate(gvr string, u *unstructured.Unstructured) {
+	for _, f := range []string{
+		"uid",
+		"resourceVersion",
+		"creationTimestamp",
+		"deletionTimestamp",
+		"deletionGracePeriodSeconds",
+		"generation",
+		"selfLink",
+		"managedFields",
+		"ownerReferences",
+	} {
+		unstructured.RemoveNestedField(u.Object, "metadata", f)
+	}
+	unstructured.RemoveNestedField(u.Object, "metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration")
+	unstructured.RemoveNestedField(u.Object, "metadata", "annotations", "deployment.kubernetes.io/revision")
+	unstructured.RemoveNestedField(u.Object, "status")
+
+	switch gvr {
+	case SvcGVR.String():
+		unstructured.RemoveNestedField(u.Object, "spec", "clusterIP")
+		unstructured.RemoveNestedField(u.Object, "spec", "clusterIPs")
+		unstructured.RemoveNestedField(u.Object, "spec", "healthCheckNodePort")
+		if pp, ok, _ := unstructured.NestedSlice(u.Object, "spec", "ports"); ok {
+			for _, p := range pp {
+				if m, ok := p.(map[string]interface{}); ok {
+					delete(m, "nodePort")
+				}
+			}
+			_ = unstructured.SetNestedSlice(u.Object, pp, "spec", "ports")
+		}
+	case PvcGVR.String():
+		unstructured.RemoveNestedField(u.Object, "spec", "volumeName")
+		unstructured.RemoveNestedField(u.Object, "metadata", "annotations", "pv.kubernetes.io/bind-completed")
+		unstructured.RemoveNestedField(u.Object, "metadata", "annotations", "pv.kubernetes.io/bound-by-controller")
+	case "batch/v1/jobs":
+		unstructured.RemoveNestedField(u.Object, "spec", "selector")
+		for _, l := range []string{"controller-uid", "job-name", "batch.kubernetes.io/controller-uid", "batch.kubernetes.io/job-name"} {
+			unstructured.RemoveNestedField(u.Object, "metadata", "labels", l)
+			unstructured.RemoveNestedField(u.Object, "spec", "template", "metadata", "labels", l)
+		}
+	}
+}
+
+// refFields tracks the object references found in pod specs by the
+// referenced kind.
+var refFields = []struct {
+	key, field, kind string
+}{
+	{"configMap", "name", "ConfigMap"},
+	{"configMapRef", "name", "ConfigMap"},
+	{"configMapKeyRef", "name", "ConfigMap"},
+	{"secret", "secretName", "Secret"},
+	{"secret", "name", "Secret"},
+	{"secretRef", "name", "Secret"},
+	{"secretKeyRef", "name", "Secret"},
+	{"persistentVolumeClaim", "claimName", "PersistentVolumeClaim"},
+}
+
+// refRenames tracks renamed resources names by kind.
+type refRenames map[string]map[string]string
+
+func (r refRenames) add(kind, from, to string) {
+	if r[kind] == nil {
+		r[kind] = make(map[string]string)
+	}
+	r[kind][from] = to
+}
+
+func (r refRenames) rename(kind string, v interface{}) interface{} {
+	if s, ok := v.(string); ok {
+		if n, ok := r[kind][s]; ok {
+			return n
+		}
+	}
+
+	return v
+}
+
+// rewriteRefs points known references ie volumes, env sources, service
+// accounts, pull secrets, role bindings and templates namespaces to the
+// cloned resources. Other values are left untouched.
+func rewriteRefs(o map[string]interface{}, from, to string, renames refRenames) {
+	for k, v := range o {
+		switch k {
+		case "serviceAccountName":
+			o[k] = renames.rename("ServiceAccount", v)
+		case "imagePullSecrets":
+			for _, m := range refMaps(v) {
+				m["name"] = renames.rename("Secret", m["name"])
+			}
+		case "roleRef":
+			if m, ok := v.(map[string]interface{}); ok {
+				if kind, ok := m["kind"].(string); ok {
+					m["name"] = renames.rename(kind, m["name"])
+				}
+			}
+		case "subjects":
+			for _, m := range refMaps(v) {
+				if m["kind"] != "ServiceAccount" {
+					continue
+				}
+				m["name"] = renames.rename("ServiceAccount", m["name"])
+				if m["namespace"] == from {
+					m["namespace"] = to
+				}
+			}
+		case "metadata":
+			if m, ok := v.(map[string]interface{}); ok && m["namespace"] == from {
+				m["namespace"] = to
+			}
+		}
+		for _, f := range refFields {
+			if m, ok := v.(map[string]interface{}); ok && k == f.key {
+				if _, ok := m[f.field]; ok {
+					m[f.field] = renames.rename(f.kind, m[f.field])
+				}
+			}
+		}
+
+		switch t := v.(type) {
+		case map[string]interface{}:
+			rewriteRefs(t, from, to, renames)
+		case []interface{}:
+			for _, m := range refMaps(t) {
+				rewriteRefs(m, from, to, renames)
+			}
+		}
+	}
+}
+
+func refMaps(v interface{}) []map[string]interface{} {
+	ii, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	mm := make([]map[string]interface{}, 0, len(ii))
+	for _, i := range ii {
+		if m, ok := i.(map[string]interface{}); ok {
+			mm = append(mm, m)
+		}
+	}
+
+	return mm
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCloneOptionsRename(t *testing.T) {
+	uu := map[string]struct {
+		opts CloneOptions
+		n, e string
+	}{
+		"none": {
+			n: "fred-blee",
+			e: "fred-blee",
+		},
+		"replace": {
+			opts: CloneOptions{From: "fred", To: "zorg"},
+			n:    "fred-blee",
+			e:    "zorg-blee",
+		},
+		"nomatch": {
+			opts: CloneOptions{From: "duh", To: "zorg"},
+			n:    "fred-blee",
+			e:    "fred-blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.opts.Rename(u.n))
+		})
+	}
+}
+
+func TestSanitizeForCreate(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "fred",
+			"uid":             "1234",
+			"resourceVersion": "10",
+			"annotations": map[string]interface{}{
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+				"blee": "duh",
+			},
+		},
+		"spec": map[string]interface{}{
+			"clusterIP": "10.0.0.1",
+			"ports": []interface{}{
+				map[string]interface{}{"port": int64(80), "nodePort": int64(30080)},
+			},
+		},
+		"status": map[string]interface{}{},
+	}}
+	sanitizeForCreate(SvcGVR.String(), &u)
+
+	assert.Equal(t, "fred", u.GetName())
+	assert.Empty(t, u.GetUID())
+	assert.Empty(t, u.GetResourceVersion())
+	assert.Equal(t, map[string]string{"blee": "duh"}, u.GetAnnotations())
+	_, ok, _ := unstructured.NestedFieldNoCopy(u.Object, "status")
+	assert.False(t, ok)
+	_, ok, _ = unstructured.NestedString(u.Object, "spec", "clusterIP")
+	assert.False(t, ok)
+	pp, _, _ := unstructured.NestedSlice(u.Object, "spec", "ports")
+	assert.Equal(t, []interface{}{map[string]interface{}{"port": int64(80)}}, pp)
+}
+
+func TestRewriteRefs(t *testing.T) {
+	o := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "fred", "namespace": "ns1"},
+		"data":     map[string]interface{}{"key": "fred"},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{"namespace": "ns1", "labels": map[string]interface{}{"app": "fred"}},
+				"spec": map[string]interface{}{
+					"volumes": []interface{}{
+						map[string]interface{}{"name": "fred", "configMap": map[string]interface{}{"name": "fred"}},
+						map[string]interface{}{"name": "certs", "secret": map[string]interface{}{"secretName": "fred"}},
+						map[string]interface{}{"name": "data", "persistentVolumeClaim": map[string]interface{}{"claimName": "blee"}},
+					},
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name": "fred",
+							"envFrom": []interface{}{
+								map[string]interface{}{"secretRef": map[string]interface{}{"name": "fred"}},
+							},
+						},
+					},
+					"serviceAccountName": "blee",
+					"imagePullSecrets":   []interface{}{map[string]interface{}{"name": "fred"}},
+				},
+			},
+		},
+		"subjects": []interface{}{
+			map[string]interface{}{"kind": "ServiceAccount", "name": "blee", "namespace": "ns1"},
+			map[string]interface{}{"kind": "User", "name": "fred"},
+		},
+		"roleRef": map[string]interface{}{"kind": "Role", "name": "fred"},
+	}
+	renames := make(refRenames)
+	renames.add("ConfigMap", "fred", "fred-copy")
+	renames.add("ServiceAccount", "blee", "blee-copy")
+	rewriteRefs(o, "ns1", "ns2", renames)
+
+	assert.Equal(t, map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "fred", "namespace": "ns2"},
+		"data":     map[string]interface{}{"key": "fred"},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{"namespace": "ns2", "labels": map[string]interface{}{"app": "fred"}},
+				"spec": map[string]interface{}{
+					"volumes": []interface{}{
+						map[string]interface{}{"name": "fred", "configMap": map[string]interface{}{"name": "fred-copy"}},
+						map[string]interface{}{"name": "certs", "secret": map[string]interface{}{"secretName": "fred"}},
+						map[string]interface{}{"name": "data", "persistentVolumeClaim": map[string]interface{}{"claimName": "blee"}},
+					},
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name": "fred",
+							"envFrom": []interface{}{
+								map[string]interface{}{"secretRef": map[string]interface{}{"name": "fred"}},
+							},
+						},
+					},
+					"serviceAccountName": "blee-copy",
+					"imagePullSecrets":   []interface{}{map[string]interface{}{"name": "fred"}},
+				},
+			},
+		},
+		"subjects": []interface{}{
+			map[string]interface{}{"kind": "ServiceAccount", "name": "blee-copy", "namespace": "ns2"},
+			map[string]interface{}{"kind": "User", "name": "fred"},
+		},
+		"roleRef": map[string]interface{}{"kind": "Role", "name": "fred"},
+	}, o)
+
+	renames.add("Secret", "fred", "fred-s")
+	renames.add("Role", "fred", "fred-r")
+	rewriteRefs(o, "ns1", "ns2", renames)
+	v, _, _ := unstructured.NestedString(o, "roleRef", "name")
+	assert.Equal(t, "fred-r", v)
+	pp, _, _ := unstructured.NestedSlice(o, "spec", "template", "spec", "imagePullSecrets")
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "fred-s"}}, pp)
+}
@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	kedaHPAPrefix       = "keda-hpa-"
+	kedaScaledObjectKey = "scaledobject.keda.sh/name"
+	externalMetricsAPI  = "/apis/external.metrics.k8s.io/v1beta1"
+)
+
+// KEDAInsight tracks a KEDA scaled resource state.
+type KEDAInsight struct {
+	Target     string            `json:"target,omitempty"`
+	HPA        string            `json:"hpa,omitempty"`
+	Paused     string            `json:"paused"`
+	Conditions map[string]string `json:"conditions,omitempty"`
+	Triggers   []KEDATrigger     `json:"triggers"`
+}
+
+// KEDATrigger tracks a KEDA trigger state.
+type KEDATrigger struct {
+	Type     string            `json:"type"`
+	Name     string            `json:"name,omitempty"`
+	Metric   string            `json:"metric,omitempty"`
+	Health   string            `json:"health,omitempty"`
+	Failures int64             `json:"failures,omitempty"`
+	Current  string            `json:"current,omitempty"`
+	Target   string            `json:"target,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// KEDAReport returns a KEDA scaled resource triggers along with their current
+// and target metric values. Current values are read off the external metrics
+// api when KEDA serves them.
+func KEDAReport(ctx context.Context, f Factory, gvr client.GVR, path string) (string, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	var hpa *autoscalingv2.HorizontalPodAutoscaler
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	if u.GetKind() == "ScaledObject" {
+		n, _, _ := unstructured.NestedString(u.Object, "status", "hpaName")
+		if n == "" {
+			n = kedaHPAPrefix + u.GetName()
+		}
+		hpa, _ = dial.AutoscalingV2().HorizontalPodAutoscalers(u.GetNamespace()).Get(ctx, n, metav1.GetOptions{})
+	}
+
+	in := NewKEDAInsight(u, hpa)
+	if u.GetKind() == "ScaledObject" {
+		sel := labels.Set{kedaScaledObjectKey: u.GetName()}.String()
+		for i := range in.Triggers {
+			m := in.Triggers[i].Metric
+			if m == "" {
+				continue
+			}
+			raw, err := dial.CoreV1().RESTClient().Get().
+				AbsPath(externalMetricsAPI, "namespaces", u.GetNamespace(), m).
+				Param("labelSelector", sel).
+				DoRaw(ctx)
+			if err != nil {
+				in.Triggers[i].Current = "error: " + err.Error()
+				continue
+			}
+			if v, err := ExternalMetricValue(raw); err == nil {
+				in.Triggers[i].Current = v
+			}
+		}
+	}
+
+	raw, err := yaml.Marshal(in)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// NewKEDAInsight returns a scaled resource state. Trigger targets and current
+// values are picked off the backing HPA if any.
+func NewKEDAInsight(u *unstructured.Unstructured, hpa *autoscalingv2.HorizontalPodAutoscaler) KEDAInsight {
+	in := KEDAInsight{
+		Paused:     render.KEDAPaused(u),
+		Conditions: make(map[string]string),
+	}
+	if u.GetKind() == "ScaledObject" {
+		kind, n := render.KEDAScaleTarget(u)
+		in.Target = kind + "/" + n
+	}
+	if hpa != nil {
+		in.HPA = hpa.Name
+	}
+	for _, c := range render.NestedMaps(u.Object, "status", "conditions") {
+		t, _, _ := unstructured.NestedString(c, "type")
+		status, reason := render.StatusCondition(u, t)
+		if reason != "" {
+			status += " (" + reason + ")"
+		}
+		in.Conditions[t] = status
+	}
+
+	mm, _, _ := unstructured.NestedStringSlice(u.Object, "status", "externalMetricNames")
+	health, _, _ := unstructured.NestedMap(u.Object, "status", "health")
+	for i, t := range render.NestedMaps(u.Object, "spec", "triggers") {
+		tr := KEDATrigger{}
+		tr.Type, _, _ = unstructured.NestedString(t, "type")
+		tr.Name, _, _ = unstructured.NestedString(t, "name")
+		tr.Metadata, _, _ = unstructured.NestedStringMap(t, "metadata")
+		switch tr.Type {
+		case "cpu", "memory":
+			tr.Target, tr.Current = hpaResourceMetric(hpa, tr.Type)
+		default:
+			tr.Metric = kedaMetricName(mm, i)
+			tr.Target, tr.Current = hpaExternalMetric(hpa, tr.Metric)
+		}
+		if h, ok := health[tr.Metric].(map[string]interface{}); ok {
+			tr.Health, _, _ = unstructured.NestedString(h, "status")
+			tr.Failures, _, _ = unstructured.NestedInt64(h, "numberOfFailures")
+		}
+		in.Triggers = append(in.Triggers, tr)
+	}
+
+	return in
+}
+
+// kedaMetricName returns the external metric KEDA serves for a given trigger.
+// KEDA prefixes metric names with the trigger index ie s0-xxx.
+func kedaMetricName(mm []string, idx int) string {
+	prefix := fmt.Sprintf("s%d-", idx)
+	for _, m := range mm {
+		if strings.HasPrefix(m, prefix) {
+			return m
+		}
+	}
+
+	return ""
+}
+
+func hpaExternalMetric(hpa *autoscalingv2.HorizontalPodAutoscaler, metric string) (string, string) {
+	if hpa == nil || metric == "" {
+		return "", ""
+	}
+	var target, current string
+	for _, m := range hpa.Spec.Metrics {
+		if m.External != nil && m.External.Metric.Name == metric {
+			target = metricTarget(m.External.Target)
+		}
+	}
+	for _, m := range hpa.Status.CurrentMetrics {
+		if m.External != nil && m.External.Metric.Name == metric {
+			current = metricValue(m.External.Current)
+		}
+	}
+
+	return target, current
+}
+
+func hpaResourceMetric(hpa *autoscalingv2.HorizontalPodAutoscaler, res string) (string, string) {
+	if hpa == nil {
+		return "", ""
+	}
+	var target, current string
+	for _, m := range hpa.Spec.Metrics {
+		if m.Resource != nil && string(m.Resource.Name) == res {
+			target = metricTarget(m.Resource.Target)
+		}
+	}
+	for _, m := range hpa.Status.CurrentMetrics {
+		if m.Resource != nil && string(m.Resource.Name) == res {
+			current = metricValue(m.Resource.Current)
+		}
+	}
+
+	return target, current
+}
+
+func metricTarget(t autoscalingv2.MetricTarget) string {
+	switch {
+	case t.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *t.AverageUtilization)
+	case t.AverageValue != nil:
+		return t.AverageValue.String() + " (avg)"
+	case t.Value != nil:
+		return t.Value.String()
+	default:
+		return ""
+	}
+}
+
+func metricValue(v autoscalingv2.MetricValueStatus) string {
+	switch {
+	case v.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *v.AverageUtilization)
+	case v.AverageValue != nil:
+		return v.AverageValue.String() + " (avg)"
+	case v.Value != nil:
+		return v.Value.String()
+	default:
+		return ""
+	}
+}
+
+// ExternalMetricValue returns the total of an external metrics api response.
+func ExternalMetricValue(raw []byte) (string, error) {
+	var ll struct {
+		Items []struct {
+			Value resource.Quantity `json:"value"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(raw, &ll); err != nil {
+		return "", err
+	}
+	if len(ll.Items) == 0 {
+		return "", fmt.Errorf("no metric values")
+	}
+	total := ll.Items[0].Value.DeepCopy()
+	for _, i := range ll.Items[1:] {
+		total.Add(i.Value)
+	}
+
+	return total.String(), nil
+}
+
+// ToggleKEDAPause pauses or resumes a KEDA scaled resource. It returns true
+// when the resource is now paused.
+func ToggleKEDAPause(ctx context.Context, f Factory, gvr client.GVR, path string) (bool, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := f.Client().CanI(ns, gvr.String(), n, client.PatchAccess)
+	if err != nil {
+		return false, err
+	}
+	if !auth {
+		return false, fmt.Errorf("user is not authorized to patch %s", path)
+	}
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return false, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	paused := render.KEDAPaused(u) != "false"
+	aa := map[string]interface{}{render.KEDAPausedAnnotation: "true"}
+	if paused {
+		aa = map[string]interface{}{
+			render.KEDAPausedAnnotation:         nil,
+			render.KEDAPausedReplicasAnnotation: nil,
+		}
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": aa},
+	})
+	if err != nil {
+		return false, err
+	}
+	dial, err := f.Client().DynDial()
+	if err != nil {
+		return false, err
+	}
+	_, err = dial.Resource(gvr.GVR()).Namespace(ns).Patch(ctx, n, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return !paused, err
+}
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makeScaledObject() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "keda.sh/v1alpha1",
+		"kind":       "ScaledObject",
+		"metadata": map[string]interface{}{
+			"name":        "fred",
+			"namespace":   "default",
+			"annotations": map[string]interface{}{"autoscaling.keda.sh/paused-replicas": "0"},
+		},
+		"spec": map[string]interface{}{
+			"scaleTargetRef": map[string]interface{}{"name": "blee"},
+			"triggers": []interface{}{
+				map[string]interface{}{
+					"type":     "rabbitmq",
+					"metadata": map[string]interface{}{"queueName": "orders", "value": "20"},
+				},
+				map[string]interface{}{
+					"type":     "cpu",
+					"metadata": map[string]interface{}{"value": "60"},
+				},
+			},
+		},
+		"status": map[string]interface{}{
+			"hpaName":             "keda-hpa-fred",
+			"externalMetricNames": []interface{}{"s0-rabbitmq-orders"},
+			"health": map[string]interface{}{
+				"s0-rabbitmq-orders": map[string]interface{}{"status": "Failing", "numberOfFailures": int64(3)},
+			},
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True", "reason": "ScaledObjectReady"},
+				map[string]interface{}{"type": "Active", "status": "False"},
+			},
+		},
+	}}
+}
+
+func makeKEDAHPA() *autoscalingv2.HorizontalPodAutoscaler {
+	avg, cur := resource.MustParse("20"), resource.MustParse("35")
+	util, curUtil := int32(60), int32(42)
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "keda-hpa-fred"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			Metrics: []autoscalingv2.MetricSpec{
+				{External: &autoscalingv2.ExternalMetricSource{
+					Metric: autoscalingv2.MetricIdentifier{Name: "s0-rabbitmq-orders"},
+					Target: autoscalingv2.MetricTarget{AverageValue: &avg},
+				}},
+				{Resource: &autoscalingv2.ResourceMetricSource{
+					Name:   "cpu",
+					Target: autoscalingv2.MetricTarget{AverageUtilization: &util},
+				}},
+			},
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			CurrentMetrics: []autoscalingv2.MetricStatus{
+				{External: &autoscalingv2.ExternalMetricStatus{
+					Metric:  autoscalingv2.MetricIdentifier{Name: "s0-rabbitmq-orders"},
+					Current: autoscalingv2.MetricValueStatus{AverageValue: &cur},
+				}},
+				{Resource: &autoscalingv2.ResourceMetricStatus{
+					Name:    "cpu",
+					Current: autoscalingv2.MetricValueStatus{AverageUtilization: &curUtil},
+				}},
+			},
+		},
+	}
+}
+
+func TestNewKEDAInsight(t *testing.T) {
+	uu := map[string]struct {
+		hpa *autoscalingv2.HorizontalPodAutoscaler
+		e   KEDAInsight
+	}{
+		"no-hpa": {
+			e: KEDAInsight{
+				Target: "Deployment/blee",
+				Paused: "true(0)",
+				Conditions: map[string]string{
+					"Ready":  "True",
+					"Active": "False",
+				},
+				Triggers: []KEDATrigger{
+					{
+						Type:     "rabbitmq",
+						Metric:   "s0-rabbitmq-orders",
+						Health:   "Failing",
+						Failures: 3,
+						Metadata: map[string]string{"queueName": "orders", "value": "20"},
+					},
+					{
+						Type:     "cpu",
+						Metadata: map[string]string{"value": "60"},
+					},
+				},
+			},
+		},
+		"hpa": {
+			hpa: makeKEDAHPA(),
+			e: KEDAInsight{
+				Target: "Deployment/blee",
+				HPA:    "keda-hpa-fred",
+				Paused: "true(0)",
+				Conditions: map[string]string{
+					"Ready":  "True",
+					"Active": "False",
+				},
+				Triggers: []KEDATrigger{
+					{
+						Type:     "rabbitmq",
+						Metric:   "s0-rabbitmq-orders",
+						Health:   "Failing",
+						Failures: 3,
+						Current:  "35 (avg)",
+						Target:   "20 (avg)",
+						Metadata: map[string]string{"queueName": "orders", "value": "20"},
+					},
+					{
+						Type:     "cpu",
+						Current:  "42%",
+						Target:   "60%",
+						Metadata: map[string]string{"value": "60"},
+					},
+				},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, NewKEDAInsight(makeScaledObject(), u.hpa))
+		})
+	}
+}
+
+func TestExternalMetricValue(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		e   string
+		err bool
+	}{
+		"single": {
+			raw: `{"items":[{"metricName":"s0-rabbitmq-orders","value":"12"}]}`,
+			e:   "12",
+		},
+		"many": {
+			raw: `{"items":[{"value":"500m"},{"value":"1500m"}]}`,
+			e:   "2",
+		},
+		"empty": {
+			raw: `{"items":[]}`,
+			err: true,
+		},
+		"toast": {
+			raw: `{`,
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v, err := ExternalMetricValue([]byte(u.raw))
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
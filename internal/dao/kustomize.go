@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const kustomization = "kustomization.yaml"
+
+// KustomizeExport writes the clean manifests of the given resources as a
+// kustomize base along with an overlay patching environment specific fields.
+func KustomizeExport(f Factory, gvr client.GVR, paths []string, rr ExportRules, dir, overlay string) error {
+	oo := make([]map[string]interface{}, 0, len(paths))
+	for _, path := range paths {
+		u, err := getUnstructured(f, gvr, path)
+		if err != nil {
+			return err
+		}
+		o := u.DeepCopy().Object
+		rr.Clean(o)
+		oo = append(oo, o)
+	}
+	ff, err := KustomizeLayout(oo, overlay)
+	if err != nil {
+		return err
+	}
+	for path, raw := range ff {
+		fpath := filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(fpath), 0744); err != nil {
+			return err
+		}
+		if err := os.WriteFile(fpath, []byte(raw), 0600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// KustomizeLayout returns the files of a kustomize base holding the given
+// manifests and of an overlay patching replicas and container images. When
+// all manifests share a namespace, the overlay sets it. Files are keyed by
+// their relative paths.
+func KustomizeLayout(oo []map[string]interface{}, overlay string) (map[string]string, error) {
+	ff := make(map[string]string, 2*len(oo)+2)
+	nss := make(map[string]struct{})
+	for _, o := range oo {
+		if ns := (&unstructured.Unstructured{Object: o}).GetNamespace(); ns != "" {
+			nss[ns] = struct{}{}
+		}
+	}
+
+	var resources, patches []string
+	for _, o := range oo {
+		u := unstructured.Unstructured{Object: o}
+		name := strings.ToLower(u.GetKind()) + "-" + u.GetName()
+		if _, ok := ff[filepath.Join("base", name+".yaml")]; ok {
+			name = strings.ToLower(u.GetKind()) + "-" + u.GetNamespace() + "-" + u.GetName()
+		}
+		name = data.SanitizeFileName(name)
+		if p := overlayPatch(&u, len(nss) > 1); p != nil {
+			raw, err := yaml.Marshal(p)
+			if err != nil {
+				return nil, err
+			}
+			ff[filepath.Join("overlays", overlay, name+"-patch.yaml")] = string(raw)
+			patches = append(patches, name+"-patch.yaml")
+		}
+		if len(nss) == 1 {
+			unstructured.RemoveNestedField(u.Object, "metadata", "namespace")
+		}
+		raw, err := yaml.Marshal(u.Object)
+		if err != nil {
+			return nil, err
+		}
+		ff[filepath.Join("base", name+".yaml")] = string(raw)
+		resources = append(resources, name+".yaml")
+	}
+	sort.Strings(resources)
+	sort.Strings(patches)
+
+	base, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  resources,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ff[filepath.Join("base", kustomization)] = string(base)
+
+	k := map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  []string{"../../base"},
+	}
+	if len(nss) == 1 {
+		for ns := range nss {
+			k["namespace"] = ns
+		}
+	}
+	if len(patches) > 0 {
+		pp := make([]map[string]string, 0, len(patches))
+		for _, p := range patches {
+			pp = append(pp, map[string]string{"path": p})
+		}
+		k["patches"] = pp
+	}
+	raw, err := yaml.Marshal(k)
+	if err != nil {
+		return nil, err
+	}
+	ff[filepath.Join("overlays", overlay, kustomization)] = string(raw)
+
+	return ff, nil
+}
+
+// overlayPatch returns a strategic merge patch holding a resource replicas
+// and container images or nil if none are set.
+func overlayPatch(u *unstructured.Unstructured, withNS bool) map[string]interface{} {
+	spec := make(map[string]interface{})
+	if r, ok, _ := unstructured.NestedFieldNoCopy(u.Object, "spec", "replicas"); ok {
+		spec["replicas"] = r
+	}
+	cc := make([]interface{}, 0, 2)
+	for _, c := range render.NestedMaps(u.Object, "spec", "template", "spec", "containers") {
+		if img, ok := c["image"].(string); ok {
+			cc = append(cc, map[string]interface{}{"name": c["name"], "image": img})
+		}
+	}
+	if len(cc) > 0 {
+		spec["template"] = map[string]interface{}{
+			"spec": map[string]interface{}{"containers": cc},
+		}
+	}
+	if len(spec) == 0 {
+		return nil
+	}
+
+	meta := map[string]interface{}{"name": u.GetName()}
+	if withNS && u.GetNamespace() != "" {
+		meta["namespace"] = u.GetNamespace()
+	}
+
+	return map[string]interface{}{
+		"apiVersion": u.GetAPIVersion(),
+		"kind":       u.GetKind(),
+		"metadata":   meta,
+		"spec":       spec,
+	}
+}
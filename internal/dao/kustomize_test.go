@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKustomizeLayout(t *testing.T) {
+	oo := []map[string]interface{}{
+		{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "fred", "namespace": "blee"},
+			"spec": map[string]interface{}{
+				"replicas": int64(2),
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{"name": "nginx", "image": "nginx:1.25", "ports": []interface{}{}},
+						},
+					},
+				},
+			},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "fred", "namespace": "blee"},
+			"data":       map[string]interface{}{"a": "b"},
+		},
+	}
+
+	ff, err := KustomizeLayout(oo, "prod")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"base/kustomization.yaml": `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- configmap-fred.yaml
+- deployment-fred.yaml
+`,
+		"base/deployment-fred.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: fred
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - image: nginx:1.25
+        name: nginx
+        ports: []
+`,
+		"base/configmap-fred.yaml": `apiVersion: v1
+data:
+  a: b
+kind: ConfigMap
+metadata:
+  name: fred
+`,
+		"overlays/prod/kustomization.yaml": `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: blee
+patches:
+- path: deployment-fred-patch.yaml
+resources:
+- ../../base
+`,
+		"overlays/prod/deployment-fred-patch.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: fred
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - image: nginx:1.25
+        name: nginx
+`,
+	}, ff)
+}
@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// LBPending tracks load balancers waiting on an external address.
+	LBPending = "Pending"
+
+	// LBProvisioned tracks load balancers with an external address.
+	LBProvisioned = "Provisioned"
+
+	// lbCleanupFinalizer tracks services a cloud controller manages.
+	lbCleanupFinalizer = "service.kubernetes.io/load-balancer-cleanup"
+
+	// lbExcludeLabel tracks nodes excluded from load balancers pools.
+	lbExcludeLabel = "node.kubernetes.io/exclude-from-external-load-balancers"
+
+	// cloudUninitializedTaint tracks nodes a cloud controller has yet to initialize.
+	cloudUninitializedTaint = "node.cloudprovider.kubernetes.io/uninitialized"
+
+	// lbSyncFailed tracks cloud controller load balancer failures.
+	lbSyncFailed = "SyncLoadBalancerFailed"
+)
+
+// lbAnnotations tracks the validation of well known cloud load balancer
+// annotations.
+var lbAnnotations = map[string]func(string) error{
+	"service.beta.kubernetes.io/aws-load-balancer-type":            validOneOf("nlb", "nlb-ip", "external"),
+	"service.beta.kubernetes.io/aws-load-balancer-scheme":          validOneOf("internal", "internet-facing"),
+	"service.beta.kubernetes.io/aws-load-balancer-internal":        validBoolOrCIDRs,
+	"service.beta.kubernetes.io/azure-load-balancer-internal":      validOneOf("true", "false"),
+	"service.beta.kubernetes.io/azure-load-balancer-ipv4":          validIPs,
+	"networking.gke.io/load-balancer-type":                         validOneOf("Internal", "External"),
+	"cloud.google.com/load-balancer-type":                          validOneOf("Internal", "External"),
+	"service.beta.kubernetes.io/do-loadbalancer-protocol":          validOneOf("tcp", "http", "https", "http2", "http3"),
+	"service.beta.kubernetes.io/oci-load-balancer-internal":        validOneOf("true", "false"),
+	"metallb.universe.tf/loadBalancerIPs":                          validIPs,
+	"metallb.io/loadBalancerIPs":                                   validIPs,
+	"service.beta.kubernetes.io/aws-load-balancer-eip-allocations": validNonEmpty,
+	"io.cilium/lb-ipam-ips":                                        validIPs,
+}
+
+// LBDiag tracks a load balancer service provisioning diagnostics.
+type LBDiag struct {
+	Service    string   `json:"service"`
+	Status     string   `json:"status"`
+	Class      string   `json:"class,omitempty"`
+	Ingress    []string `json:"ingress,omitempty"`
+	Finalizers []string `json:"finalizers,omitempty"`
+	Issues     []string `json:"issues,omitempty"`
+	Events     []string `json:"events,omitempty"`
+}
+
+// LBDiagReport explains why a load balancer service has no external address
+// from its cloud controller events, finalizers and annotations.
+func LBDiagReport(ctx context.Context, f Factory, path string) (string, error) {
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	ns, n := client.Namespaced(path)
+	svc, err := dial.CoreV1().Services(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	if svc.Spec.Type != v1.ServiceTypeLoadBalancer {
+		return "", fmt.Errorf("service %s is not a load balancer", path)
+	}
+
+	ee, err := dial.CoreV1().Events(ns).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.Set{
+			"involvedObject.kind": "Service",
+			"involvedObject.name": n,
+		}.AsSelector().String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	var nn []v1.Node
+	if svc.Spec.LoadBalancerClass == nil {
+		if ll, err := dial.CoreV1().Nodes().List(ctx, metav1.ListOptions{ResourceVersion: "0"}); err == nil {
+			nn = ll.Items
+		}
+	}
+
+	raw, err := yaml.Marshal(DiagnoseLB(svc, ee.Items, nn, time.Now()))
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// DiagnoseLB returns a load balancer service diagnostics. Nodes are only
+// checked for services provisioned by the cloud controller.
+func DiagnoseLB(svc *v1.Service, ee []v1.Event, nn []v1.Node, now time.Time) LBDiag {
+	d := LBDiag{
+		Service:    client.FQN(svc.Namespace, svc.Name),
+		Status:     LBPending,
+		Finalizers: svc.Finalizers,
+		Events:     recentEvents(ee, now, func(*v1.Event) bool { return true }),
+	}
+	for _, ing := range svc.Status.LoadBalancer.Ingress {
+		d.Ingress = append(d.Ingress, lbTarget(ing.IP, ing.Hostname))
+	}
+	if len(d.Ingress) > 0 {
+		d.Status = LBProvisioned
+	}
+	if svc.Spec.LoadBalancerClass != nil {
+		d.Class = *svc.Spec.LoadBalancerClass
+	}
+
+	d.Issues = append(d.Issues, lbSpecIssues(svc)...)
+	d.Issues = append(d.Issues, lbAnnotationIssues(svc.Annotations)...)
+	if svc.DeletionTimestamp != nil && hasFinalizer(svc.Finalizers, lbCleanupFinalizer) {
+		d.Issues = append(d.Issues, fmt.Sprintf("deletion blocked for %s waiting on the cloud controller to release the load balancer",
+			duration.HumanDuration(now.Sub(svc.DeletionTimestamp.Time))))
+	}
+	if d.Status == LBProvisioned {
+		return d
+	}
+
+	if e, ok := lastEvent(ee, lbSyncFailed); ok {
+		d.Issues = append(d.Issues, "cloud controller failed to sync load balancer: "+strings.TrimSpace(e.Message))
+	}
+	if d.Class != "" {
+		d.Issues = append(d.Issues, fmt.Sprintf("provisioning delegated to the controller implementing load balancer class %q", d.Class))
+		return d
+	}
+	if !hasFinalizer(svc.Finalizers, lbCleanupFinalizer) && len(ee) == 0 {
+		d.Issues = append(d.Issues, "no cloud controller has picked up this service (no cleanup finalizer or events)")
+	}
+
+	d.Issues = append(d.Issues, lbNodeIssues(nn)...)
+
+	return d
+}
+
+// lbSpecIssues reports load balancer spec misconfigurations.
+func lbSpecIssues(svc *v1.Service) []string {
+	var ii []string
+	if ip := svc.Spec.LoadBalancerIP; ip != "" && net.ParseIP(ip) == nil {
+		ii = append(ii, fmt.Sprintf("spec.loadBalancerIP %q is not a valid IP", ip))
+	}
+	for _, r := range svc.Spec.LoadBalancerSourceRanges {
+		if _, _, err := net.ParseCIDR(strings.TrimSpace(r)); err != nil {
+			ii = append(ii, fmt.Sprintf("spec.loadBalancerSourceRanges %q is not a valid CIDR", r))
+		}
+	}
+	if len(svc.Spec.Ports) == 0 {
+		ii = append(ii, "service exposes no ports")
+	}
+	protos := make(map[v1.Protocol]struct{})
+	for _, p := range svc.Spec.Ports {
+		protos[p.Protocol] = struct{}{}
+	}
+	if len(protos) > 1 {
+		ii = append(ii, "mixed protocols ports are not supported by all cloud providers")
+	}
+
+	return ii
+}
+
+// lbAnnotationIssues reports invalid well known load balancer annotations.
+func lbAnnotationIssues(aa map[string]string) []string {
+	kk := make([]string, 0, len(aa))
+	for k := range aa {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	var ii []string
+	for _, k := range kk {
+		validate, ok := lbAnnotations[k]
+		if !ok {
+			continue
+		}
+		if err := validate(aa[k]); err != nil {
+			ii = append(ii, fmt.Sprintf("annotation %s: %s", k, err))
+		}
+	}
+
+	return ii
+}
+
+// lbNodeIssues reports nodes that cannot back a cloud load balancer.
+func lbNodeIssues(nn []v1.Node) []string {
+	if len(nn) == 0 {
+		return nil
+	}
+	var (
+		ii                                 []string
+		unmanaged, excluded, uninitialized int
+	)
+	for i := range nn {
+		if nn[i].Spec.ProviderID == "" {
+			unmanaged++
+		}
+		if _, ok := nn[i].Labels[lbExcludeLabel]; ok {
+			excluded++
+		}
+		for _, t := range nn[i].Spec.Taints {
+			if t.Key == cloudUninitializedTaint {
+				uninitialized++
+				break
+			}
+		}
+	}
+	if unmanaged == len(nn) {
+		ii = append(ii, "no node reports a providerID. The cluster may not run a cloud controller manager")
+	}
+	if excluded == len(nn) {
+		ii = append(ii, fmt.Sprintf("all nodes are labeled %s", lbExcludeLabel))
+	}
+	if uninitialized > 0 {
+		ii = append(ii, fmt.Sprintf("%d node(s) not yet initialized by the cloud controller", uninitialized))
+	}
+
+	return ii
+}
+
+// lastEvent returns the most recent event with the given reason.
+func lastEvent(ee []v1.Event, reason string) (v1.Event, bool) {
+	var (
+		last v1.Event
+		ok   bool
+	)
+	for _, e := range ee {
+		if e.Reason != reason {
+			continue
+		}
+		if !ok || eventTime(e).After(eventTime(last)) {
+			last, ok = e, true
+		}
+	}
+
+	return last, ok
+}
+
+func hasFinalizer(ff []string, f string) bool {
+	for _, v := range ff {
+		if v == f {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validOneOf(vv ...string) func(string) error {
+	return func(s string) error {
+		for _, v := range vv {
+			if s == v {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid value %q, expecting one of %s", s, strings.Join(vv, "|"))
+	}
+}
+
+func validBoolOrCIDRs(s string) error {
+	if s == "true" || s == "false" {
+		return nil
+	}
+	for _, c := range strings.Split(s, ",") {
+		if _, _, err := net.ParseCIDR(strings.TrimSpace(c)); err != nil {
+			return fmt.Errorf("invalid value %q, expecting true|false or CIDRs", s)
+		}
+	}
+
+	return nil
+}
+
+func validIPs(s string) error {
+	for _, ip := range strings.Split(s, ",") {
+		if net.ParseIP(strings.TrimSpace(ip)) == nil {
+			return fmt.Errorf("invalid IP %q", ip)
+		}
+	}
+
+	return nil
+}
+
+func validNonEmpty(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return errors.New("empty value")
+	}
+
+	return nil
+}
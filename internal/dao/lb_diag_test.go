@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDiagnoseLB(t *testing.T) {
+	now := time.Now()
+	class := "metallb"
+	failed := v1.Event{
+		InvolvedObject: v1.ObjectReference{Namespace: "default", Name: "fred"},
+		Reason:         lbSyncFailed,
+		Message:        "quota exceeded",
+		LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+	}
+	node := v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1"}}
+	cloudNode := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "n2"},
+		Spec: v1.NodeSpec{
+			ProviderID: "aws:///us-east-1a/i-1",
+			Taints:     []v1.Taint{{Key: cloudUninitializedTaint}},
+		},
+	}
+	tcp := []v1.ServicePort{{Port: 80, Protocol: v1.ProtocolTCP}}
+
+	uu := map[string]struct {
+		svc    v1.Service
+		ee     []v1.Event
+		nn     []v1.Node
+		status string
+		issues []string
+	}{
+		"provisioned": {
+			svc: v1.Service{
+				Spec: v1.ServiceSpec{Ports: tcp},
+				Status: v1.ServiceStatus{
+					LoadBalancer: v1.LoadBalancerStatus{Ingress: []v1.LoadBalancerIngress{{Hostname: "lb.example.com"}}},
+				},
+			},
+			nn:     []v1.Node{node},
+			status: LBProvisioned,
+		},
+		"no-controller": {
+			svc:    v1.Service{Spec: v1.ServiceSpec{Ports: tcp}},
+			nn:     []v1.Node{node},
+			status: LBPending,
+			issues: []string{
+				"no cloud controller has picked up this service (no cleanup finalizer or events)",
+				"no node reports a providerID. The cluster may not run a cloud controller manager",
+			},
+		},
+		"sync-failed": {
+			svc: v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Finalizers: []string{lbCleanupFinalizer},
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-scheme": "public",
+					},
+				},
+				Spec: v1.ServiceSpec{Ports: tcp, LoadBalancerSourceRanges: []string{"10.0.0.0/33"}},
+			},
+			ee:     []v1.Event{failed},
+			nn:     []v1.Node{cloudNode},
+			status: LBPending,
+			issues: []string{
+				`spec.loadBalancerSourceRanges "10.0.0.0/33" is not a valid CIDR`,
+				`annotation service.beta.kubernetes.io/aws-load-balancer-scheme: invalid value "public", expecting one of internal|internet-facing`,
+				"cloud controller failed to sync load balancer: quota exceeded",
+				"1 node(s) not yet initialized by the cloud controller",
+			},
+		},
+		"class": {
+			svc: v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"metallb.universe.tf/loadBalancerIPs": "10.0.0.300"},
+				},
+				Spec: v1.ServiceSpec{
+					Ports:             []v1.ServicePort{{Port: 53, Protocol: v1.ProtocolTCP}, {Port: 53, Protocol: v1.ProtocolUDP}},
+					LoadBalancerClass: &class,
+				},
+			},
+			status: LBPending,
+			issues: []string{
+				"mixed protocols ports are not supported by all cloud providers",
+				`annotation metallb.universe.tf/loadBalancerIPs: invalid IP "10.0.0.300"`,
+				`provisioning delegated to the controller implementing load balancer class "metallb"`,
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.svc.Namespace, u.svc.Name = "default", "fred"
+			d := DiagnoseLB(&u.svc, u.ee, u.nn, now)
+			assert.Equal(t, "default/fred", d.Service)
+			assert.Equal(t, u.status, d.Status)
+			assert.Equal(t, u.issues, d.Issues)
+			assert.Equal(t, len(u.ee), len(d.Events))
+		})
+	}
+}
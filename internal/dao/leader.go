@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// replicatedControllers tracks workloads whose replicas may elect a leader.
+var replicatedControllers = map[client.GVR]struct{}{
+	client.NewGVR("apps/v1/deployments"):  {},
+	client.NewGVR("apps/v1/statefulsets"): {},
+	client.NewGVR("apps/v1/daemonsets"):   {},
+}
+
+// IsReplicated checks if a workload runs replicas that may elect a leader.
+func IsReplicated(gvr client.GVR) bool {
+	_, ok := replicatedControllers[gvr]
+
+	return ok
+}
+
+// Leader represents the workload replica holding a lease.
+type Leader struct {
+	Pod       string
+	Lease     string
+	RenewedAt time.Time
+}
+
+// LeaderFor returns the workload replica holding a lease in the workload
+// namespace. When several leases match, the most recently renewed one wins.
+func LeaderFor(ctx context.Context, f Factory, o *unstructured.Unstructured) (Leader, error) {
+	ls, err := ChildSelector(o)
+	if err != nil {
+		return Leader{}, err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(ls)
+	if err != nil {
+		return Leader{}, err
+	}
+	oo, err := f.List(PodGVR.String(), o.GetNamespace(), true, sel)
+	if err != nil {
+		return Leader{}, err
+	}
+	pods := make([]string, 0, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			pods = append(pods, u.GetName())
+		}
+	}
+
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return Leader{}, err
+	}
+	ll, err := dial.CoordinationV1().Leases(o.GetNamespace()).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return Leader{}, err
+	}
+	l, ok := electLeader(ll.Items, pods)
+	if !ok {
+		return Leader{}, fmt.Errorf("no lease in %q is held by any of the %d replicas of %s", o.GetNamespace(), len(pods), o.GetName())
+	}
+	l.Pod = client.FQN(o.GetNamespace(), l.Pod)
+
+	return l, nil
+}
+
+func electLeader(ll []coordinationv1.Lease, pods []string) (Leader, bool) {
+	var (
+		leader Leader
+		found  bool
+	)
+	for _, l := range ll {
+		if l.Spec.HolderIdentity == nil {
+			continue
+		}
+		pod, ok := MatchLeader(*l.Spec.HolderIdentity, pods)
+		if !ok {
+			continue
+		}
+		var renewed time.Time
+		if l.Spec.RenewTime != nil {
+			renewed = l.Spec.RenewTime.Time
+		}
+		if !found || renewed.After(leader.RenewedAt) {
+			leader, found = Leader{Pod: pod, Lease: l.Name, RenewedAt: renewed}, true
+		}
+	}
+
+	return leader, found
+}
+
+// MatchLeader returns the pod a lease holder identity refers to. Holders are
+// either the pod name or the pod name suffixed ie pod_uuid.
+func MatchLeader(holder string, pods []string) (string, bool) {
+	var best string
+	for _, p := range pods {
+		if holder != p && !strings.HasPrefix(holder, p+"_") && !strings.HasPrefix(holder, p+"-") {
+			continue
+		}
+		if len(p) > len(best) {
+			best = p
+		}
+	}
+
+	return best, best != ""
+}
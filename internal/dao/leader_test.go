@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMatchLeader(t *testing.T) {
+	pods := []string{"ctrl-abc-1", "ctrl-abc-12", "blee"}
+	uu := map[string]struct {
+		holder string
+		pod    string
+		ok     bool
+	}{
+		"exact":   {holder: "blee", pod: "blee", ok: true},
+		"uuid":    {holder: "ctrl-abc-1_5f0c7a2e-1b3d", pod: "ctrl-abc-1", ok: true},
+		"longest": {holder: "ctrl-abc-12_5f0c7a2e", pod: "ctrl-abc-12", ok: true},
+		"suffix":  {holder: "blee-external-controller", pod: "blee", ok: true},
+		"none":    {holder: "zorg_1234"},
+		"partial": {holder: "ctrl-ab"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pod, ok := MatchLeader(u.holder, pods)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.pod, pod)
+		})
+	}
+}
+
+func TestElectLeader(t *testing.T) {
+	now := time.Now()
+	lease := func(n, holder string, renewed time.Time) coordinationv1.Lease {
+		l := coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: n}}
+		if holder != "" {
+			l.Spec.HolderIdentity = &holder
+		}
+		rt := metav1.NewMicroTime(renewed)
+		l.Spec.RenewTime = &rt
+		return l
+	}
+	ll := []coordinationv1.Lease{
+		lease("other", "zorg_1", now),
+		lease("stale", "p1_1", now.Add(-time.Hour)),
+		lease("fresh", "p2_1", now.Add(-time.Second)),
+		lease("free", "", now),
+	}
+
+	l, ok := electLeader(ll, []string{"p1", "p2"})
+	assert.True(t, ok)
+	assert.Equal(t, "p2", l.Pod)
+	assert.Equal(t, "fresh", l.Lease)
+
+	_, ok = electLeader(ll[:1], []string{"p1", "p2"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// LogStep represents a pod container or a job attempt logs can be stepped through.
+type LogStep struct {
+	GVR       client.GVR
+	Path      string
+	Container string
+	Status    string
+}
+
+// LogSteps returns the containers of a pod or the attempts of a job in
+// execution order. A job with a single attempt steps through its pod
+// containers. Other resources have no steps.
+func LogSteps(f Factory, gvr client.GVR, path string) ([]LogStep, error) {
+	switch gvr {
+	case PodGVR:
+		u, err := getUnstructured(f, gvr, path)
+		if err != nil {
+			return nil, err
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		return PodLogSteps(&po), nil
+	case JobGVR:
+		u, err := getUnstructured(f, gvr, path)
+		if err != nil {
+			return nil, err
+		}
+		var job batchv1.Job
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &job); err != nil {
+			return nil, err
+		}
+		if job.Spec.Selector == nil || len(job.Spec.Selector.MatchLabels) == 0 {
+			return nil, fmt.Errorf("no valid selector found on job %s", path)
+		}
+		pp, err := podsFromSelector(f, job.Namespace, job.Spec.Selector.MatchLabels)
+		if err != nil {
+			return nil, err
+		}
+		if len(pp) == 1 {
+			return PodLogSteps(pp[0]), nil
+		}
+		return JobLogSteps(pp), nil
+	default:
+		return nil, nil
+	}
+}
+
+// PodLogSteps returns a pod init containers followed by its containers
+// annotated with their current state.
+func PodLogSteps(po *v1.Pod) []LogStep {
+	path := client.FQN(po.Namespace, po.Name)
+	ss := make([]LogStep, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers))
+	for i, co := range po.Spec.InitContainers {
+		status := fmt.Sprintf("init %d/%d", i+1, len(po.Spec.InitContainers))
+		if s := containerStatus(po.Status.InitContainerStatuses, co.Name); s != nil {
+			status += " " + containerStepStatus(s)
+		}
+		ss = append(ss, LogStep{GVR: PodGVR, Path: path, Container: co.Name, Status: status})
+	}
+	for _, co := range po.Spec.Containers {
+		status := "Pending"
+		if s := containerStatus(po.Status.ContainerStatuses, co.Name); s != nil {
+			status = containerStepStatus(s)
+		}
+		ss = append(ss, LogStep{GVR: PodGVR, Path: path, Container: co.Name, Status: status})
+	}
+
+	return ss
+}
+
+// JobLogSteps returns the pods of a job as attempts ordered by creation
+// annotated with their phase.
+func JobLogSteps(pp []*v1.Pod) []LogStep {
+	pp = append([]*v1.Pod(nil), pp...)
+	sort.SliceStable(pp, func(i, j int) bool {
+		return pp[i].CreationTimestamp.Before(&pp[j].CreationTimestamp)
+	})
+	ss := make([]LogStep, 0, len(pp))
+	for i, po := range pp {
+		status := fmt.Sprintf("attempt %d/%d %s", i+1, len(pp), po.Status.Phase)
+		if po.Status.Reason != "" {
+			status += ":" + po.Status.Reason
+		}
+		for _, s := range po.Status.ContainerStatuses {
+			if t := s.State.Terminated; t != nil && t.ExitCode != 0 {
+				status += fmt.Sprintf(" (%s exit %d)", s.Name, t.ExitCode)
+				break
+			}
+		}
+		ss = append(ss, LogStep{GVR: PodGVR, Path: client.FQN(po.Namespace, po.Name), Status: status})
+	}
+
+	return ss
+}
+
+func containerStatus(ss []v1.ContainerStatus, co string) *v1.ContainerStatus {
+	for i := range ss {
+		if ss[i].Name == co {
+			return &ss[i]
+		}
+	}
+
+	return nil
+}
+
+// containerStepStatus returns a container state along with its restarts if any.
+func containerStepStatus(s *v1.ContainerStatus) string {
+	var status string
+	switch {
+	case s.State.Running != nil:
+		status = "Running"
+	case s.State.Waiting != nil:
+		status = "Waiting"
+		if s.State.Waiting.Reason != "" {
+			status += ":" + s.State.Waiting.Reason
+		}
+	case s.State.Terminated != nil:
+		status = fmt.Sprintf("Terminated:%s exit %d", s.State.Terminated.Reason, s.State.Terminated.ExitCode)
+	default:
+		status = "Unknown"
+	}
+	if s.RestartCount > 0 {
+		status += fmt.Sprintf(" restarts %d", s.RestartCount)
+	}
+
+	return status
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodLogSteps(t *testing.T) {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{Name: "i1"}, {Name: "i2"}},
+			Containers:     []v1.Container{{Name: "c1"}},
+		},
+		Status: v1.PodStatus{
+			InitContainerStatuses: []v1.ContainerStatus{
+				{Name: "i1", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Completed"}}},
+				{Name: "i2", RestartCount: 3, State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+			},
+		},
+	}
+
+	assert.Equal(t, []LogStep{
+		{GVR: PodGVR, Path: "ns1/p1", Container: "i1", Status: "init 1/2 Terminated:Completed exit 0"},
+		{GVR: PodGVR, Path: "ns1/p1", Container: "i2", Status: "init 2/2 Waiting:CrashLoopBackOff restarts 3"},
+		{GVR: PodGVR, Path: "ns1/p1", Container: "c1", Status: "Pending"},
+	}, PodLogSteps(&po))
+}
+
+func TestJobLogSteps(t *testing.T) {
+	now := time.Now()
+	pp := []*v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "j1-b", CreationTimestamp: metav1.NewTime(now)},
+			Status:     v1.PodStatus{Phase: v1.PodRunning},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "j1-a", CreationTimestamp: metav1.NewTime(now.Add(-time.Minute))},
+			Status: v1.PodStatus{
+				Phase: v1.PodFailed,
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Error", ExitCode: 2}}},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []LogStep{
+		{GVR: PodGVR, Path: "ns1/j1-a", Status: "attempt 1/2 Failed (c1 exit 2)"},
+		{GVR: PodGVR, Path: "ns1/j1-b", Status: "attempt 2/2 Running"},
+	}, JobLogSteps(pp))
+	assert.Equal(t, "ns1/j1-b", pp[0].Namespace+"/"+pp[0].Name)
+}
@@ -0,0 +1,313 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	// meshSidecars tracks well known mesh proxies container names.
+	meshSidecars = map[string]string{
+		"istio-proxy":       "istio",
+		"linkerd-proxy":     "linkerd",
+		"consul-dataplane":  "consul",
+		"envoy-sidecar":     "consul",
+		"kuma-sidecar":      "kuma",
+		"cilium-envoy":      "cilium",
+		"osm-envoy":         "osm",
+		"aws-appmesh-envoy": "appmesh",
+	}
+
+	istioVSGVRs = []string{
+		"networking.istio.io/v1/virtualservices",
+		"networking.istio.io/v1beta1/virtualservices",
+		"networking.istio.io/v1alpha3/virtualservices",
+	}
+	ciliumPolicyGVRs = []string{
+		"cilium.io/v2/ciliumnetworkpolicies",
+		"cilium.io/v2/ciliumclusterwidenetworkpolicies",
+	}
+	calicoPolicyGVRs = []string{
+		"crd.projectcalico.org/v1/networkpolicies",
+		"crd.projectcalico.org/v1/globalnetworkpolicies",
+	}
+)
+
+// MeshInfo tracks a pod's mesh sidecars and the policies and routes applying to it.
+type MeshInfo struct {
+	Sidecars        []string `json:"sidecars,omitempty"`
+	Services        []string `json:"services,omitempty"`
+	VirtualServices []string `json:"virtualServices,omitempty"`
+	NetworkPolicies []string `json:"networkPolicies,omitempty"`
+	CiliumPolicies  []string `json:"ciliumPolicies,omitempty"`
+	CalicoPolicies  []string `json:"calicoPolicies,omitempty"`
+	Unknown         []string `json:"unknownPolicies,omitempty"`
+}
+
+// MeshReport returns the mesh sidecars, routes and network policies
+// associated with a given pod.
+func MeshReport(f Factory, path string) (string, error) {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+		return "", err
+	}
+
+	info := MeshInfo{Sidecars: PodSidecars(&po)}
+	set := labels.Set(po.Labels)
+	svcs := podServices(f, &po)
+	for _, s := range svcs {
+		info.Services = append(info.Services, client.FQN(po.Namespace, s))
+	}
+	for _, u := range meshListAny(f, istioVSGVRs, client.BlankNamespace) {
+		if vsTargets(u, po.Namespace, svcs) {
+			info.VirtualServices = append(info.VirtualServices, client.FQN(u.GetNamespace(), u.GetName())+" "+render.VirtualServiceRoutes(u))
+		}
+	}
+	for _, u := range meshList(f, "networking.k8s.io/v1/networkpolicies", po.Namespace) {
+		m, _, _ := unstructured.NestedMap(u.Object, "spec", "podSelector")
+		ok, err := selectorMatches(m, set)
+		if err != nil {
+			info.Unknown = append(info.Unknown, unknownPolicy(u, err))
+			continue
+		}
+		if ok {
+			info.NetworkPolicies = append(info.NetworkPolicies, client.FQN(u.GetNamespace(), u.GetName()))
+		}
+	}
+	for _, gvr := range ciliumPolicyGVRs {
+		for _, u := range meshList(f, gvr, po.Namespace) {
+			ss, err := render.CiliumPolicySelectors(u)
+			if err != nil {
+				info.Unknown = append(info.Unknown, unknownPolicy(u, err))
+				continue
+			}
+			for _, s := range ss {
+				sel, err := metav1.LabelSelectorAsSelector(s)
+				if err != nil {
+					info.Unknown = append(info.Unknown, unknownPolicy(u, err))
+					break
+				}
+				if sel.Matches(set) {
+					info.CiliumPolicies = append(info.CiliumPolicies, client.FQN(u.GetNamespace(), u.GetName()))
+					break
+				}
+			}
+		}
+	}
+	for _, gvr := range calicoPolicyGVRs {
+		for _, u := range meshList(f, gvr, po.Namespace) {
+			sel, _, _ := unstructured.NestedString(u.Object, "spec", "selector")
+			ok, err := CalicoSelects(sel, po.Labels)
+			if err != nil {
+				info.Unknown = append(info.Unknown, unknownPolicy(u, err))
+				continue
+			}
+			if ok {
+				info.CalicoPolicies = append(info.CalicoPolicies, client.FQN(u.GetNamespace(), u.GetName()))
+			}
+		}
+	}
+
+	raw, err := yaml.Marshal(info)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// unknownPolicy reports a policy whose selector could not be evaluated so
+// it may or may not apply to the pod.
+func unknownPolicy(u *unstructured.Unstructured, err error) string {
+	return fmt.Sprintf("%s %s (%s)", u.GetKind(), client.FQN(u.GetNamespace(), u.GetName()), err)
+}
+
+// selectorMatches checks if an unstructured label selector, including its
+// match expressions, matches the given labels. An empty selector matches all.
+func selectorMatches(m map[string]interface{}, set labels.Set) (bool, error) {
+	var ls metav1.LabelSelector
+	if m != nil {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &ls); err != nil {
+			return false, err
+		}
+	}
+	sel, err := metav1.LabelSelectorAsSelector(&ls)
+	if err != nil {
+		return false, err
+	}
+
+	return sel.Matches(set), nil
+}
+
+// PodSidecars returns the mesh proxies injected in a pod.
+func PodSidecars(po *v1.Pod) []string {
+	ss := make([]string, 0, 1)
+	for _, cc := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+		for _, c := range cc {
+			if m, ok := meshSidecars[c.Name]; ok {
+				ss = append(ss, c.Name+" ("+m+")")
+			}
+		}
+	}
+
+	return ss
+}
+
+// CalicoSelects checks if a Calico selector matches the given labels. Only
+// conjunctions of all(), has(), ==, != expressions are supported.
+func CalicoSelects(sel string, ll map[string]string) (bool, error) {
+	sel = strings.TrimSpace(sel)
+	if sel == "" {
+		return true, nil
+	}
+	for _, e := range strings.Split(sel, "&&") {
+		e = strings.TrimSpace(e)
+		switch {
+		case e == "all()":
+			continue
+		case strings.HasPrefix(e, "!has(") && strings.HasSuffix(e, ")") && calicoKey(e[5:len(e)-1]):
+			if _, ok := ll[strings.TrimSpace(e[5:len(e)-1])]; ok {
+				return false, nil
+			}
+		case strings.HasPrefix(e, "has(") && strings.HasSuffix(e, ")") && calicoKey(e[4:len(e)-1]):
+			if _, ok := ll[strings.TrimSpace(e[4:len(e)-1])]; !ok {
+				return false, nil
+			}
+		case calicoTerm(e, "!="):
+			k, v, _ := strings.Cut(e, "!=")
+			if ll[strings.TrimSpace(k)] == unquote(v) {
+				return false, nil
+			}
+		case calicoTerm(e, "=="):
+			k, v, _ := strings.Cut(e, "==")
+			if lv, ok := ll[strings.TrimSpace(k)]; !ok || lv != unquote(v) {
+				return false, nil
+			}
+		default:
+			return false, fmt.Errorf("unsupported calico selector expression %q", e)
+		}
+	}
+
+	return true, nil
+}
+
+// calicoTerm checks for a key op 'value' expression.
+func calicoTerm(e, op string) bool {
+	k, v, ok := strings.Cut(e, op)
+	if !ok || !calicoKey(k) {
+		return false
+	}
+	v = strings.TrimSpace(v)
+	if len(v) < 2 || v[0] != v[len(v)-1] || (v[0] != '\'' && v[0] != '"') {
+		return false
+	}
+
+	return !strings.ContainsAny(v[1:len(v)-1], `'"`)
+}
+
+// calicoKey checks for a plain label key.
+func calicoKey(k string) bool {
+	k = strings.TrimSpace(k)
+
+	return k != "" && !strings.ContainsAny(k, " '\"()!=&|{}")
+}
+
+func unquote(s string) string {
+	return strings.Trim(strings.TrimSpace(s), `'"`)
+}
+
+func podServices(f Factory, po *v1.Pod) []string {
+	ss := make([]string, 0, 1)
+	for _, u := range meshList(f, "v1/services", po.Namespace) {
+		sel, _, _ := unstructured.NestedStringMap(u.Object, "spec", "selector")
+		if len(sel) > 0 && labels.SelectorFromSet(sel).Matches(labels.Set(po.Labels)) {
+			ss = append(ss, u.GetName())
+		}
+	}
+
+	return ss
+}
+
+// vsTargets checks if a VirtualService routes to one of the given services.
+func vsTargets(u *unstructured.Unstructured, ns string, svcs []string) bool {
+	hosts := make([]string, 0, 5)
+	for _, proto := range []string{"http", "tls", "tcp"} {
+		for _, r := range render.NestedMaps(u.Object, "spec", proto) {
+			for _, d := range render.NestedMaps(r, "route") {
+				if h, ok, _ := unstructured.NestedString(d, "destination", "host"); ok {
+					hosts = append(hosts, h)
+				}
+			}
+		}
+	}
+	for _, h := range hosts {
+		name, hns, _ := strings.Cut(h, ".")
+		if hns == "" {
+			hns = u.GetNamespace()
+		} else {
+			hns, _, _ = strings.Cut(hns, ".")
+		}
+		if hns != ns {
+			continue
+		}
+		for _, s := range svcs {
+			if s == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// meshListAny lists the first available resource amongst the given versions.
+func meshListAny(f Factory, gvrs []string, ns string) []*unstructured.Unstructured {
+	for _, gvr := range gvrs {
+		if _, err := MetaAccess.MetaFor(client.NewGVR(gvr)); err != nil {
+			continue
+		}
+		return meshList(f, gvr, ns)
+	}
+
+	return nil
+}
+
+func meshList(f Factory, gvr, ns string) []*unstructured.Unstructured {
+	meta, err := MetaAccess.MetaFor(client.NewGVR(gvr))
+	if err != nil {
+		return nil
+	}
+	if !meta.Namespaced {
+		ns = client.ClusterScope
+	}
+	oo, err := f.List(gvr, ns, true, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("Mesh scan skipped %q", gvr)
+		return nil
+	}
+	uu := make([]*unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			uu = append(uu, u)
+		}
+	}
+
+	return uu
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestCalicoSelects(t *testing.T) {
+	ll := map[string]string{"app": "fred", "tier": "web"}
+	uu := map[string]struct {
+		sel string
+		e   bool
+		err bool
+	}{
+		"blank":   {e: true},
+		"all":     {sel: "all()", e: true},
+		"eq":      {sel: "app == 'fred'", e: true},
+		"neq":     {sel: `app != "fred"`},
+		"has":     {sel: "has(tier) && app == 'fred'", e: true},
+		"not-has": {sel: "!has(tier)"},
+		"miss":    {sel: "app == 'blee'"},
+		"or":      {sel: "app == 'fred' || app == 'blee'", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ok, err := CalicoSelects(u.sel, ll)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, ok)
+		})
+	}
+}
+
+func TestUnknownPolicy(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "NetworkPolicy",
+		"metadata": map[string]interface{}{
+			"namespace": "fred",
+			"name":      "blee",
+		},
+	}}
+	_, err := CalicoSelects("app == 'fred' || app == 'blee'", nil)
+
+	assert.Equal(t, `NetworkPolicy fred/blee (unsupported calico selector expression "app == 'fred' || app == 'blee'")`, unknownPolicy(&u, err))
+}
+
+func TestSelectorMatches(t *testing.T) {
+	set := labels.Set{"app": "fred", "tier": "web"}
+	uu := map[string]struct {
+		sel map[string]interface{}
+		e   bool
+		err bool
+	}{
+		"empty": {sel: map[string]interface{}{}, e: true},
+		"labels": {
+			sel: map[string]interface{}{"matchLabels": map[string]interface{}{"app": "fred"}},
+			e:   true,
+		},
+		"expressions": {
+			sel: map[string]interface{}{"matchExpressions": []interface{}{
+				map[string]interface{}{"key": "tier", "operator": "In", "values": []interface{}{"db"}},
+			}},
+		},
+		"mixed": {
+			sel: map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "fred"},
+				"matchExpressions": []interface{}{
+					map[string]interface{}{"key": "tier", "operator": "Exists"},
+				},
+			},
+			e: true,
+		},
+		"bad": {
+			sel: map[string]interface{}{"matchExpressions": []interface{}{
+				map[string]interface{}{"key": "tier", "operator": "Blee"},
+			}},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ok, err := selectorMatches(u.sel, set)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, ok)
+		})
+	}
+}
+
+func TestPodSidecars(t *testing.T) {
+	po := v1.Pod{
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{Name: "istio-init"}, {Name: "istio-proxy"}},
+			Containers:     []v1.Container{{Name: "app"}},
+		},
+	}
+
+	assert.Equal(t, []string{"istio-proxy (istio)"}, PodSidecars(&po))
+}
+
+func TestVSTargets(t *testing.T) {
+	vs := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "vs", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"http": []interface{}{
+				map[string]interface{}{
+					"route": []interface{}{
+						map[string]interface{}{"destination": map[string]interface{}{"host": "reviews.bookinfo.svc.cluster.local"}},
+					},
+				},
+			},
+		},
+	}}
+
+	assert.True(t, vsTargets(&vs, "bookinfo", []string{"reviews"}))
+	assert.False(t, vsTargets(&vs, "default", []string{"reviews"}))
+}
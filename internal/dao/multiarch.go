@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ArchOK indicates an image supports all candidate node platforms.
+	ArchOK = "OK"
+
+	// ArchMismatch indicates an image is missing some node platforms.
+	ArchMismatch = "MISMATCH"
+
+	unknownPlatform = "unknown"
+)
+
+// PlatformFetcher returns the os/arch platforms an image is published for.
+type PlatformFetcher func(ctx context.Context, image string) ([]string, error)
+
+// ArchReport tracks a workload images compatibility with its nodes platforms.
+type ArchReport struct {
+	Nodes     int         `json:"candidateNodes"`
+	Platforms []string    `json:"nodePlatforms"`
+	Images    []ImageArch `json:"images"`
+}
+
+// ImageArch tracks an image published platforms.
+type ImageArch struct {
+	Container string   `json:"container"`
+	Image     string   `json:"image"`
+	Status    string   `json:"status"`
+	Platforms []string `json:"platforms,omitempty"`
+	Missing   []string `json:"missing,omitempty"`
+}
+
+// ImageArchReport checks if a pod spec images are available for the
+// platforms of the nodes the pods can be scheduled on.
+func ImageArchReport(ctx context.Context, f Factory, spec *v1.PodSpec) (string, error) {
+	nos, err := FetchNodes(ctx, f, "")
+	if err != nil {
+		return "", err
+	}
+
+	r := NewArchReport(ctx, spec, nos.Items, RegistryPlatforms)
+	raw, err := yaml.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// NewArchReport matches a pod spec images platforms against its candidate
+// nodes platforms.
+func NewArchReport(ctx context.Context, spec *v1.PodSpec, nn []v1.Node, fetch PlatformFetcher) ArchReport {
+	var r ArchReport
+	pp := make(map[string]struct{})
+	for i := range nn {
+		if !SchedulableOn(&nn[i], spec) {
+			continue
+		}
+		r.Nodes++
+		info := nn[i].Status.NodeInfo
+		pp[info.OperatingSystem+"/"+info.Architecture] = struct{}{}
+	}
+	for p := range pp {
+		r.Platforms = append(r.Platforms, p)
+	}
+	sort.Strings(r.Platforms)
+
+	cache := make(map[string]ImageArch)
+	for _, cc := range [][]v1.Container{spec.InitContainers, spec.Containers} {
+		for _, co := range cc {
+			ia, ok := cache[co.Image]
+			if !ok {
+				ia = checkImageArch(ctx, co.Image, r.Platforms, fetch)
+				cache[co.Image] = ia
+			}
+			ia.Container = co.Name
+			r.Images = append(r.Images, ia)
+		}
+	}
+
+	return r
+}
+
+func checkImageArch(ctx context.Context, image string, nodePlatforms []string, fetch PlatformFetcher) ImageArch {
+	ia := ImageArch{Image: image}
+	pp, err := fetch(ctx, image)
+	if err != nil {
+		ia.Status = err.Error()
+		return ia
+	}
+	ia.Platforms = pp
+
+	published := make(map[string]struct{}, len(pp))
+	for _, p := range pp {
+		published[osArch(p)] = struct{}{}
+	}
+	for _, p := range nodePlatforms {
+		if _, ok := published[p]; !ok {
+			ia.Missing = append(ia.Missing, p)
+		}
+	}
+	ia.Status = ArchOK
+	if len(ia.Missing) > 0 {
+		ia.Status = ArchMismatch
+	}
+
+	return ia
+}
+
+// RegistryPlatforms retrieves an image platforms from its registry manifest
+// list or, for single platform images, from its config.
+func RegistryPlatforms(ctx context.Context, image string) ([]string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	desc, err := remote.Get(ref, remote.WithContext(ctx), remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return nil, err
+	}
+
+	if desc.MediaType.IsIndex() {
+		idx, err := desc.ImageIndex()
+		if err != nil {
+			return nil, err
+		}
+		m, err := idx.IndexManifest()
+		if err != nil {
+			return nil, err
+		}
+		pp := make([]string, 0, len(m.Manifests))
+		for _, d := range m.Manifests {
+			// Skips attestation manifests.
+			if d.Platform == nil || d.Platform.OS == unknownPlatform {
+				continue
+			}
+			pp = append(pp, platform(d.Platform.OS, d.Platform.Architecture, d.Platform.Variant))
+		}
+		sort.Strings(pp)
+		return pp, nil
+	}
+
+	img, err := desc.Image()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{platform(cfg.OS, cfg.Architecture, cfg.Variant)}, nil
+}
+
+// SchedulableOn checks if a pod spec node selector, required node affinity
+// and tolerations allow it to land on a given node.
+func SchedulableOn(no *v1.Node, spec *v1.PodSpec) bool {
+	if spec.NodeName != "" {
+		return spec.NodeName == no.Name
+	}
+	if !labels.SelectorFromSet(spec.NodeSelector).Matches(labels.Set(no.Labels)) {
+		return false
+	}
+	if a := spec.Affinity; a != nil && a.NodeAffinity != nil && a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		if !matchNodeSelectorTerms(no, a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms) {
+			return false
+		}
+	}
+	for i := range no.Spec.Taints {
+		t := &no.Spec.Taints[i]
+		if t.Effect == v1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !tolerates(spec.Tolerations, t) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchNodeSelectorTerms(no *v1.Node, tt []v1.NodeSelectorTerm) bool {
+	for _, t := range tt {
+		if len(t.MatchExpressions) == 0 && len(t.MatchFields) == 0 {
+			continue
+		}
+		if matchNodeSelectorTerm(no, t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchNodeSelectorTerm(no *v1.Node, t v1.NodeSelectorTerm) bool {
+	for _, e := range t.MatchExpressions {
+		if !matchNodeSelectorRequirement(e, labels.Set(no.Labels)) {
+			return false
+		}
+	}
+	for _, e := range t.MatchFields {
+		if e.Key != "metadata.name" {
+			return false
+		}
+		if !matchNodeSelectorRequirement(e, labels.Set{e.Key: no.Name}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchNodeSelectorRequirement(e v1.NodeSelectorRequirement, ll labels.Set) bool {
+	var op selection.Operator
+	switch e.Operator {
+	case v1.NodeSelectorOpIn:
+		op = selection.In
+	case v1.NodeSelectorOpNotIn:
+		op = selection.NotIn
+	case v1.NodeSelectorOpExists:
+		op = selection.Exists
+	case v1.NodeSelectorOpDoesNotExist:
+		op = selection.DoesNotExist
+	case v1.NodeSelectorOpGt:
+		op = selection.GreaterThan
+	case v1.NodeSelectorOpLt:
+		op = selection.LessThan
+	default:
+		return false
+	}
+	req, err := labels.NewRequirement(e.Key, op, e.Values)
+	if err != nil {
+		return false
+	}
+
+	return req.Matches(ll)
+}
+
+func tolerates(tt []v1.Toleration, t *v1.Taint) bool {
+	for i := range tt {
+		if tt[i].ToleratesTaint(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func platform(os, arch, variant string) string {
+	p := os + "/" + arch
+	if variant != "" {
+		p += "/" + variant
+	}
+
+	return p
+}
+
+func osArch(p string) string {
+	tokens := strings.SplitN(p, "/", 3)
+	if len(tokens) < 2 {
+		return p
+	}
+
+	return tokens[0] + "/" + tokens[1]
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSchedulableOn(t *testing.T) {
+	arm := makeArchNode("n1", "arm64")
+	arm.Spec.Taints = []v1.Taint{{Key: "arch", Value: "arm64", Effect: v1.TaintEffectNoSchedule}}
+
+	uu := map[string]struct {
+		spec v1.PodSpec
+		e    bool
+	}{
+		"tainted": {},
+		"tolerated": {
+			spec: v1.PodSpec{
+				Tolerations: []v1.Toleration{{Key: "arch", Operator: v1.TolerationOpExists}},
+			},
+			e: true,
+		},
+		"selector": {
+			spec: v1.PodSpec{
+				NodeSelector: map[string]string{"kubernetes.io/arch": "amd64"},
+				Tolerations:  []v1.Toleration{{Operator: v1.TolerationOpExists}},
+			},
+		},
+		"affinity": {
+			spec: v1.PodSpec{
+				Tolerations: []v1.Toleration{{Operator: v1.TolerationOpExists}},
+				Affinity: &v1.Affinity{
+					NodeAffinity: &v1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+							NodeSelectorTerms: []v1.NodeSelectorTerm{
+								{
+									MatchExpressions: []v1.NodeSelectorRequirement{
+										{Key: "kubernetes.io/arch", Operator: v1.NodeSelectorOpIn, Values: []string{"amd64"}},
+									},
+								},
+								{
+									MatchFields: []v1.NodeSelectorRequirement{
+										{Key: "metadata.name", Operator: v1.NodeSelectorOpIn, Values: []string{"n1"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			e: true,
+		},
+		"node-name": {
+			spec: v1.PodSpec{NodeName: "n2"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.SchedulableOn(&arm, &u.spec))
+		})
+	}
+}
+
+func TestNewArchReport(t *testing.T) {
+	nn := []v1.Node{
+		makeArchNode("n1", "amd64"),
+		makeArchNode("n2", "arm64"),
+		makeArchNode("n3", "arm64"),
+	}
+	spec := v1.PodSpec{
+		InitContainers: []v1.Container{{Name: "i1", Image: "multi"}},
+		Containers: []v1.Container{
+			{Name: "c1", Image: "amd"},
+			{Name: "c2", Image: "multi"},
+			{Name: "c3", Image: "toast"},
+		},
+	}
+	calls := 0
+	fetch := func(_ context.Context, image string) ([]string, error) {
+		calls++
+		switch image {
+		case "multi":
+			return []string{"linux/amd64", "linux/arm64/v8"}, nil
+		case "amd":
+			return []string{"linux/amd64"}, nil
+		default:
+			return nil, errors.New("not found")
+		}
+	}
+
+	r := dao.NewArchReport(context.Background(), &spec, nn, fetch)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, 3, r.Nodes)
+	assert.Equal(t, []string{"linux/amd64", "linux/arm64"}, r.Platforms)
+	assert.Equal(t, 4, len(r.Images))
+	assert.Equal(t, "i1", r.Images[0].Container)
+	assert.Equal(t, dao.ArchOK, r.Images[0].Status)
+	assert.Equal(t, dao.ArchMismatch, r.Images[1].Status)
+	assert.Equal(t, []string{"linux/arm64"}, r.Images[1].Missing)
+	assert.Equal(t, "c2", r.Images[2].Container)
+	assert.Equal(t, dao.ArchOK, r.Images[2].Status)
+	assert.Equal(t, "not found", r.Images[3].Status)
+}
+
+// Helpers...
+
+func makeArchNode(n, arch string) v1.Node {
+	return v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: n,
+			Labels: map[string]string{
+				"kubernetes.io/arch": arch,
+				"kubernetes.io/os":   "linux",
+			},
+		},
+		Status: v1.NodeStatus{
+			NodeInfo: v1.NodeSystemInfo{
+				OperatingSystem: "linux",
+				Architecture:    arch,
+			},
+		},
+	}
+}
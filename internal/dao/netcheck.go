@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	netCheckPrefix    = "k9s-netcheck"
+	netCheckPollDelay = time.Second
+
+	// NetCheckOK tracks a successful probe.
+	NetCheckOK = "OK"
+
+	// NetCheckFailed tracks a failed probe.
+	NetCheckFailed = "FAILED"
+)
+
+// NetCheck represents an in-cluster DNS and connectivity probe.
+type NetCheck struct {
+	Host    string
+	Port    int32
+	HTTP    bool
+	TLS     bool
+	Path    string
+	Timeout time.Duration
+}
+
+// NewNetCheck returns a probe targeting a service first port. Port 443 and
+// https ports are probed over tls.
+func NewNetCheck(svc *v1.Service) NetCheck {
+	chk := NetCheck{
+		Host:    svc.Name + "." + svc.Namespace + ".svc",
+		Path:    "/",
+		Timeout: 5 * time.Second,
+	}
+	if len(svc.Spec.Ports) == 0 {
+		return chk
+	}
+	p := svc.Spec.Ports[0]
+	chk.Port = p.Port
+	proto := strings.ToLower(p.Name)
+	if p.AppProtocol != nil {
+		proto = strings.ToLower(*p.AppProtocol)
+	}
+	chk.HTTP = strings.HasPrefix(proto, "http")
+	if p.Port == 443 || strings.HasPrefix(proto, "https") {
+		chk.HTTP, chk.TLS = true, true
+	}
+
+	return chk
+}
+
+// Validate checks the probe target is safe to hand over to a shell.
+func (n NetCheck) Validate() error {
+	if net.ParseIP(n.Host) == nil {
+		if errs := validation.IsDNS1123Subdomain(n.Host); len(errs) > 0 {
+			return fmt.Errorf("invalid netcheck host %q: %s", n.Host, strings.Join(errs, ", "))
+		}
+	}
+	if n.Port < 0 || n.Port > 65535 {
+		return fmt.Errorf("invalid netcheck port %d", n.Port)
+	}
+	if n.HTTP {
+		if !strings.HasPrefix(n.Path, "/") {
+			return fmt.Errorf("invalid netcheck path %q: must start with /", n.Path)
+		}
+		if _, err := url.ParseRequestURI(n.Path); err != nil || strings.ContainsAny(n.Path, " \t\r\n") {
+			return fmt.Errorf("invalid netcheck path %q", n.Path)
+		}
+	}
+
+	return nil
+}
+
+// Script returns the shell commands performing the probe. The target host,
+// port and url are referenced as positional parameters so they are never
+// parsed by the shell, see Command.
+func (n NetCheck) Script() string {
+	secs := int(n.Timeout.Seconds())
+	if secs < 1 {
+		secs = 1
+	}
+
+	ss := make([]string, 0, 6)
+	if net.ParseIP(n.Host) == nil {
+		ss = append(ss,
+			`echo "==> DNS lookup $1"`,
+			fmt.Sprintf(`if nslookup "$1"; then echo 'DNS: %s'; else echo 'DNS: %s'; fi`, NetCheckOK, NetCheckFailed),
+		)
+	}
+	if n.Port > 0 {
+		ss = append(ss,
+			`echo "==> TCP connect $1:$2"`,
+			fmt.Sprintf(`if nc -z -w %d "$1" "$2"; then echo 'TCP: %s'; else echo 'TCP: %s'; fi`, secs, NetCheckOK, NetCheckFailed),
+		)
+	}
+	if n.Port > 0 && n.HTTP {
+		opts := ""
+		if n.TLS {
+			opts = " --no-check-certificate"
+		}
+		ss = append(ss,
+			`echo "==> HTTP GET $3"`,
+			fmt.Sprintf(`if wget -q -S -O /dev/null -T %d%s "$3" 2>&1; then echo 'HTTP: %s'; else echo 'HTTP: %s'; fi`, secs, opts, NetCheckOK, NetCheckFailed),
+		)
+	}
+
+	return strings.Join(ss, "\n")
+}
+
+// Command returns the shell invocation running the probe script against
+// the probe target.
+func (n NetCheck) Command() []string {
+	return []string{"sh", "-c", n.Script(), netCheckPrefix, n.Host, strconv.Itoa(int(n.Port)), n.URL()}
+}
+
+// URL returns the probe http url.
+func (n NetCheck) URL() string {
+	scheme := "http"
+	if n.TLS {
+		scheme = "https"
+	}
+
+	return scheme + "://" + net.JoinHostPort(n.Host, strconv.Itoa(int(n.Port))) + n.Path
+}
+
+// NetCheckSummary extracts the probes verdicts from a probe output.
+func NetCheckSummary(out string) []string {
+	ss := make([]string, 0, 3)
+	for _, l := range strings.Split(out, "\n") {
+		l = strings.TrimSpace(l)
+		for _, p := range []string{"DNS: ", "TCP: ", "HTTP: "} {
+			if strings.HasPrefix(l, p) {
+				ss = append(ss, l)
+			}
+		}
+	}
+
+	return ss
+}
+
+// RunNetCheck runs a probe from a short-lived pod in the given namespace
+// and returns its output.
+func RunNetCheck(ctx context.Context, f Factory, ns string, cfg config.ShellPod, chk NetCheck) (string, error) {
+	if err := chk.Validate(); err != nil {
+		return "", err
+	}
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	pods := dial.CoreV1().Pods(ns)
+	po, err := pods.Create(ctx, netCheckPod(ns, cfg, chk), metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		var grace int64
+		if err := pods.Delete(context.Background(), po.Name, metav1.DeleteOptions{GracePeriodSeconds: &grace}); err != nil {
+			log.Warn().Err(err).Msgf("Unable to delete netcheck pod %s", po.Name)
+		}
+	}()
+
+	for {
+		o, err := pods.Get(ctx, po.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		if o.Status.Phase == v1.PodSucceeded || o.Status.Phase == v1.PodFailed {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("netcheck pod %s did not complete: %w", po.Name, ctx.Err())
+		case <-time.After(netCheckPollDelay):
+		}
+	}
+
+	bb, err := pods.GetLogs(po.Name, &v1.PodLogOptions{}).DoRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bb), nil
+}
+
+func netCheckPod(ns string, cfg config.ShellPod, chk NetCheck) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      netCheckPrefix + "-" + rand.String(5),
+			Namespace: ns,
+			Labels:    cfg.Labels,
+		},
+		Spec: v1.PodSpec{
+			RestartPolicy:    v1.RestartPolicyNever,
+			ImagePullSecrets: cfg.ImagePullSecrets,
+			Containers: []v1.Container{
+				{
+					Name:            netCheckPrefix,
+					Image:           cfg.Image,
+					ImagePullPolicy: cfg.ImagePullPolicy,
+					Command:         chk.Command(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewNetCheck(t *testing.T) {
+	https := "HTTPS"
+	uu := map[string]struct {
+		port      v1.ServicePort
+		http, tls bool
+	}{
+		"http": {
+			port: v1.ServicePort{Name: "http-web", Port: 8080},
+			http: true,
+		},
+		"tcp": {
+			port: v1.ServicePort{Name: "grpc", Port: 9090},
+		},
+		"443": {
+			port: v1.ServicePort{Name: "web", Port: 443},
+			http: true,
+			tls:  true,
+		},
+		"httpsName": {
+			port: v1.ServicePort{Name: "https-web", Port: 8443},
+			http: true,
+			tls:  true,
+		},
+		"appProtocol": {
+			port: v1.ServicePort{Name: "web", Port: 8443, AppProtocol: &https},
+			http: true,
+			tls:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			svc := v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "fred", Namespace: "blee"},
+				Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{u.port}},
+			}
+
+			chk := NewNetCheck(&svc)
+			assert.Equal(t, "fred.blee.svc", chk.Host)
+			assert.Equal(t, u.port.Port, chk.Port)
+			assert.Equal(t, u.http, chk.HTTP)
+			assert.Equal(t, u.tls, chk.TLS)
+		})
+	}
+}
+
+func TestNetCheckScript(t *testing.T) {
+	uu := map[string]struct {
+		chk   NetCheck
+		lines int
+		url   bool
+	}{
+		"dns": {
+			chk:   NetCheck{Host: "fred"},
+			lines: 2,
+		},
+		"tcp": {
+			chk:   NetCheck{Host: "fred", Port: 53, Timeout: time.Second},
+			lines: 4,
+		},
+		"http": {
+			chk:   NetCheck{Host: "fred", Port: 80, HTTP: true, Path: "/healthz"},
+			lines: 6,
+			url:   true,
+		},
+		"ip": {
+			chk:   NetCheck{Host: "10.0.0.1", Port: 80},
+			lines: 2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := u.chk.Script()
+			assert.Equal(t, u.lines, len(strings.Split(s, "\n")))
+			assert.NotContains(t, s, u.chk.Host)
+			cmd := u.chk.Command()
+			assert.Equal(t, []string{"sh", "-c", s, netCheckPrefix, u.chk.Host}, cmd[:5])
+			assert.Equal(t, u.url, cmd[6] == "http://fred:80/healthz")
+		})
+	}
+}
+
+func TestNetCheckValidate(t *testing.T) {
+	uu := map[string]struct {
+		chk NetCheck
+		err bool
+	}{
+		"dns": {
+			chk: NetCheck{Host: "fred.blee.svc", Port: 80, HTTP: true, Path: "/healthz?x=1"},
+		},
+		"ip": {
+			chk: NetCheck{Host: "10.0.0.1", Port: 80},
+		},
+		"ipv6": {
+			chk: NetCheck{Host: "fd00::1", Port: 80},
+		},
+		"host-inject": {
+			chk: NetCheck{Host: "fred;reboot", Port: 80},
+			err: true,
+		},
+		"host-subst": {
+			chk: NetCheck{Host: "$(reboot)"},
+			err: true,
+		},
+		"port": {
+			chk: NetCheck{Host: "fred", Port: 70000},
+			err: true,
+		},
+		"path-quote": {
+			chk: NetCheck{Host: "fred", Port: 80, HTTP: true, Path: "/'; reboot; '"},
+			err: true,
+		},
+		"path-relative": {
+			chk: NetCheck{Host: "fred", Port: 80, HTTP: true, Path: "healthz"},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := u.chk.Validate()
+			assert.Equal(t, u.err, err != nil, err)
+		})
+	}
+}
+
+func TestNetCheckSummary(t *testing.T) {
+	out := "==> DNS lookup fred\nServer: 10.0.0.10\nDNS: OK\n==> TCP connect fred:80\nTCP: FAILED\n"
+
+	assert.Equal(t, []string{"DNS: OK", "TCP: FAILED"}, NetCheckSummary(out))
+}
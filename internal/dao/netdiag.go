@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	netDiagNS         = "kube-system"
+	maxNetDiagEvents  = 20
+	defaultProxyMode  = "iptables"
+	kubeProxyHealthz  = "10256"
+	corednsHealthPort = "8080"
+	corednsReadyPort  = "8181"
+)
+
+// NetDiag tracks cluster networking diagnostics.
+type NetDiag struct {
+	CoreDNS   CoreDNSDiag   `json:"coredns"`
+	KubeProxy KubeProxyDiag `json:"kubeProxy"`
+	DNSEvents []string      `json:"dnsEvents,omitempty"`
+}
+
+// CoreDNSDiag tracks CoreDNS health.
+type CoreDNSDiag struct {
+	Pods     []DiagPod `json:"pods"`
+	Corefile string    `json:"corefile,omitempty"`
+}
+
+// KubeProxyDiag tracks kube-proxy health.
+type KubeProxyDiag struct {
+	Mode string    `json:"mode"`
+	Pods []DiagPod `json:"pods"`
+}
+
+// DiagPod tracks a system pod health.
+type DiagPod struct {
+	Name     string            `json:"name"`
+	Node     string            `json:"node,omitempty"`
+	Phase    string            `json:"phase"`
+	Ready    bool              `json:"ready"`
+	Restarts int32             `json:"restarts"`
+	Probes   map[string]string `json:"probes,omitempty"`
+}
+
+// NetDiagReport checks CoreDNS and kube-proxy health and lists recent DNS
+// related events.
+func NetDiagReport(ctx context.Context, f Factory) (string, error) {
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+
+	var diag NetDiag
+	dns, err := dial.CoreV1().Pods(netDiagNS).List(ctx, metav1.ListOptions{LabelSelector: "k8s-app=kube-dns"})
+	if err != nil {
+		return "", err
+	}
+	for i := range dns.Items {
+		po := NewDiagPod(&dns.Items[i])
+		po.Probes = map[string]string{
+			"health": probeProxy(ctx, dial, ProxyPath("pods", netDiagNS, po.Name, corednsHealthPort, "health")),
+			"ready":  probeProxy(ctx, dial, ProxyPath("pods", netDiagNS, po.Name, corednsReadyPort, "ready")),
+		}
+		diag.CoreDNS.Pods = append(diag.CoreDNS.Pods, po)
+	}
+	if cm, err := dial.CoreV1().ConfigMaps(netDiagNS).Get(ctx, "coredns", metav1.GetOptions{}); err == nil {
+		diag.CoreDNS.Corefile = cm.Data["Corefile"]
+	}
+
+	diag.KubeProxy.Mode = defaultProxyMode
+	if cm, err := dial.CoreV1().ConfigMaps(netDiagNS).Get(ctx, "kube-proxy", metav1.GetOptions{}); err == nil {
+		diag.KubeProxy.Mode = KubeProxyMode(cm.Data["config.conf"])
+	}
+	kp, err := dial.CoreV1().Pods(netDiagNS).List(ctx, metav1.ListOptions{LabelSelector: "k8s-app=kube-proxy"})
+	if err != nil {
+		return "", err
+	}
+	for i := range kp.Items {
+		po := NewDiagPod(&kp.Items[i])
+		if po.Node != "" {
+			po.Probes = map[string]string{
+				"healthz": probeProxy(ctx, dial, fmt.Sprintf("/api/v1/nodes/%s:%s/proxy/healthz", po.Node, kubeProxyHealthz)),
+			}
+		}
+		diag.KubeProxy.Pods = append(diag.KubeProxy.Pods, po)
+	}
+
+	if ee, err := dial.CoreV1().Events(client.BlankNamespace).List(ctx, metav1.ListOptions{}); err == nil {
+		diag.DNSEvents = DNSEvents(ee.Items, time.Now())
+	}
+
+	raw, err := yaml.Marshal(diag)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// NewDiagPod returns the health of a pod.
+func NewDiagPod(po *v1.Pod) DiagPod {
+	d := DiagPod{
+		Name:  po.Name,
+		Node:  po.Spec.NodeName,
+		Phase: string(po.Status.Phase),
+	}
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodReady {
+			d.Ready = c.Status == v1.ConditionTrue
+		}
+	}
+	for _, s := range po.Status.ContainerStatuses {
+		d.Restarts += s.RestartCount
+	}
+
+	return d
+}
+
+// KubeProxyMode returns the proxy mode set in a kube-proxy configuration.
+// An unset mode defaults to iptables.
+func KubeProxyMode(conf string) string {
+	for _, l := range strings.Split(conf, "\n") {
+		k, v, ok := strings.Cut(strings.TrimSpace(l), ":")
+		if !ok || k != "mode" {
+			continue
+		}
+		if v = strings.Trim(strings.TrimSpace(v), `"'`); v != "" {
+			return v
+		}
+	}
+
+	return defaultProxyMode
+}
+
+// DNSEvents returns the most recent DNS related events, newest first.
+func DNSEvents(ee []v1.Event, now time.Time) []string {
+	return recentEvents(ee, now, isDNSEvent)
+}
+
+// recentEvents returns the most recent events matching a filter, newest first.
+func recentEvents(ee []v1.Event, now time.Time, keep func(*v1.Event) bool) []string {
+	hits := make([]v1.Event, 0, len(ee))
+	for i := range ee {
+		if keep(&ee[i]) {
+			hits = append(hits, ee[i])
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		return eventTime(hits[i]).After(eventTime(hits[j]))
+	})
+	if len(hits) > maxNetDiagEvents {
+		hits = hits[:maxNetDiagEvents]
+	}
+	ss := make([]string, 0, len(hits))
+	for _, e := range hits {
+		ss = append(ss, fmt.Sprintf("%s ago: %s %s %s",
+			duration.HumanDuration(now.Sub(eventTime(e))),
+			e.Reason,
+			client.FQN(e.InvolvedObject.Namespace, e.InvolvedObject.Name),
+			strings.TrimSpace(e.Message),
+		))
+	}
+
+	return ss
+}
+
+func isDNSEvent(e *v1.Event) bool {
+	if strings.HasPrefix(e.InvolvedObject.Name, "coredns") || strings.HasPrefix(e.InvolvedObject.Name, "kube-dns") {
+		return true
+	}
+
+	return strings.Contains(strings.ToLower(e.Reason+" "+e.Message), "dns")
+}
+
+// probeProxy issues a GET through the API server proxy and reports the outcome.
+func probeProxy(ctx context.Context, dial kubernetes.Interface, path string) string {
+	raw, err := dial.CoreV1().RESTClient().Get().AbsPath(path).DoRaw(ctx)
+	if err != nil {
+		return "error: " + err.Error()
+	}
+	if s := strings.TrimSpace(string(raw)); s != "" {
+		return s
+	}
+
+	return "ok"
+}
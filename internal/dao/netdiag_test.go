@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKubeProxyMode(t *testing.T) {
+	uu := map[string]struct {
+		conf, e string
+	}{
+		"empty": {
+			e: "iptables",
+		},
+		"unset": {
+			conf: "apiVersion: kubeproxy.config.k8s.io/v1alpha1\nmode: \"\"\n",
+			e:    "iptables",
+		},
+		"ipvs": {
+			conf: "kind: KubeProxyConfiguration\nipvs:\n  strictARP: true\nmode: ipvs\n",
+			e:    "ipvs",
+		},
+		"quoted": {
+			conf: "mode: 'nftables'",
+			e:    "nftables",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, KubeProxyMode(u.conf))
+		})
+	}
+}
+
+func TestNewDiagPod(t *testing.T) {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "coredns-1"},
+		Spec:       v1.PodSpec{NodeName: "n1"},
+		Status: v1.PodStatus{
+			Phase:      v1.PodRunning,
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}},
+			ContainerStatuses: []v1.ContainerStatus{
+				{RestartCount: 2},
+				{RestartCount: 1},
+			},
+		},
+	}
+
+	assert.Equal(t, DiagPod{Name: "coredns-1", Node: "n1", Phase: "Running", Ready: true, Restarts: 3}, NewDiagPod(&po))
+}
+
+func TestDNSEvents(t *testing.T) {
+	now := time.Now()
+	event := func(n, reason, msg string, ago time.Duration) v1.Event {
+		return v1.Event{
+			InvolvedObject: v1.ObjectReference{Namespace: "default", Name: n},
+			Reason:         reason,
+			Message:        msg,
+			LastTimestamp:  metav1.NewTime(now.Add(-ago)),
+		}
+	}
+	ee := []v1.Event{
+		event("fred", "Pulled", "Pulled image", time.Minute),
+		event("fred", "DNSConfigForming", "Nameserver limits were exceeded", 5*time.Minute),
+		event("coredns-1", "Unhealthy", "Readiness probe failed", 2*time.Minute),
+		event("blee", "Failed", "lookup dns timeout", 10*time.Minute),
+	}
+
+	assert.Equal(t, []string{
+		"2m ago: Unhealthy default/coredns-1 Readiness probe failed",
+		"5m ago: DNSConfigForming default/fred Nameserver limits were exceeded",
+		"10m ago: Failed default/blee lookup dns timeout",
+	}, DNSEvents(ee, now))
+}
@@ -82,6 +82,7 @@ func (o DrainOptions) toDrainHelper(k kubernetes.Interface, w io.Writer) drain.H
 		Out:                 w,
 		ErrOut:              w,
 		Force:               o.Force,
+		Ctx:                 o.Ctx,
 	}
 }
 
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/yaml"
+)
+
+// NodePodsReport tracks a node pods grouped by namespace along with their usage.
+type NodePodsReport struct {
+	Node       string          `json:"node"`
+	Pods       int             `json:"pods"`
+	CPU        string          `json:"cpu,omitempty"`
+	MEM        string          `json:"mem,omitempty"`
+	Namespaces []NamespacePods `json:"namespaces"`
+}
+
+// NamespacePods tracks a namespace pods running on a node.
+type NamespacePods struct {
+	Namespace string     `json:"namespace"`
+	Pods      int        `json:"pods"`
+	CPU       string     `json:"cpu,omitempty"`
+	MEM       string     `json:"mem,omitempty"`
+	Items     []PodUsage `json:"items"`
+}
+
+// PodUsage tracks a pod status and resources usage.
+type PodUsage struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Restarts int32  `json:"restarts,omitempty"`
+	CPU      string `json:"cpu,omitempty"`
+	MEM      string `json:"mem,omitempty"`
+
+	cpu, mem int64
+}
+
+// NodePods returns a YAML report of a node pods grouped by namespace along
+// with their usage when metrics are available.
+func NodePods(ctx context.Context, f Factory, node string, withMx bool) (string, error) {
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	ll, err := dial.CoreV1().Pods(client.BlankNamespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", node).String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	pp := make([]*v1.Pod, 0, len(ll.Items))
+	for i := range ll.Items {
+		pp = append(pp, &ll.Items[i])
+	}
+
+	var pmx client.PodsMetricsMap
+	if withMx {
+		if pmx, err = client.DialMetrics(f.Client()).FetchPodsMetricsMap(ctx, client.NamespaceAll); err != nil {
+			log.Warn().Err(err).Msgf("Pods metrics failed for node %s", node)
+		}
+	}
+	raw, err := yaml.Marshal(NewNodePodsReport(node, pp, pmx))
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// NewNodePodsReport groups a node pods by namespace. Namespaces are sorted
+// by name and their pods by cpu usage so noisy neighbors come first.
+func NewNodePodsReport(node string, pp []*v1.Pod, pmx client.PodsMetricsMap) NodePodsReport {
+	r := NodePodsReport{Node: node, Pods: len(pp)}
+	nss := make(map[string][]PodUsage)
+	for _, po := range pp {
+		u := PodUsage{
+			Name:   po.Name,
+			Status: render.PodStatus(po),
+		}
+		for _, s := range po.Status.ContainerStatuses {
+			u.Restarts += s.RestartCount
+		}
+		if mx, ok := pmx[client.FQN(po.Namespace, po.Name)]; ok && mx != nil {
+			for _, co := range mx.Containers {
+				u.cpu += co.Usage.Cpu().MilliValue()
+				u.mem += co.Usage.Memory().Value()
+			}
+			u.CPU, u.MEM = toMillis(u.cpu), toMebis(u.mem)
+		}
+		nss[po.Namespace] = append(nss[po.Namespace], u)
+	}
+
+	var cpu, mem int64
+	for ns, uu := range nss {
+		sort.SliceStable(uu, func(i, j int) bool {
+			if uu[i].cpu != uu[j].cpu {
+				return uu[i].cpu > uu[j].cpu
+			}
+			return uu[i].Name < uu[j].Name
+		})
+		n := NamespacePods{Namespace: ns, Pods: len(uu), Items: uu}
+		var nsCPU, nsMEM int64
+		for _, u := range uu {
+			nsCPU, nsMEM = nsCPU+u.cpu, nsMEM+u.mem
+		}
+		if len(pmx) > 0 {
+			n.CPU, n.MEM = toMillis(nsCPU), toMebis(nsMEM)
+		}
+		cpu, mem = cpu+nsCPU, mem+nsMEM
+		r.Namespaces = append(r.Namespaces, n)
+	}
+	sort.Slice(r.Namespaces, func(i, j int) bool {
+		return r.Namespaces[i].Namespace < r.Namespaces[j].Namespace
+	})
+	if len(pmx) > 0 {
+		r.CPU, r.MEM = toMillis(cpu), toMebis(mem)
+	}
+
+	return r
+}
+
+func toMillis(v int64) string {
+	return fmt.Sprintf("%dm", v)
+}
+
+func toMebis(v int64) string {
+	return fmt.Sprintf("%dMi", client.ToMB(v))
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestNewNodePodsReport(t *testing.T) {
+	pp := []*v1.Pod{
+		makeNodePod("ns2", "p1"),
+		makeNodePod("ns1", "p1"),
+		makeNodePod("ns1", "p2"),
+	}
+	pmx := client.PodsMetricsMap{
+		"ns1/p1": makePodMx("10m", "10Mi"),
+		"ns1/p2": makePodMx("200m", "20Mi"),
+	}
+
+	r := NewNodePodsReport("n1", pp, pmx)
+	assert.Equal(t, "n1", r.Node)
+	assert.Equal(t, 3, r.Pods)
+	assert.Equal(t, "210m", r.CPU)
+	assert.Equal(t, "30Mi", r.MEM)
+	assert.Equal(t, 2, len(r.Namespaces))
+	assert.Equal(t, "ns1", r.Namespaces[0].Namespace)
+	assert.Equal(t, "210m", r.Namespaces[0].CPU)
+	assert.Equal(t, []string{"p2", "p1"}, []string{r.Namespaces[0].Items[0].Name, r.Namespaces[0].Items[1].Name})
+	assert.Equal(t, "200m", r.Namespaces[0].Items[0].CPU)
+	assert.Equal(t, "ns2", r.Namespaces[1].Namespace)
+	assert.Equal(t, "", r.Namespaces[1].Items[0].CPU)
+	assert.Equal(t, int32(2), r.Namespaces[1].Items[0].Restarts)
+
+	r = NewNodePodsReport("n1", pp, nil)
+	assert.Equal(t, "", r.CPU)
+	assert.Equal(t, "", r.Namespaces[0].CPU)
+}
+
+// Helpers...
+
+func makeNodePod(ns, n string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+		Spec:       v1.PodSpec{NodeName: "n1"},
+		Status: v1.PodStatus{
+			Phase:             v1.PodRunning,
+			ContainerStatuses: []v1.ContainerStatus{{Name: "c1", RestartCount: 2}},
+		},
+	}
+}
+
+func makePodMx(cpu, mem string) *mv1beta1.PodMetrics {
+	return &mv1beta1.PodMetrics{
+		Containers: []mv1beta1.ContainerMetrics{
+			{Name: "c1", Usage: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse(cpu),
+				v1.ResourceMemory: resource.MustParse(mem),
+			}},
+		},
+	}
+}
@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+const rollGatePollInterval = 2 * time.Second
+
+// RollOptions tracks a node group rolling maintenance options.
+type RollOptions struct {
+	Drain DrainOptions
+
+	// Concurrency is the number of nodes maintained at once.
+	Concurrency int
+
+	// Gate is how long to wait for a batch to be healthy once uncordoned.
+	Gate time.Duration
+}
+
+// NodeHealthFunc returns an error when a node is not healthy.
+type NodeHealthFunc func(ctx context.Context, node string) error
+
+// NodeGateFunc is called prior to a node drain and returns the health check
+// gating the roll once the node is uncordoned.
+type NodeGateFunc func(ctx context.Context, node string) (NodeHealthFunc, error)
+
+// RollNodes cordons, drains and uncordons nodes in batches of the given
+// concurrency. A batch must be healthy before the next one starts. Rolling
+// stops on the first failure or once the context is canceled.
+func RollNodes(ctx context.Context, m NodeMaintainer, gate NodeGateFunc, nodes []string, opts RollOptions, w io.Writer) error {
+	w = &syncWriter{w: w}
+	bb := NodeBatches(nodes, opts.Concurrency)
+	for i, b := range bb {
+		if err := ctx.Err(); err != nil {
+			fmt.Fprintf(w, "Rolling canceled! %s\n", err)
+			return err
+		}
+		fmt.Fprintf(w, "Batch %d/%d %v\n", i+1, len(bb), b)
+		var (
+			wg   sync.WaitGroup
+			mx   sync.Mutex
+			errs error
+		)
+		for _, no := range b {
+			wg.Add(1)
+			go func(no string) {
+				defer wg.Done()
+				if err := rollNode(ctx, m, gate, no, opts, w); err != nil {
+					mx.Lock()
+					errs = errors.Join(errs, fmt.Errorf("node %s: %w", no, err))
+					mx.Unlock()
+				}
+			}(no)
+		}
+		wg.Wait()
+		if errs != nil {
+			fmt.Fprintf(w, "Rolling aborted! %s\n", errs)
+			return errs
+		}
+	}
+	fmt.Fprintf(w, "Rolled %d node(s) successfully!\n", len(nodes))
+
+	return nil
+}
+
+func rollNode(ctx context.Context, m NodeMaintainer, gate NodeGateFunc, no string, opts RollOptions, w io.Writer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	health, err := gate(ctx, no)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "[%s] Draining...\n", no)
+	opts.Drain.Ctx = ctx
+	if err := m.Drain(no, opts.Drain, w); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	fmt.Fprintf(w, "\n[%s] Uncordoning...\n", no)
+	if err := m.ToggleCordon(no, false); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "[%s] Waiting for node and evicted workloads to be healthy...\n", no)
+	ctx, cancel := context.WithTimeout(ctx, opts.Gate)
+	defer cancel()
+	for {
+		err := health(ctx, no)
+		if err == nil {
+			fmt.Fprintf(w, "[%s] Healthy\n", no)
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return ctx.Err()
+			}
+			return fmt.Errorf("health gate failed: %w", err)
+		case <-time.After(rollGatePollInterval):
+		}
+	}
+}
+
+// NodeBatches splits nodes in sequential batches of at most n nodes.
+func NodeBatches(nodes []string, n int) [][]string {
+	if n <= 0 {
+		n = 1
+	}
+	bb := make([][]string, 0, (len(nodes)+n-1)/n)
+	for i := 0; i < len(nodes); i += n {
+		bb = append(bb, nodes[i:min(i+n, len(nodes))])
+	}
+
+	return bb
+}
+
+// NodeGate gates a node roll on the node health and on the workloads evicted
+// by its drain having all their replicas rescheduled and ready.
+func NodeGate(f Factory) NodeGateFunc {
+	return func(ctx context.Context, node string) (NodeHealthFunc, error) {
+		dial, err := f.Client().Dial()
+		if err != nil {
+			return nil, err
+		}
+		pp, err := dial.CoreV1().Pods(client.BlankNamespace).List(ctx, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", node).String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		oo, nodeHealth := evictedOwners(pp.Items), NodeHealth(f)
+
+		return func(ctx context.Context, node string) error {
+			if err := nodeHealth(ctx, node); err != nil {
+				return err
+			}
+			return ownersReady(ctx, dial, oo)
+		}, nil
+	}
+}
+
+// podOwner tracks a pod controller.
+type podOwner struct {
+	kind, ns, name string
+}
+
+// evictedOwners returns the replicasets and statefulsets controlling the
+// given pods. Other pods are either not evicted or not rescheduled.
+func evictedOwners(pp []v1.Pod) []podOwner {
+	set := make(map[podOwner]struct{})
+	oo := make([]podOwner, 0, len(pp))
+	for _, po := range pp {
+		ref := metav1.GetControllerOf(&po)
+		if ref == nil || (ref.Kind != "ReplicaSet" && ref.Kind != "StatefulSet") {
+			continue
+		}
+		o := podOwner{kind: ref.Kind, ns: po.Namespace, name: ref.Name}
+		if _, ok := set[o]; ok {
+			continue
+		}
+		set[o] = struct{}{}
+		oo = append(oo, o)
+	}
+
+	return oo
+}
+
+// ownersReady returns an error while a controller has missing ready replicas.
+func ownersReady(ctx context.Context, dial kubernetes.Interface, oo []podOwner) error {
+	for _, o := range oo {
+		var (
+			desired *int32
+			ready   int32
+		)
+		switch o.kind {
+		case "ReplicaSet":
+			rs, err := dial.AppsV1().ReplicaSets(o.ns).Get(ctx, o.name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			desired, ready = rs.Spec.Replicas, rs.Status.ReadyReplicas
+		default:
+			sts, err := dial.AppsV1().StatefulSets(o.ns).Get(ctx, o.name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			desired, ready = sts.Spec.Replicas, sts.Status.ReadyReplicas
+		}
+		if n := desiredReplicas(desired); ready < n {
+			return fmt.Errorf("%s %s has %d/%d ready replicas", o.kind, client.FQN(o.ns, o.name), ready, n)
+		}
+	}
+
+	return nil
+}
+
+func desiredReplicas(n *int32) int32 {
+	if n == nil {
+		return 1
+	}
+
+	return *n
+}
+
+// NodeHealth checks a node is ready, schedulable and under no pressure.
+func NodeHealth(f Factory) NodeHealthFunc {
+	return func(ctx context.Context, node string) error {
+		no, err := FetchNode(ctx, f, node)
+		if err != nil {
+			return err
+		}
+		return CheckNodeHealth(no)
+	}
+}
+
+// CheckNodeHealth returns an error when a node is not ready, unschedulable
+// or under pressure.
+func CheckNodeHealth(no *v1.Node) error {
+	if no.Spec.Unschedulable {
+		return errors.New("node is unschedulable")
+	}
+	var ready bool
+	for _, c := range no.Status.Conditions {
+		switch c.Type {
+		case v1.NodeReady:
+			ready = c.Status == v1.ConditionTrue
+		case v1.NodeMemoryPressure, v1.NodeDiskPressure, v1.NodePIDPressure, v1.NodeNetworkUnavailable:
+			if c.Status == v1.ConditionTrue {
+				return fmt.Errorf("node has %s", c.Type)
+			}
+		}
+	}
+	if !ready {
+		return errors.New("node is not ready")
+	}
+
+	return nil
+}
+
+// syncWriter serializes writes from concurrent node rolls.
+type syncWriter struct {
+	w  io.Writer
+	mx sync.Mutex
+}
+
+func (s *syncWriter) Write(p []byte) (int, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return s.w.Write(p)
+}
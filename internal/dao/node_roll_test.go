@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestNodeBatches(t *testing.T) {
+	uu := map[string]struct {
+		nodes []string
+		n     int
+		e     [][]string
+	}{
+		"empty": {
+			e: [][]string{},
+		},
+		"one-by-one": {
+			nodes: []string{"n1", "n2", "n3"},
+			n:     1,
+			e:     [][]string{{"n1"}, {"n2"}, {"n3"}},
+		},
+		"uneven": {
+			nodes: []string{"n1", "n2", "n3"},
+			n:     2,
+			e:     [][]string{{"n1", "n2"}, {"n3"}},
+		},
+		"oversized": {
+			nodes: []string{"n1", "n2"},
+			n:     5,
+			e:     [][]string{{"n1", "n2"}},
+		},
+		"invalid": {
+			nodes: []string{"n1", "n2"},
+			e:     [][]string{{"n1"}, {"n2"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, NodeBatches(u.nodes, u.n))
+		})
+	}
+}
+
+func TestCheckNodeHealth(t *testing.T) {
+	uu := map[string]struct {
+		unschedulable bool
+		cc            []v1.NodeCondition
+		err           string
+	}{
+		"healthy": {
+			cc: []v1.NodeCondition{
+				{Type: v1.NodeReady, Status: v1.ConditionTrue},
+				{Type: v1.NodeMemoryPressure, Status: v1.ConditionFalse},
+			},
+		},
+		"not-ready": {
+			cc:  []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionFalse}},
+			err: "node is not ready",
+		},
+		"no-conditions": {
+			err: "node is not ready",
+		},
+		"cordoned": {
+			unschedulable: true,
+			cc:            []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}},
+			err:           "node is unschedulable",
+		},
+		"pressure": {
+			cc: []v1.NodeCondition{
+				{Type: v1.NodeReady, Status: v1.ConditionTrue},
+				{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue},
+			},
+			err: "node has DiskPressure",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var no v1.Node
+			no.Spec.Unschedulable = u.unschedulable
+			no.Status.Conditions = u.cc
+			err := CheckNodeHealth(&no)
+			if u.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
+func TestRollNodes(t *testing.T) {
+	uu := map[string]struct {
+		nodes   []string
+		failing string
+		e       []string
+		err     bool
+	}{
+		"all": {
+			nodes: []string{"n1", "n2", "n3"},
+			e:     []string{"n1", "n2", "n3"},
+		},
+		"abort": {
+			nodes:   []string{"n1", "n2", "n3"},
+			failing: "n2",
+			e:       []string{"n1", "n2"},
+			err:     true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			m := &mockMaintainer{failing: u.failing}
+			health := func(context.Context, string) error { return nil }
+			var w bytes.Buffer
+			err := RollNodes(context.Background(), m, gateOn(health), u.nodes, RollOptions{Concurrency: 1, Gate: time.Second}, &w)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, m.drained)
+		})
+	}
+}
+
+func TestRollNodesGate(t *testing.T) {
+	m := new(mockMaintainer)
+	health := func(context.Context, string) error { return errors.New("node is not ready") }
+	err := RollNodes(context.Background(), m, gateOn(health), []string{"n1", "n2"}, RollOptions{Concurrency: 1, Gate: 10 * time.Millisecond}, io.Discard)
+
+	assert.ErrorContains(t, err, "health gate failed: node is not ready")
+	assert.Equal(t, []string{"n1"}, m.drained)
+}
+
+func TestRollNodesCanceled(t *testing.T) {
+	m := new(mockMaintainer)
+	ctx, cancel := context.WithCancel(context.Background())
+	health := func(context.Context, string) error {
+		cancel()
+		return errors.New("node is not ready")
+	}
+	err := RollNodes(ctx, m, gateOn(health), []string{"n1", "n2"}, RollOptions{Concurrency: 1, Gate: time.Minute}, io.Discard)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"n1"}, m.drained)
+}
+
+func TestEvictedOwnersReady(t *testing.T) {
+	rs := func(n string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{Kind: "ReplicaSet", Name: n, Controller: ptr.To(true)}}
+	}
+	pp := []v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1", OwnerReferences: rs("fred")}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p2", OwnerReferences: rs("fred")}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p3", OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: "ds", Controller: ptr.To(true)}}}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bare"}},
+	}
+	oo := evictedOwners(pp)
+	assert.Equal(t, []podOwner{{kind: "ReplicaSet", ns: "default", name: "fred"}}, oo)
+
+	r := appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred"},
+		Spec:       appsv1.ReplicaSetSpec{Replicas: ptr.To(int32(2))},
+		Status:     appsv1.ReplicaSetStatus{ReadyReplicas: 1},
+	}
+	dial := fake.NewSimpleClientset(&r)
+	assert.EqualError(t, ownersReady(context.Background(), dial, oo), "ReplicaSet default/fred has 1/2 ready replicas")
+
+	r.Status.ReadyReplicas = 2
+	_, err := dial.AppsV1().ReplicaSets("default").UpdateStatus(context.Background(), &r, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	assert.NoError(t, ownersReady(context.Background(), dial, oo))
+}
+
+// Helpers...
+
+func gateOn(health NodeHealthFunc) NodeGateFunc {
+	return func(context.Context, string) (NodeHealthFunc, error) {
+		return health, nil
+	}
+}
+
+type mockMaintainer struct {
+	failing string
+	mx      sync.Mutex
+	drained []string
+}
+
+func (m *mockMaintainer) ToggleCordon(string, bool) error {
+	return nil
+}
+
+func (m *mockMaintainer) Drain(path string, _ DrainOptions, _ io.Writer) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	m.drained = append(m.drained, path)
+	if path == m.failing {
+		return errors.New("drain failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+)
+
+// ProjectGVR tracks OpenShift projects.
+var ProjectGVR = client.NewGVR("project.openshift.io/v1/projects")
+
+// OCBinary tracks the OpenShift cli.
+const OCBinary = "oc"
+
+// IsOpenShift checks if the discovered cluster resources include OpenShift projects.
+func IsOpenShift() bool {
+	_, err := MetaAccess.MetaFor(ProjectGVR)
+
+	return err == nil
+}
+
+// NSTerm returns the namespace terminology in use on the current cluster.
+func NSTerm() string {
+	if IsOpenShift() {
+		return "project"
+	}
+
+	return "namespace"
+}
+
+// OCLogin returns an interactive oc login command for a given api server.
+func OCLogin(server string) *data.Login {
+	return &data.Login{
+		Command:     OCBinary,
+		Args:        []string{"login", server},
+		Interactive: true,
+	}
+}
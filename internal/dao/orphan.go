@@ -0,0 +1,499 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const helmReleaseSecret = "helm.sh/release.v1"
+
+var (
+	PvGVR  = client.NewGVR("v1/persistentvolumes")
+	JobGVR = client.NewGVR("batch/v1/jobs")
+	IngGVR = client.NewGVR("networking.k8s.io/v1/ingresses")
+	CjGVR  = client.NewGVR("batch/v1/cronjobs")
+)
+
+var _ Accessor = (*Orphan)(nil)
+
+// Orphan tracks resources no longer in use.
+type Orphan struct {
+	NonResource
+}
+
+// List returns the orphaned resources in the given namespace.
+func (o *Orphan) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	rr, err := o.scan(ns)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// Get returns a resource given its row identifier.
+func (o *Orphan) Get(ctx context.Context, id string) (runtime.Object, error) {
+	gvr, path, ok := render.ParseResourceID(id)
+	if !ok {
+		return nil, fmt.Errorf("invalid orphan id %q", id)
+	}
+
+	return o.getFactory().Get(gvr, path, true, labels.Everything())
+}
+
+// CleanupPreview summarizes the resources deleted by a cleanup.
+func (o *Orphan) CleanupPreview(ids []string) string {
+	counts := make(map[string]int)
+	for _, id := range ids {
+		if gvr, _, ok := render.ParseResourceID(id); ok {
+			counts[gvr]++
+		}
+	}
+	kk := make([]string, 0, len(counts))
+	for k := range counts {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	var b strings.Builder
+	for _, k := range kk {
+		fmt.Fprintf(&b, "%s: %d\n", k, counts[k])
+	}
+
+	return b.String()
+}
+
+// Cleanup deletes the given orphans and returns how many were deleted.
+func (o *Orphan) Cleanup(ctx context.Context, ids []string) (int, error) {
+	dial, err := o.Client().DynDial()
+	if err != nil {
+		return 0, err
+	}
+	var (
+		count int
+		errs  []error
+		prop  = metav1.DeletePropagationBackground
+		opts  = metav1.DeleteOptions{PropagationPolicy: &prop}
+	)
+	for _, id := range ids {
+		gvr, path, ok := render.ParseResourceID(id)
+		if !ok {
+			errs = append(errs, fmt.Errorf("invalid orphan id %q", id))
+			continue
+		}
+		ns, n := client.Namespaced(path)
+		auth, err := o.Client().CanI(ns, gvr, n, []string{client.DeleteVerb})
+		if err != nil || !auth {
+			errs = append(errs, fmt.Errorf("user is not authorized to delete %s/%s", gvr, n))
+			continue
+		}
+		ri := dial.Resource(client.NewGVR(gvr).GVR())
+		if client.IsClusterScoped(ns) || ns == "" {
+			err = ri.Delete(ctx, n, opts)
+		} else {
+			err = ri.Namespace(ns).Delete(ctx, n, opts)
+		}
+		if err != nil && !kerrors.IsNotFound(err) {
+			errs = append(errs, err)
+			continue
+		}
+		count++
+	}
+
+	return count, errors.Join(errs...)
+}
+
+func (o *Orphan) scan(ns string) ([]render.OrphanRes, error) {
+	var (
+		rr   []render.OrphanRes
+		errs []error
+	)
+	for _, fn := range []func(string) ([]render.OrphanRes, error){
+		o.replicaSets,
+		o.volumes,
+		o.configs,
+		o.jobs,
+	} {
+		oo, err := fn(ns)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		rr = append(rr, oo...)
+	}
+	if len(rr) == 0 && len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	for _, err := range errs {
+		log.Warn().Err(err).Msg("Orphans partially scanned")
+	}
+
+	return rr, nil
+}
+
+// replicaSets collects scaled down replicasets no longer backed by a deployment.
+func (o *Orphan) replicaSets(ns string) ([]render.OrphanRes, error) {
+	dps, err := o.names(DpGVR, ns)
+	if err != nil {
+		return nil, err
+	}
+	uu, err := o.list(RsGVR, ns)
+	if err != nil {
+		return nil, err
+	}
+	rr := make([]render.OrphanRes, 0, len(uu))
+	for _, u := range uu {
+		var rs appsv1.ReplicaSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &rs); err != nil {
+			return nil, err
+		}
+		if reason, ok := orphanedRS(&rs, dps); ok {
+			rr = append(rr, render.OrphanRes{GVR: RsGVR.String(), Object: u, Reason: reason})
+		}
+	}
+
+	return rr, nil
+}
+
+// volumes collects unbound volume claims and volumes.
+func (o *Orphan) volumes(ns string) ([]render.OrphanRes, error) {
+	uu, err := o.list(PvcGVR, ns)
+	if err != nil {
+		return nil, err
+	}
+	rr := make([]render.OrphanRes, 0, len(uu))
+	for _, u := range uu {
+		phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+		if phase != string(v1.ClaimBound) {
+			rr = append(rr, render.OrphanRes{GVR: PvcGVR.String(), Object: u, Reason: "Unbound " + phase})
+		}
+	}
+	if client.IsNamespaced(ns) {
+		return rr, nil
+	}
+
+	uu, err = o.list(PvGVR, client.ClusterScope)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range uu {
+		phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+		if phase != string(v1.VolumeBound) && phase != string(v1.VolumePending) {
+			rr = append(rr, render.OrphanRes{GVR: PvGVR.String(), Object: u, Reason: "Unbound " + phase})
+		}
+	}
+
+	return rr, nil
+}
+
+// configs collects configmaps and secrets not referenced by any pod or
+// workload pod template.
+func (o *Orphan) configs(ns string) ([]render.OrphanRes, error) {
+	refs := make(podRefs)
+	if err := o.collectRefs(ns, refs); err != nil {
+		return nil, err
+	}
+
+	var rr []render.OrphanRes
+	for _, gvr := range []client.GVR{CmGVR, SecGVR} {
+		uu, err := o.list(gvr, ns)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range uu {
+			if isOwned(u) || isConfigDefault(gvr, u) || refs.has(gvr, u.GetNamespace(), u.GetName()) {
+				continue
+			}
+			rr = append(rr, render.OrphanRes{GVR: gvr.String(), Object: u, Reason: "Unreferenced"})
+		}
+	}
+
+	return rr, nil
+}
+
+func (o *Orphan) collectRefs(ns string, refs podRefs) error {
+	uu, err := o.list(PodGVR, ns)
+	if err != nil {
+		return err
+	}
+	for _, u := range uu {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return err
+		}
+		refs.addPod(po.Namespace, &po.Spec)
+	}
+
+	uu, err = o.list(SaGVR, ns)
+	if err != nil {
+		return err
+	}
+	for _, u := range uu {
+		var sa v1.ServiceAccount
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sa); err != nil {
+			return err
+		}
+		for _, s := range sa.Secrets {
+			refs.add(SecGVR, sa.Namespace, s.Name)
+		}
+		for _, s := range sa.ImagePullSecrets {
+			refs.add(SecGVR, sa.Namespace, s.Name)
+		}
+	}
+
+	if err := o.collectTemplateRefs(ns, refs); err != nil {
+		return err
+	}
+
+	uu, err = o.list(IngGVR, ns)
+	if err != nil {
+		log.Debug().Err(err).Msg("Orphans skipped ingress references")
+		return nil
+	}
+	for _, u := range uu {
+		var ing netv1.Ingress
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ing); err != nil {
+			return err
+		}
+		for _, tls := range ing.Spec.TLS {
+			refs.add(SecGVR, ing.Namespace, tls.SecretName)
+		}
+	}
+
+	return nil
+}
+
+// collectTemplateRefs collects the references held by workloads pod templates
+// so configs used by scaled down workloads are not deemed orphans.
+func (o *Orphan) collectTemplateRefs(ns string, refs podRefs) error {
+	for _, gvr := range []client.GVR{DpGVR, StsGVR, DsGVR, RsGVR, JobGVR, CjGVR} {
+		uu, err := o.list(gvr, ns)
+		if err != nil {
+			log.Debug().Err(err).Msgf("Orphans skipped %s references", gvr)
+			continue
+		}
+		for _, u := range uu {
+			spec, ok, err := templateSpec(gvr, u)
+			if err != nil {
+				return err
+			}
+			if ok {
+				refs.addPod(u.GetNamespace(), spec)
+			}
+		}
+	}
+
+	return nil
+}
+
+// templateSpec extracts a workload pod template spec.
+func templateSpec(gvr client.GVR, u *unstructured.Unstructured) (*v1.PodSpec, bool, error) {
+	path := []string{"spec", "template", "spec"}
+	if gvr == CjGVR {
+		path = []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	}
+	m, ok, err := unstructured.NestedMap(u.Object, path...)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	var spec v1.PodSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &spec); err != nil {
+		return nil, false, err
+	}
+
+	return &spec, true, nil
+}
+
+// jobs collects finished jobs the ttl controller should have reclaimed.
+func (o *Orphan) jobs(ns string) ([]render.OrphanRes, error) {
+	uu, err := o.list(JobGVR, ns)
+	if err != nil {
+		return nil, err
+	}
+	rr := make([]render.OrphanRes, 0, len(uu))
+	for _, u := range uu {
+		var job batchv1.Job
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &job); err != nil {
+			return nil, err
+		}
+		if reason, ok := expiredJob(&job, time.Now()); ok {
+			rr = append(rr, render.OrphanRes{GVR: JobGVR.String(), Object: u, Reason: reason})
+		}
+	}
+
+	return rr, nil
+}
+
+func (o *Orphan) list(gvr client.GVR, ns string) ([]*unstructured.Unstructured, error) {
+	oo, err := o.getFactory().List(gvr.String(), ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	uu := make([]*unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			uu = append(uu, u)
+		}
+	}
+
+	return uu, nil
+}
+
+func (o *Orphan) names(gvr client.GVR, ns string) (map[string]struct{}, error) {
+	uu, err := o.list(gvr, ns)
+	if err != nil {
+		return nil, err
+	}
+	nn := make(map[string]struct{}, len(uu))
+	for _, u := range uu {
+		nn[client.FQN(u.GetNamespace(), u.GetName())] = struct{}{}
+	}
+
+	return nn, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// podRefs tracks the resources referenced by pods keyed by gvr|fqn.
+type podRefs map[string]struct{}
+
+func (p podRefs) add(gvr client.GVR, ns, n string) {
+	if n != "" {
+		p[render.ResourceID(gvr.String(), client.FQN(ns, n))] = struct{}{}
+	}
+}
+
+func (p podRefs) has(gvr client.GVR, ns, n string) bool {
+	_, ok := p[render.ResourceID(gvr.String(), client.FQN(ns, n))]
+
+	return ok
+}
+
+func (p podRefs) addPod(ns string, spec *v1.PodSpec) {
+	for _, s := range spec.ImagePullSecrets {
+		p.add(SecGVR, ns, s.Name)
+	}
+	for _, v := range spec.Volumes {
+		if v.ConfigMap != nil {
+			p.add(CmGVR, ns, v.ConfigMap.Name)
+		}
+		if v.Secret != nil {
+			p.add(SecGVR, ns, v.Secret.SecretName)
+		}
+		if v.Projected == nil {
+			continue
+		}
+		for _, s := range v.Projected.Sources {
+			if s.ConfigMap != nil {
+				p.add(CmGVR, ns, s.ConfigMap.Name)
+			}
+			if s.Secret != nil {
+				p.add(SecGVR, ns, s.Secret.Name)
+			}
+		}
+	}
+	cc := append(append([]v1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, e := range spec.EphemeralContainers {
+		cc = append(cc, v1.Container(e.EphemeralContainerCommon))
+	}
+	for _, c := range cc {
+		for _, e := range c.EnvFrom {
+			if e.ConfigMapRef != nil {
+				p.add(CmGVR, ns, e.ConfigMapRef.Name)
+			}
+			if e.SecretRef != nil {
+				p.add(SecGVR, ns, e.SecretRef.Name)
+			}
+		}
+		for _, e := range c.Env {
+			if e.ValueFrom == nil {
+				continue
+			}
+			if e.ValueFrom.ConfigMapKeyRef != nil {
+				p.add(CmGVR, ns, e.ValueFrom.ConfigMapKeyRef.Name)
+			}
+			if e.ValueFrom.SecretKeyRef != nil {
+				p.add(SecGVR, ns, e.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+	}
+}
+
+// isConfigDefault checks for configs provisioned by the cluster or tooling
+// and not meant to be mounted.
+func isConfigDefault(gvr client.GVR, u *unstructured.Unstructured) bool {
+	switch gvr {
+	case CmGVR:
+		return u.GetName() == rootCAConfigMap
+	case SecGVR:
+		t, _, _ := unstructured.NestedString(u.Object, "type")
+		return t == string(v1.SecretTypeServiceAccountToken) || t == helmReleaseSecret
+	}
+
+	return false
+}
+
+func orphanedRS(rs *appsv1.ReplicaSet, dps map[string]struct{}) (string, bool) {
+	if rs.Status.Replicas > 0 || (rs.Spec.Replicas != nil && *rs.Spec.Replicas > 0) {
+		return "", false
+	}
+	ref := metav1.GetControllerOf(rs)
+	if ref == nil {
+		return "Scaled down, no owner", true
+	}
+	if ref.Kind != "Deployment" {
+		return "", false
+	}
+	if _, ok := dps[client.FQN(rs.Namespace, ref.Name)]; ok {
+		return "", false
+	}
+
+	return "Scaled down, deployment gone", true
+}
+
+func expiredJob(job *batchv1.Job, now time.Time) (string, bool) {
+	if job.Spec.TTLSecondsAfterFinished == nil {
+		return "", false
+	}
+	var done time.Time
+	for _, c := range job.Status.Conditions {
+		if (c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed) && c.Status == v1.ConditionTrue {
+			done = c.LastTransitionTime.Time
+			break
+		}
+	}
+	if done.IsZero() {
+		return "", false
+	}
+	ttl := time.Duration(*job.Spec.TTLSecondsAfterFinished) * time.Second
+	if now.Before(done.Add(ttl)) {
+		return "", false
+	}
+
+	return "Finished, past TTL", true
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestOrphanedRS(t *testing.T) {
+	var (
+		zero, one = int32(0), int32(1)
+		yes       = true
+		owner     = []metav1.OwnerReference{{Kind: "Deployment", Name: "fred", Controller: &yes}}
+		dps       = map[string]struct{}{"ns1/fred": {}}
+	)
+	uu := map[string]struct {
+		replicas *int32
+		owners   []metav1.OwnerReference
+		ns       string
+		e        bool
+	}{
+		"running":    {replicas: &one, ns: "ns1"},
+		"owned":      {replicas: &zero, owners: owner, ns: "ns1"},
+		"no-owner":   {replicas: &zero, ns: "ns1", e: true},
+		"owner-gone": {replicas: &zero, owners: owner, ns: "ns2", e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rs := appsv1.ReplicaSet{
+				ObjectMeta: metav1.ObjectMeta{Namespace: u.ns, Name: "fred-1", OwnerReferences: u.owners},
+				Spec:       appsv1.ReplicaSetSpec{Replicas: u.replicas},
+			}
+			_, ok := orphanedRS(&rs, dps)
+			assert.Equal(t, u.e, ok)
+		})
+	}
+}
+
+func TestExpiredJob(t *testing.T) {
+	var (
+		now = time.Now()
+		ttl = int32(60)
+	)
+	uu := map[string]struct {
+		ttl  *int32
+		cond batchv1.JobConditionType
+		at   time.Time
+		e    bool
+	}{
+		"no-ttl":    {cond: batchv1.JobComplete, at: now.Add(-time.Hour)},
+		"running":   {ttl: &ttl},
+		"fresh":     {ttl: &ttl, cond: batchv1.JobComplete, at: now.Add(-time.Second)},
+		"expired":   {ttl: &ttl, cond: batchv1.JobComplete, at: now.Add(-time.Hour), e: true},
+		"failed":    {ttl: &ttl, cond: batchv1.JobFailed, at: now.Add(-time.Hour), e: true},
+		"suspended": {ttl: &ttl, cond: batchv1.JobSuspended, at: now.Add(-time.Hour)},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			job := batchv1.Job{Spec: batchv1.JobSpec{TTLSecondsAfterFinished: u.ttl}}
+			if u.cond != "" {
+				job.Status.Conditions = []batchv1.JobCondition{
+					{Type: u.cond, Status: v1.ConditionTrue, LastTransitionTime: metav1.NewTime(u.at)},
+				}
+			}
+			_, ok := expiredJob(&job, now)
+			assert.Equal(t, u.e, ok)
+		})
+	}
+}
+
+func TestPodRefs(t *testing.T) {
+	spec := v1.PodSpec{
+		ImagePullSecrets: []v1.LocalObjectReference{{Name: "pull"}},
+		Volumes: []v1.Volume{
+			{VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm-vol"}}}},
+			{VolumeSource: v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{Sources: []v1.VolumeProjection{
+				{Secret: &v1.SecretProjection{LocalObjectReference: v1.LocalObjectReference{Name: "sec-proj"}}},
+			}}}},
+		},
+		Containers: []v1.Container{{
+			EnvFrom: []v1.EnvFromSource{{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm-env"}}}},
+			Env:     []v1.EnvVar{{ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "sec-env"}}}}},
+		}},
+		EphemeralContainers: []v1.EphemeralContainer{{EphemeralContainerCommon: v1.EphemeralContainerCommon{
+			EnvFrom: []v1.EnvFromSource{{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "sec-debug"}}}},
+		}}},
+	}
+	refs := make(podRefs)
+	refs.addPod("fred", &spec)
+
+	for _, n := range []string{"cm-vol", "cm-env"} {
+		assert.True(t, refs.has(CmGVR, "fred", n), n)
+	}
+	for _, n := range []string{"pull", "sec-proj", "sec-env", "sec-debug"} {
+		assert.True(t, refs.has(SecGVR, "fred", n), n)
+	}
+	assert.False(t, refs.has(CmGVR, "blee", "cm-vol"))
+	assert.False(t, refs.has(SecGVR, "fred", "cm-env"))
+}
+
+func TestTemplateSpec(t *testing.T) {
+	pull := map[string]any{
+		"imagePullSecrets": []any{map[string]any{"name": "pull"}},
+		"containers":       []any{map[string]any{"name": "c1"}},
+	}
+	var dp, cj, po unstructured.Unstructured
+	dp.Object = map[string]any{"spec": map[string]any{"template": map[string]any{"spec": pull}}}
+	cj.Object = map[string]any{"spec": map[string]any{"jobTemplate": map[string]any{"spec": map[string]any{"template": map[string]any{"spec": pull}}}}}
+	po.Object = map[string]any{"spec": pull}
+
+	uu := map[string]struct {
+		gvr client.GVR
+		u   *unstructured.Unstructured
+		ok  bool
+	}{
+		"deploy":  {gvr: DpGVR, u: &dp, ok: true},
+		"cronjob": {gvr: CjGVR, u: &cj, ok: true},
+		"none":    {gvr: StsGVR, u: &po},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			spec, ok, err := templateSpec(u.gvr, u.u)
+			assert.NoError(t, err)
+			assert.Equal(t, u.ok, ok)
+			if ok {
+				assert.Equal(t, "pull", spec.ImagePullSecrets[0].Name)
+			}
+		})
+	}
+}
+
+func TestIsConfigDefault(t *testing.T) {
+	var cm, tok, helm, opaque unstructured.Unstructured
+	cm.SetName(rootCAConfigMap)
+	tok.Object = map[string]any{"type": string(v1.SecretTypeServiceAccountToken)}
+	helm.Object = map[string]any{"type": helmReleaseSecret}
+	opaque.Object = map[string]any{"type": string(v1.SecretTypeOpaque)}
+
+	assert.True(t, isConfigDefault(CmGVR, &cm))
+	assert.True(t, isConfigDefault(SecGVR, &tok))
+	assert.True(t, isConfigDefault(SecGVR, &helm))
+	assert.False(t, isConfigDefault(SecGVR, &opaque))
+}
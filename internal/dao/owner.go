@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// OwnerPath returns the resource and path of an owner reference.
+func OwnerPath(ns string, ref metav1.OwnerReference) (client.GVR, string, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return client.NoGVR, "", err
+	}
+	gvr, namespaced, ok := MetaAccess.GVK2GVR(gv, ref.Kind)
+	if !ok {
+		return client.NoGVR, "", fmt.Errorf("unsupported GVK: %s/%s", ref.APIVersion, ref.Kind)
+	}
+	if !namespaced {
+		return gvr, ref.Name, nil
+	}
+
+	return gvr, client.FQN(ns, ref.Name), nil
+}
+
+// ChildSelector returns the label selector a controller uses to select its
+// pods. Both label selectors and plain labels maps are supported.
+func ChildSelector(o *unstructured.Unstructured) (*metav1.LabelSelector, error) {
+	raw, ok, err := unstructured.NestedFieldNoCopy(o.Object, "spec", "selector")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("no selector found")
+	}
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unsupported selector type %T", raw)
+	}
+
+	var sel *metav1.LabelSelector
+	_, hasLabels := m["matchLabels"]
+	_, hasExprs := m["matchExpressions"]
+	if hasLabels || hasExprs {
+		if sel, err = toLabelSelector(m); err != nil {
+			return nil, err
+		}
+	} else {
+		sel = &metav1.LabelSelector{MatchLabels: make(map[string]string, len(m))}
+		for k, v := range m {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid selector value for %q", k)
+			}
+			sel.MatchLabels[k] = s
+		}
+	}
+	if len(sel.MatchLabels) == 0 && len(sel.MatchExpressions) == 0 {
+		return nil, errors.New("empty selector")
+	}
+
+	return sel, nil
+}
+
+func toLabelSelector(m map[string]interface{}) (*metav1.LabelSelector, error) {
+	var sel metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &sel); err != nil {
+		return nil, err
+	}
+
+	return &sel, nil
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestChildSelector(t *testing.T) {
+	uu := map[string]struct {
+		spec map[string]interface{}
+		e    *metav1.LabelSelector
+		err  bool
+	}{
+		"none": {
+			spec: map[string]interface{}{},
+			err:  true,
+		},
+		"empty": {
+			spec: map[string]interface{}{"selector": map[string]interface{}{}},
+			err:  true,
+		},
+		"map": {
+			spec: map[string]interface{}{"selector": map[string]interface{}{"app": "fred"}},
+			e:    &metav1.LabelSelector{MatchLabels: map[string]string{"app": "fred"}},
+		},
+		"label-selector": {
+			spec: map[string]interface{}{"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "fred"},
+				"matchExpressions": []interface{}{
+					map[string]interface{}{"key": "tier", "operator": "In", "values": []interface{}{"web"}},
+				},
+			}},
+			e: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "fred"},
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"web"}},
+				},
+			},
+		},
+		"bad": {
+			spec: map[string]interface{}{"selector": "app=fred"},
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sel, err := ChildSelector(&unstructured.Unstructured{Object: map[string]interface{}{"spec": u.spec}})
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, sel)
+		})
+	}
+}
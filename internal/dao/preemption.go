@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/yaml"
+)
+
+const maxSchedulingEvents = 5
+
+// PreemptionInfo tracks a pod scheduling priority and preemption outcome.
+type PreemptionInfo struct {
+	Priority         string   `json:"priority"`
+	PriorityClass    string   `json:"priorityClass,omitempty"`
+	PreemptionPolicy string   `json:"preemptionPolicy,omitempty"`
+	Phase            string   `json:"phase"`
+	NominatedNode    string   `json:"nominatedNode,omitempty"`
+	Attempted        bool     `json:"preemptionAttempted"`
+	Preemption       string   `json:"preemption,omitempty"`
+	Victims          []string `json:"victims,omitempty"`
+	Scheduling       []string `json:"scheduling,omitempty"`
+}
+
+// PreemptionReport returns a pod priority along with any preemption
+// attempts and victims recorded in the cluster events.
+func PreemptionReport(ctx context.Context, f Factory, po *v1.Pod) (string, error) {
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	own, err := dial.CoreV1().Events(po.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("involvedObject.name", po.Name).String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	preempted, err := dial.CoreV1().Events(client.BlankNamespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("reason", "Preempted").String(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	info := NewPreemptionInfo(po, own.Items, preempted.Items, time.Now())
+	raw, err := yaml.Marshal(info)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// NewPreemptionInfo assembles a pod preemption details from its own
+// scheduling events and the cluster preemption events.
+func NewPreemptionInfo(po *v1.Pod, own, preempted []v1.Event, now time.Time) PreemptionInfo {
+	info := PreemptionInfo{
+		Priority:      render.NAValue,
+		PriorityClass: po.Spec.PriorityClassName,
+		Phase:         string(po.Status.Phase),
+		NominatedNode: po.Status.NominatedNodeName,
+		Victims:       PreemptionVictims(po, preempted),
+	}
+	if po.Spec.Priority != nil {
+		info.Priority = strconv.Itoa(int(*po.Spec.Priority))
+	}
+	if po.Spec.PreemptionPolicy != nil {
+		info.PreemptionPolicy = string(*po.Spec.PreemptionPolicy)
+	}
+
+	ee := make([]v1.Event, 0, len(own))
+	for _, e := range own {
+		if e.Reason == "FailedScheduling" {
+			ee = append(ee, e)
+		}
+	}
+	sortEvents(ee)
+	if len(ee) > 0 {
+		info.Preemption = preemptionVerdict(ee[0].Message)
+	}
+	if len(ee) > maxSchedulingEvents {
+		ee = ee[:maxSchedulingEvents]
+	}
+	for _, e := range ee {
+		info.Scheduling = append(info.Scheduling, duration.HumanDuration(now.Sub(eventTime(e)))+" ago: "+e.Message)
+	}
+	info.Attempted = info.NominatedNode != "" || len(info.Victims) > 0
+
+	return info
+}
+
+// PreemptionVictims returns the pods evicted to make room for a given pod.
+func PreemptionVictims(po *v1.Pod, ee []v1.Event) []string {
+	by := []string{"Preempted by " + client.FQN(po.Namespace, po.Name) + " "}
+	if po.UID != "" {
+		by = append(by, "Preempted by pod "+string(po.UID)+" ")
+	}
+	vv := make([]string, 0, 2)
+	for _, e := range ee {
+		if e.Reason != "Preempted" {
+			continue
+		}
+		for _, p := range by {
+			if strings.HasPrefix(e.Message, p) {
+				vv = append(vv, client.FQN(e.InvolvedObject.Namespace, e.InvolvedObject.Name))
+				break
+			}
+		}
+	}
+
+	return vv
+}
+
+// preemptionVerdict extracts the preemption outcome from a scheduling failure.
+func preemptionVerdict(msg string) string {
+	_, v, ok := strings.Cut(msg, "preemption: ")
+	if !ok {
+		return ""
+	}
+
+	return strings.TrimSpace(v)
+}
+
+// sortEvents orders events most recent first.
+func sortEvents(ee []v1.Event) {
+	sort.SliceStable(ee, func(i, j int) bool {
+		return eventTime(ee[i]).After(eventTime(ee[j]))
+	})
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewPreemptionInfo(t *testing.T) {
+	now, prio, never := time.Now(), int32(1000), v1.PreemptNever
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ml", Name: "train", UID: "u1"},
+		Spec: v1.PodSpec{
+			Priority:          &prio,
+			PriorityClassName: "high",
+			PreemptionPolicy:  &never,
+		},
+		Status: v1.PodStatus{Phase: v1.PodPending, NominatedNodeName: "n1"},
+	}
+	own := []v1.Event{
+		{
+			Reason:        "FailedScheduling",
+			Message:       "0/3 nodes are available: 3 Insufficient nvidia.com/gpu. preemption: 0/3 nodes are available: 3 No preemption victims found for incoming pod.",
+			LastTimestamp: metav1.NewTime(now.Add(-time.Minute)),
+		},
+		{
+			Reason:        "FailedScheduling",
+			Message:       "0/3 nodes are available: 3 Insufficient cpu.",
+			LastTimestamp: metav1.NewTime(now.Add(-10 * time.Minute)),
+		},
+		{
+			Reason:        "Scheduled",
+			Message:       "Successfully assigned ml/train to n1",
+			LastTimestamp: metav1.NewTime(now),
+		},
+	}
+	preempted := []v1.Event{
+		{
+			Reason:         "Preempted",
+			Message:        "Preempted by pod u1 on node n1",
+			InvolvedObject: v1.ObjectReference{Namespace: "batch", Name: "job-1"},
+		},
+		{
+			Reason:         "Preempted",
+			Message:        "Preempted by ml/train on node n1",
+			InvolvedObject: v1.ObjectReference{Namespace: "batch", Name: "job-2"},
+		},
+		{
+			Reason:         "Preempted",
+			Message:        "Preempted by pod u2 on node n2",
+			InvolvedObject: v1.ObjectReference{Namespace: "batch", Name: "job-3"},
+		},
+	}
+
+	info := NewPreemptionInfo(&po, own, preempted, now)
+	assert.Equal(t, "1000", info.Priority)
+	assert.Equal(t, "high", info.PriorityClass)
+	assert.Equal(t, "Never", info.PreemptionPolicy)
+	assert.True(t, info.Attempted)
+	assert.Equal(t, []string{"batch/job-1", "batch/job-2"}, info.Victims)
+	assert.Equal(t, "0/3 nodes are available: 3 No preemption victims found for incoming pod.", info.Preemption)
+	assert.Equal(t, 2, len(info.Scheduling))
+	assert.Equal(t, "60s ago: 0/3 nodes are available: 3 Insufficient nvidia.com/gpu. preemption: 0/3 nodes are available: 3 No preemption victims found for incoming pod.", info.Scheduling[0])
+}
+
+func TestNewPreemptionInfoNone(t *testing.T) {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ml", Name: "train"},
+		Status:     v1.PodStatus{Phase: v1.PodPending},
+	}
+
+	info := NewPreemptionInfo(&po, nil, nil, time.Now())
+	assert.Equal(t, "n/a", info.Priority)
+	assert.False(t, info.Attempted)
+	assert.Equal(t, 0, len(info.Victims))
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// MissingVerbs returns the verbs the current user is denied on a resource.
+func MissingVerbs(conn client.Connection, ns string, gvr client.GVR, verbs []string) []string {
+	missing := make([]string, 0, len(verbs))
+	for _, v := range verbs {
+		if ok, err := conn.CanI(ns, gvr.String(), "", []string{v}); !ok || err != nil {
+			missing = append(missing, v)
+		}
+	}
+
+	return missing
+}
+
+// AccessDeniedReport returns an explanation and the role granting the
+// missing verbs on a resource.
+func AccessDeniedReport(ns string, gvr client.GVR, namespaced bool, missing []string) (string, error) {
+	name := "k9s-" + gvr.R() + "-reader"
+	rules := []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{gvr.G()},
+			Resources: []string{gvr.R()},
+			Verbs:     missing,
+		},
+	}
+
+	var (
+		o     any
+		where string
+	)
+	if namespaced && !client.IsClusterWide(ns) {
+		where = fmt.Sprintf("in namespace %q", ns)
+		o = rbacv1.Role{
+			TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "Role"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Rules:      rules,
+		}
+	} else {
+		where = "cluster wide"
+		o = rbacv1.ClusterRole{
+			TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Rules:      rules,
+		}
+	}
+	raw, err := yaml.Marshal(o)
+	if err != nil {
+		return "", err
+	}
+
+	header := fmt.Sprintf("# Access denied! Current user can't %s %s %s.\n", strings.Join(missing, ","), gvr, where) +
+		"# Ask your cluster admin to bind the following role.\n"
+
+	return header + string(raw), nil
+}
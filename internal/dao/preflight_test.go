@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessDeniedReport(t *testing.T) {
+	uu := map[string]struct {
+		ns         string
+		gvr        string
+		namespaced bool
+		missing    []string
+		e          string
+	}{
+		"role": {
+			ns:         "fred",
+			gvr:        "apps/v1/deployments",
+			namespaced: true,
+			missing:    []string{"list", "watch"},
+			e: `# Access denied! Current user can't list,watch apps/v1/deployments in namespace "fred".
+# Ask your cluster admin to bind the following role.
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  creationTimestamp: null
+  name: k9s-deployments-reader
+  namespace: fred
+rules:
+- apiGroups:
+  - apps
+  resources:
+  - deployments
+  verbs:
+  - list
+  - watch
+`,
+		},
+		"all-ns": {
+			ns:         client.BlankNamespace,
+			gvr:        "v1/pods",
+			namespaced: true,
+			missing:    []string{"watch"},
+			e: `# Access denied! Current user can't watch v1/pods cluster wide.
+# Ask your cluster admin to bind the following role.
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  creationTimestamp: null
+  name: k9s-pods-reader
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - pods
+  verbs:
+  - watch
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw, err := dao.AccessDeniedReport(u.ns, client.NewGVR(u.gvr), u.namespaced, u.missing)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, raw)
+		})
+	}
+}
@@ -0,0 +1,288 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	maxProbeFailures = 20
+
+	// LivenessProbe tags a container liveness probe.
+	LivenessProbe = "Liveness"
+
+	// ReadinessProbe tags a container readiness probe.
+	ReadinessProbe = "Readiness"
+
+	// StartupProbe tags a container startup probe.
+	StartupProbe = "Startup"
+)
+
+// ContainerProbes tracks a container probes and readiness.
+type ContainerProbes struct {
+	Name      string `json:"name"`
+	Ready     bool   `json:"ready"`
+	Restarts  int32  `json:"restarts"`
+	LastState string `json:"lastState,omitempty"`
+	Probes    string `json:"probes,omitempty"`
+}
+
+// ProbeReport tracks a pod probes status and recent failures.
+type ProbeReport struct {
+	Containers []ContainerProbes `json:"containers"`
+	Failures   []string          `json:"failures,omitempty"`
+}
+
+// ProbeFailure represents a probe failure event.
+type ProbeFailure struct {
+	Container, Probe, Message string
+	Count                     int32
+	Last                      time.Time
+}
+
+// ProbeHistory returns a pod containers probes status and recent failures.
+func ProbeHistory(ctx context.Context, f Factory, po *v1.Pod) (string, error) {
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	ll, err := dial.CoreV1().Events(po.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("involvedObject.name", po.Name).String(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var r ProbeReport
+	for i, cc := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+		for j := range cc {
+			co := &cc[j]
+			if i == 0 && render.ContainerType(co, true) == render.InitContainer {
+				continue
+			}
+			cp := ContainerProbes{Name: co.Name, Probes: render.ProbesSpec(co)}
+			if cs := getContainerStatus(co.Name, po.Status); cs != nil {
+				cp.Ready, cp.Restarts = cs.Ready, cs.RestartCount
+				cp.LastState = render.ToLastTermination(cs.LastTerminationState)
+			}
+			r.Containers = append(r.Containers, cp)
+		}
+	}
+	r.Failures = FormatProbeFailures(ProbeFailures(ll.Items), time.Now())
+
+	raw, err := yaml.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// ProbeFailures extracts probe failures from a pod events, newest first.
+func ProbeFailures(ee []v1.Event) []ProbeFailure {
+	ff := make([]ProbeFailure, 0, len(ee))
+	for _, e := range ee {
+		if e.Reason != "Unhealthy" && e.Reason != "ProbeWarning" {
+			continue
+		}
+		probe, _, ok := strings.Cut(e.Message, " probe ")
+		if !ok {
+			continue
+		}
+		ff = append(ff, ProbeFailure{
+			Container: fieldPathContainer(e.InvolvedObject.FieldPath),
+			Probe:     probe,
+			Message:   e.Message,
+			Count:     e.Count,
+			Last:      eventTime(e),
+		})
+	}
+	sort.SliceStable(ff, func(i, j int) bool {
+		return ff[i].Last.After(ff[j].Last)
+	})
+	if len(ff) > maxProbeFailures {
+		ff = ff[:maxProbeFailures]
+	}
+
+	return ff
+}
+
+// FormatProbeFailures renders probe failures for display.
+func FormatProbeFailures(ff []ProbeFailure, now time.Time) []string {
+	ss := make([]string, 0, len(ff))
+	for _, f := range ff {
+		s := duration.HumanDuration(now.Sub(f.Last)) + " ago"
+		if f.Count > 1 {
+			s += fmt.Sprintf(" (x%d)", f.Count)
+		}
+		if f.Container != "" {
+			s += " [" + f.Container + "]"
+		}
+		ss = append(ss, s+" "+f.Message)
+	}
+
+	return ss
+}
+
+// fieldPathContainer extracts a container name from an event field path,
+// ie spec.containers{fred}.
+func fieldPathContainer(p string) string {
+	_, co, ok := strings.Cut(p, "{")
+	if !ok {
+		return ""
+	}
+
+	return strings.TrimSuffix(co, "}")
+}
+
+// ProbeRun represents an on demand execution of a container probe.
+type ProbeRun struct {
+	Container string
+	Probe     string
+	Exec      []string
+	Check     NetCheck
+}
+
+// ContainerProbeKinds lists the probes defined on a given container.
+func ContainerProbeKinds(po *v1.Pod, co string) []string {
+	c := findContainer(po, co)
+	if c == nil {
+		return nil
+	}
+	kk := make([]string, 0, 3)
+	for _, k := range []string{LivenessProbe, ReadinessProbe, StartupProbe} {
+		if containerProbe(c, k) != nil {
+			kk = append(kk, k)
+		}
+	}
+
+	return kk
+}
+
+// NewProbeRun converts a container probe definition into an on demand check.
+func NewProbeRun(po *v1.Pod, co, kind string) (ProbeRun, error) {
+	c := findContainer(po, co)
+	if c == nil {
+		return ProbeRun{}, fmt.Errorf("no container %q found in pod %s", co, client.FQN(po.Namespace, po.Name))
+	}
+	p := containerProbe(c, kind)
+	if p == nil {
+		return ProbeRun{}, fmt.Errorf("no %s probe defined on container %q", strings.ToLower(kind), co)
+	}
+
+	run := ProbeRun{Container: co, Probe: kind}
+	timeout := time.Second
+	if p.TimeoutSeconds > 0 {
+		timeout = time.Duration(p.TimeoutSeconds) * time.Second
+	}
+	switch {
+	case p.Exec != nil:
+		run.Exec = p.Exec.Command
+	case p.HTTPGet != nil:
+		port, err := resolveProbePort(c, p.HTTPGet.Port)
+		if err != nil {
+			return run, err
+		}
+		run.Check = NetCheck{
+			Host:    probeHost(po, p.HTTPGet.Host),
+			Port:    port,
+			HTTP:    true,
+			TLS:     p.HTTPGet.Scheme == v1.URISchemeHTTPS,
+			Path:    p.HTTPGet.Path,
+			Timeout: timeout,
+		}
+		if run.Check.Path == "" {
+			run.Check.Path = "/"
+		}
+	case p.TCPSocket != nil:
+		port, err := resolveProbePort(c, p.TCPSocket.Port)
+		if err != nil {
+			return run, err
+		}
+		run.Check = NetCheck{
+			Host:    probeHost(po, p.TCPSocket.Host),
+			Port:    port,
+			Timeout: timeout,
+		}
+	default:
+		return run, fmt.Errorf("%s probe handler is not supported", strings.ToLower(kind))
+	}
+	if run.Exec == nil && run.Check.Host == "" {
+		return run, fmt.Errorf("pod %s has no IP assigned", client.FQN(po.Namespace, po.Name))
+	}
+	if run.Exec == nil {
+		if err := run.Check.Validate(); err != nil {
+			return run, err
+		}
+	}
+
+	return run, nil
+}
+
+// ExecArgs returns the kubectl arguments to run an exec probe.
+func (p ProbeRun) ExecArgs(fqn string) []string {
+	ns, po := client.Namespaced(fqn)
+
+	return append([]string{"exec", "-n", ns, po, "-c", p.Container, "--"}, p.Exec...)
+}
+
+func findContainer(po *v1.Pod, co string) *v1.Container {
+	for _, cc := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+		for i := range cc {
+			if cc[i].Name == co {
+				return &cc[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+func containerProbe(co *v1.Container, kind string) *v1.Probe {
+	switch kind {
+	case LivenessProbe:
+		return co.LivenessProbe
+	case ReadinessProbe:
+		return co.ReadinessProbe
+	case StartupProbe:
+		return co.StartupProbe
+	default:
+		return nil
+	}
+}
+
+func probeHost(po *v1.Pod, host string) string {
+	if host != "" {
+		return host
+	}
+
+	return po.Status.PodIP
+}
+
+func resolveProbePort(co *v1.Container, p intstr.IntOrString) (int32, error) {
+	if p.Type == intstr.Int {
+		return p.IntVal, nil
+	}
+	for _, cp := range co.Ports {
+		if cp.Name == p.StrVal {
+			return cp.ContainerPort, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no port named %q on container %q", p.StrVal, co.Name)
+}
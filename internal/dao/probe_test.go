@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestProbeFailures(t *testing.T) {
+	now := time.Now()
+	ee := []v1.Event{
+		{
+			Reason:         "Unhealthy",
+			Message:        "Readiness probe failed: HTTP probe failed with statuscode: 503",
+			Count:          12,
+			InvolvedObject: v1.ObjectReference{FieldPath: "spec.containers{fred}"},
+			LastTimestamp:  metav1.NewTime(now.Add(-2 * time.Minute)),
+		},
+		{
+			Reason:        "Pulled",
+			Message:       "Container image already present",
+			LastTimestamp: metav1.NewTime(now),
+		},
+		{
+			Reason:         "Unhealthy",
+			Message:        "Liveness probe failed: dial tcp 10.0.0.1:80: connect: connection refused",
+			Count:          1,
+			InvolvedObject: v1.ObjectReference{FieldPath: "spec.containers{blee}"},
+			LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+		},
+	}
+
+	ff := ProbeFailures(ee)
+	assert.Equal(t, 2, len(ff))
+	assert.Equal(t, "Liveness", ff[0].Probe)
+	assert.Equal(t, "blee", ff[0].Container)
+	assert.Equal(t, "Readiness", ff[1].Probe)
+
+	assert.Equal(t, []string{
+		"60s ago [blee] Liveness probe failed: dial tcp 10.0.0.1:80: connect: connection refused",
+		"2m ago (x12) [fred] Readiness probe failed: HTTP probe failed with statuscode: 503",
+	}, FormatProbeFailures(ff, now))
+}
+
+func TestNewProbeRun(t *testing.T) {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "blee", Name: "p1"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:  "fred",
+					Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+					LivenessProbe: &v1.Probe{
+						ProbeHandler: v1.ProbeHandler{
+							HTTPGet: &v1.HTTPGetAction{Port: intstr.FromString("http"), Path: "/healthz", Scheme: v1.URISchemeHTTPS},
+						},
+						TimeoutSeconds: 3,
+					},
+					ReadinessProbe: &v1.Probe{
+						ProbeHandler: v1.ProbeHandler{
+							TCPSocket: &v1.TCPSocketAction{Port: intstr.FromString("grpc")},
+						},
+					},
+					StartupProbe: &v1.Probe{
+						ProbeHandler: v1.ProbeHandler{
+							Exec: &v1.ExecAction{Command: []string{"cat", "/tmp/ready"}},
+						},
+					},
+				},
+			},
+		},
+		Status: v1.PodStatus{PodIP: "10.0.0.1"},
+	}
+
+	assert.Equal(t, []string{LivenessProbe, ReadinessProbe, StartupProbe}, ContainerProbeKinds(&po, "fred"))
+
+	run, err := NewProbeRun(&po, "fred", LivenessProbe)
+	assert.Nil(t, err)
+	assert.Equal(t, NetCheck{Host: "10.0.0.1", Port: 8080, HTTP: true, TLS: true, Path: "/healthz", Timeout: 3 * time.Second}, run.Check)
+
+	_, err = NewProbeRun(&po, "fred", ReadinessProbe)
+	assert.Equal(t, `no port named "grpc" on container "fred"`, err.Error())
+
+	run, err = NewProbeRun(&po, "fred", StartupProbe)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"exec", "-n", "blee", "p1", "-c", "fred", "--", "cat", "/tmp/ready"}, run.ExecArgs("blee/p1"))
+
+	_, err = NewProbeRun(&po, "zorg", StartupProbe)
+	assert.Equal(t, `no container "zorg" found in pod blee/p1`, err.Error())
+}
@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ProvenanceMatch indicates the pods run the digest the tag points to.
+	ProvenanceMatch = "MATCH"
+
+	// ProvenanceDrift indicates the tag moved since the pods pulled the image.
+	ProvenanceDrift = "DRIFT"
+
+	// ProvenanceUnknown indicates the digests could not be compared.
+	ProvenanceUnknown = "UNKNOWN"
+
+	signatureFound = "found"
+	signatureNone  = "none"
+)
+
+// DigestFetcher returns the digest an image reference currently resolves to.
+type DigestFetcher func(ctx context.Context, image string) (string, error)
+
+// SignatureVerifier verifies a digest image reference signature.
+type SignatureVerifier func(ctx context.Context, ref string) error
+
+// ProvenanceOpts tracks how images are resolved and their signatures checked.
+type ProvenanceOpts struct {
+	Fetch DigestFetcher
+
+	// Signatures looks up cosign signatures and attestations when set.
+	Signatures bool
+
+	// Verify verifies images signatures when set.
+	Verify SignatureVerifier
+}
+
+// ProvenanceReport tracks a workload images digests and signatures.
+type ProvenanceReport struct {
+	Pods   int               `json:"pods"`
+	Images []ImageProvenance `json:"images"`
+}
+
+// ImageProvenance tracks an image tag digest against the digests being run.
+type ImageProvenance struct {
+	Container      string   `json:"container"`
+	Image          string   `json:"image"`
+	Status         string   `json:"status"`
+	Digest         string   `json:"digest,omitempty"`
+	RunningDigests []string `json:"runningDigests,omitempty"`
+	Signature      string   `json:"signature,omitempty"`
+	Attestation    string   `json:"attestation,omitempty"`
+	Verified       string   `json:"verified,omitempty"`
+	Error          string   `json:"error,omitempty"`
+}
+
+// ImageProvenanceReport resolves a pod or workload images to digests and
+// checks whether its pods run the digests their tags currently point to.
+func ImageProvenanceReport(ctx context.Context, f Factory, gvr client.GVR, path string, opts ProvenanceOpts) (string, error) {
+	spec, pp, err := workloadPods(f, gvr, path)
+	if err != nil {
+		return "", err
+	}
+
+	r := NewProvenanceReport(ctx, spec, pp, opts)
+	raw, err := yaml.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// NewProvenanceReport matches a pod spec images digests against the ones
+// reported by the given pods.
+func NewProvenanceReport(ctx context.Context, spec *v1.PodSpec, pp []*v1.Pod, opts ProvenanceOpts) ProvenanceReport {
+	r := ProvenanceReport{Pods: len(pp)}
+	for _, cc := range [][]v1.Container{spec.InitContainers, spec.Containers} {
+		for _, co := range cc {
+			r.Images = append(r.Images, checkProvenance(ctx, co, runningDigests(pp, co.Name), opts))
+		}
+	}
+
+	return r
+}
+
+func checkProvenance(ctx context.Context, co v1.Container, running []string, opts ProvenanceOpts) ImageProvenance {
+	ip := ImageProvenance{
+		Container:      co.Name,
+		Image:          co.Image,
+		Status:         ProvenanceUnknown,
+		RunningDigests: running,
+	}
+	ref, err := name.ParseReference(co.Image)
+	if err != nil {
+		ip.Error = err.Error()
+		return ip
+	}
+	if d, ok := ref.(name.Digest); ok {
+		ip.Digest = d.DigestStr()
+	} else {
+		d, err := opts.Fetch(ctx, ref.Name())
+		if err != nil {
+			ip.Error = err.Error()
+			return ip
+		}
+		ip.Digest = d
+	}
+	if len(running) > 0 {
+		ip.Status = ProvenanceMatch
+		for _, d := range running {
+			if d != ip.Digest {
+				ip.Status = ProvenanceDrift
+			}
+		}
+	}
+
+	repo := ref.Context()
+	if opts.Signatures {
+		// Cosign stores signatures and attestations as sha256-<hex>.sig|att tags.
+		tag := strings.Replace(ip.Digest, ":", "-", 1)
+		ip.Signature, ip.Attestation = signatureNone, signatureNone
+		if _, err := opts.Fetch(ctx, repo.Tag(tag+".sig").Name()); err == nil {
+			ip.Signature = signatureFound
+		}
+		if _, err := opts.Fetch(ctx, repo.Tag(tag+".att").Name()); err == nil {
+			ip.Attestation = signatureFound
+		}
+	}
+	if opts.Verify != nil {
+		ip.Verified = "true"
+		// Only hand verifiers a re-serialized, validated digest reference.
+		d, err := name.NewDigest(repo.Name() + "@" + ip.Digest)
+		if err == nil {
+			err = opts.Verify(ctx, d.Name())
+		}
+		if err != nil {
+			ip.Verified = "false -- " + err.Error()
+		}
+	}
+
+	return ip
+}
+
+// RegistryDigest retrieves the digest an image reference resolves to from
+// its registry.
+func RegistryDigest(ctx context.Context, image string) (string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "", err
+	}
+	desc, err := remote.Head(ref, remote.WithContext(ctx), remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return "", err
+	}
+
+	return desc.Digest.String(), nil
+}
+
+// runningDigests returns the image digests a container runs across pods.
+func runningDigests(pp []*v1.Pod, co string) []string {
+	dd := make(map[string]struct{})
+	for _, po := range pp {
+		for _, s := range append(append([]v1.ContainerStatus{}, po.Status.InitContainerStatuses...), po.Status.ContainerStatuses...) {
+			if s.Name != co || s.ImageID == "" {
+				continue
+			}
+			id := s.ImageID
+			if i := strings.LastIndex(id, "@"); i >= 0 {
+				id = id[i+1:]
+			}
+			dd[id] = struct{}{}
+		}
+	}
+	if len(dd) == 0 {
+		return nil
+	}
+	ss := make([]string, 0, len(dd))
+	for d := range dd {
+		ss = append(ss, d)
+	}
+	sort.Strings(ss)
+
+	return ss
+}
+
+// workloadPods returns a pod or workload pod spec along with its pods.
+func workloadPods(f Factory, gvr client.GVR, path string) (*v1.PodSpec, []*v1.Pod, error) {
+	u, err := getUnstructured(f, gvr, path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if u.GetKind() == "Pod" {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, nil, err
+		}
+		return &po.Spec, []*v1.Pod{&po}, nil
+	}
+
+	var w struct {
+		Spec struct {
+			Selector *metav1.LabelSelector `json:"selector"`
+			Template v1.PodTemplateSpec    `json:"template"`
+		} `json:"spec"`
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &w); err != nil {
+		return nil, nil, err
+	}
+	spec := &w.Spec.Template.Spec
+	if w.Spec.Selector == nil {
+		return spec, nil, nil
+	}
+	sel, err := metav1.LabelSelectorAsSelector(w.Spec.Selector)
+	if err != nil {
+		return nil, nil, err
+	}
+	pp, err := podsMatching(f, u.GetNamespace(), sel)
+	if err != nil {
+		log.Warn().Err(err).Msgf("No pods found for %s", path)
+	}
+
+	return spec, pp, nil
+}
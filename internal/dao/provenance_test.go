@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestNewProvenanceReport(t *testing.T) {
+	var (
+		aaa = "sha256:" + strings.Repeat("a", 64)
+		bbb = "sha256:" + strings.Repeat("b", 64)
+		ccc = "sha256:" + strings.Repeat("c", 64)
+		ddd = "sha256:" + strings.Repeat("d", 64)
+	)
+	digests := map[string]string{
+		"index.docker.io/library/nginx:1.25":                                       aaa,
+		"index.docker.io/library/redis:7":                                          ccc,
+		"index.docker.io/library/nginx:sha256-" + strings.Repeat("a", 64) + ".sig": "sha256:sig",
+		"ghcr.io/fred/blee:sha256-" + strings.Repeat("d", 64) + ".att":             "sha256:att",
+	}
+	fetch := func(_ context.Context, image string) (string, error) {
+		if d, ok := digests[image]; ok {
+			return d, nil
+		}
+		return "", errors.New("not found")
+	}
+	spec := v1.PodSpec{
+		InitContainers: []v1.Container{{Name: "i1", Image: "ghcr.io/fred/blee@" + ddd}},
+		Containers: []v1.Container{
+			{Name: "c1", Image: "nginx:1.25"},
+			{Name: "c2", Image: "redis:7"},
+			{Name: "c3", Image: "zorg:1"},
+		},
+	}
+	pp := []*v1.Pod{
+		{Status: v1.PodStatus{
+			InitContainerStatuses: []v1.ContainerStatus{{Name: "i1", ImageID: "ghcr.io/fred/blee@" + ddd}},
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "c1", ImageID: "docker-pullable://nginx@" + aaa},
+				{Name: "c2", ImageID: "docker.io/library/redis@" + bbb},
+			},
+		}},
+		{Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "c1", ImageID: "docker-pullable://nginx@" + aaa},
+				{Name: "c2", ImageID: "docker.io/library/redis@" + ccc},
+			},
+		}},
+	}
+
+	var verified []string
+	opts := ProvenanceOpts{
+		Fetch:      fetch,
+		Signatures: true,
+		Verify: func(_ context.Context, ref string) error {
+			verified = append(verified, ref)
+			return nil
+		},
+	}
+	r := NewProvenanceReport(context.Background(), &spec, pp, opts)
+
+	assert.Equal(t, 2, r.Pods)
+	assert.Equal(t, []ImageProvenance{
+		{
+			Container:      "i1",
+			Image:          "ghcr.io/fred/blee@" + ddd,
+			Status:         ProvenanceMatch,
+			Digest:         ddd,
+			RunningDigests: []string{ddd},
+			Signature:      "none",
+			Attestation:    "found",
+			Verified:       "true",
+		},
+		{
+			Container:      "c1",
+			Image:          "nginx:1.25",
+			Status:         ProvenanceMatch,
+			Digest:         aaa,
+			RunningDigests: []string{aaa},
+			Signature:      "found",
+			Attestation:    "none",
+			Verified:       "true",
+		},
+		{
+			Container:      "c2",
+			Image:          "redis:7",
+			Status:         ProvenanceDrift,
+			Digest:         ccc,
+			RunningDigests: []string{bbb, ccc},
+			Signature:      "none",
+			Attestation:    "none",
+			Verified:       "true",
+		},
+		{
+			Container: "c3",
+			Image:     "zorg:1",
+			Status:    ProvenanceUnknown,
+			Error:     "not found",
+		},
+	}, r.Images)
+	assert.Equal(t, []string{
+		"ghcr.io/fred/blee@" + ddd,
+		"index.docker.io/library/nginx@" + aaa,
+		"index.docker.io/library/redis@" + ccc,
+	}, verified)
+}
+
+func TestNewProvenanceReportBadImages(t *testing.T) {
+	spec := v1.PodSpec{
+		Containers: []v1.Container{
+			{Name: "c1", Image: "nginx@sha256:$(reboot)"},
+			{Name: "c2", Image: "nginx:1.25;reboot"},
+			{Name: "c3", Image: "nginx:1.25"},
+		},
+	}
+	fetch := func(_ context.Context, image string) (string, error) {
+		return "sha256:aaa;reboot", nil
+	}
+	var verified []string
+	opts := ProvenanceOpts{
+		Fetch: fetch,
+		Verify: func(_ context.Context, ref string) error {
+			verified = append(verified, ref)
+			return nil
+		},
+	}
+	r := NewProvenanceReport(context.Background(), &spec, nil, opts)
+
+	assert.Len(t, r.Images, 3)
+	for _, ip := range r.Images[:2] {
+		assert.Equal(t, ProvenanceUnknown, ip.Status)
+		assert.NotEmpty(t, ip.Error)
+		assert.Empty(t, ip.Verified)
+	}
+	assert.True(t, strings.HasPrefix(r.Images[2].Verified, "false -- "))
+	assert.Empty(t, verified)
+}
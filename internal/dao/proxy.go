@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ProxyPath returns the API server proxy path to a pod or service port ie
+// /api/v1/namespaces/ns/services/name:port/proxy/metrics. Ports may carry a
+// scheme ie https:443.
+func ProxyPath(res, ns, n, port, sub string) string {
+	target := n
+	if scheme, p, ok := strings.Cut(port, ":"); ok {
+		target = scheme + ":" + n + ":" + p
+	} else if port != "" {
+		target += ":" + port
+	}
+
+	return fmt.Sprintf("/api/v1/namespaces/%s/%s/%s/proxy/%s", ns, res, target, strings.TrimLeft(sub, "/"))
+}
+
+// ProxyPorts returns the ports a pod or service can be proxied on.
+func ProxyPorts(o *unstructured.Unstructured) []string {
+	var pp []string
+	switch o.GetKind() {
+	case "Service":
+		ports, _, _ := unstructured.NestedSlice(o.Object, "spec", "ports")
+		pp = append(pp, portsOf(ports, "port")...)
+	default:
+		cc, _, _ := unstructured.NestedSlice(o.Object, "spec", "containers")
+		for _, c := range cc {
+			m, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			ports, _, _ := unstructured.NestedSlice(m, "ports")
+			pp = append(pp, portsOf(ports, "containerPort")...)
+		}
+	}
+
+	return pp
+}
+
+func portsOf(ports []interface{}, key string) []string {
+	pp := make([]string, 0, len(ports))
+	for _, p := range ports {
+		m, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if proto, ok := m["protocol"].(string); ok && proto != "TCP" {
+			continue
+		}
+		if n, ok, _ := unstructured.NestedInt64(m, key); ok {
+			pp = append(pp, strconv.FormatInt(n, 10))
+		}
+	}
+
+	return pp
+}
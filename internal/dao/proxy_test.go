@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestProxyPath(t *testing.T) {
+	uu := map[string]struct {
+		res, ns, n, port, sub string
+		e                     string
+	}{
+		"svc": {
+			res: "services", ns: "fred", n: "blee", port: "8080", sub: "/metrics",
+			e: "/api/v1/namespaces/fred/services/blee:8080/proxy/metrics",
+		},
+		"pod-no-port": {
+			res: "pods", ns: "fred", n: "blee",
+			e: "/api/v1/namespaces/fred/pods/blee/proxy/",
+		},
+		"scheme": {
+			res: "services", ns: "fred", n: "blee", port: "https:443", sub: "debug/vars?x=1",
+			e: "/api/v1/namespaces/fred/services/https:blee:443/proxy/debug/vars?x=1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ProxyPath(u.res, u.ns, u.n, u.port, u.sub))
+		})
+	}
+}
+
+func TestProxyPorts(t *testing.T) {
+	svc := unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Service",
+		"spec": map[string]interface{}{
+			"ports": []interface{}{
+				map[string]interface{}{"port": int64(80), "protocol": "TCP"},
+				map[string]interface{}{"port": int64(53), "protocol": "UDP"},
+			},
+		},
+	}}
+	assert.Equal(t, []string{"80"}, ProxyPorts(&svc))
+
+	po := unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Pod",
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"ports": []interface{}{
+					map[string]interface{}{"containerPort": int64(8080)},
+				}},
+				map[string]interface{}{"ports": []interface{}{
+					map[string]interface{}{"containerPort": int64(9090)},
+				}},
+			},
+		},
+	}}
+	assert.Equal(t, []string{"8080", "9090"}, ProxyPorts(&po))
+}
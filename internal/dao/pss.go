@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const pssLabelPrefix = "pod-security.kubernetes.io/"
+
+var pssModes = []string{"enforce", "audit", "warn"}
+
+// PSSReport summarizes a namespace pods against the Pod Security Standards.
+type PSSReport struct {
+	Namespace  string              `json:"namespace"`
+	Modes      map[string]string   `json:"modes,omitempty"`
+	Target     string              `json:"target"`
+	Pods       int                 `json:"pods"`
+	Levels     map[string]int      `json:"levels"`
+	Violations map[string][]string `json:"violations,omitempty"`
+}
+
+// NamespacePSS returns a namespace Pod Security Standards summary.
+func NamespacePSS(f Factory, ns string) (string, error) {
+	o, err := f.Get("v1/namespaces", client.FQN(client.ClusterScope, ns), true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	nsLabels := o.(*unstructured.Unstructured).GetLabels()
+
+	oo, err := f.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	pp := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+			return "", err
+		}
+		pp = append(pp, po)
+	}
+
+	raw, err := yaml.Marshal(NewPSSReport(ns, nsLabels, pp))
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// NewPSSReport checks pods against the strictest level configured on their
+// namespace, defaulting to restricted when no level is set.
+func NewPSSReport(ns string, nsLabels map[string]string, pp []v1.Pod) PSSReport {
+	r := PSSReport{
+		Namespace: ns,
+		Modes:     make(map[string]string),
+		Target:    render.PSSRestricted,
+		Pods:      len(pp),
+		Levels: map[string]int{
+			render.PSSPrivileged: 0,
+			render.PSSBaseline:   0,
+			render.PSSRestricted: 0,
+		},
+		Violations: make(map[string][]string),
+	}
+	target := ""
+	for _, m := range pssModes {
+		l, ok := nsLabels[pssLabelPrefix+m]
+		if !ok {
+			continue
+		}
+		if v, ok := nsLabels[pssLabelPrefix+m+"-version"]; ok {
+			r.Modes[m] = l + "@" + v
+		} else {
+			r.Modes[m] = l
+		}
+		if pssRank(l) > pssRank(target) {
+			target = l
+		}
+	}
+	if target != "" {
+		r.Target = target
+	}
+
+	sort.Slice(pp, func(i, j int) bool {
+		return pp[i].Name < pp[j].Name
+	})
+	for i := range pp {
+		spec := &pp[i].Spec
+		r.Levels[render.PSSLevel(spec)]++
+		if vv := render.PSSViolations(spec, r.Target); len(vv) > 0 {
+			r.Violations[pp[i].Name] = vv
+		}
+	}
+
+	return r
+}
+
+func pssRank(l string) int {
+	switch l {
+	case render.PSSPrivileged:
+		return 1
+	case render.PSSBaseline:
+		return 2
+	case render.PSSRestricted:
+		return 3
+	default:
+		return 0
+	}
+}
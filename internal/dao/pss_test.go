@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewPSSReport(t *testing.T) {
+	pp := []v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "p2"},
+			Spec:       v1.PodSpec{HostPID: true, Containers: []v1.Container{{Name: "c1"}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "p1"},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+		},
+	}
+	ll := map[string]string{
+		"pod-security.kubernetes.io/enforce":         "baseline",
+		"pod-security.kubernetes.io/enforce-version": "v1.29",
+		"pod-security.kubernetes.io/warn":            "privileged",
+	}
+
+	r := NewPSSReport("ns1", ll, pp)
+	assert.Equal(t, map[string]string{"enforce": "baseline@v1.29", "warn": "privileged"}, r.Modes)
+	assert.Equal(t, render.PSSBaseline, r.Target)
+	assert.Equal(t, 2, r.Pods)
+	assert.Equal(t, map[string]int{"privileged": 1, "baseline": 1, "restricted": 0}, r.Levels)
+	assert.Equal(t, map[string][]string{"p2": {"host namespaces"}}, r.Violations)
+}
+
+func TestNewPSSReportDefaultTarget(t *testing.T) {
+	r := NewPSSReport("ns1", nil, []v1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+	}})
+	assert.Equal(t, render.PSSRestricted, r.Target)
+	assert.Equal(t, 4, len(r.Violations["p1"]))
+}
@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/kubectl/pkg/util/storage"
+)
+
+// ScGVR tracks storage classes.
+var ScGVR = client.NewGVR("storage.k8s.io/v1/storageclasses")
+
+var (
+	// PVCAccessModes lists the claim access modes.
+	PVCAccessModes = []string{
+		string(v1.ReadWriteOnce),
+		string(v1.ReadOnlyMany),
+		string(v1.ReadWriteMany),
+		string(v1.ReadWriteOncePod),
+	}
+
+	// PVCVolumeModes lists the claim volume modes.
+	PVCVolumeModes = []string{
+		string(v1.PersistentVolumeFilesystem),
+		string(v1.PersistentVolumeBlock),
+	}
+)
+
+// StorageClassInfo describes a storage class a claim can be provisioned from.
+type StorageClassInfo struct {
+	Name            string
+	Provisioner     string
+	Default         bool
+	WaitForConsumer bool
+}
+
+// PVCSpec describes a new persistent volume claim.
+type PVCSpec struct {
+	Namespace    string
+	Name         string
+	StorageClass string
+	Size         string
+	AccessModes  []string
+	VolumeMode   string
+}
+
+// Build validates the spec and returns the matching claim. A blank storage
+// class defers to the cluster default.
+func (s PVCSpec) Build() (*v1.PersistentVolumeClaim, error) {
+	if ee := validation.IsDNS1123Subdomain(s.Name); len(ee) > 0 {
+		return nil, fmt.Errorf("invalid claim name %q: %s", s.Name, strings.Join(ee, ", "))
+	}
+	if s.Namespace == "" || client.IsAllNamespaces(s.Namespace) {
+		return nil, errors.New("a claim requires a namespace")
+	}
+	q, err := resource.ParseQuantity(strings.TrimSpace(s.Size))
+	if err != nil {
+		return nil, fmt.Errorf("invalid claim size %q. Use a quantity ie 10Gi", s.Size)
+	}
+	if q.Sign() <= 0 {
+		return nil, fmt.Errorf("claim size must be greater than 0 but got %q", s.Size)
+	}
+	if len(s.AccessModes) == 0 {
+		return nil, errors.New("a claim requires at least one access mode")
+	}
+	mm := make([]v1.PersistentVolumeAccessMode, 0, len(s.AccessModes))
+	for _, m := range s.AccessModes {
+		mm = append(mm, v1.PersistentVolumeAccessMode(m))
+	}
+
+	pvc := v1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: s.Namespace,
+			Name:      s.Name,
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: mm,
+			Resources: v1.VolumeResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceStorage: q},
+			},
+		},
+	}
+	if s.StorageClass != "" {
+		sc := s.StorageClass
+		pvc.Spec.StorageClassName = &sc
+	}
+	if s.VolumeMode != "" {
+		vm := v1.PersistentVolumeMode(s.VolumeMode)
+		pvc.Spec.VolumeMode = &vm
+	}
+
+	return &pvc, nil
+}
+
+// FetchStorageClasses lists the cluster storage classes, default class first.
+func FetchStorageClasses(f Factory) ([]StorageClassInfo, error) {
+	oo, err := f.List(ScGVR.String(), "", true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ss := make([]StorageClassInfo, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var sc storagev1.StorageClass
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sc); err != nil {
+			return nil, err
+		}
+		ss = append(ss, storageClassInfo(&sc))
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		if ss[i].Default != ss[j].Default {
+			return ss[i].Default
+		}
+		return ss[i].Name < ss[j].Name
+	})
+
+	return ss, nil
+}
+
+func storageClassInfo(sc *storagev1.StorageClass) StorageClassInfo {
+	return StorageClassInfo{
+		Name:        sc.Name,
+		Provisioner: sc.Provisioner,
+		Default:     storage.IsDefaultAnnotationText(sc.ObjectMeta) == "Yes",
+		WaitForConsumer: sc.VolumeBindingMode != nil &&
+			*sc.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer,
+	}
+}
+
+// CreatePVC creates a persistent volume claim.
+func CreatePVC(ctx context.Context, f Factory, s PVCSpec) (*v1.PersistentVolumeClaim, error) {
+	pvc, err := s.Build()
+	if err != nil {
+		return nil, err
+	}
+	auth, err := f.Client().CanI(s.Namespace, PvcGVR.String(), "", []string{client.CreateVerb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to create claims in namespace %s", s.Namespace)
+	}
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+
+	return dial.CoreV1().PersistentVolumeClaims(s.Namespace).Create(ctx, pvc, metav1.CreateOptions{})
+}
+
+// PVCBindStatus tracks a claim binding progress.
+type PVCBindStatus struct {
+	// Bound indicates the claim is bound to a volume.
+	Bound bool
+
+	// Settled indicates the claim no longer needs watching.
+	Settled bool
+
+	// Message describes the claim binding state.
+	Message string
+}
+
+// PVCBinding checks on a claim binding progress.
+func PVCBinding(ctx context.Context, f Factory, path string, waitForConsumer bool) (PVCBindStatus, error) {
+	ns, n := client.Namespaced(path)
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return PVCBindStatus{}, err
+	}
+	pvc, err := dial.CoreV1().PersistentVolumeClaims(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return PVCBindStatus{}, err
+	}
+	ll, err := dial.CoreV1().Events(ns).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.Set{
+			"involvedObject.kind": "PersistentVolumeClaim",
+			"involvedObject.name": n,
+		}.String(),
+	})
+	if err != nil {
+		return PVCBindStatus{}, err
+	}
+
+	return pvcBinding(pvc, ll.Items, waitForConsumer), nil
+}
+
+func pvcBinding(pvc *v1.PersistentVolumeClaim, ee []v1.Event, waitForConsumer bool) PVCBindStatus {
+	path := client.FQN(pvc.Namespace, pvc.Name)
+	switch pvc.Status.Phase {
+	case v1.ClaimBound:
+		capacity := pvc.Status.Capacity[v1.ResourceStorage]
+		return PVCBindStatus{
+			Bound:   true,
+			Settled: true,
+			Message: fmt.Sprintf("Claim %s bound to volume %s (%s)", path, pvc.Spec.VolumeName, capacity.String()),
+		}
+	case v1.ClaimLost:
+		return PVCBindStatus{
+			Settled: true,
+			Message: fmt.Sprintf("Claim %s lost its volume %s", path, pvc.Spec.VolumeName),
+		}
+	}
+
+	sortEvents(ee)
+	for _, e := range ee {
+		if e.Type == v1.EventTypeWarning {
+			return PVCBindStatus{
+				Settled: true,
+				Message: fmt.Sprintf("Claim %s pending: %s", path, e.Message),
+			}
+		}
+	}
+	if waitForConsumer {
+		return PVCBindStatus{
+			Settled: true,
+			Message: fmt.Sprintf("Claim %s pending until a pod mounts it (WaitForFirstConsumer)", path),
+		}
+	}
+
+	return PVCBindStatus{Message: fmt.Sprintf("Claim %s pending...", path)}
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPVCSpecBuild(t *testing.T) {
+	uu := map[string]struct {
+		s   PVCSpec
+		err bool
+	}{
+		"ok": {
+			s: PVCSpec{Namespace: "fred", Name: "data", Size: "10Gi", AccessModes: []string{"ReadWriteOnce"}},
+		},
+		"bad-name": {
+			s:   PVCSpec{Namespace: "fred", Name: "Data_1", Size: "10Gi", AccessModes: []string{"ReadWriteOnce"}},
+			err: true,
+		},
+		"all-ns": {
+			s:   PVCSpec{Namespace: "", Name: "data", Size: "10Gi", AccessModes: []string{"ReadWriteOnce"}},
+			err: true,
+		},
+		"bad-size": {
+			s:   PVCSpec{Namespace: "fred", Name: "data", Size: "10GB", AccessModes: []string{"ReadWriteOnce"}},
+			err: true,
+		},
+		"zero-size": {
+			s:   PVCSpec{Namespace: "fred", Name: "data", Size: "0", AccessModes: []string{"ReadWriteOnce"}},
+			err: true,
+		},
+		"no-modes": {
+			s:   PVCSpec{Namespace: "fred", Name: "data", Size: "1Gi"},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pvc, err := u.s.Build()
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "fred", pvc.Namespace)
+			assert.Nil(t, pvc.Spec.StorageClassName)
+			assert.Nil(t, pvc.Spec.VolumeMode)
+			q := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+			assert.Equal(t, "10Gi", q.String())
+		})
+	}
+}
+
+func TestPVCSpecBuildOptionals(t *testing.T) {
+	s := PVCSpec{
+		Namespace:    "fred",
+		Name:         "data",
+		StorageClass: "fast",
+		Size:         "1Gi",
+		AccessModes:  []string{"ReadWriteOnce", "ReadOnlyMany"},
+		VolumeMode:   "Block",
+	}
+	pvc, err := s.Build()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "fast", *pvc.Spec.StorageClassName)
+	assert.Equal(t, v1.PersistentVolumeBlock, *pvc.Spec.VolumeMode)
+	assert.Equal(t, []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce, v1.ReadOnlyMany}, pvc.Spec.AccessModes)
+}
+
+func TestStorageClassInfo(t *testing.T) {
+	wait := storagev1.VolumeBindingWaitForFirstConsumer
+	sc := storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "local-path",
+			Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": "true"},
+		},
+		Provisioner:       "rancher.io/local-path",
+		VolumeBindingMode: &wait,
+	}
+
+	assert.Equal(t, StorageClassInfo{
+		Name:            "local-path",
+		Provisioner:     "rancher.io/local-path",
+		Default:         true,
+		WaitForConsumer: true,
+	}, storageClassInfo(&sc))
+}
+
+func TestPVCBinding(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		phase v1.PersistentVolumeClaimPhase
+		ee    []v1.Event
+		wait  bool
+		e     PVCBindStatus
+	}{
+		"bound": {
+			phase: v1.ClaimBound,
+			e: PVCBindStatus{
+				Bound:   true,
+				Settled: true,
+				Message: "Claim fred/data bound to volume pv-1 (1Gi)",
+			},
+		},
+		"pending": {
+			phase: v1.ClaimPending,
+			ee: []v1.Event{
+				{Type: v1.EventTypeNormal, Message: "provisioning", LastTimestamp: metav1.Time{Time: now}},
+			},
+			e: PVCBindStatus{Message: "Claim fred/data pending..."},
+		},
+		"failed": {
+			phase: v1.ClaimPending,
+			ee: []v1.Event{
+				{Type: v1.EventTypeWarning, Message: "old", LastTimestamp: metav1.Time{Time: now.Add(-time.Minute)}},
+				{Type: v1.EventTypeWarning, Message: "storageclass \"fats\" not found", LastTimestamp: metav1.Time{Time: now}},
+			},
+			e: PVCBindStatus{
+				Settled: true,
+				Message: `Claim fred/data pending: storageclass "fats" not found`,
+			},
+		},
+		"wait-for-consumer": {
+			phase: v1.ClaimPending,
+			wait:  true,
+			e: PVCBindStatus{
+				Settled: true,
+				Message: "Claim fred/data pending until a pod mounts it (WaitForFirstConsumer)",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pvc := v1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "fred", Name: "data"},
+				Spec:       v1.PersistentVolumeClaimSpec{VolumeName: "pv-1"},
+				Status: v1.PersistentVolumeClaimStatus{
+					Phase:    u.phase,
+					Capacity: v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			}
+			assert.Equal(t, u.e, pvcBinding(&pvc, u.ee, u.wait))
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ToPatchType converts an action patch type to an api patch type.
+func ToPatchType(s string) (types.PatchType, error) {
+	switch s {
+	case "", config.MergePatch:
+		return types.MergePatchType, nil
+	case config.JSONPatch:
+		return types.JSONPatchType, nil
+	case config.StrategicPatch:
+		return types.StrategicMergePatchType, nil
+	default:
+		return "", fmt.Errorf("unsupported patch type %q", s)
+	}
+}
+
+// TogglePatch builds a merge patch flipping a boolean field. A missing field
+// is treated as false.
+func TogglePatch(o *unstructured.Unstructured, field string) ([]byte, bool, error) {
+	fields := strings.Split(strings.TrimPrefix(field, "."), ".")
+	v, _, err := unstructured.NestedBool(o.Object, fields...)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var patch interface{} = !v
+	for i := len(fields) - 1; i >= 0; i-- {
+		patch = map[string]interface{}{fields[i]: patch}
+	}
+	bb, err := json.Marshal(patch)
+
+	return bb, !v, err
+}
+
+// PatchResource applies a patch to a resource or one of its subresources.
+func PatchResource(ctx context.Context, f Factory, gvr client.GVR, path string, pt types.PatchType, patch []byte, sub string) error {
+	ns, n := client.Namespaced(path)
+	res := gvr.String()
+	if sub != "" {
+		res += ":" + sub
+	}
+	auth, err := f.Client().CanI(ns, res, n, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", path)
+	}
+
+	dial, err := f.Client().DynDial()
+	if err != nil {
+		return err
+	}
+	var ss []string
+	if sub != "" {
+		ss = append(ss, sub)
+	}
+	r := dial.Resource(gvr.GVR())
+	if client.IsClusterScoped(ns) {
+		_, err = r.Patch(ctx, n, pt, patch, metav1.PatchOptions{}, ss...)
+	} else {
+		_, err = r.Namespace(ns).Patch(ctx, n, pt, patch, metav1.PatchOptions{}, ss...)
+	}
+
+	return err
+}
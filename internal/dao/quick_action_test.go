@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestTogglePatch(t *testing.T) {
+	uu := map[string]struct {
+		o     map[string]interface{}
+		field string
+		e     string
+		on    bool
+	}{
+		"missing": {
+			o:     map[string]interface{}{"spec": map[string]interface{}{}},
+			field: "spec.suspend",
+			e:     `{"spec":{"suspend":true}}`,
+			on:    true,
+		},
+		"on": {
+			o:     map[string]interface{}{"spec": map[string]interface{}{"suspend": true}},
+			field: ".spec.suspend",
+			e:     `{"spec":{"suspend":false}}`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, on, err := TogglePatch(&unstructured.Unstructured{Object: u.o}, u.field)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, string(bb))
+			assert.Equal(t, u.on, on)
+		})
+	}
+}
+
+func TestTogglePatchNotBool(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{"spec": map[string]interface{}{"suspend": "yes"}}}
+	_, _, err := TogglePatch(&o, "spec.suspend")
+	assert.Error(t, err)
+}
+
+func TestToPatchType(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   types.PatchType
+		err bool
+	}{
+		"default":   {e: types.MergePatchType},
+		"json":      {s: "json", e: types.JSONPatchType},
+		"strategic": {s: "strategic", e: types.StrategicMergePatchType},
+		"bozo":      {s: "bozo", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pt, err := ToPatchType(u.s)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, pt)
+		})
+	}
+}
@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+const evictedReason = "Evicted"
+
+// Reap reasons.
+const (
+	ReapSucceeded = "Succeeded"
+	ReapEvicted   = "Evicted"
+	ReapCompleted = "Completed"
+)
+
+// ReapOptions describes the finished resources to delete.
+type ReapOptions struct {
+	// Namespace to reap or all namespaces if blank.
+	Namespace string
+
+	// Succeeded reaps pods that ran to completion.
+	Succeeded bool
+
+	// Evicted reaps evicted pods.
+	Evicted bool
+
+	// Jobs reaps completed jobs.
+	Jobs bool
+
+	// OlderThan only reaps resources finished for at least that long.
+	OlderThan time.Duration
+}
+
+// Reapable represents a finished resource up for deletion.
+type Reapable struct {
+	GVR       client.GVR
+	Namespace string
+	Name      string
+	Reason    string
+}
+
+// Reaper deletes finished pods and jobs in bulk.
+type Reaper struct {
+	NonResource
+}
+
+// Candidates returns the resources matching the options. Pods are filtered
+// server side by phase.
+func (r *Reaper) Candidates(ctx context.Context, opts ReapOptions) ([]Reapable, error) {
+	now := time.Now()
+	var rr []Reapable
+	if opts.Succeeded {
+		pp, err := r.pods(ctx, opts.Namespace, v1.PodSucceeded)
+		if err != nil {
+			return nil, err
+		}
+		for _, po := range pp {
+			if now.Sub(podFinishedAt(&po)) >= opts.OlderThan {
+				rr = append(rr, Reapable{GVR: PodGVR, Namespace: po.Namespace, Name: po.Name, Reason: ReapSucceeded})
+			}
+		}
+	}
+	if opts.Evicted {
+		pp, err := r.pods(ctx, opts.Namespace, v1.PodFailed)
+		if err != nil {
+			return nil, err
+		}
+		for _, po := range pp {
+			if po.Status.Reason == evictedReason && now.Sub(podFinishedAt(&po)) >= opts.OlderThan {
+				rr = append(rr, Reapable{GVR: PodGVR, Namespace: po.Namespace, Name: po.Name, Reason: ReapEvicted})
+			}
+		}
+	}
+	if opts.Jobs {
+		jj, err := r.jobs(ctx, opts.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		for _, job := range jj {
+			if t, ok := jobCompletedAt(&job); ok && now.Sub(t) >= opts.OlderThan {
+				rr = append(rr, Reapable{GVR: JobGVR, Namespace: job.Namespace, Name: job.Name, Reason: ReapCompleted})
+			}
+		}
+	}
+
+	return rr, nil
+}
+
+// Preview summarizes the resources deleted by a reap.
+func (r *Reaper) Preview(rr []Reapable) string {
+	counts, nss := make(map[string]int), make(map[string]int)
+	for _, re := range rr {
+		counts[re.GVR.R()+" ("+re.Reason+")"]++
+		nss[re.Namespace]++
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "total: %d\n", len(rr))
+	for _, k := range sortedKeys(counts) {
+		fmt.Fprintf(&b, "%s: %d\n", k, counts[k])
+	}
+	if len(nss) > 1 {
+		b.WriteString("namespaces:\n")
+		for _, k := range sortedKeys(nss) {
+			fmt.Fprintf(&b, "  %s: %d\n", k, nss[k])
+		}
+	}
+
+	return b.String()
+}
+
+// Reap deletes the given resources and returns how many were deleted.
+func (r *Reaper) Reap(ctx context.Context, rr []Reapable) (int, error) {
+	dial, err := r.Client().DynDial()
+	if err != nil {
+		return 0, err
+	}
+	var (
+		count int
+		errs  []error
+		auths = make(map[string]bool)
+		prop  = metav1.DeletePropagationBackground
+		opts  = metav1.DeleteOptions{PropagationPolicy: &prop}
+	)
+	for _, re := range rr {
+		key := client.FQN(re.Namespace, re.GVR.String())
+		auth, ok := auths[key]
+		if !ok {
+			auth, err = r.Client().CanI(re.Namespace, re.GVR.String(), "", []string{client.DeleteVerb})
+			auth = auth && err == nil
+			auths[key] = auth
+		}
+		if !auth {
+			errs = append(errs, fmt.Errorf("user is not authorized to delete %s in %s", re.GVR, re.Namespace))
+			continue
+		}
+		err := dial.Resource(re.GVR.GVR()).Namespace(re.Namespace).Delete(ctx, re.Name, opts)
+		if err != nil && !kerrors.IsNotFound(err) {
+			errs = append(errs, err)
+			continue
+		}
+		count++
+	}
+
+	return count, errors.Join(errs...)
+}
+
+func (r *Reaper) pods(ctx context.Context, ns string, phase v1.PodPhase) ([]v1.Pod, error) {
+	dial, err := r.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	opts := metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("status.phase", string(phase)).String(),
+		Limit:         scanPageSize,
+	}
+	var pp []v1.Pod
+	for {
+		ll, err := dial.CoreV1().Pods(ns).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		pp = append(pp, ll.Items...)
+		if opts.Continue = ll.Continue; opts.Continue == "" {
+			return pp, nil
+		}
+	}
+}
+
+func (r *Reaper) jobs(ctx context.Context, ns string) ([]batchv1.Job, error) {
+	dial, err := r.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	opts := metav1.ListOptions{Limit: scanPageSize}
+	var jj []batchv1.Job
+	for {
+		ll, err := dial.BatchV1().Jobs(ns).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		jj = append(jj, ll.Items...)
+		if opts.Continue = ll.Continue; opts.Continue == "" {
+			return jj, nil
+		}
+	}
+}
+
+// ParseAge parses a duration that may also be expressed in days ie 7d.
+func ParseAge(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if d, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(d)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid age %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid age %q", s)
+	}
+
+	return d, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// podFinishedAt returns when a pod last had a container terminate or when
+// it started if none did.
+func podFinishedAt(po *v1.Pod) time.Time {
+	var t time.Time
+	for _, cs := range append(append([]v1.ContainerStatus{}, po.Status.InitContainerStatuses...), po.Status.ContainerStatuses...) {
+		if term := cs.State.Terminated; term != nil && term.FinishedAt.After(t) {
+			t = term.FinishedAt.Time
+		}
+	}
+	if !t.IsZero() {
+		return t
+	}
+	if po.Status.StartTime != nil {
+		return po.Status.StartTime.Time
+	}
+
+	return po.CreationTimestamp.Time
+}
+
+func jobCompletedAt(job *batchv1.Job) (time.Time, bool) {
+	for _, c := range job.Status.Conditions {
+		if c.Type != batchv1.JobComplete || c.Status != v1.ConditionTrue {
+			continue
+		}
+		if job.Status.CompletionTime != nil {
+			return job.Status.CompletionTime.Time, true
+		}
+		return c.LastTransitionTime.Time, true
+	}
+
+	return time.Time{}, false
+}
+
+func sortedKeys(m map[string]int) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseAge(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   time.Duration
+		err bool
+	}{
+		"minutes": {s: "30m", e: 30 * time.Minute},
+		"days":    {s: "7d", e: 7 * 24 * time.Hour},
+		"zero":    {s: "0s"},
+		"blank":   {err: true},
+		"bad-day": {s: "xd", err: true},
+		"neg":     {s: "-1h", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := ParseAge(u.s)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, d)
+		})
+	}
+}
+
+func TestPodFinishedAt(t *testing.T) {
+	var (
+		created = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		started = created.Add(time.Minute)
+		done    = created.Add(time.Hour)
+	)
+	uu := map[string]struct {
+		start *metav1.Time
+		cs    []v1.ContainerStatus
+		e     time.Time
+	}{
+		"pending": {e: created},
+		"evicted": {start: &metav1.Time{Time: started}, e: started},
+		"done": {
+			start: &metav1.Time{Time: started},
+			cs: []v1.ContainerStatus{
+				{State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{FinishedAt: metav1.NewTime(done.Add(-time.Minute))}}},
+				{State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{FinishedAt: metav1.NewTime(done)}}},
+			},
+			e: done,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+				Status:     v1.PodStatus{StartTime: u.start, ContainerStatuses: u.cs},
+			}
+			assert.True(t, u.e.Equal(podFinishedAt(&po)))
+		})
+	}
+}
+
+func TestJobCompletedAt(t *testing.T) {
+	done := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	var job batchv1.Job
+	_, ok := jobCompletedAt(&job)
+	assert.False(t, ok)
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionTrue}}
+	_, ok = jobCompletedAt(&job)
+	assert.False(t, ok)
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: v1.ConditionTrue, LastTransitionTime: done}}
+	at, ok := jobCompletedAt(&job)
+	assert.True(t, ok)
+	assert.True(t, done.Time.Equal(at))
+}
+
+func TestReaperPreview(t *testing.T) {
+	var r Reaper
+	rr := []Reapable{
+		{GVR: PodGVR, Namespace: "fred", Name: "p1", Reason: ReapEvicted},
+		{GVR: PodGVR, Namespace: "fred", Name: "p2", Reason: ReapEvicted},
+		{GVR: JobGVR, Namespace: "blee", Name: "j1", Reason: ReapCompleted},
+	}
+	e := "total: 3\njobs (Completed): 1\npods (Evicted): 2\nnamespaces:\n  blee: 1\n  fred: 2\n"
+	assert.Equal(t, e, r.Preview(rr))
+	assert.Equal(t, "total: 1\npods (Evicted): 1\n", r.Preview(rr[:1]))
+}
@@ -4,10 +4,14 @@
 package dao
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
@@ -16,13 +20,19 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 )
 
 const (
-	crdCat  = "crd"
-	k9sCat  = "k9s"
-	helmCat = "helm"
-	crdGVR  = "apiextensions.k8s.io/v1/customresourcedefinitions"
+	crdCat     = "crd"
+	k9sCat     = "k9s"
+	helmCat    = "helm"
+	scaleCat   = "scale"
+	suspendCat = "suspend"
+	crdGVR     = "apiextensions.k8s.io/v1/customresourcedefinitions"
+
+	discoveryRetryMin = 30 * time.Second
+	discoveryRetryMax = 5 * time.Minute
 )
 
 // MetaAccess tracks resources metadata.
@@ -50,13 +60,23 @@ func (m ResourceMetas) clear() {
 
 // Meta represents available resource metas.
 type Meta struct {
-	resMetas ResourceMetas
-	mx       sync.RWMutex
+	resMetas   ResourceMetas
+	cachePath  string
+	discovered time.Time
+	gvks       map[schema.GroupVersionKind]client.GVR
+	failed     map[string]string
+	generation int
+	recoverFn  func([]string)
+	mx         sync.RWMutex
 }
 
 // NewMeta returns a resource meta.
 func NewMeta() *Meta {
-	return &Meta{resMetas: make(ResourceMetas)}
+	return &Meta{
+		resMetas: make(ResourceMetas),
+		gvks:     make(map[schema.GroupVersionKind]client.GVR),
+		failed:   make(map[string]string),
+	}
 }
 
 // AccessorFor returns a client accessor for a resource if registered.
@@ -66,12 +86,24 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 	m := Accessors{
 		client.NewGVR("workloads"):                                         &Workload{},
 		client.NewGVR("contexts"):                                          &Context{},
+		client.NewGVR("fleet"):                                             &Fleet{},
+		client.NewGVR("tlsexpiry"):                                         &TLSExpiry{},
+		client.NewGVR("dnsrecords"):                                        &DNSRecord{},
 		client.NewGVR("containers"):                                        &Container{},
 		client.NewGVR("scans"):                                             &ImageScan{},
 		client.NewGVR("screendumps"):                                       &ScreenDump{},
 		client.NewGVR("benchmarks"):                                        &Benchmark{},
 		client.NewGVR("portforwards"):                                      &PortForward{},
 		client.NewGVR("dir"):                                               &Dir{},
+		client.NewGVR("finalizers"):                                        &Finalizer{},
+		client.NewGVR("changes"):                                           &Change{},
+		client.NewGVR("finds"):                                             &Finder{},
+		client.NewGVR("orphans"):                                           &Orphan{},
+		client.NewGVR("subresources"):                                      &Subresource{},
+		client.NewGVR("apipaths"):                                          &APIPath{},
+		client.NewGVR("inventory"):                                         &Inventory{},
+		client.NewGVR("templates"):                                         &Template{},
+		client.NewGVR("datakeys"):                                          &DataKey{},
 		client.NewGVR("v1/services"):                                       &Service{},
 		client.NewGVR("v1/pods"):                                           &Pod{},
 		client.NewGVR("v1/nodes"):                                          &Node{},
@@ -88,6 +120,10 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("helm"):                                              &HelmChart{},
 		client.NewGVR("helm-history"):                                      &HelmHistory{},
 		client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions"): &CustomResourceDefinition{},
+		client.NewGVR("flowcontrol.apiserver.k8s.io/v1/flowschemas"):       &FlowControl{},
+		client.NewGVR("flowcontrol.apiserver.k8s.io/v1/prioritylevelconfigurations"):      &FlowControl{},
+		client.NewGVR("flowcontrol.apiserver.k8s.io/v1beta3/flowschemas"):                 &FlowControl{},
+		client.NewGVR("flowcontrol.apiserver.k8s.io/v1beta3/prioritylevelconfigurations"): &FlowControl{},
 		// !!BOZO!! Popeye
 		//client.NewGVR("popeye"):                 &Popeye{},
 	}
@@ -108,6 +144,27 @@ func (m *Meta) RegisterMeta(gvr string, res metav1.APIResource) {
 	defer m.mx.Unlock()
 
 	m.resMetas[client.NewGVR(gvr)] = res
+	m.indexGVK(client.NewGVR(gvr), res)
+}
+
+// reindex rebuilds the gvk to gvr index.
+func (m *Meta) reindex() {
+	for k := range m.gvks {
+		delete(m.gvks, k)
+	}
+	for gvr, meta := range m.resMetas {
+		m.indexGVK(gvr, meta)
+	}
+}
+
+// indexGVK records a resource gvk. When several resources share a gvk the
+// first one in lexical order wins so lookups are stable.
+func (m *Meta) indexGVK(gvr client.GVR, meta metav1.APIResource) {
+	gvk := schema.GroupVersionKind{Group: meta.Group, Version: meta.Version, Kind: meta.Kind}
+	if cur, ok := m.gvks[gvk]; ok && cur != gvr && cur.String() < gvr.String() {
+		return
+	}
+	m.gvks[gvk] = gvr
 }
 
 // AllGVRs returns all cluster resources.
@@ -129,13 +186,118 @@ func (m *Meta) GVK2GVR(gv schema.GroupVersion, kind string) (client.GVR, bool, b
 	m.mx.RLock()
 	defer m.mx.RUnlock()
 
-	for gvr, meta := range m.resMetas {
-		if gv.Group == meta.Group && gv.Version == meta.Version && kind == meta.Kind {
-			return gvr, meta.Namespaced, true
+	return m.lookupGVK(gv.WithKind(kind))
+}
+
+func (m *Meta) lookupGVK(gvk schema.GroupVersionKind) (client.GVR, bool, bool) {
+	gvr, ok := m.gvks[gvk]
+	if !ok {
+		return client.NoGVR, false, false
+	}
+
+	return gvr, m.resMetas[gvr].Namespaced, true
+}
+
+var versionRX = regexp.MustCompile(`^v\d+((alpha|beta)\d+)?$`)
+
+// ParseKind parses a kind reference in the shape of Kind.group/version or
+// Kind/version for core resources.
+func ParseKind(s string) (schema.GroupVersionKind, bool) {
+	i := strings.LastIndex(s, "/")
+	if i <= 0 || i == len(s)-1 {
+		return schema.GroupVersionKind{}, false
+	}
+	gvk := schema.GroupVersionKind{Kind: s[:i], Version: s[i+1:]}
+	if j := strings.Index(gvk.Kind, "."); j >= 0 {
+		gvk.Kind, gvk.Group = gvk.Kind[:j], gvk.Kind[j+1:]
+	}
+	if gvk.Kind == "" || strings.Contains(gvk.Kind, "/") || !versionRX.MatchString(gvk.Version) {
+		return schema.GroupVersionKind{}, false
+	}
+
+	return gvk, true
+}
+
+// ResolveKind resolves a Kind.group/version reference to a resource. Kinds
+// match regardless of case. Versions other than the preferred one are looked
+// up via discovery and registered on the fly.
+func (m *Meta) ResolveKind(f Factory, s string) (client.GVR, error) {
+	gvk, ok := ParseKind(s)
+	if !ok {
+		return client.NoGVR, fmt.Errorf("invalid kind reference %q. Use Kind.group/version", s)
+	}
+	if gvr, ok := m.findKind(gvk); ok {
+		return gvr, nil
+	}
+	if f.Client() == nil || !f.Client().ConnectionOK() {
+		return client.NoGVR, fmt.Errorf("no resource found for %q", s)
+	}
+	dial, err := f.Client().CachedDiscovery()
+	if err != nil {
+		return client.NoGVR, err
+	}
+	gv := gvk.GroupVersion().String()
+	rl, err := dial.ServerResourcesForGroupVersion(gv)
+	if err != nil {
+		return client.NoGVR, fmt.Errorf("unable to discover %q: %w", gv, err)
+	}
+	rm := make(ResourceMetas)
+	registerResources(rm, rl)
+	for gvr, meta := range rm {
+		if strings.EqualFold(meta.Kind, gvk.Kind) {
+			m.RegisterMeta(gvr.String(), meta)
+			return gvr, nil
+		}
+	}
+
+	return client.NoGVR, fmt.Errorf("no kind %q found in %q", gvk.Kind, gv)
+}
+
+// SubresourceFor returns the discovery metadata for a resource subresource
+// ie pods/status.
+func (m *Meta) SubresourceFor(f Factory, gvr client.GVR, sub string) (metav1.APIResource, error) {
+	if f.Client() == nil || !f.Client().ConnectionOK() {
+		return metav1.APIResource{}, fmt.Errorf("no subresource found for %s/%s", gvr, sub)
+	}
+	dial, err := f.Client().CachedDiscovery()
+	if err != nil {
+		return metav1.APIResource{}, err
+	}
+	gv := gvr.GV().String()
+	rl, err := dial.ServerResourcesForGroupVersion(gv)
+	if err != nil {
+		return metav1.APIResource{}, fmt.Errorf("unable to discover %q: %w", gv, err)
+	}
+	for _, r := range rl.APIResources {
+		if r.Name == gvr.R()+"/"+sub {
+			return r, nil
+		}
+	}
+
+	return metav1.APIResource{}, fmt.Errorf("%s does not support a %s subresource", gvr, sub)
+}
+
+func (m *Meta) findKind(gvk schema.GroupVersionKind) (client.GVR, bool) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	if gvr, _, ok := m.lookupGVK(gvk); ok {
+		return gvr, true
+	}
+	var (
+		gvr   client.GVR
+		found bool
+	)
+	for k, v := range m.gvks {
+		if k.Group != gvk.Group || k.Version != gvk.Version || !strings.EqualFold(k.Kind, gvk.Kind) {
+			continue
+		}
+		if !found || v.String() < gvr.String() {
+			gvr, found = v, true
 		}
 	}
 
-	return client.NoGVR, false, false
+	return gvr, found
 }
 
 // IsCRD checks if resource represents a CRD
@@ -182,21 +344,201 @@ func IsK9sMeta(m metav1.APIResource) bool {
 	return false
 }
 
+// UseDiscoveryCache persists discovered resources to the given path and
+// loads them from there instead of hitting the api server. A blank path
+// disables the cache.
+func (m *Meta) UseDiscoveryCache(path string) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if m.cachePath != path {
+		m.cachePath, m.discovered = path, time.Time{}
+	}
+}
+
+// DiscoveryStatus returns the discovery cache status.
+func (m *Meta) DiscoveryStatus() DiscoveryStatus {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	var count int
+	for _, meta := range m.resMetas {
+		if !IsK9sMeta(meta) {
+			count++
+		}
+	}
+
+	st := NewDiscoveryStatus(m.cachePath, m.discovered, count, time.Now())
+	if len(m.failed) > 0 {
+		st.FailedGroups = make(map[string]string, len(m.failed))
+		for gv, err := range m.failed {
+			st.FailedGroups[gv] = err
+		}
+	}
+
+	return st
+}
+
+// SetRecoverFn registers a callback invoked once failed api groups are
+// successfully rediscovered in the background.
+func (m *Meta) SetRecoverFn(f func(gvs []string)) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.recoverFn = f
+}
+
 // LoadResources hydrates server preferred+CRDs resource metadata.
 func (m *Meta) LoadResources(f Factory) error {
 	m.mx.Lock()
 	defer m.mx.Unlock()
 
+	if m.cachePath != "" {
+		dc, err := LoadDiscoveryCache(m.cachePath)
+		if err == nil {
+			m.resMetas.clear()
+			for gvr, meta := range dc.Resources {
+				m.resMetas[client.NewGVR(gvr)] = meta
+			}
+			loadNonResource(m.resMetas)
+			m.reindex()
+			m.discovered = dc.Discovered
+			return nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.Warn().Err(err).Msgf("Discovery cache load failed %q", m.cachePath)
+		}
+	}
+
+	return m.discover(f)
+}
+
+// RefreshResources rediscovers resource metadata from the api server and
+// updates the discovery cache if enabled.
+func (m *Meta) RefreshResources(f Factory) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	return m.discover(f)
+}
+
+func (m *Meta) discover(f Factory) error {
+	t := time.Now()
 	m.resMetas.clear()
-	if err := loadPreferred(f, m.resMetas); err != nil {
+	m.reindex()
+	m.generation++
+	failed, err := loadPreferred(f, m.resMetas)
+	if err != nil {
 		return err
 	}
-	loadNonResource(m.resMetas)
+	m.failed = failed
+	client.Stats.RecordPhase("discovery.preferred", time.Since(t))
+	crdT := time.Now()
 	loadCRDs(f, m.resMetas)
+	client.Stats.RecordPhase("discovery.crds", time.Since(crdT))
+	if len(m.failed) > 0 {
+		log.Warn().Msgf("Discovery failed for api groups %v. Retrying in the background", failedGroups(m.failed))
+		go m.retryFailed(f, m.generation)
+	} else {
+		m.saveCache()
+	}
+	loadNonResource(m.resMetas)
+	m.reindex()
 
 	return nil
 }
 
+// saveCache persists the discovered resources. Partial discoveries are
+// not cached so failed groups are not lost for good.
+func (m *Meta) saveCache() {
+	if m.cachePath == "" || len(m.resMetas) == 0 {
+		return
+	}
+	now := time.Now()
+	if err := NewDiscoveryCache(m.resMetas, now).Save(m.cachePath); err != nil {
+		log.Warn().Err(err).Msgf("Discovery cache save failed %q", m.cachePath)
+		return
+	}
+	m.discovered = now
+}
+
+// retryFailed rediscovers the failed api groups with exponential backoff
+// until they all come back or a new discovery supersedes this one.
+func (m *Meta) retryFailed(f Factory, gen int) {
+	for delay := discoveryRetryMin; ; delay = min(2*delay, discoveryRetryMax) {
+		<-time.After(delay)
+		done, ok := m.retryGroups(f, gen)
+		if !ok {
+			return
+		}
+		if len(done) == 0 {
+			continue
+		}
+		m.mx.Lock()
+		left, fn := len(m.failed), m.recoverFn
+		if left == 0 {
+			m.saveCache()
+		}
+		m.mx.Unlock()
+		log.Info().Msgf("Discovery recovered api groups %v", done)
+		if fn != nil {
+			fn(done)
+		}
+		if left == 0 {
+			return
+		}
+	}
+}
+
+// retryGroups rediscovers failed api groups and returns the recovered ones.
+// It bails out if a new discovery superseded the given one.
+func (m *Meta) retryGroups(f Factory, gen int) ([]string, bool) {
+	m.mx.RLock()
+	gvs, cur := failedGroups(m.failed), m.generation
+	m.mx.RUnlock()
+	if gen != cur {
+		return nil, false
+	}
+	if f.Client() == nil || !f.Client().ConnectionOK() {
+		return nil, true
+	}
+	dial, err := f.Client().CachedDiscovery()
+	if err != nil {
+		return nil, true
+	}
+
+	done := make([]string, 0, len(gvs))
+	for _, gv := range gvs {
+		rl, err := dial.ServerResourcesForGroupVersion(gv)
+		m.mx.Lock()
+		if gen != m.generation {
+			m.mx.Unlock()
+			return nil, false
+		}
+		if err != nil {
+			m.failed[gv] = err.Error()
+		} else {
+			registerResources(m.resMetas, rl)
+			m.reindex()
+			delete(m.failed, gv)
+			done = append(done, gv)
+		}
+		m.mx.Unlock()
+	}
+
+	return done, true
+}
+
+func failedGroups(m map[string]string) []string {
+	gvs := make([]string, 0, len(m))
+	for gv := range m {
+		gvs = append(gvs, gv)
+	}
+	sort.Strings(gvs)
+
+	return gvs
+}
+
 // BOZO!! Need countermeasures for direct commands!
 func loadNonResource(m ResourceMetas) {
 	loadK9s(m)
@@ -246,6 +588,13 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{k9sCat},
 	}
+	m[client.NewGVR("help")] = metav1.APIResource{
+		Name:         "help",
+		Kind:         "Help",
+		SingularName: "help",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
 	m[client.NewGVR("popeye")] = metav1.APIResource{
 		Name:         "popeye",
 		Kind:         "Popeye",
@@ -269,6 +618,32 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{k9sCat},
 	}
+	m[client.NewGVR("fleet")] = metav1.APIResource{
+		Name:         "fleet",
+		Kind:         "Fleet",
+		SingularName: "fleet",
+		ShortNames:   []string{"fl"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("tlsexpiry")] = metav1.APIResource{
+		Name:         "tlsexpiry",
+		Kind:         "TLSExpiry",
+		SingularName: "tlsexpiry",
+		ShortNames:   []string{"tlsexp"},
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("dnsrecords")] = metav1.APIResource{
+		Name:         "dnsrecords",
+		Kind:         "DNSRecord",
+		SingularName: "dnsrecord",
+		ShortNames:   []string{"dnsrec"},
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
 	m[client.NewGVR("screendumps")] = metav1.APIResource{
 		Name:         "screendumps",
 		Kind:         "ScreenDumps",
@@ -308,6 +683,80 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{k9sCat},
 	}
+	m[client.NewGVR("finalizers")] = metav1.APIResource{
+		Name:         "finalizers",
+		Kind:         "Finalizers",
+		SingularName: "finalizer",
+		Namespaced:   true,
+		ShortNames:   []string{"fin", "stuck"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("changes")] = metav1.APIResource{
+		Name:         "changes",
+		Kind:         "Changes",
+		SingularName: "change",
+		Namespaced:   true,
+		ShortNames:   []string{"chg"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("finds")] = metav1.APIResource{
+		Name:         "finds",
+		Kind:         "Finds",
+		SingularName: "find",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("orphans")] = metav1.APIResource{
+		Name:         "orphans",
+		Kind:         "Orphans",
+		SingularName: "orphan",
+		Namespaced:   true,
+		ShortNames:   []string{"orph", "garbage"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("subresources")] = metav1.APIResource{
+		Name:         "subresources",
+		Kind:         "Subresources",
+		SingularName: "subresource",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("apipaths")] = metav1.APIResource{
+		Name:         "apipaths",
+		Kind:         "APIPaths",
+		SingularName: "apipath",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("inventory")] = metav1.APIResource{
+		Name:         "inventory",
+		Kind:         "Inventory",
+		SingularName: "inventory",
+		Namespaced:   true,
+		ShortNames:   []string{"inv"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("templates")] = metav1.APIResource{
+		Name:         "templates",
+		Kind:         "Templates",
+		SingularName: "template",
+		ShortNames:   []string{"tpl", "create"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("datakeys")] = metav1.APIResource{
+		Name:         "datakeys",
+		Kind:         "DataKeys",
+		SingularName: "datakey",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
 }
 
 func loadHelm(m ResourceMetas) {
@@ -351,38 +800,68 @@ func loadRBAC(m ResourceMetas) {
 	}
 }
 
-func loadPreferred(f Factory, m ResourceMetas) error {
+// preferredLister represents a connection that serves its own resources
+// without a discovery client ie demo mode.
+type preferredLister interface {
+	ServerPreferredResources() ([]*metav1.APIResourceList, error)
+}
+
+// loadPreferred loads the server preferred resources. Groups failing
+// discovery, ie a dead aggregated api, are skipped and returned.
+func loadPreferred(f Factory, m ResourceMetas) (map[string]string, error) {
+	failed := make(map[string]string)
 	if f.Client() == nil || !f.Client().ConnectionOK() {
 		log.Error().Msgf("Load cluster resources - No API server connection")
-		return nil
+		return failed, nil
 	}
 
-	dial, err := f.Client().CachedDiscovery()
-	if err != nil {
-		return err
+	var (
+		rr  []*metav1.APIResourceList
+		err error
+	)
+	if p, ok := f.Client().(preferredLister); ok {
+		rr, err = p.ServerPreferredResources()
+	} else {
+		dial, derr := f.Client().CachedDiscovery()
+		if derr != nil {
+			return failed, derr
+		}
+		rr, err = dial.ServerPreferredResources()
 	}
-	rr, err := dial.ServerPreferredResources()
-	if err != nil {
+	var gerr *discovery.ErrGroupDiscoveryFailed
+	switch {
+	case errors.As(err, &gerr):
+		for gv, e := range gerr.Groups {
+			failed[gv.String()] = e.Error()
+		}
+	case err != nil:
 		log.Debug().Err(err).Msgf("Failed to load preferred resources")
 	}
 	for _, r := range rr {
-		for _, res := range r.APIResources {
-			gvr := client.FromGVAndR(r.GroupVersion, res.Name)
-			if isDeprecated(gvr) {
-				continue
-			}
-			res.Group, res.Version = gvr.G(), gvr.V()
-			if res.SingularName == "" {
-				res.SingularName = strings.ToLower(res.Kind)
-			}
-			if !isStandardGroup(r.GroupVersion) {
-				res.Categories = append(res.Categories, crdCat)
-			}
-			m[gvr] = res
-		}
+		registerResources(m, r)
 	}
 
-	return nil
+	return failed, nil
+}
+
+func registerResources(m ResourceMetas, r *metav1.APIResourceList) {
+	if r == nil {
+		return
+	}
+	for _, res := range r.APIResources {
+		gvr := client.FromGVAndR(r.GroupVersion, res.Name)
+		if isDeprecated(gvr) || strings.Contains(res.Name, "/") {
+			continue
+		}
+		res.Group, res.Version = gvr.G(), gvr.V()
+		if res.SingularName == "" {
+			res.SingularName = strings.ToLower(res.Kind)
+		}
+		if !isStandardGroup(r.GroupVersion) {
+			res.Categories = append(res.Categories, crdCat)
+		}
+		m[gvr] = res
+	}
 }
 
 func isStandardGroup(gv string) bool {
@@ -419,6 +898,12 @@ func loadCRDs(f Factory, m ResourceMetas) {
 			continue
 		}
 		meta.Categories = append(meta.Categories, crdCat)
+		if u, ok := o.(*unstructured.Unstructured); ok && hasScaleSubresource(u) {
+			meta.Categories = append(meta.Categories, scaleCat)
+		}
+		if u, ok := o.(*unstructured.Unstructured); ok && hasSuspendField(u) {
+			meta.Categories = append(meta.Categories, suspendCat)
+		}
 		gvr := client.NewGVRFromMeta(meta)
 		m[gvr] = meta
 	}
@@ -9,9 +9,11 @@ import (
 	"os"
 	"testing"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestExtractMeta(t *testing.T) {
@@ -101,3 +103,117 @@ func load(t *testing.T, n string) *unstructured.Unstructured {
 
 	return &o
 }
+
+func TestRegisterResources(t *testing.T) {
+	m := make(ResourceMetas)
+	registerResources(m, &metav1.APIResourceList{
+		GroupVersion: "metrics.k8s.io/v1beta1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "PodMetrics"},
+			{Name: "pods/status", Kind: "PodMetrics"},
+		},
+	})
+	registerResources(m, &metav1.APIResourceList{
+		GroupVersion: "fred.io/v1",
+		APIResources: []metav1.APIResource{{Name: "blees", Kind: "Blee"}},
+	})
+	registerResources(m, nil)
+
+	assert.Equal(t, 2, len(m))
+	mx := m[client.NewGVR("metrics.k8s.io/v1beta1/pods")]
+	assert.Equal(t, "metrics.k8s.io", mx.Group)
+	assert.Equal(t, "podmetrics", mx.SingularName)
+	assert.Empty(t, mx.Categories)
+	assert.Equal(t, []string{crdCat}, m[client.NewGVR("fred.io/v1/blees")].Categories)
+}
+
+func TestGVK2GVR(t *testing.T) {
+	m := NewMeta()
+	m.RegisterMeta("apps/v1/deployments", metav1.APIResource{
+		Name: "deployments", Group: "apps", Version: "v1", Kind: "Deployment", Namespaced: true,
+	})
+	m.RegisterMeta("v1/nodes", metav1.APIResource{
+		Name: "nodes", Version: "v1", Kind: "Node",
+	})
+
+	gvr, namespaced, ok := m.GVK2GVR(schema.GroupVersion{Group: "apps", Version: "v1"}, "Deployment")
+	assert.True(t, ok)
+	assert.True(t, namespaced)
+	assert.Equal(t, "apps/v1/deployments", gvr.String())
+
+	gvr, namespaced, ok = m.GVK2GVR(schema.GroupVersion{Version: "v1"}, "Node")
+	assert.True(t, ok)
+	assert.False(t, namespaced)
+	assert.Equal(t, "v1/nodes", gvr.String())
+
+	gvr, _, ok = m.GVK2GVR(schema.GroupVersion{Group: "fred.io", Version: "v1"}, "Blee")
+	assert.False(t, ok)
+	assert.Equal(t, client.NoGVR, gvr)
+}
+
+func TestGVKIndexStable(t *testing.T) {
+	m := NewMeta()
+	res := metav1.APIResource{Group: "fred.io", Version: "v1", Kind: "Blee"}
+	m.RegisterMeta("fred.io/v1/zblees", res)
+	m.RegisterMeta("fred.io/v1/blees", res)
+	m.RegisterMeta("fred.io/v1/yblees", res)
+	m.reindex()
+
+	gvr, _, ok := m.GVK2GVR(schema.GroupVersion{Group: "fred.io", Version: "v1"}, "Blee")
+	assert.True(t, ok)
+	assert.Equal(t, "fred.io/v1/blees", gvr.String())
+}
+
+func TestParseKind(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		gvk schema.GroupVersionKind
+		ok  bool
+	}{
+		"crd": {
+			s:   "Application.argoproj.io/v1alpha1",
+			gvk: schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Application"},
+			ok:  true,
+		},
+		"core": {
+			s:   "pod/v1",
+			gvk: schema.GroupVersionKind{Version: "v1", Kind: "pod"},
+			ok:  true,
+		},
+		"gvr": {
+			s: "apps/v1/deployments",
+		},
+		"no-version": {
+			s: "Application.argoproj.io",
+		},
+		"bad-version": {
+			s: "po/fred",
+		},
+		"no-kind": {
+			s: ".argoproj.io/v1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gvk, ok := ParseKind(u.s)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.gvk, gvk)
+		})
+	}
+}
+
+func TestFindKind(t *testing.T) {
+	m := NewMeta()
+	m.RegisterMeta("argoproj.io/v1alpha1/applications", metav1.APIResource{
+		Name: "applications", Group: "argoproj.io", Version: "v1alpha1", Kind: "Application", Namespaced: true,
+	})
+
+	gvr, ok := m.findKind(schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "application"})
+	assert.True(t, ok)
+	assert.Equal(t, "argoproj.io/v1alpha1/applications", gvr.String())
+
+	_, ok = m.findKind(schema.GroupVersionKind{Group: "argoproj.io", Version: "v1", Kind: "Application"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// RolloutStatus reports a workload rollout progress and whether it completed.
+func RolloutStatus(o *unstructured.Unstructured) (string, bool, error) {
+	switch o.GetKind() {
+	case "Deployment":
+		var dp appsv1.Deployment
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.Object, &dp); err != nil {
+			return "", false, err
+		}
+		return deploymentRollout(&dp)
+	case "StatefulSet":
+		var sts appsv1.StatefulSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.Object, &sts); err != nil {
+			return "", false, err
+		}
+		return statefulSetRollout(&sts)
+	case "DaemonSet":
+		var ds appsv1.DaemonSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.Object, &ds); err != nil {
+			return "", false, err
+		}
+		return daemonSetRollout(&ds)
+	default:
+		return "", false, fmt.Errorf("no rollout status for kind %q", o.GetKind())
+	}
+}
+
+func deploymentRollout(dp *appsv1.Deployment) (string, bool, error) {
+	if dp.Generation > dp.Status.ObservedGeneration {
+		return "waiting for rollout to be observed", false, nil
+	}
+	for _, c := range dp.Status.Conditions {
+		if c.Type == appsv1.DeploymentProgressing && c.Reason == "ProgressDeadlineExceeded" {
+			return "", false, fmt.Errorf("rollout exceeded its progress deadline")
+		}
+	}
+	var desired int32 = 1
+	if dp.Spec.Replicas != nil {
+		desired = *dp.Spec.Replicas
+	}
+	st := dp.Status
+	switch {
+	case st.UpdatedReplicas < desired:
+		return fmt.Sprintf("%d/%d replicas updated", st.UpdatedReplicas, desired), false, nil
+	case st.Replicas > st.UpdatedReplicas:
+		return fmt.Sprintf("%d old replicas pending termination", st.Replicas-st.UpdatedReplicas), false, nil
+	case st.AvailableReplicas < st.UpdatedReplicas:
+		return fmt.Sprintf("%d/%d updated replicas available", st.AvailableReplicas, st.UpdatedReplicas), false, nil
+	}
+
+	return "successfully rolled out", true, nil
+}
+
+func statefulSetRollout(sts *appsv1.StatefulSet) (string, bool, error) {
+	if sts.Spec.UpdateStrategy.Type != appsv1.RollingUpdateStatefulSetStrategyType {
+		return "", true, fmt.Errorf("rollout status is only available for %s strategy", appsv1.RollingUpdateStatefulSetStrategyType)
+	}
+	if sts.Generation > sts.Status.ObservedGeneration {
+		return "waiting for rollout to be observed", false, nil
+	}
+	var desired int32 = 1
+	if sts.Spec.Replicas != nil {
+		desired = *sts.Spec.Replicas
+	}
+	st := sts.Status
+	switch {
+	case st.ReadyReplicas < desired:
+		return fmt.Sprintf("%d/%d pods ready", st.ReadyReplicas, desired), false, nil
+	case st.UpdateRevision != st.CurrentRevision:
+		return fmt.Sprintf("%d/%d pods updated", st.UpdatedReplicas, desired), false, nil
+	}
+
+	return "successfully rolled out", true, nil
+}
+
+func daemonSetRollout(ds *appsv1.DaemonSet) (string, bool, error) {
+	if ds.Spec.UpdateStrategy.Type != appsv1.RollingUpdateDaemonSetStrategyType {
+		return "", true, fmt.Errorf("rollout status is only available for %s strategy", appsv1.RollingUpdateDaemonSetStrategyType)
+	}
+	if ds.Generation > ds.Status.ObservedGeneration {
+		return "waiting for rollout to be observed", false, nil
+	}
+	st := ds.Status
+	switch {
+	case st.UpdatedNumberScheduled < st.DesiredNumberScheduled:
+		return fmt.Sprintf("%d/%d pods updated", st.UpdatedNumberScheduled, st.DesiredNumberScheduled), false, nil
+	case st.NumberAvailable < st.DesiredNumberScheduled:
+		return fmt.Sprintf("%d/%d pods available", st.NumberAvailable, st.DesiredNumberScheduled), false, nil
+	}
+
+	return "successfully rolled out", true, nil
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestRolloutStatusDeployment(t *testing.T) {
+	three := int32(3)
+	uu := map[string]struct {
+		gen int64
+		st  appsv1.DeploymentStatus
+		msg string
+		ok  bool
+	}{
+		"unobserved": {
+			gen: 2,
+			st:  appsv1.DeploymentStatus{ObservedGeneration: 1},
+			msg: "waiting for rollout to be observed",
+		},
+		"updating": {
+			gen: 1,
+			st:  appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 4, UpdatedReplicas: 1},
+			msg: "1/3 replicas updated",
+		},
+		"terminating": {
+			gen: 1,
+			st:  appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 4, UpdatedReplicas: 3},
+			msg: "1 old replicas pending termination",
+		},
+		"unavailable": {
+			gen: 1,
+			st:  appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 2},
+			msg: "2/3 updated replicas available",
+		},
+		"done": {
+			gen: 1,
+			st:  appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			msg: "successfully rolled out",
+			ok:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dp := appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "fred", Generation: u.gen},
+				Spec:       appsv1.DeploymentSpec{Replicas: &three},
+				Status:     u.st,
+			}
+			m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&dp)
+			assert.Nil(t, err)
+
+			msg, ok, err := RolloutStatus(&unstructured.Unstructured{Object: m})
+			assert.Nil(t, err)
+			assert.Equal(t, u.msg, msg)
+			assert.Equal(t, u.ok, ok)
+		})
+	}
+}
+
+func TestRolloutStatusUnsupported(t *testing.T) {
+	var u unstructured.Unstructured
+	u.SetKind("Pod")
+
+	_, _, err := RolloutStatus(&u)
+	assert.Error(t, err)
+}
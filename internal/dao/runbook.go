@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	maxRunbookSize      = 1 << 20
+	runbookFetchTimeout = 10 * time.Second
+)
+
+// ResourceRunbooks renders the runbooks attached to a resource instance.
+func ResourceRunbooks(ctx context.Context, f Factory, gvr client.GVR, path string, rr config.Runbooks) (string, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		b    strings.Builder
+		hits int
+	)
+	for _, r := range rr {
+		if !r.Matches(gvr.String(), m.GetNamespace(), m.GetLabels()) {
+			continue
+		}
+		if hits > 0 {
+			b.WriteString("\n")
+		}
+		hits++
+		fmt.Fprintf(&b, "<<< %s >>>\n\n", r.Name)
+		b.WriteString(ReadRunbook(ctx, r))
+	}
+	if hits == 0 {
+		return "", fmt.Errorf("no runbooks attached to %s", path)
+	}
+
+	return b.String(), nil
+}
+
+// ReadRunbook returns a runbook file and url contents. Load errors are
+// reported inline so other runbooks still show.
+func ReadRunbook(ctx context.Context, r config.Runbook) string {
+	var b strings.Builder
+	if r.File != "" {
+		bb, err := os.ReadFile(r.Path())
+		if err != nil {
+			fmt.Fprintf(&b, "Runbook file unavailable: %s\n", err)
+		} else {
+			b.Write(bb)
+		}
+	}
+	if r.URL != "" {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "Source: %s\n\n", r.URL)
+		s, err := fetchRunbook(ctx, r.URL)
+		if err != nil {
+			fmt.Fprintf(&b, "Runbook url unavailable: %s\n", err)
+		} else {
+			b.WriteString(s)
+		}
+	}
+
+	return b.String()
+}
+
+func fetchRunbook(ctx context.Context, url string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, runbookFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "text/markdown, text/plain;q=0.9, */*;q=0.5")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GET %s returned %s", url, resp.Status)
+	}
+	bb, err := io.ReadAll(io.LimitReader(resp.Body, maxRunbookSize))
+	if err != nil {
+		return "", err
+	}
+
+	return string(bb), nil
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadRunbook(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/pg.md" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("## Failover\n"))
+	}))
+	defer srv.Close()
+
+	file := filepath.Join(t.TempDir(), "pg.md")
+	assert.NoError(t, os.WriteFile(file, []byte("# Postgres\n"), 0600))
+
+	uu := map[string]struct {
+		r config.Runbook
+		e string
+	}{
+		"file": {
+			r: config.Runbook{File: file},
+			e: "# Postgres\n",
+		},
+		"url": {
+			r: config.Runbook{URL: srv.URL + "/pg.md"},
+			e: "Source: " + srv.URL + "/pg.md\n\n## Failover\n",
+		},
+		"both": {
+			r: config.Runbook{File: file, URL: srv.URL + "/pg.md"},
+			e: "# Postgres\n\nSource: " + srv.URL + "/pg.md\n\n## Failover\n",
+		},
+		"missing": {
+			r: config.Runbook{URL: srv.URL + "/blee.md"},
+			e: "Source: " + srv.URL + "/blee.md\n\nRunbook url unavailable: GET " + srv.URL + "/blee.md returned 404 Not Found\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ReadRunbook(context.Background(), u.r))
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	scaleSubresource = "scale"
+	maxScaleHistory  = 5
+)
+
+var (
+	_ Scalable = (*Generic)(nil)
+
+	scaleReasons = map[string]struct{}{
+		"ScalingReplicaSet": {},
+		"SuccessfulRescale": {},
+	}
+)
+
+// Scale scales a resource exposing a scale subresource.
+func (g *Generic) Scale(ctx context.Context, path string, replicas int32) error {
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvrStr()+":"+scaleSubresource, n, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to scale %s", path)
+	}
+
+	dial, err := g.dynClient()
+	if err != nil {
+		return err
+	}
+	patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)
+	if client.IsClusterScoped(ns) {
+		_, err = dial.Patch(ctx, n, types.MergePatchType, []byte(patch), metav1.PatchOptions{}, scaleSubresource)
+	} else {
+		_, err = dial.Namespace(ns).Patch(ctx, n, types.MergePatchType, []byte(patch), metav1.PatchOptions{}, scaleSubresource)
+	}
+
+	return err
+}
+
+// Replicas returns the desired replicas of a resource via its scale subresource.
+func (g *Generic) Replicas(ctx context.Context, path string) (int32, error) {
+	ns, n := client.Namespaced(path)
+	dial, err := g.dynClient()
+	if err != nil {
+		return 0, err
+	}
+	var o *unstructured.Unstructured
+	if client.IsClusterScoped(ns) {
+		o, err = dial.Get(ctx, n, metav1.GetOptions{}, scaleSubresource)
+	} else {
+		o, err = dial.Namespace(ns).Get(ctx, n, metav1.GetOptions{}, scaleSubresource)
+	}
+	if err != nil {
+		return 0, err
+	}
+	r, _, err := unstructured.NestedInt64(o.Object, "spec", "replicas")
+
+	return int32(r), err
+}
+
+// HPAFor returns the autoscaler targeting a given resource if any.
+func HPAFor(ctx context.Context, f Factory, gvr client.GVR, path string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	meta, err := MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+	ns, n := client.Namespaced(path)
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	ll, err := dial.AutoscalingV2().HorizontalPodAutoscalers(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range ll.Items {
+		if scaleTargets(ll.Items[i].Spec.ScaleTargetRef, gvr.G(), meta.Kind, n) {
+			return &ll.Items[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// scaleTargets checks if an autoscaler scale target references the given
+// resource, matching its api group as well as its kind and name.
+func scaleTargets(ref autoscalingv2.CrossVersionObjectReference, group, kind, name string) bool {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return false
+	}
+
+	return gv.Group == group && ref.Kind == kind && ref.Name == name
+}
+
+// UpdateHPABounds updates an autoscaler replicas range.
+func UpdateHPABounds(ctx context.Context, f Factory, path string, min, max int32) error {
+	if min < 1 || max < min {
+		return fmt.Errorf("invalid replicas range [%d, %d]", min, max)
+	}
+	ns, n := client.Namespaced(path)
+	auth, err := f.Client().CanI(ns, "autoscaling/v2/horizontalpodautoscalers", n, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch hpa %s", path)
+	}
+
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return err
+	}
+	patch := fmt.Sprintf(`{"spec":{"minReplicas":%d,"maxReplicas":%d}}`, min, max)
+	_, err = dial.AutoscalingV2().HorizontalPodAutoscalers(ns).Patch(
+		ctx,
+		n,
+		types.MergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{},
+	)
+
+	return err
+}
+
+// ScaleHistory returns the most recent scaling events for the given resources.
+func ScaleHistory(ctx context.Context, f Factory, ns string, names ...string) ([]string, error) {
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	ee := make([]v1.Event, 0, 10)
+	for _, n := range names {
+		ll, err := dial.CoreV1().Events(ns).List(ctx, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("involvedObject.name", n).String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range ll.Items {
+			if _, ok := scaleReasons[e.Reason]; ok {
+				ee = append(ee, e)
+			}
+		}
+	}
+
+	return formatScaleEvents(ee, time.Now()), nil
+}
+
+func formatScaleEvents(ee []v1.Event, now time.Time) []string {
+	sort.Slice(ee, func(i, j int) bool {
+		return eventTime(ee[i]).After(eventTime(ee[j]))
+	})
+	if len(ee) > maxScaleHistory {
+		ee = ee[:maxScaleHistory]
+	}
+	hh := make([]string, 0, len(ee))
+	for _, e := range ee {
+		hh = append(hh, duration.HumanDuration(now.Sub(eventTime(e)))+" ago: "+e.Message)
+	}
+
+	return hh
+}
+
+func eventTime(e v1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+// IsScalable checks if a resource exposes a scale subresource.
+func IsScalable(m metav1.APIResource) bool {
+	for _, c := range m.Categories {
+		if c == scaleCat {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasScaleSubresource(crd *unstructured.Unstructured) bool {
+	vv, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range vv {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok, _ := unstructured.NestedMap(m, "subresources", "scale"); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestHasScaleSubresource(t *testing.T) {
+	uu := map[string]struct {
+		vv []interface{}
+		e  bool
+	}{
+		"none": {},
+		"status": {
+			vv: []interface{}{
+				map[string]interface{}{
+					"name":         "v1",
+					"subresources": map[string]interface{}{"status": map[string]interface{}{}},
+				},
+			},
+		},
+		"scale": {
+			vv: []interface{}{
+				map[string]interface{}{"name": "v1alpha1"},
+				map[string]interface{}{
+					"name": "v1",
+					"subresources": map[string]interface{}{
+						"scale": map[string]interface{}{"specReplicasPath": ".spec.replicas"},
+					},
+				},
+			},
+			e: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{"versions": u.vv},
+			}}
+			assert.Equal(t, u.e, hasScaleSubresource(&o))
+		})
+	}
+}
+
+func TestFormatScaleEvents(t *testing.T) {
+	now := time.Now()
+	ee := []v1.Event{
+		{Message: "fred", LastTimestamp: metav1.NewTime(now.Add(-10 * time.Minute))},
+		{Message: "blee", LastTimestamp: metav1.NewTime(now.Add(-2 * time.Minute))},
+		{Message: "duh", EventTime: metav1.NewMicroTime(now.Add(-5 * time.Minute))},
+	}
+
+	assert.Equal(t, []string{
+		"2m ago: blee",
+		"5m ago: duh",
+		"10m ago: fred",
+	}, formatScaleEvents(ee, now))
+}
+
+func TestScaleTargets(t *testing.T) {
+	uu := map[string]struct {
+		ref autoscalingv2.CrossVersionObjectReference
+		e   bool
+	}{
+		"match": {
+			ref: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "fred"},
+			e:   true,
+		},
+		"other-group": {
+			ref: autoscalingv2.CrossVersionObjectReference{APIVersion: "fred.io/v1", Kind: "Deployment", Name: "fred"},
+		},
+		"other-name": {
+			ref: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "blee"},
+		},
+		"bad-version": {
+			ref: autoscalingv2.CrossVersionObjectReference{APIVersion: "a/b/c", Kind: "Deployment", Name: "fred"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, scaleTargets(u.ref, "apps", "Deployment", "fred"))
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+// NSScope tracks the user access rules when running namespace scoped.
+var NSScope = NewScopedAccess()
+
+// ScopedAccess restricts resources and namespaces to the ones the user can
+// list when cluster wide access is denied.
+type ScopedAccess struct {
+	enabled bool
+	rules   client.AccessRules
+	mx      sync.RWMutex
+}
+
+// NewScopedAccess returns a new instance.
+func NewScopedAccess() *ScopedAccess {
+	return &ScopedAccess{}
+}
+
+// Probe fetches the user access rules for the given namespaces and turns on
+// namespace scoped mode. Scoped mode stays off when no namespace could be
+// probed so access is not denied across the board.
+func (s *ScopedAccess) Probe(ctx context.Context, f Factory, nss []string) error {
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return err
+	}
+	rules := make(client.AccessRules, len(nss))
+	for _, ns := range nss {
+		if client.IsAllNamespaces(ns) {
+			continue
+		}
+		rr, err := client.FetchRules(ctx, dial, ns)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Rules review failed for namespace %q", ns)
+			continue
+		}
+		rules[ns] = rr
+	}
+	if len(rules) == 0 {
+		return errors.New("no namespace could be probed")
+	}
+	s.Set(rules)
+
+	return nil
+}
+
+// Set turns on namespace scoped mode with the given rules.
+func (s *ScopedAccess) Set(rules client.AccessRules) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.enabled, s.rules = true, rules
+}
+
+// Reset turns off namespace scoped mode.
+func (s *ScopedAccess) Reset() {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.enabled, s.rules = false, nil
+}
+
+// IsEnabled checks if namespace scoped mode is on.
+func (s *ScopedAccess) IsEnabled() bool {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	return s.enabled
+}
+
+// Namespaces returns the probed namespaces.
+func (s *ScopedAccess) Namespaces() []string {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	nss := make([]string, 0, len(s.rules))
+	for ns := range s.rules {
+		nss = append(nss, ns)
+	}
+	sort.Strings(nss)
+
+	return nss
+}
+
+// CanList checks if a resource is listable in any probed namespace. K9s
+// internal resources are always allowed.
+func (s *ScopedAccess) CanList(gvr client.GVR) bool {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	if !s.enabled {
+		return true
+	}
+	if m, err := MetaAccess.MetaFor(gvr); err == nil && !IsK8sMeta(m) {
+		return true
+	}
+
+	return len(s.rules.Namespaces(gvr, client.ListVerb)) > 0
+}
+
+// CanAccessNamespace checks if a namespace was probed.
+func (s *ScopedAccess) CanAccessNamespace(ns string) bool {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	if !s.enabled {
+		return true
+	}
+	_, ok := s.rules[ns]
+
+	return ok
+}
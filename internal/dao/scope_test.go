@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+func TestScopedAccess(t *testing.T) {
+	s := dao.NewScopedAccess()
+	assert.False(t, s.IsEnabled())
+	assert.True(t, s.CanList(client.NewGVR("v1/secrets")))
+	assert.True(t, s.CanAccessNamespace("zorg"))
+
+	s.Set(client.AccessRules{
+		"fred": []authorizationv1.ResourceRule{
+			{Verbs: []string{"list"}, APIGroups: []string{""}, Resources: []string{"pods"}},
+		},
+		"blee": nil,
+	})
+	assert.True(t, s.IsEnabled())
+	assert.Equal(t, []string{"blee", "fred"}, s.Namespaces())
+	assert.True(t, s.CanList(client.NewGVR("v1/pods")))
+	assert.False(t, s.CanList(client.NewGVR("v1/secrets")))
+	assert.True(t, s.CanAccessNamespace("blee"))
+	assert.False(t, s.CanAccessNamespace("zorg"))
+	assert.False(t, s.CanAccessNamespace(client.NamespaceAll))
+
+	s.Reset()
+	assert.False(t, s.IsEnabled())
+	assert.True(t, s.CanList(client.NewGVR("v1/secrets")))
+}
+
+func TestScopedAccessProbeAllNamespaces(t *testing.T) {
+	s := dao.NewScopedAccess()
+
+	err := s.Probe(context.Background(), podFactory{}, []string{client.NamespaceAll})
+
+	assert.Error(t, err)
+	assert.False(t, s.IsEnabled())
+	assert.True(t, s.CanList(client.NewGVR("v1/secrets")))
+}
@@ -4,9 +4,14 @@
 package dao
 
 import (
+	"bufio"
 	"context"
 	"errors"
+	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/render"
@@ -49,3 +54,128 @@ func (d *ScreenDump) List(ctx context.Context, _ string) ([]runtime.Object, erro
 
 	return oo, nil
 }
+
+// MaxDiffLines caps the number of lines compared when diffing dumps.
+const MaxDiffLines = 2_000
+
+// DumpMatch represents a screen dump line matching a search.
+type DumpMatch struct {
+	Path string
+	Line int
+	Text string
+}
+
+// SearchDumps finds dump lines containing the given term across all
+// dumps in dir and its incident folders. Matches are case insensitive.
+func SearchDumps(dir, term string) ([]DumpMatch, error) {
+	term = strings.ToLower(term)
+	var mm []DumpMatch
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		scanner := bufio.NewScanner(f)
+		for l := 1; scanner.Scan(); l++ {
+			if strings.Contains(strings.ToLower(scanner.Text()), term) {
+				mm = append(mm, DumpMatch{Path: path, Line: l, Text: scanner.Text()})
+			}
+		}
+
+		return scanner.Err()
+	})
+
+	return mm, err
+}
+
+// TagDumps moves dumps into an incident folder named after the tag.
+func TagDumps(dir, tag string, paths []string) (string, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" || tag != filepath.Base(tag) || tag == "." || tag == ".." {
+		return "", fmt.Errorf("invalid incident tag %q", tag)
+	}
+	target := filepath.Join(dir, tag)
+	if err := os.MkdirAll(target, 0700); err != nil {
+		return "", err
+	}
+	for _, p := range paths {
+		if err := os.Rename(p, filepath.Join(target, filepath.Base(p))); err != nil {
+			return "", err
+		}
+	}
+
+	return target, nil
+}
+
+// DiffDumps returns a line diff between two dumps. Removed lines are
+// prefixed with -, added lines with + and common lines with a space.
+func DiffDumps(before, after string) ([]string, error) {
+	a, err := readLines(before)
+	if err != nil {
+		return nil, err
+	}
+	b, err := readLines(after)
+	if err != nil {
+		return nil, err
+	}
+	if len(a) > MaxDiffLines || len(b) > MaxDiffLines {
+		return nil, fmt.Errorf("dumps exceed the %d lines diff limit", MaxDiffLines)
+	}
+
+	return diffLines(a, b), nil
+}
+
+func readLines(path string) ([]string, error) {
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitLines(string(bb)), nil
+}
+
+// diffLines computes a diff using the longest common subsequence of lines.
+func diffLines(a, b []string) []string {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	dd := make([]string, 0, max(len(a), len(b)))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			dd = append(dd, "  "+a[i])
+			i, j = i+1, j+1
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			dd = append(dd, "- "+a[i])
+			i++
+		default:
+			dd = append(dd, "+ "+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		dd = append(dd, "- "+a[i])
+	}
+	for ; j < len(b); j++ {
+		dd = append(dd, "+ "+b[j])
+	}
+
+	return dd
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchDumps(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "inc-1"), 0700))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "d1.csv"), []byte("NAME,STATUS\nfred,Running\nblee,CrashLoopBackOff\n"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "inc-1", "d2.csv"), []byte("NAME,STATUS\nzorg,crashloopbackoff\n"), 0600))
+
+	mm, err := SearchDumps(dir, "CrashLoop")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []DumpMatch{
+		{Path: filepath.Join(dir, "d1.csv"), Line: 3, Text: "blee,CrashLoopBackOff"},
+		{Path: filepath.Join(dir, "inc-1", "d2.csv"), Line: 2, Text: "zorg,crashloopbackoff"},
+	}, mm)
+}
+
+func TestTagDumps(t *testing.T) {
+	dir := t.TempDir()
+	p := filepath.Join(dir, "d1.csv")
+	assert.NoError(t, os.WriteFile(p, []byte("fred"), 0600))
+
+	target, err := TagDumps(dir, "outage-42", []string{p})
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "outage-42"), target)
+	_, err = os.Stat(filepath.Join(target, "d1.csv"))
+	assert.NoError(t, err)
+
+	_, err = TagDumps(dir, "../fred", []string{p})
+	assert.Error(t, err)
+}
+
+func TestDiffLines(t *testing.T) {
+	uu := map[string]struct {
+		a, b []string
+		e    []string
+	}{
+		"same": {
+			a: []string{"fred", "blee"},
+			b: []string{"fred", "blee"},
+			e: []string{"  fred", "  blee"},
+		},
+		"changed": {
+			a: []string{"NAME", "fred,Running", "blee,Running"},
+			b: []string{"NAME", "fred,Error", "blee,Running", "zorg,Pending"},
+			e: []string{"  NAME", "- fred,Running", "+ fred,Error", "  blee,Running", "+ zorg,Pending"},
+		},
+		"removed": {
+			a: []string{"fred", "blee"},
+			e: []string{"- fred", "- blee"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, diffLines(u.a, u.b))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// podSelectors tracks resources selecting pods without owning them.
+var podSelectors = map[client.GVR]struct{}{
+	client.NewGVR("policy/v1/poddisruptionbudgets"):          {},
+	client.NewGVR("networking.k8s.io/v1/networkpolicies"):    {},
+	client.NewGVR("autoscaling/v1/horizontalpodautoscalers"): {},
+	client.NewGVR("autoscaling/v2/horizontalpodautoscalers"): {},
+}
+
+// SelectsPods checks if a resource selects pods it does not own.
+func SelectsPods(gvr client.GVR) bool {
+	_, ok := podSelectors[gvr]
+
+	return ok
+}
+
+// PodSelector returns the label selector matching a resource pods. Network
+// policies select via spec.podSelector and autoscalers via their scale target.
+func PodSelector(f Factory, o *unstructured.Unstructured) (*metav1.LabelSelector, error) {
+	if m, ok, _ := unstructured.NestedMap(o.Object, "spec", "podSelector"); ok {
+		// An empty pod selector matches all pods in the namespace.
+		return toLabelSelector(m)
+	}
+	if ref, ok, _ := unstructured.NestedStringMap(o.Object, "spec", "scaleTargetRef"); ok {
+		t, err := scaleTarget(f, o.GetNamespace(), ref)
+		if err != nil {
+			return nil, err
+		}
+		return ChildSelector(t)
+	}
+
+	return ChildSelector(o)
+}
+
+func scaleTarget(f Factory, ns string, ref map[string]string) (*unstructured.Unstructured, error) {
+	gv, err := schema.ParseGroupVersion(ref["apiVersion"])
+	if err != nil {
+		return nil, err
+	}
+	gvr, namespaced, ok := MetaAccess.GVK2GVR(gv, ref["kind"])
+	if !ok {
+		return nil, fmt.Errorf("unsupported scale target: %s/%s", ref["apiVersion"], ref["kind"])
+	}
+	path := ref["name"]
+	if namespaced {
+		path = client.FQN(ns, path)
+	}
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return u, nil
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPodSelector(t *testing.T) {
+	uu := map[string]struct {
+		spec map[string]interface{}
+		e    *metav1.LabelSelector
+	}{
+		"netpol": {
+			spec: map[string]interface{}{"podSelector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "fred"},
+			}},
+			e: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "fred"}},
+		},
+		"netpol-all": {
+			spec: map[string]interface{}{"podSelector": map[string]interface{}{}},
+			e:    &metav1.LabelSelector{},
+		},
+		"pdb": {
+			spec: map[string]interface{}{"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "blee"},
+			}},
+			e: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "blee"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sel, err := PodSelector(nil, &unstructured.Unstructured{Object: map[string]interface{}{"spec": u.spec}})
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, sel)
+		})
+	}
+}
+
+func TestSelectsPods(t *testing.T) {
+	assert.True(t, SelectsPods(client.NewGVR("policy/v1/poddisruptionbudgets")))
+	assert.False(t, SelectsPods(client.NewGVR("apps/v1/deployments")))
+}
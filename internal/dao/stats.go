@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"runtime"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+)
+
+// K9sStats tracks k9s own health.
+type K9sStats struct {
+	Memory    MemStats                `json:"memory"`
+	API       client.APIStatsSnapshot `json:"api"`
+	Discovery DiscoveryStatus         `json:"discovery"`
+	Watches   WatchStats              `json:"watches"`
+}
+
+// MemStats tracks k9s memory usage.
+type MemStats struct {
+	Goroutines int    `json:"goroutines"`
+	HeapAlloc  string `json:"heapAlloc"`
+	HeapInUse  string `json:"heapInUse"`
+	Sys        string `json:"sys"`
+	NumGC      uint32 `json:"numGC"`
+}
+
+// WatchStats tracks active informers and their cache sizes.
+type WatchStats struct {
+	Count      int               `json:"count"`
+	Objects    int               `json:"cachedObjects"`
+	MaxObjects int               `json:"maxCachedObjects,omitempty"`
+	EvictAfter string            `json:"evictAfter,omitempty"`
+	Evictions  int               `json:"evictions"`
+	Uncached   []string          `json:"uncached,omitempty"`
+	Items      []watch.WatchStat `json:"informers,omitempty"`
+}
+
+// NewK9sStats returns k9s current stats.
+func NewK9sStats(ww []watch.WatchStat, cs watch.CacheStats, api client.APIStatsSnapshot) K9sStats {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+
+	return K9sStats{
+		Memory: MemStats{
+			Goroutines: runtime.NumGoroutine(),
+			HeapAlloc:  toMB(ms.HeapAlloc),
+			HeapInUse:  toMB(ms.HeapInuse),
+			Sys:        toMB(ms.Sys),
+			NumGC:      ms.NumGC,
+		},
+		API:       api,
+		Discovery: MetaAccess.DiscoveryStatus(),
+		Watches:   newWatchStats(ww, cs),
+	}
+}
+
+func newWatchStats(ww []watch.WatchStat, cs watch.CacheStats) WatchStats {
+	st := WatchStats{
+		Count:      len(ww),
+		MaxObjects: cs.Budget.MaxObjects,
+		Evictions:  cs.Evictions,
+		Uncached:   cs.Capped,
+		Items:      ww,
+	}
+	if cs.Budget.EvictAfter > 0 {
+		st.EvictAfter = cs.Budget.EvictAfter.String()
+	}
+	for _, w := range ww {
+		st.Objects += w.Objects
+	}
+
+	return st
+}
+
+func toMB(b uint64) string {
+	return render.AsThousands(int64(b/client.MegaByte)) + "Mi"
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// csiRegistrar tracks the sidecar registering CSI node plugins with the kubelet.
+	csiRegistrar = "node-driver-registrar"
+
+	// stuckAttachmentAge tracks how long a volume attachment may settle.
+	stuckAttachmentAge = 5 * time.Minute
+)
+
+// volumeEventReasons tracks events reporting volume attach/mount failures.
+var volumeEventReasons = map[string]struct{}{
+	"FailedAttachVolume": {},
+	"FailedDetachVolume": {},
+	"FailedMount":        {},
+	"FailedUnMount":      {},
+	"FailedMapVolume":    {},
+	"FailedUnmapDevice":  {},
+	"VolumeResizeFailed": {},
+}
+
+// StorageDiag tracks cluster storage diagnostics.
+type StorageDiag struct {
+	Drivers          []CSIDriverDiag `json:"drivers"`
+	StuckAttachments []string        `json:"stuckAttachments,omitempty"`
+	VolumeEvents     []string        `json:"volumeEvents,omitempty"`
+}
+
+// CSIDriverDiag tracks a CSI driver health.
+type CSIDriverDiag struct {
+	Name           string    `json:"name"`
+	AttachRequired bool      `json:"attachRequired"`
+	Registered     string    `json:"registered"`
+	Unregistered   []string  `json:"unregistered,omitempty"`
+	Plugins        []DiagPod `json:"plugins,omitempty"`
+	Note           string    `json:"note,omitempty"`
+}
+
+// StorageDiagReport checks CSI drivers registrations and node plugins health
+// along with stuck volume attachments and recent volume errors.
+func StorageDiagReport(ctx context.Context, f Factory) (string, error) {
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+
+	dd, err := dial.StorageV1().CSIDrivers().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", err
+	}
+	cn, err := dial.StorageV1().CSINodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", err
+	}
+	nn, err := dial.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", err
+	}
+	nodes := make([]string, 0, len(nn.Items))
+	for i := range nn.Items {
+		nodes = append(nodes, nn.Items[i].Name)
+	}
+	pp, err := dial.CoreV1().Pods(client.BlankNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	diag := StorageDiag{
+		Drivers: CSIDrivers(dd.Items, cn.Items, nodes, pp.Items),
+	}
+	if vv, err := dial.StorageV1().VolumeAttachments().List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range vv.Items {
+			if s, ok := StuckAttachment(&vv.Items[i], now); ok {
+				diag.StuckAttachments = append(diag.StuckAttachments, s)
+			}
+		}
+	}
+	if ee, err := dial.CoreV1().Events(client.BlankNamespace).List(ctx, metav1.ListOptions{}); err == nil {
+		diag.VolumeEvents = VolumeEvents(ee.Items, now)
+	}
+
+	raw, err := yaml.Marshal(diag)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// CSIDrivers returns the health of the CSI drivers known to the cluster.
+// Drivers registered on nodes without a CSIDriver object are listed too.
+func CSIDrivers(dd []storagev1.CSIDriver, cn []storagev1.CSINode, nodes []string, pp []v1.Pod) []CSIDriverDiag {
+	regs := make(map[string]map[string]struct{})
+	for i := range cn {
+		for _, d := range cn[i].Spec.Drivers {
+			if _, ok := regs[d.Name]; !ok {
+				regs[d.Name] = make(map[string]struct{})
+			}
+			regs[d.Name][cn[i].Name] = struct{}{}
+		}
+	}
+
+	diags := make(map[string]*CSIDriverDiag, len(dd))
+	for i := range dd {
+		diags[dd[i].Name] = &CSIDriverDiag{
+			Name:           dd[i].Name,
+			AttachRequired: dd[i].Spec.AttachRequired == nil || *dd[i].Spec.AttachRequired,
+		}
+	}
+	for n := range regs {
+		if _, ok := diags[n]; !ok {
+			diags[n] = &CSIDriverDiag{
+				Name:           n,
+				AttachRequired: true,
+				Note:           "no CSIDriver object found",
+			}
+		}
+	}
+	for i := range pp {
+		if n := CSIPluginDriver(&pp[i]); n != "" {
+			if d, ok := diags[n]; ok {
+				d.Plugins = append(d.Plugins, NewDiagPod(&pp[i]))
+			}
+		}
+	}
+
+	out := make([]CSIDriverDiag, 0, len(diags))
+	for _, d := range diags {
+		for _, no := range nodes {
+			if _, ok := regs[d.Name][no]; !ok {
+				d.Unregistered = append(d.Unregistered, no)
+			}
+		}
+		d.Registered = fmt.Sprintf("%d/%d nodes", len(nodes)-len(d.Unregistered), len(nodes))
+		if d.Note == "" && len(d.Plugins) == 0 {
+			d.Note = "no node plugin pods found"
+		}
+		out = append(out, *d)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+
+	return out
+}
+
+// CSIPluginDriver returns the CSI driver a node plugin pod registers if any.
+// The driver is read off the registrar kubelet registration path.
+func CSIPluginDriver(po *v1.Pod) string {
+	for _, co := range po.Spec.Containers {
+		if co.Name != csiRegistrar && !strings.Contains(co.Image, csiRegistrar) {
+			continue
+		}
+		for _, a := range co.Args {
+			_, path, ok := strings.Cut(a, "--kubelet-registration-path=")
+			if !ok {
+				continue
+			}
+			_, rest, ok := strings.Cut(path, "/plugins/")
+			if !ok {
+				continue
+			}
+			if n, _, ok := strings.Cut(rest, "/"); ok {
+				return n
+			}
+		}
+	}
+
+	return ""
+}
+
+// StuckAttachment checks if a volume attachment failed or failed to settle.
+func StuckAttachment(va *storagev1.VolumeAttachment, now time.Time) (string, bool) {
+	pv := render.NAValue
+	if va.Spec.Source.PersistentVolumeName != nil {
+		pv = *va.Spec.Source.PersistentVolumeName
+	}
+	prefix := fmt.Sprintf("%s %s on %s", va.Name, pv, va.Spec.NodeName)
+	switch {
+	case va.Status.AttachError != nil:
+		return prefix + " attach error: " + va.Status.AttachError.Message, true
+	case va.Status.DetachError != nil:
+		return prefix + " detach error: " + va.Status.DetachError.Message, true
+	case va.DeletionTimestamp != nil && now.Sub(va.DeletionTimestamp.Time) > stuckAttachmentAge:
+		return prefix + " detaching for " + duration.HumanDuration(now.Sub(va.DeletionTimestamp.Time)), true
+	case va.DeletionTimestamp == nil && !va.Status.Attached && now.Sub(va.CreationTimestamp.Time) > stuckAttachmentAge:
+		return prefix + " not attached after " + duration.HumanDuration(now.Sub(va.CreationTimestamp.Time)), true
+	default:
+		return "", false
+	}
+}
+
+// VolumeEvents returns the most recent volume attach and mount failures,
+// newest first.
+func VolumeEvents(ee []v1.Event, now time.Time) []string {
+	return recentEvents(ee, now, func(e *v1.Event) bool {
+		_, ok := volumeEventReasons[e.Reason]
+		return ok
+	})
+}
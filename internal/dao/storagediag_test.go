@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func makeCSIPlugin(n, node, driver string) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: n},
+		Spec: v1.PodSpec{
+			NodeName: node,
+			Containers: []v1.Container{
+				{Name: "ebs-plugin", Image: "ebs-csi-driver:v1"},
+				{
+					Name:  "registrar",
+					Image: "registry.k8s.io/sig-storage/csi-node-driver-registrar:v2.9.0",
+					Args: []string{
+						"--csi-address=/csi/csi.sock",
+						"--kubelet-registration-path=/var/lib/kubelet/plugins/" + driver + "/csi.sock",
+					},
+				},
+			},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+}
+
+func TestCSIPluginDriver(t *testing.T) {
+	uu := map[string]struct {
+		po v1.Pod
+		e  string
+	}{
+		"plugin": {
+			po: makeCSIPlugin("ebs-node-1", "n1", "ebs.csi.aws.com"),
+			e:  "ebs.csi.aws.com",
+		},
+		"plain": {
+			po: v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "fred", Image: "nginx"}}}},
+		},
+		"no-path": {
+			po: v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{Name: csiRegistrar, Args: []string{"--v=5"}}}}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, CSIPluginDriver(&u.po))
+		})
+	}
+}
+
+func TestCSIDrivers(t *testing.T) {
+	no := false
+	dd := []storagev1.CSIDriver{
+		{ObjectMeta: metav1.ObjectMeta{Name: "ebs.csi.aws.com"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "nfs.csi.k8s.io"}, Spec: storagev1.CSIDriverSpec{AttachRequired: &no}},
+	}
+	cn := []storagev1.CSINode{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+			Spec: storagev1.CSINodeSpec{Drivers: []storagev1.CSINodeDriver{
+				{Name: "ebs.csi.aws.com"},
+				{Name: "legacy.csi.io"},
+			}},
+		},
+		{ObjectMeta: metav1.ObjectMeta{Name: "n2"}},
+	}
+	pp := []v1.Pod{
+		makeCSIPlugin("ebs-node-1", "n1", "ebs.csi.aws.com"),
+		makeCSIPlugin("ebs-node-2", "n2", "ebs.csi.aws.com"),
+	}
+
+	assert.Equal(t, []CSIDriverDiag{
+		{
+			Name:           "ebs.csi.aws.com",
+			AttachRequired: true,
+			Registered:     "1/2 nodes",
+			Unregistered:   []string{"n2"},
+			Plugins: []DiagPod{
+				{Name: "ebs-node-1", Node: "n1", Phase: "Running"},
+				{Name: "ebs-node-2", Node: "n2", Phase: "Running"},
+			},
+		},
+		{
+			Name:           "legacy.csi.io",
+			AttachRequired: true,
+			Registered:     "1/2 nodes",
+			Unregistered:   []string{"n2"},
+			Note:           "no CSIDriver object found",
+		},
+		{
+			Name:         "nfs.csi.k8s.io",
+			Registered:   "0/2 nodes",
+			Unregistered: []string{"n1", "n2"},
+			Note:         "no node plugin pods found",
+		},
+	}, CSIDrivers(dd, cn, []string{"n1", "n2"}, pp))
+}
+
+func TestStuckAttachment(t *testing.T) {
+	now := time.Now()
+	pv := "pv-1"
+	uu := map[string]struct {
+		va storagev1.VolumeAttachment
+		e  string
+		ok bool
+	}{
+		"attached": {
+			va: storagev1.VolumeAttachment{
+				ObjectMeta: metav1.ObjectMeta{Name: "csi-1", CreationTimestamp: metav1.NewTime(now.Add(-time.Hour))},
+				Status:     storagev1.VolumeAttachmentStatus{Attached: true},
+			},
+		},
+		"settling": {
+			va: storagev1.VolumeAttachment{
+				ObjectMeta: metav1.ObjectMeta{Name: "csi-1", CreationTimestamp: metav1.NewTime(now.Add(-time.Minute))},
+			},
+		},
+		"attach-error": {
+			va: storagev1.VolumeAttachment{
+				ObjectMeta: metav1.ObjectMeta{Name: "csi-1", CreationTimestamp: metav1.NewTime(now.Add(-time.Minute))},
+				Spec: storagev1.VolumeAttachmentSpec{
+					NodeName: "n1",
+					Source:   storagev1.VolumeAttachmentSource{PersistentVolumeName: &pv},
+				},
+				Status: storagev1.VolumeAttachmentStatus{AttachError: &storagev1.VolumeError{Message: "volume in use"}},
+			},
+			e:  "csi-1 pv-1 on n1 attach error: volume in use",
+			ok: true,
+		},
+		"not-attached": {
+			va: storagev1.VolumeAttachment{
+				ObjectMeta: metav1.ObjectMeta{Name: "csi-1", CreationTimestamp: metav1.NewTime(now.Add(-10 * time.Minute))},
+				Spec: storagev1.VolumeAttachmentSpec{
+					NodeName: "n1",
+					Source:   storagev1.VolumeAttachmentSource{PersistentVolumeName: &pv},
+				},
+			},
+			e:  "csi-1 pv-1 on n1 not attached after 10m",
+			ok: true,
+		},
+		"detaching": {
+			va: storagev1.VolumeAttachment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "csi-1",
+					CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+					DeletionTimestamp: &metav1.Time{Time: now.Add(-20 * time.Minute)},
+				},
+				Spec:   storagev1.VolumeAttachmentSpec{NodeName: "n1"},
+				Status: storagev1.VolumeAttachmentStatus{Attached: true},
+			},
+			e:  "csi-1 n/a on n1 detaching for 20m",
+			ok: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, ok := StuckAttachment(&u.va, now)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func TestVolumeEvents(t *testing.T) {
+	now := time.Now()
+	ee := []v1.Event{
+		{
+			InvolvedObject: v1.ObjectReference{Namespace: "default", Name: "fred"},
+			Reason:         "FailedAttachVolume",
+			Message:        "Multi-Attach error",
+			LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+		},
+		{
+			InvolvedObject: v1.ObjectReference{Namespace: "default", Name: "fred"},
+			Reason:         "Pulled",
+			Message:        "Pulled image",
+			LastTimestamp:  metav1.NewTime(now),
+		},
+	}
+
+	assert.Equal(t, []string{"60s ago: FailedAttachVolume default/fred Multi-Attach error"}, VolumeEvents(ee, now))
+}
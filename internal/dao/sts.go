@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
@@ -33,6 +34,7 @@ var (
 	_ Controller      = (*StatefulSet)(nil)
 	_ ContainsPodSpec = (*StatefulSet)(nil)
 	_ ImageLister     = (*StatefulSet)(nil)
+	_ DeleteWarner    = (*StatefulSet)(nil)
 )
 
 // StatefulSet represents a K8s sts.
@@ -316,8 +318,96 @@ func (s *StatefulSet) SetImages(ctx context.Context, path string, imageSpecs Ima
 	return err
 }
 
+// SetPartition sets a StatefulSet rolling update partition. Only pods with
+// an ordinal greater or equal to the partition get updated.
+func (s *StatefulSet) SetPartition(ctx context.Context, path string, partition int32) error {
+	ns, n := client.Namespaced(path)
+	auth, err := s.Client().CanI(ns, "apps/v1/statefulsets", n, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch a statefulset")
+	}
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return err
+	}
+	patch := fmt.Sprintf(`{"spec":{"updateStrategy":{"type":%q,"rollingUpdate":{"partition":%d}}}}`, appsv1.RollingUpdateStatefulSetStrategyType, partition)
+	_, err = dial.AppsV1().StatefulSets(ns).Patch(
+		ctx,
+		n,
+		types.StrategicMergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{},
+	)
+
+	return err
+}
+
+// DeleteWarning warns about the PVCs a StatefulSet deletion orphans or deletes.
+func (s *StatefulSet) DeleteWarning(path string) string {
+	sts, err := s.GetInstance(s.Factory, path)
+	if err != nil || len(sts.Spec.VolumeClaimTemplates) == 0 {
+		return ""
+	}
+	oo, err := s.getFactory().List("v1/persistentvolumeclaims", sts.Namespace, true, labels.Everything())
+	if err != nil {
+		log.Warn().Err(err).Msgf("PVC list failed for %s", path)
+		return ""
+	}
+	nn := make([]string, 0, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			nn = append(nn, u.GetName())
+		}
+	}
+
+	return StatefulSetDeleteWarning(sts, StatefulSetClaims(sts, nn))
+}
+
+// StatefulSetClaims returns the PVCs provisioned from a StatefulSet volume
+// claim templates given PVC names.
+func StatefulSetClaims(sts *appsv1.StatefulSet, names []string) []string {
+	cc := make([]string, 0, len(names))
+	for _, n := range names {
+		for _, t := range sts.Spec.VolumeClaimTemplates {
+			ordinal, ok := strings.CutPrefix(n, t.Name+"-"+sts.Name+"-")
+			if !ok || ordinal == "" || strings.Trim(ordinal, "0123456789") != "" {
+				continue
+			}
+			cc = append(cc, n)
+			break
+		}
+	}
+	sort.Strings(cc)
+
+	return cc
+}
+
+// StatefulSetDeleteWarning returns a warning about the fate of a
+// StatefulSet PVCs once deleted or an empty string if it has none.
+func StatefulSetDeleteWarning(sts *appsv1.StatefulSet, claims []string) string {
+	if len(claims) == 0 {
+		return ""
+	}
+	whenDeleted, _ := render.PVCRetention(sts)
+	if whenDeleted == string(appsv1.DeletePersistentVolumeClaimRetentionPolicyType) {
+		return fmt.Sprintf("WARNING! PVC retention policy is whenDeleted=Delete. %d PVC(s) will be deleted: %s",
+			len(claims), strings.Join(claims, ", "))
+	}
+
+	return fmt.Sprintf("WARNING! PVC retention policy is whenDeleted=Retain. %d PVC(s) will be orphaned: %s",
+		len(claims), strings.Join(claims, ", "))
+}
+
 func podsFromSelector(f Factory, ns string, sel map[string]string) ([]*v1.Pod, error) {
-	oo, err := f.List("v1/pods", ns, true, labels.Set(sel).AsSelector())
+	return podsMatching(f, ns, labels.Set(sel).AsSelector())
+}
+
+// podsMatching returns the pods matching a label selector.
+func podsMatching(f Factory, ns string, sel labels.Selector) ([]*v1.Pod, error) {
+	oo, err := f.List("v1/pods", ns, true, sel)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStatefulSetClaims(t *testing.T) {
+	sts := makeSts(nil)
+	nn := []string{"www-web-1", "www-web-0", "www-web-x", "www-web-", "data-web-0", "www-webby-0", "logs-web-10"}
+
+	assert.Equal(t, []string{"logs-web-10", "www-web-0", "www-web-1"}, StatefulSetClaims(sts, nn))
+}
+
+func TestStatefulSetDeleteWarning(t *testing.T) {
+	uu := map[string]struct {
+		policy *appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy
+		claims []string
+		e      string
+	}{
+		"none": {},
+		"retain": {
+			claims: []string{"www-web-0", "www-web-1"},
+			e:      "WARNING! PVC retention policy is whenDeleted=Retain. 2 PVC(s) will be orphaned: www-web-0, www-web-1",
+		},
+		"delete": {
+			policy: &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+				WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+			},
+			claims: []string{"www-web-0"},
+			e:      "WARNING! PVC retention policy is whenDeleted=Delete. 1 PVC(s) will be deleted: www-web-0",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, StatefulSetDeleteWarning(makeSts(u.policy), u.claims))
+		})
+	}
+}
+
+// Helpers...
+
+func makeSts(policy *appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "web"},
+		Spec: appsv1.StatefulSetSpec{
+			PersistentVolumeClaimRetentionPolicy: policy,
+			VolumeClaimTemplates: []v1.PersistentVolumeClaim{
+				{ObjectMeta: metav1.ObjectMeta{Name: "www"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "logs"}},
+			},
+		},
+	}
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+)
+
+var _ Accessor = (*Subresource)(nil)
+
+// Subresource lists resources through one of their subresources ie status.
+type Subresource struct {
+	NonResource
+}
+
+// List returns the resources owning the context subresource.
+func (s *Subresource) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	gvr, err := subresourceFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	labelSel, _ := ctx.Value(internal.KeyLabels).(string)
+	ri, err := s.resourceFor(gvr, ns)
+	if err != nil {
+		return nil, err
+	}
+	ll, err := ri.List(ctx, metav1.ListOptions{LabelSelector: labelSel})
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(ll.Items))
+	for i := range ll.Items {
+		oo = append(oo, render.SubresourceRes{GVR: gvr, Object: &ll.Items[i]})
+	}
+
+	return oo, nil
+}
+
+// Get returns the subresource of a given resource.
+func (s *Subresource) Get(ctx context.Context, fqn string) (runtime.Object, error) {
+	gvr, err := subresourceFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ns, n := client.Namespaced(fqn)
+	ri, err := s.resourceFor(gvr, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	return ri.Get(ctx, n, metav1.GetOptions{}, gvr.SubResource())
+}
+
+func (s *Subresource) resourceFor(gvr client.GVR, ns string) (dynamic.ResourceInterface, error) {
+	dial, err := s.Client().DynDial()
+	if err != nil {
+		return nil, err
+	}
+	meta, err := MetaAccess.MetaFor(ParentGVR(gvr))
+	if err != nil {
+		return nil, err
+	}
+	if !meta.Namespaced || client.IsClusterScoped(ns) {
+		return dial.Resource(gvr.GVR()), nil
+	}
+	if client.IsAllNamespace(ns) {
+		ns = client.BlankNamespace
+	}
+
+	return dial.Resource(gvr.GVR()).Namespace(ns), nil
+}
+
+// ParentGVR returns the resource owning a subresource.
+func ParentGVR(gvr client.GVR) client.GVR {
+	return client.NewGVR(path.Join(gvr.G(), gvr.V(), gvr.R()))
+}
+
+func subresourceFrom(ctx context.Context) (client.GVR, error) {
+	gvr, ok := ctx.Value(internal.KeySubresource).(client.GVR)
+	if !ok || gvr.SubResource() == "" {
+		return client.NoGVR, fmt.Errorf("expecting a subresource but got %v", ctx.Value(internal.KeySubresource))
+	}
+
+	return gvr, nil
+}
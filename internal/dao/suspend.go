@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var (
+	_ Suspendable = (*Generic)(nil)
+	_ Suspendable = (*CronJob)(nil)
+)
+
+// ToggleSuspend suspends or resumes a resource exposing a spec.suspend field.
+func (g *Generic) ToggleSuspend(ctx context.Context, path string) error {
+	ns, n := client.Namespaced(path)
+	dial, err := g.dynClient()
+	if err != nil {
+		return err
+	}
+	var o *unstructured.Unstructured
+	if client.IsClusterScoped(ns) {
+		o, err = dial.Get(ctx, n, metav1.GetOptions{})
+	} else {
+		o, err = dial.Namespace(ns).Get(ctx, n, metav1.GetOptions{})
+	}
+	if err != nil {
+		return err
+	}
+	patch, err := suspendPatch(o)
+	if err != nil {
+		return err
+	}
+
+	return PatchResource(ctx, g.Factory, g.gvr, path, types.MergePatchType, patch, "")
+}
+
+// suspendPatch flips spec.suspend. The patch carries the resource version
+// so the api server rejects it if the resource changed since it was read.
+func suspendPatch(o *unstructured.Unstructured) ([]byte, error) {
+	s, err := IsSuspended(o)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"resourceVersion": o.GetResourceVersion()},
+		"spec":     map[string]interface{}{"suspend": !s},
+	})
+}
+
+// IsSuspendable checks if a resource exposes a spec.suspend field.
+func IsSuspendable(m metav1.APIResource) bool {
+	for _, c := range m.Categories {
+		if c == suspendCat {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsSuspended checks if a resource is currently suspended.
+func IsSuspended(o *unstructured.Unstructured) (bool, error) {
+	s, _, err := unstructured.NestedBool(o.Object, "spec", "suspend")
+	if err != nil {
+		return false, fmt.Errorf("invalid suspend field: %w", err)
+	}
+
+	return s, nil
+}
+
+// hasSuspendField checks if any of the crd versions schema declares a
+// boolean spec.suspend field.
+func hasSuspendField(crd *unstructured.Unstructured) bool {
+	vv, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range vv {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		t, _, _ := unstructured.NestedString(m, "schema", "openAPIV3Schema", "properties", "spec", "properties", "suspend", "type")
+		if t == "boolean" {
+			return true
+		}
+	}
+
+	return false
+}
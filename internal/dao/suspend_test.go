@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestHasSuspendField(t *testing.T) {
+	schema := func(typ string) map[string]interface{} {
+		return map[string]interface{}{
+			"openAPIV3Schema": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"properties": map[string]interface{}{
+							"suspend": map[string]interface{}{"type": typ},
+						},
+					},
+				},
+			},
+		}
+	}
+	uu := map[string]struct {
+		vv []interface{}
+		e  bool
+	}{
+		"none": {},
+		"not-bool": {
+			vv: []interface{}{
+				map[string]interface{}{"name": "v1", "schema": schema("string")},
+			},
+		},
+		"suspend": {
+			vv: []interface{}{
+				map[string]interface{}{"name": "v1beta1"},
+				map[string]interface{}{"name": "v1", "schema": schema("boolean")},
+			},
+			e: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{"versions": u.vv},
+			}}
+			assert.Equal(t, u.e, hasSuspendField(&o))
+		})
+	}
+}
+
+func TestIsSuspended(t *testing.T) {
+	uu := map[string]struct {
+		spec map[string]interface{}
+		e    bool
+		err  bool
+	}{
+		"missing":   {spec: map[string]interface{}{}},
+		"suspended": {spec: map[string]interface{}{"suspend": true}, e: true},
+		"bad":       {spec: map[string]interface{}{"suspend": "yes"}, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := IsSuspended(&unstructured.Unstructured{Object: map[string]interface{}{"spec": u.spec}})
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func TestSuspendPatch(t *testing.T) {
+	uu := map[string]struct {
+		spec map[string]interface{}
+		e    string
+		err  bool
+	}{
+		"suspend": {
+			spec: map[string]interface{}{},
+			e:    `{"metadata":{"resourceVersion":"42"},"spec":{"suspend":true}}`,
+		},
+		"resume": {
+			spec: map[string]interface{}{"suspend": true},
+			e:    `{"metadata":{"resourceVersion":"42"},"spec":{"suspend":false}}`,
+		},
+		"bad": {spec: map[string]interface{}{"suspend": "yes"}, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"resourceVersion": "42"},
+				"spec":     u.spec,
+			}}
+			bb, err := suspendPatch(&o)
+			assert.Equal(t, u.err, err != nil)
+			if err == nil {
+				assert.Equal(t, u.e, string(bb))
+			}
+		})
+	}
+}
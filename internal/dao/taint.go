@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+// TaintEffects tracks the supported taint effects.
+var TaintEffects = []string{
+	string(v1.TaintEffectNoSchedule),
+	string(v1.TaintEffectPreferNoSchedule),
+	string(v1.TaintEffectNoExecute),
+}
+
+// Taint adds or removes a node taint.
+func (n *Node) Taint(ctx context.Context, path string, t v1.Taint, remove bool) error {
+	no, err := FetchNode(ctx, n.Factory, path)
+	if err != nil {
+		return err
+	}
+	auth, err := n.Client().CanI(client.ClusterScope, "v1/nodes", no.Name, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch nodes")
+	}
+	tt, err := UpdateTaints(no.Spec.Taints, t, remove)
+	if err != nil {
+		return err
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"resourceVersion": no.ResourceVersion},
+		"spec":     map[string]interface{}{"taints": tt},
+	})
+	if err != nil {
+		return err
+	}
+	dial, err := n.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.CoreV1().Nodes().Patch(ctx, no.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// UpdateTaints adds a taint or updates the value of a taint with the same key
+// and effect. When removing, taints matching the key and effect are dropped.
+func UpdateTaints(tt []v1.Taint, t v1.Taint, remove bool) ([]v1.Taint, error) {
+	if t.Key == "" {
+		return nil, fmt.Errorf("a taint key is required")
+	}
+	res := make([]v1.Taint, 0, len(tt)+1)
+	var found bool
+	for _, taint := range tt {
+		if !taint.MatchTaint(&t) {
+			res = append(res, taint)
+			continue
+		}
+		found = true
+		if !remove {
+			res = append(res, t)
+		}
+	}
+	switch {
+	case remove && !found:
+		return nil, fmt.Errorf("no taint %s:%s found", t.Key, t.Effect)
+	case !remove && !found:
+		res = append(res, t)
+	}
+
+	return res, nil
+}
+
+// TolerationReport tracks the node taints a pod tolerations match.
+type TolerationReport struct {
+	Pod         string           `json:"pod"`
+	Node        string           `json:"node,omitempty"`
+	Tolerations []TolerationHits `json:"tolerations"`
+	Untolerated []string         `json:"untolerated,omitempty"`
+}
+
+// TolerationHits tracks the node taints a toleration matches.
+type TolerationHits struct {
+	Toleration string   `json:"toleration"`
+	Matches    []string `json:"matches,omitempty"`
+}
+
+// PodTolerations returns a YAML report of the taints each pod toleration
+// matches across nodes.
+func PodTolerations(ctx context.Context, f Factory, po *v1.Pod) (string, error) {
+	nn, err := FetchNodes(ctx, f, "")
+	if err != nil {
+		return "", err
+	}
+	raw, err := yaml.Marshal(NewTolerationReport(po, nn.Items))
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// NewTolerationReport matches a pod tolerations against the nodes taints.
+// Taints on the pod node no toleration matches are reported as untolerated.
+func NewTolerationReport(po *v1.Pod, nn []v1.Node) TolerationReport {
+	sort.Slice(nn, func(i, j int) bool {
+		return nn[i].Name < nn[j].Name
+	})
+	r := TolerationReport{
+		Pod:         client.FQN(po.Namespace, po.Name),
+		Node:        po.Spec.NodeName,
+		Tolerations: make([]TolerationHits, 0, len(po.Spec.Tolerations)),
+	}
+	for i := range po.Spec.Tolerations {
+		t := &po.Spec.Tolerations[i]
+		h := TolerationHits{Toleration: tolerationString(t)}
+		for _, no := range nn {
+			for j := range no.Spec.Taints {
+				if t.ToleratesTaint(&no.Spec.Taints[j]) {
+					h.Matches = append(h.Matches, no.Name+": "+no.Spec.Taints[j].ToString())
+				}
+			}
+		}
+		r.Tolerations = append(r.Tolerations, h)
+	}
+	for _, no := range nn {
+		if no.Name != po.Spec.NodeName {
+			continue
+		}
+		for j := range no.Spec.Taints {
+			if !tolerated(po.Spec.Tolerations, &no.Spec.Taints[j]) {
+				r.Untolerated = append(r.Untolerated, no.Spec.Taints[j].ToString())
+			}
+		}
+	}
+
+	return r
+}
+
+func tolerated(tt []v1.Toleration, taint *v1.Taint) bool {
+	for i := range tt {
+		if tt[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tolerationString returns a toleration as key=value:effect or key:Exists.
+func tolerationString(t *v1.Toleration) string {
+	key, effect := t.Key, string(t.Effect)
+	if key == "" {
+		key = "*"
+	}
+	if effect == "" {
+		effect = "*"
+	}
+	s := key + "=" + t.Value
+	if t.Operator == v1.TolerationOpExists {
+		s = key + ":" + string(v1.TolerationOpExists)
+	}
+	s += ":" + effect
+	if t.TolerationSeconds != nil {
+		s += fmt.Sprintf(" (%ds)", *t.TolerationSeconds)
+	}
+
+	return s
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpdateTaints(t *testing.T) {
+	tt := []v1.Taint{
+		{Key: "a", Value: "1", Effect: v1.TaintEffectNoSchedule},
+		{Key: "a", Value: "1", Effect: v1.TaintEffectNoExecute},
+		{Key: "b", Effect: v1.TaintEffectNoSchedule},
+	}
+
+	uu := map[string]struct {
+		t      v1.Taint
+		remove bool
+		e      []v1.Taint
+		err    error
+	}{
+		"add": {
+			t: v1.Taint{Key: "c", Value: "3", Effect: v1.TaintEffectPreferNoSchedule},
+			e: append(append([]v1.Taint{}, tt...), v1.Taint{Key: "c", Value: "3", Effect: v1.TaintEffectPreferNoSchedule}),
+		},
+		"update": {
+			t: v1.Taint{Key: "a", Value: "2", Effect: v1.TaintEffectNoExecute},
+			e: []v1.Taint{tt[0], {Key: "a", Value: "2", Effect: v1.TaintEffectNoExecute}, tt[2]},
+		},
+		"remove": {
+			t:      v1.Taint{Key: "a", Effect: v1.TaintEffectNoSchedule},
+			remove: true,
+			e:      []v1.Taint{tt[1], tt[2]},
+		},
+		"remove-missing": {
+			t:      v1.Taint{Key: "b", Effect: v1.TaintEffectNoExecute},
+			remove: true,
+			err:    errors.New("no taint b:NoExecute found"),
+		},
+		"no-key": {
+			err: errors.New("a taint key is required"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tt, err := UpdateTaints(tt, u.t, u.remove)
+			assert.Equal(t, u.err, err)
+			assert.Equal(t, u.e, tt)
+		})
+	}
+}
+
+func TestNewTolerationReport(t *testing.T) {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"},
+		Spec: v1.PodSpec{
+			NodeName: "n1",
+			Tolerations: []v1.Toleration{
+				{Key: "gpu", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+				{Key: "zone", Operator: v1.TolerationOpEqual, Value: "a"},
+			},
+		},
+	}
+	nn := []v1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "n2"},
+			Spec: v1.NodeSpec{Taints: []v1.Taint{
+				{Key: "zone", Value: "a", Effect: v1.TaintEffectNoExecute},
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+			Spec: v1.NodeSpec{Taints: []v1.Taint{
+				{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+				{Key: "spot", Effect: v1.TaintEffectPreferNoSchedule},
+			}},
+		},
+	}
+
+	assert.Equal(t, TolerationReport{
+		Pod:  "ns1/p1",
+		Node: "n1",
+		Tolerations: []TolerationHits{
+			{Toleration: "gpu:Exists:NoSchedule", Matches: []string{"n1: gpu=true:NoSchedule"}},
+			{Toleration: "zone=a:*", Matches: []string{"n2: zone=a:NoExecute"}},
+		},
+		Untolerated: []string{"spot:PreferNoSchedule"},
+	}, NewTolerationReport(&po, nn))
+}
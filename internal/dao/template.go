@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Template)(nil)
+
+// Template represents resource creation templates.
+type Template struct {
+	NonResource
+}
+
+// List returns a collection of templates.
+func (t *Template) List(_ context.Context, _ string) ([]runtime.Object, error) {
+	tt, err := config.LoadResourceTemplates(config.AppTemplatesDir)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(tt))
+	for _, tpl := range tt {
+		oo = append(oo, render.TemplateRes{Template: tpl})
+	}
+
+	return oo, nil
+}
+
+// Get fetch a template by name.
+func (t *Template) Get(_ context.Context, n string) (runtime.Object, error) {
+	tt, err := config.LoadResourceTemplates(config.AppTemplatesDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, tpl := range tt {
+		if tpl.Name == n {
+			return render.TemplateRes{Template: tpl}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no template found for %q", n)
+}
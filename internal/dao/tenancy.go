@@ -0,0 +1,280 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// VClusterLabel tracks the label identifying a vcluster control plane.
+	VClusterLabel = "app"
+
+	// VClusterApp tracks the vcluster control plane app label value.
+	VClusterApp = "vcluster"
+
+	vclusterSecretPrefix = "vc-"
+	vclusterSecretKey    = "config"
+)
+
+// NamespaceTree returns the namespaces hierarchy along with the vclusters
+// they host.
+func NamespaceTree(f Factory) (string, error) {
+	oo, err := f.List("v1/namespaces", client.BlankNamespace, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	nss := make([]v1.Namespace, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return "", fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var ns v1.Namespace
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ns); err != nil {
+			return "", err
+		}
+		nss = append(nss, ns)
+	}
+	vcs, err := VClusters(f, client.NamespaceAll)
+	if err != nil {
+		return "", err
+	}
+
+	return BuildNamespaceTree(nss, vcs), nil
+}
+
+// BuildNamespaceTree renders namespaces as a tree following their HNC
+// parents. Namespaces hosting vclusters are annotated.
+func BuildNamespaceTree(nss []v1.Namespace, vcs []string) string {
+	known := make(map[string]struct{}, len(nss))
+	for i := range nss {
+		known[nss[i].Name] = struct{}{}
+	}
+	children := make(map[string][]string, len(nss))
+	for i := range nss {
+		p := render.NamespaceParent(&nss[i])
+		if _, ok := known[p]; !ok {
+			p = ""
+		}
+		children[p] = append(children[p], nss[i].Name)
+	}
+	hosted := make(map[string][]string)
+	for _, fqn := range vcs {
+		ns, n := client.Namespaced(fqn)
+		hosted[ns] = append(hosted[ns], n)
+	}
+
+	var b strings.Builder
+	var walk func(ns, indent string, last bool)
+	walk = func(ns, indent string, last bool) {
+		branch, next := "├─ ", "│  "
+		if last {
+			branch, next = "└─ ", "   "
+		}
+		b.WriteString(indent + branch + ns)
+		if vv := hosted[ns]; len(vv) > 0 {
+			sort.Strings(vv)
+			b.WriteString(" [vcluster: " + strings.Join(vv, ",") + "]")
+		}
+		b.WriteString("\n")
+		kids := children[ns]
+		sort.Strings(kids)
+		for i, k := range kids {
+			walk(k, indent+next, i == len(kids)-1)
+		}
+	}
+	roots := children[""]
+	sort.Strings(roots)
+	for i, r := range roots {
+		walk(r, "", i == len(roots)-1)
+	}
+
+	return b.String()
+}
+
+// VClusters returns the vclusters control planes running in a namespace.
+func VClusters(f Factory, ns string) ([]string, error) {
+	sel := labels.SelectorFromSet(labels.Set{VClusterLabel: VClusterApp})
+	var vcs []string
+	for _, gvr := range []string{"apps/v1/statefulsets", "apps/v1/deployments"} {
+		oo, err := f.List(gvr, ns, true, sel)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range oo {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("expecting unstructured but got %T", o)
+			}
+			vcs = append(vcs, client.FQN(u.GetNamespace(), VClusterName(u.GetName(), u.GetLabels())))
+		}
+	}
+	sort.Strings(vcs)
+
+	return vcs, nil
+}
+
+// VClusterName returns a vcluster name from its control plane release label.
+func VClusterName(n string, ll map[string]string) string {
+	if r, ok := ll["release"]; ok && r != "" {
+		return r
+	}
+
+	return n
+}
+
+// IsVCluster checks if a resource is a vcluster control plane.
+func IsVCluster(ll map[string]string) bool {
+	return ll[VClusterLabel] == VClusterApp
+}
+
+// VClusterKubeconfig returns a vcluster kubeconfig from its host secret.
+func VClusterKubeconfig(f Factory, fqn string) ([]byte, error) {
+	ns, n := client.Namespaced(fqn)
+	o, err := f.Get(SecGVR.String(), client.FQN(ns, vclusterSecretPrefix+n), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	var sec v1.Secret
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sec); err != nil {
+		return nil, err
+	}
+	raw, ok := sec.Data[vclusterSecretKey]
+	if !ok {
+		return nil, fmt.Errorf("no kubeconfig found in secret %s", client.FQN(ns, sec.Name))
+	}
+
+	return raw, nil
+}
+
+// VClusterServer returns a vcluster kubeconfig api server and whether it is
+// only reachable via a local port forward.
+func VClusterServer(raw []byte) (string, bool, error) {
+	cfg, err := clientcmd.Load(raw)
+	if err != nil {
+		return "", false, err
+	}
+	ctx, ok := cfg.Contexts[cfg.CurrentContext]
+	if !ok {
+		return "", false, fmt.Errorf("no current context found in vcluster kubeconfig")
+	}
+	cl, ok := cfg.Clusters[ctx.Cluster]
+	if !ok {
+		return "", false, fmt.Errorf("no cluster %q found in vcluster kubeconfig", ctx.Cluster)
+	}
+	u, err := url.Parse(cl.Server)
+	if err != nil {
+		return "", false, err
+	}
+	host := u.Hostname()
+	if host == "localhost" {
+		return cl.Server, true, nil
+	}
+	ip := net.ParseIP(host)
+
+	return cl.Server, ip != nil && ip.IsLoopback(), nil
+}
+
+// VClusterTunnel returns the pod, container and port backing a vcluster
+// api server service.
+func VClusterTunnel(f Factory, fqn string) (string, string, string, error) {
+	o, err := f.Get(SvcGVR.String(), fqn, true, labels.Everything())
+	if err != nil {
+		return "", "", "", err
+	}
+	var svc v1.Service
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &svc); err != nil {
+		return "", "", "", err
+	}
+	pp, err := podsMatching(f, svc.Namespace, labels.Set(svc.Spec.Selector).AsSelector())
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return vclusterTarget(&svc, pp)
+}
+
+// VClusterKubeconfigServer points a vcluster kubeconfig current cluster at
+// the given api server.
+func VClusterKubeconfigServer(raw []byte, server string) ([]byte, error) {
+	cfg, err := clientcmd.Load(raw)
+	if err != nil {
+		return nil, err
+	}
+	ctx, ok := cfg.Contexts[cfg.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("no current context found in vcluster kubeconfig")
+	}
+	cl, ok := cfg.Clusters[ctx.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("no cluster %q found in vcluster kubeconfig", ctx.Cluster)
+	}
+	cl.Server = server
+
+	return clientcmd.Write(*cfg)
+}
+
+// Helpers...
+
+func vclusterTarget(svc *v1.Service, pp []*v1.Pod) (string, string, string, error) {
+	if len(svc.Spec.Ports) == 0 {
+		return "", "", "", fmt.Errorf("no ports found on service %s", client.FQN(svc.Namespace, svc.Name))
+	}
+	sp := svc.Spec.Ports[0]
+	for _, p := range svc.Spec.Ports {
+		if p.Name == "https" {
+			sp = p
+			break
+		}
+	}
+
+	for _, pod := range pp {
+		if pod.Status.Phase != v1.PodRunning || len(pod.Spec.Containers) == 0 {
+			continue
+		}
+		fqn := client.FQN(pod.Namespace, pod.Name)
+		if sp.TargetPort.Type == intstr.Int {
+			cp := sp.TargetPort.IntVal
+			if cp == 0 {
+				cp = sp.Port
+			}
+			for _, co := range pod.Spec.Containers {
+				for _, p := range co.Ports {
+					if p.ContainerPort == cp {
+						return fqn, co.Name, strconv.Itoa(int(cp)), nil
+					}
+				}
+			}
+			return fqn, pod.Spec.Containers[0].Name, strconv.Itoa(int(cp)), nil
+		}
+		for _, co := range pod.Spec.Containers {
+			for _, p := range co.Ports {
+				if p.Name == sp.TargetPort.StrVal {
+					return fqn, co.Name, strconv.Itoa(int(p.ContainerPort)), nil
+				}
+			}
+		}
+	}
+
+	return "", "", "", fmt.Errorf("no running pod serving port %s on service %s", sp.TargetPort.String(), client.FQN(svc.Namespace, svc.Name))
+}
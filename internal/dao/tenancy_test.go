@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestBuildNamespaceTree(t *testing.T) {
+	nss := []v1.Namespace{
+		makeTenantNs("default", nil),
+		makeTenantNs("team-a", map[string]string{"team-a.tree.hnc.x-k8s.io/depth": "0"}),
+		makeTenantNs("team-a-dev", map[string]string{
+			"team-a.tree.hnc.x-k8s.io/depth":     "1",
+			"team-a-dev.tree.hnc.x-k8s.io/depth": "0",
+		}),
+		makeTenantNs("team-a-prod", map[string]string{
+			"team-a.tree.hnc.x-k8s.io/depth":      "1",
+			"team-a-prod.tree.hnc.x-k8s.io/depth": "0",
+		}),
+		makeTenantNs("orphan", map[string]string{"gone.tree.hnc.x-k8s.io/depth": "1"}),
+	}
+	e := `├─ default
+├─ orphan
+└─ team-a
+   ├─ team-a-dev [vcluster: vc1,vc2]
+   └─ team-a-prod
+`
+
+	assert.Equal(t, e, BuildNamespaceTree(nss, []string{"team-a-dev/vc2", "team-a-dev/vc1"}))
+}
+
+func TestVClusterServer(t *testing.T) {
+	uu := map[string]struct {
+		server string
+		local  bool
+	}{
+		"localhost": {
+			server: "https://localhost:8443",
+			local:  true,
+		},
+		"loopback": {
+			server: "https://127.0.0.1:8443",
+			local:  true,
+		},
+		"exposed": {
+			server: "https://vc1.example.com",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw := []byte(`apiVersion: v1
+kind: Config
+current-context: vc
+contexts:
+- name: vc
+  context:
+    cluster: vc
+    user: vc
+clusters:
+- name: vc
+  cluster:
+    server: ` + u.server + `
+users:
+- name: vc
+  user: {}
+`)
+			server, local, err := VClusterServer(raw)
+			assert.NoError(t, err)
+			assert.Equal(t, u.server, server)
+			assert.Equal(t, u.local, local)
+		})
+	}
+}
+
+func TestVClusterKubeconfigServer(t *testing.T) {
+	raw := []byte(`apiVersion: v1
+kind: Config
+current-context: vc
+contexts:
+- name: vc
+  context:
+    cluster: vc
+    user: vc
+clusters:
+- name: vc
+  cluster:
+    server: https://vc1.vc1:443
+users:
+- name: vc
+  user: {}
+`)
+	bb, err := VClusterKubeconfigServer(raw, "https://localhost:30443")
+	assert.NoError(t, err)
+
+	server, local, err := VClusterServer(bb)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://localhost:30443", server)
+	assert.True(t, local)
+}
+
+func TestVClusterTarget(t *testing.T) {
+	pod := func(phase v1.PodPhase) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "vc1", Name: "vc1-0"},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{
+					{Name: "syncer", Ports: []v1.ContainerPort{{Name: "https", ContainerPort: 8443}}},
+				},
+			},
+			Status: v1.PodStatus{Phase: phase},
+		}
+	}
+	uu := map[string]struct {
+		target   intstr.IntOrString
+		pp       []*v1.Pod
+		co, port string
+		err      bool
+	}{
+		"numeric": {
+			target: intstr.FromInt(8443),
+			pp:     []*v1.Pod{pod(v1.PodRunning)},
+			co:     "syncer",
+			port:   "8443",
+		},
+		"named": {
+			target: intstr.FromString("https"),
+			pp:     []*v1.Pod{pod(v1.PodRunning)},
+			co:     "syncer",
+			port:   "8443",
+		},
+		"pending": {
+			target: intstr.FromInt(8443),
+			pp:     []*v1.Pod{pod(v1.PodPending)},
+			err:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			svc := v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "vc1", Name: "vc1"},
+				Spec: v1.ServiceSpec{
+					Ports: []v1.ServicePort{
+						{Name: "kubelet", Port: 10250, TargetPort: intstr.FromInt(8443)},
+						{Name: "https", Port: 443, TargetPort: u.target},
+					},
+				},
+			}
+			fqn, co, port, err := vclusterTarget(&svc, u.pp)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "vc1/vc1-0", fqn)
+			assert.Equal(t, u.co, co)
+			assert.Equal(t, u.port, port)
+		})
+	}
+}
+
+func TestVClusterName(t *testing.T) {
+	assert.Equal(t, "vc1", VClusterName("vc1", nil))
+	assert.Equal(t, "fred", VClusterName("vc1", map[string]string{"release": "fred"}))
+}
+
+// Helpers...
+
+func makeTenantNs(n string, ll map[string]string) v1.Namespace {
+	return v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: n, Labels: ll}}
+}
@@ -0,0 +1,370 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	// TimelineCreated tracks pod creations.
+	TimelineCreated = "Created"
+
+	// TimelineRestart tracks container restarts.
+	TimelineRestart = "Restart"
+
+	// TimelineBackOff tracks crash loop back-offs.
+	TimelineBackOff = "BackOff"
+
+	// TimelineOOMKilled tracks containers killed out of memory.
+	TimelineOOMKilled = "OOMKilled"
+
+	// TimelineEvicted tracks evicted or preempted pods.
+	TimelineEvicted = "Evicted"
+
+	// TimelineKilled tracks containers killed by the kubelet.
+	TimelineKilled = "Killed"
+
+	// DefaultTimelineWindow tracks the default timeline time span.
+	DefaultTimelineWindow = 6 * time.Hour
+
+	// timelineWidth tracks the number of time slots per pod lane.
+	timelineWidth = 60
+
+	// maxTimelineEvents caps the number of events listed below the lanes.
+	maxTimelineEvents = 50
+
+	timelineIdle = '·'
+
+	timelineFmt = "01-02 15:04"
+
+	timelineNote = "\nPods only keep their containers last termination. Older restarts are lost once their events expire (1h by default).\n"
+)
+
+// timelineMarks tracks the lane marks per event kind, by precedence.
+var timelineMarks = []struct {
+	kind string
+	mark rune
+}{
+	{TimelineOOMKilled, 'O'},
+	{TimelineEvicted, 'E'},
+	{TimelineRestart, 'R'},
+	{TimelineBackOff, 'B'},
+	{TimelineKilled, 'K'},
+	{TimelineCreated, '+'},
+}
+
+// timelineReasons maps pod event reasons to timeline event kinds.
+var timelineReasons = map[string]string{
+	"Scheduled":  TimelineCreated,
+	"BackOff":    TimelineBackOff,
+	"Evicted":    TimelineEvicted,
+	"Preempted":  TimelineEvicted,
+	"Preempting": TimelineEvicted,
+	"Killing":    TimelineKilled,
+}
+
+// TimelineEvent tracks a pod lifecycle event. Recurring events track the
+// series first occurrence and count.
+type TimelineEvent struct {
+	Pod    string
+	At     time.Time
+	Kind   string
+	Detail string
+	Since  time.Time
+	Count  int32
+}
+
+// occurrences returns the event series timestamps spread evenly from its
+// first to its last occurrence, capped to limit.
+func (t TimelineEvent) occurrences(limit int) []time.Time {
+	n := int(t.Count)
+	if n <= 1 || t.Since.IsZero() || !t.Since.Before(t.At) {
+		return []time.Time{t.At}
+	}
+	if n > limit {
+		n = limit
+	}
+	span, tt := t.At.Sub(t.Since), make([]time.Time, 0, n)
+	for i := 0; i < n; i++ {
+		tt = append(tt, t.Since.Add(span*time.Duration(i)/time.Duration(n-1)))
+	}
+
+	return tt
+}
+
+// WorkloadTimeline renders a workload pods creations, restarts, evictions
+// and OOM kills over a time window. Pods that are gone are reconstructed
+// from their events.
+func WorkloadTimeline(ctx context.Context, f Factory, gvr client.GVR, path string, window time.Duration) (string, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	m, _, _ := unstructured.NestedMap(u.Object, "spec", "selector")
+	var ls metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &ls); err != nil {
+		return "", err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(&ls)
+	if err != nil {
+		return "", err
+	}
+
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	ns := u.GetNamespace()
+	pp, err := dial.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{LabelSelector: sel.String()})
+	if err != nil {
+		return "", err
+	}
+	owners := []string{u.GetName()}
+	if gvr == DpGVR {
+		rr, err := dial.AppsV1().ReplicaSets(ns).List(ctx, metav1.ListOptions{LabelSelector: sel.String()})
+		if err != nil {
+			return "", err
+		}
+		owners = owners[:0]
+		for i := range rr.Items {
+			if metav1.IsControlledBy(&rr.Items[i], u) {
+				owners = append(owners, rr.Items[i].Name)
+			}
+		}
+	}
+	ee, err := dial.CoreV1().Events(ns).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("involvedObject.kind", "Pod").String(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	tt := PodTimelineEvents(pp.Items, ee.Items, PodOwnedBy(owners))
+	title := fmt.Sprintf("%s %s pods timeline over the last %s", u.GetKind(), path, duration.HumanDuration(window))
+
+	return RenderTimeline(title, pp.Items, tt, now.Add(-window), now), nil
+}
+
+// PodOwnedBy matches pods names spawned by the given owners ie owner-suffix.
+func PodOwnedBy(owners []string) func(string) bool {
+	return func(n string) bool {
+		for _, o := range owners {
+			if rest, ok := strings.CutPrefix(n, o+"-"); ok && rest != "" && !strings.Contains(rest, "-") {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// PodTimelineEvents returns pods lifecycle events from their status and
+// events, oldest first. Events are retained for pods matching the filter.
+func PodTimelineEvents(pp []v1.Pod, ee []v1.Event, owned func(string) bool) []TimelineEvent {
+	var (
+		tt   []TimelineEvent
+		pods = make(map[string]struct{}, len(pp))
+		seen = make(map[string]struct{})
+	)
+	add := func(t TimelineEvent) {
+		k := fmt.Sprintf("%s|%s|%d", t.Pod, t.Kind, t.At.Unix())
+		if _, ok := seen[k]; ok {
+			return
+		}
+		seen[k] = struct{}{}
+		tt = append(tt, t)
+	}
+
+	for i := range pp {
+		po := &pp[i]
+		pods[po.Name] = struct{}{}
+		add(TimelineEvent{Pod: po.Name, At: po.CreationTimestamp.Time, Kind: TimelineCreated})
+		for _, cs := range po.Status.ContainerStatuses {
+			t := cs.LastTerminationState.Terminated
+			if t == nil {
+				continue
+			}
+			kind := TimelineRestart
+			if t.Reason == "OOMKilled" {
+				kind = TimelineOOMKilled
+			}
+			add(TimelineEvent{
+				Pod:    po.Name,
+				At:     t.FinishedAt.Time,
+				Kind:   kind,
+				Detail: fmt.Sprintf("container %s exited %d (%s), %d restarts", cs.Name, t.ExitCode, t.Reason, cs.RestartCount),
+			})
+		}
+		if po.Status.Reason == "Evicted" {
+			add(TimelineEvent{Pod: po.Name, At: lastTransition(po), Kind: TimelineEvicted, Detail: po.Status.Message})
+		}
+	}
+
+	for _, e := range ee {
+		kind, ok := timelineReasons[e.Reason]
+		if !ok {
+			continue
+		}
+		n := e.InvolvedObject.Name
+		_, live := pods[n]
+		if !live && !owned(n) {
+			continue
+		}
+		// Live pods creation is tracked from their metadata.
+		if live && kind == TimelineCreated {
+			continue
+		}
+		t := TimelineEvent{Pod: n, At: eventTime(e), Kind: kind, Detail: strings.TrimSpace(e.Message)}
+		if e.Count > 1 {
+			t.Detail += fmt.Sprintf(" (x%d)", e.Count)
+			if f := e.FirstTimestamp.Time; !f.IsZero() && f.Before(t.At) {
+				t.Since, t.Count = f, e.Count
+			}
+		}
+		add(t)
+	}
+	sort.SliceStable(tt, func(i, j int) bool {
+		return tt[i].At.Before(tt[j].At)
+	})
+
+	return tt
+}
+
+// RenderTimeline renders pods events as a textual Gantt chart, one lane per
+// pod, followed by the events list newest first.
+func RenderTimeline(title string, pp []v1.Pod, tt []TimelineEvent, from, to time.Time) string {
+	var (
+		lanes = make(map[string][]rune)
+		order []string
+		slot  = to.Sub(from) / timelineWidth
+		hits  []TimelineEvent
+	)
+	if slot <= 0 {
+		slot = time.Second
+	}
+	index := func(t time.Time) int {
+		i := int(t.Sub(from) / slot)
+		if i >= timelineWidth {
+			i = timelineWidth - 1
+		}
+		return i
+	}
+	lane := func(n string) []rune {
+		l, ok := lanes[n]
+		if !ok {
+			l = []rune(strings.Repeat(" ", timelineWidth))
+			lanes[n], order = l, append(order, n)
+		}
+		return l
+	}
+
+	for i := range pp {
+		l, start := lane(pp[i].Name), 0
+		if c := pp[i].CreationTimestamp.Time; c.After(from) {
+			start = index(c)
+		}
+		for j := start; j < timelineWidth; j++ {
+			l[j] = timelineIdle
+		}
+	}
+	for _, t := range tt {
+		first := t.At
+		if !t.Since.IsZero() {
+			first = t.Since
+		}
+		if t.At.Before(from) || first.After(to) {
+			continue
+		}
+		hits = append(hits, t)
+		l := lane(t.Pod)
+		for _, at := range t.occurrences(timelineWidth) {
+			if at.Before(from) || at.After(to) {
+				continue
+			}
+			if i := index(at); timelineRank(t.Kind) < timelineRank(string(l[i])) {
+				l[i] = timelineMark(t.Kind)
+			}
+		}
+	}
+	sort.Strings(order)
+
+	w := 0
+	for _, n := range order {
+		if len(n) > w {
+			w = len(n)
+		}
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\n", title)
+	fmt.Fprintf(&b, "%-*s  %-*s%s\n", w, "", timelineWidth-len(timelineFmt), from.Format(timelineFmt), to.Format(timelineFmt))
+	for _, n := range order {
+		fmt.Fprintf(&b, "%-*s |%s|\n", w, n, string(lanes[n]))
+	}
+	b.WriteString("\nLegend: + created  R restart  B back-off  O OOMKilled  E evicted  K killed  · running\n")
+
+	if len(hits) == 0 {
+		b.WriteString("\nNo pod events in this time window.\n")
+		b.WriteString(timelineNote)
+		return b.String()
+	}
+	b.WriteString("\nEvents (newest first):\n")
+	for i := len(hits) - 1; i >= 0 && len(hits)-i <= maxTimelineEvents; i-- {
+		t := hits[i]
+		fmt.Fprintf(&b, "  %s  %-*s  %-9s  %s\n", t.At.Format(timelineFmt), w, t.Pod, t.Kind, t.Detail)
+	}
+	b.WriteString(timelineNote)
+
+	return b.String()
+}
+
+// timelineRank returns a kind or mark precedence, lower wins.
+func timelineRank(s string) int {
+	for i, m := range timelineMarks {
+		if s == m.kind || s == string(m.mark) {
+			return i
+		}
+	}
+
+	return len(timelineMarks)
+}
+
+func timelineMark(kind string) rune {
+	for _, m := range timelineMarks {
+		if m.kind == kind {
+			return m.mark
+		}
+	}
+
+	return '?'
+}
+
+// lastTransition returns a pod most recent condition transition.
+func lastTransition(po *v1.Pod) time.Time {
+	t := po.CreationTimestamp.Time
+	for _, c := range po.Status.Conditions {
+		if c.LastTransitionTime.After(t) {
+			t = c.LastTransitionTime.Time
+		}
+	}
+
+	return t
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodOwnedBy(t *testing.T) {
+	owned := PodOwnedBy([]string{"fred-7d9c8b", "blee"})
+
+	assert.True(t, owned("fred-7d9c8b-x2x4z"))
+	assert.True(t, owned("blee-0"))
+	assert.False(t, owned("blee-api-0"))
+	assert.False(t, owned("fred-7d9c8b-"))
+	assert.False(t, owned("zorg-1"))
+}
+
+func TestPodTimeline(t *testing.T) {
+	to := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	from := to.Add(-time.Hour)
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "fred-abc-x1", CreationTimestamp: metav1.NewTime(from.Add(30 * time.Minute))},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					Name:         "app",
+					RestartCount: 2,
+					LastTerminationState: v1.ContainerState{
+						Terminated: &v1.ContainerStateTerminated{
+							Reason:     "OOMKilled",
+							ExitCode:   137,
+							FinishedAt: metav1.NewTime(from.Add(50 * time.Minute)),
+						},
+					},
+				},
+			},
+		},
+	}
+	ee := []v1.Event{
+		{
+			InvolvedObject: v1.ObjectReference{Name: "fred-abc-x1"},
+			Reason:         "Scheduled",
+			LastTimestamp:  metav1.NewTime(from.Add(30 * time.Minute)),
+		},
+		{
+			InvolvedObject: v1.ObjectReference{Name: "fred-abc-y2"},
+			Reason:         "Evicted",
+			Message:        "The node was low on resource: memory.",
+			LastTimestamp:  metav1.NewTime(from.Add(10 * time.Minute)),
+		},
+		{
+			InvolvedObject: v1.ObjectReference{Name: "fred-abc-y2"},
+			Reason:         "Pulled",
+			LastTimestamp:  metav1.NewTime(from.Add(5 * time.Minute)),
+		},
+		{
+			InvolvedObject: v1.ObjectReference{Name: "blee-0"},
+			Reason:         "BackOff",
+			LastTimestamp:  metav1.NewTime(from.Add(15 * time.Minute)),
+		},
+	}
+
+	tt := PodTimelineEvents([]v1.Pod{po}, ee, PodOwnedBy([]string{"fred-abc"}))
+	assert.Equal(t, []TimelineEvent{
+		{Pod: "fred-abc-y2", At: from.Add(10 * time.Minute), Kind: TimelineEvicted, Detail: "The node was low on resource: memory."},
+		{Pod: "fred-abc-x1", At: from.Add(30 * time.Minute), Kind: TimelineCreated},
+		{Pod: "fred-abc-x1", At: from.Add(50 * time.Minute), Kind: TimelineOOMKilled, Detail: "container app exited 137 (OOMKilled), 2 restarts"},
+	}, tt)
+
+	ll := strings.Split(RenderTimeline("fred", []v1.Pod{po}, tt, from, to), "\n")
+	assert.Equal(t, "fred", ll[0])
+	assert.Equal(t, "fred-abc-x1 |"+strings.Repeat(" ", 30)+"+"+strings.Repeat("·", 19)+"O"+strings.Repeat("·", 9)+"|", ll[3])
+	assert.Equal(t, "fred-abc-y2 |"+strings.Repeat(" ", 10)+"E"+strings.Repeat(" ", 49)+"|", ll[4])
+	assert.Equal(t, "  01-01 11:50  fred-abc-x1  OOMKilled  container app exited 137 (OOMKilled), 2 restarts", ll[9])
+}
+
+func TestPodTimelineSeries(t *testing.T) {
+	to := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	from := to.Add(-time.Hour)
+	ee := []v1.Event{
+		{
+			InvolvedObject: v1.ObjectReference{Name: "fred-abc-x1"},
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container",
+			Count:          3,
+			FirstTimestamp: metav1.NewTime(from.Add(10 * time.Minute)),
+			LastTimestamp:  metav1.NewTime(from.Add(50 * time.Minute)),
+		},
+	}
+
+	tt := PodTimelineEvents(nil, ee, PodOwnedBy([]string{"fred-abc"}))
+	assert.Equal(t, []TimelineEvent{
+		{
+			Pod:    "fred-abc-x1",
+			At:     from.Add(50 * time.Minute),
+			Kind:   TimelineBackOff,
+			Detail: "Back-off restarting failed container (x3)",
+			Since:  from.Add(10 * time.Minute),
+			Count:  3,
+		},
+	}, tt)
+
+	out := RenderTimeline("fred", nil, tt, from, to)
+	ll := strings.Split(out, "\n")
+	assert.Equal(t, "fred-abc-x1 |"+strings.Repeat(" ", 10)+"B"+strings.Repeat(" ", 19)+"B"+strings.Repeat(" ", 19)+"B"+strings.Repeat(" ", 9)+"|", ll[3])
+	assert.Contains(t, out, "Older restarts are lost once their events expire")
+}
@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// certIssuing tracks cert-manager certificates being issued.
+const certIssuing = "Issuing"
+
+var (
+	// GatewayGVR tracks Gateway API gateways.
+	GatewayGVR = client.NewGVR("gateway.networking.k8s.io/v1/gateways")
+
+	// CertificateGVR tracks cert-manager certificates.
+	CertificateGVR = client.NewGVR("cert-manager.io/v1/certificates")
+)
+
+var _ Accessor = (*TLSExpiry)(nil)
+
+// TLSExpiry tracks the certificates served by ingresses and gateways.
+type TLSExpiry struct {
+	NonResource
+}
+
+// List returns the certificates referenced by TLS enabled ingresses and
+// gateways along with their expiry and cert-manager renewal status.
+func (t *TLSExpiry) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	ii, err := t.list(IngGVR, ns)
+	if err != nil {
+		return nil, err
+	}
+	cc := make([]render.TLSCert, 0, len(ii))
+	for _, u := range ii {
+		var ing netv1.Ingress
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ing); err != nil {
+			return nil, err
+		}
+		cc = append(cc, IngressTLSCerts(&ing)...)
+	}
+	if gg, err := t.list(GatewayGVR, ns); err == nil {
+		for _, u := range gg {
+			cc = append(cc, GatewayTLSCerts(u)...)
+		}
+	}
+
+	certs := make(map[string]*unstructured.Unstructured)
+	if uu, err := t.list(CertificateGVR, ns); err == nil {
+		for _, u := range uu {
+			if n, _, _ := unstructured.NestedString(u.Object, "spec", "secretName"); n != "" {
+				certs[client.FQN(u.GetNamespace(), n)] = u
+			}
+		}
+	}
+
+	oo := make([]runtime.Object, 0, len(cc))
+	for i := range cc {
+		t.inspect(&cc[i], certs)
+		oo = append(oo, &cc[i])
+	}
+
+	return oo, nil
+}
+
+// inspect fills in a certificate details from its secret and its
+// cert-manager certificate if any.
+func (t *TLSExpiry) inspect(c *render.TLSCert, certs map[string]*unstructured.Unstructured) {
+	fqn := c.Secret
+	if !strings.Contains(fqn, "/") {
+		fqn = client.FQN(c.Namespace, c.Secret)
+	}
+	c.Renewal = render.TLSRenewalManual
+	if u, ok := certs[fqn]; ok {
+		c.Renewal, c.Failing = CertManagerRenewal(u)
+	}
+
+	o, err := t.getFactory().Get(SecGVR.String(), fqn, true, labels.Everything())
+	if err != nil {
+		if c.Renewal != certIssuing {
+			c.Err = fmt.Errorf("secret %s not found", fqn)
+		}
+		return
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		c.Err = fmt.Errorf("expecting unstructured but got %T", o)
+		return
+	}
+	var sec v1.Secret
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sec); err != nil {
+		c.Err = err
+		return
+	}
+	cert, err := ParseLeafCert(sec.Data[v1.TLSCertKey])
+	if err != nil {
+		c.Err = err
+		return
+	}
+	c.Subject, c.Issuer, c.NotAfter = cert.Subject.CommonName, cert.Issuer.CommonName, cert.NotAfter
+}
+
+func (t *TLSExpiry) list(gvr client.GVR, ns string) ([]*unstructured.Unstructured, error) {
+	if _, err := MetaAccess.MetaFor(gvr); err != nil {
+		return nil, err
+	}
+	oo, err := t.getFactory().List(gvr.String(), ns, true, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("TLS expiry skipped %q", gvr)
+		return nil, err
+	}
+	uu := make([]*unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			uu = append(uu, u)
+		}
+	}
+
+	return uu, nil
+}
+
+// IngressTLSCerts returns the certificates an ingress serves.
+func IngressTLSCerts(ing *netv1.Ingress) []render.TLSCert {
+	cc := make([]render.TLSCert, 0, len(ing.Spec.TLS))
+	for _, tls := range ing.Spec.TLS {
+		if tls.SecretName == "" {
+			continue
+		}
+		cc = append(cc, render.TLSCert{
+			GVR:       IngGVR.String(),
+			Namespace: ing.Namespace,
+			Name:      ing.Name,
+			Kind:      "Ingress",
+			Hosts:     tls.Hosts,
+			Secret:    tls.SecretName,
+		})
+	}
+
+	return cc
+}
+
+// GatewayTLSCerts returns the certificates a gateway listeners serve.
+// Secrets living in another namespace are reported as ns/name.
+func GatewayTLSCerts(u *unstructured.Unstructured) []render.TLSCert {
+	var (
+		cc    []render.TLSCert
+		index = make(map[string]int)
+	)
+	for _, l := range render.NestedMaps(u.Object, "spec", "listeners") {
+		host, _, _ := unstructured.NestedString(l, "hostname")
+		for _, ref := range render.NestedMaps(l, "tls", "certificateRefs") {
+			if kind, _, _ := unstructured.NestedString(ref, "kind"); kind != "" && kind != "Secret" {
+				continue
+			}
+			if g, _, _ := unstructured.NestedString(ref, "group"); g != "" {
+				continue
+			}
+			n, _, _ := unstructured.NestedString(ref, "name")
+			if ns, _, _ := unstructured.NestedString(ref, "namespace"); ns != "" && ns != u.GetNamespace() {
+				n = client.FQN(ns, n)
+			}
+			if i, ok := index[n]; ok {
+				if host != "" {
+					cc[i].Hosts = append(cc[i].Hosts, host)
+				}
+				continue
+			}
+			c := render.TLSCert{
+				GVR:       GatewayGVR.String(),
+				Namespace: u.GetNamespace(),
+				Name:      u.GetName(),
+				Kind:      "Gateway",
+				Secret:    n,
+			}
+			if host != "" {
+				c.Hosts = []string{host}
+			}
+			index[n] = len(cc)
+			cc = append(cc, c)
+		}
+	}
+
+	return cc
+}
+
+// ParseLeafCert returns the first certificate of a PEM bundle.
+func ParseLeafCert(raw []byte) (*x509.Certificate, error) {
+	for len(raw) > 0 {
+		var b *pem.Block
+		b, raw = pem.Decode(raw)
+		if b == nil {
+			break
+		}
+		if b.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(b.Bytes)
+		}
+	}
+
+	return nil, errors.New("no certificate found")
+}
+
+// CertManagerRenewal returns a cert-manager certificate renewal status along
+// with the reason the certificate is not ready if any. Certificates being
+// issued are not reported as failing.
+func CertManagerRenewal(u *unstructured.Unstructured) (string, string) {
+	if status, _ := render.StatusCondition(u, "Issuing"); status == "True" {
+		return certIssuing, ""
+	}
+	var failing string
+	if status, reason := render.StatusCondition(u, "Ready"); status == "False" {
+		failing = reason
+	}
+	rt, _, _ := unstructured.NestedString(u.Object, "status", "renewalTime")
+	t, err := time.Parse(time.RFC3339, rt)
+	if err != nil {
+		return "Auto", failing
+	}
+
+	return "Auto " + t.UTC().Format("2006-01-02"), failing
+}
@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestIngressTLSCerts(t *testing.T) {
+	ing := netv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred"},
+		Spec: netv1.IngressSpec{
+			TLS: []netv1.IngressTLS{
+				{Hosts: []string{"a.example.com"}, SecretName: "a-tls"},
+				{Hosts: []string{"b.example.com"}},
+			},
+		},
+	}
+
+	assert.Equal(t, []render.TLSCert{
+		{
+			GVR:       "networking.k8s.io/v1/ingresses",
+			Namespace: "default",
+			Name:      "fred",
+			Kind:      "Ingress",
+			Hosts:     []string{"a.example.com"},
+			Secret:    "a-tls",
+		},
+	}, IngressTLSCerts(&ing))
+}
+
+func TestGatewayTLSCerts(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"namespace": "infra", "name": "gw"},
+		"spec": map[string]interface{}{
+			"listeners": []interface{}{
+				map[string]interface{}{
+					"name":     "http",
+					"protocol": "HTTP",
+				},
+				map[string]interface{}{
+					"hostname": "a.example.com",
+					"tls": map[string]interface{}{
+						"certificateRefs": []interface{}{
+							map[string]interface{}{"name": "wild-tls"},
+						},
+					},
+				},
+				map[string]interface{}{
+					"hostname": "b.example.com",
+					"tls": map[string]interface{}{
+						"certificateRefs": []interface{}{
+							map[string]interface{}{"kind": "Secret", "name": "wild-tls"},
+							map[string]interface{}{"name": "shop-tls", "namespace": "shop"},
+							map[string]interface{}{"group": "fred.io", "kind": "Blee", "name": "blee"},
+						},
+					},
+				},
+			},
+		},
+	}}
+
+	assert.Equal(t, []render.TLSCert{
+		{
+			GVR:       "gateway.networking.k8s.io/v1/gateways",
+			Namespace: "infra",
+			Name:      "gw",
+			Kind:      "Gateway",
+			Hosts:     []string{"a.example.com", "b.example.com"},
+			Secret:    "wild-tls",
+		},
+		{
+			GVR:       "gateway.networking.k8s.io/v1/gateways",
+			Namespace: "infra",
+			Name:      "gw",
+			Kind:      "Gateway",
+			Hosts:     []string{"b.example.com"},
+			Secret:    "shop/shop-tls",
+		},
+	}, GatewayTLSCerts(&u))
+}
+
+func TestParseLeafCert(t *testing.T) {
+	notAfter := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC()
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "a.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &k.PublicKey, k)
+	assert.NoError(t, err)
+	raw := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("fred")})
+	raw = append(raw, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+
+	c, err := ParseLeafCert(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "a.example.com", c.Subject.CommonName)
+	assert.Equal(t, notAfter, c.NotAfter)
+
+	_, err = ParseLeafCert([]byte("blee"))
+	assert.EqualError(t, err, "no certificate found")
+}
+
+func TestCertManagerRenewal(t *testing.T) {
+	uu := map[string]struct {
+		status           map[string]interface{}
+		renewal, failing string
+	}{
+		"ready": {
+			status: map[string]interface{}{
+				"renewalTime": "2026-12-01T10:00:00Z",
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True"},
+				},
+			},
+			renewal: "Auto 2026-12-01",
+		},
+		"issuing": {
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False", "reason": "DoesNotExist"},
+					map[string]interface{}{"type": "Issuing", "status": "True"},
+				},
+			},
+			renewal: "Issuing",
+		},
+		"failing": {
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False", "reason": "Failed", "message": "rate limited"},
+				},
+			},
+			renewal: "Auto",
+			failing: "Failed: rate limited",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cert := unstructured.Unstructured{Object: map[string]interface{}{"status": u.status}}
+			renewal, failing := CertManagerRenewal(&cert)
+			assert.Equal(t, u.renewal, renewal)
+			assert.Equal(t, u.failing, failing)
+		})
+	}
+}
@@ -88,6 +88,9 @@ type DrainOptions struct {
 	IgnoreAllDaemonSets bool
 	DeleteEmptyDirData  bool
 	Force               bool
+
+	// Ctx cancels an in flight drain. Defaults to the background context.
+	Ctx context.Context
 }
 
 // NodeMaintainer performs node maintenance operations.
@@ -120,6 +123,12 @@ type Scalable interface {
 	Scale(ctx context.Context, path string, replicas int32) error
 }
 
+// Suspendable represents resources that can be suspended.
+type Suspendable interface {
+	// ToggleSuspend suspends or resumes a resource.
+	ToggleSuspend(ctx context.Context, path string) error
+}
+
 // Controller represents a pod controller.
 type Controller interface {
 	// Pod returns a pod instance matching the selector.
@@ -132,6 +141,12 @@ type Nuker interface {
 	Delete(context.Context, string, *metav1.DeletionPropagation, Grace) error
 }
 
+// DeleteWarner represents resources warning about deletion side effects.
+type DeleteWarner interface {
+	// DeleteWarning returns what deleting a resource entails or an empty string.
+	DeleteWarning(path string) string
+}
+
 // Switchable represents a switchable resource.
 type Switchable interface {
 	// Switch changes the active context.
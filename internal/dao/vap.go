@@ -0,0 +1,359 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+const vapBindings = "validatingadmissionpolicybindings"
+
+// PolicyResult tracks the outcome of a validation expression.
+type PolicyResult struct {
+	Expression string `json:"expression"`
+	Passed     bool   `json:"passed"`
+	Message    string `json:"message,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// PolicyEval tracks a validating admission policy evaluation.
+type PolicyEval struct {
+	Binding string         `json:"binding,omitempty"`
+	Params  string         `json:"params,omitempty"`
+	Skipped string         `json:"skipped,omitempty"`
+	Results []PolicyResult `json:"results,omitempty"`
+}
+
+// PolicyEvalReport tracks the evaluations of a policy against an object.
+type PolicyEvalReport struct {
+	Policy      string       `json:"policy"`
+	Object      string       `json:"object"`
+	Evaluations []PolicyEval `json:"evaluations"`
+}
+
+// EvalPolicyReport evaluates a validating admission policy CEL expressions
+// against an object locally, once per binding and resolved params.
+func EvalPolicyReport(f Factory, gvr client.GVR, fqn string, target client.GVR, targetFQN string) (string, error) {
+	policy, err := getUnstructured(f, gvr, fqn)
+	if err != nil {
+		return "", err
+	}
+	obj, err := getUnstructured(f, target, targetFQN)
+	if err != nil {
+		return "", err
+	}
+
+	report := PolicyEvalReport{
+		Policy: policy.GetName(),
+		Object: target.String() + " " + targetFQN,
+	}
+	bb, err := PolicyBindings(f, gvr, policy.GetName())
+	if err != nil {
+		return "", err
+	}
+	if len(bb) == 0 {
+		e := evalPolicy(policy.Object, obj.Object, nil)
+		e.Binding = "<unbound>"
+		report.Evaluations = append(report.Evaluations, e)
+	}
+	for _, b := range bb {
+		report.Evaluations = append(report.Evaluations, evalBinding(f, policy, b, obj)...)
+	}
+
+	raw, err := yaml.Marshal(report)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// PolicyParamsReport resolves the params a binding hands to its policy.
+func PolicyParamsReport(f Factory, gvr client.GVR, fqn string) (string, error) {
+	b, err := getUnstructured(f, gvr, fqn)
+	if err != nil {
+		return "", err
+	}
+	pn, _, _ := unstructured.NestedString(b.Object, "spec", "policyName")
+	policyGVR := client.NewGVR(path.Join(gvr.G(), gvr.V(), "validatingadmissionpolicies"))
+	policy, err := getUnstructured(f, policyGVR, client.FQN(client.ClusterScope, pn))
+	if err != nil {
+		return "", err
+	}
+	pp, err := PolicyParams(f, policy, b, client.BlankNamespace)
+	if err != nil {
+		return "", err
+	}
+	if len(pp) == 0 {
+		return "# no params resolved for " + b.GetName() + "\n", nil
+	}
+	oo := make([]map[string]interface{}, 0, len(pp))
+	for _, p := range pp {
+		oo = append(oo, p.Object)
+	}
+	raw, err := yaml.Marshal(oo)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// PolicyBindings returns the bindings referencing a given policy.
+func PolicyBindings(f Factory, gvr client.GVR, policy string) ([]*unstructured.Unstructured, error) {
+	bgvr := path.Join(gvr.G(), gvr.V(), vapBindings)
+	oo, err := f.List(bgvr, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	bb := make([]*unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if n, _, _ := unstructured.NestedString(u.Object, "spec", "policyName"); n == policy {
+			bb = append(bb, u)
+		}
+	}
+
+	return bb, nil
+}
+
+// PolicyParams resolves the param resources a binding refers to. Namespaced
+// params default to the namespace of the object under evaluation.
+func PolicyParams(f Factory, policy, binding *unstructured.Unstructured, ns string) ([]*unstructured.Unstructured, error) {
+	kind, ok, _ := unstructured.NestedStringMap(policy.Object, "spec", "paramKind")
+	if !ok {
+		return nil, nil
+	}
+	gv, err := schema.ParseGroupVersion(kind["apiVersion"])
+	if err != nil {
+		return nil, err
+	}
+	gvr, namespaced, ok := MetaAccess.GVK2GVR(gv, kind["kind"])
+	if !ok {
+		return nil, fmt.Errorf("unable to resolve param kind %s %s", kind["apiVersion"], kind["kind"])
+	}
+
+	ref, ok, _ := unstructured.NestedMap(binding.Object, "spec", "paramRef")
+	if !ok {
+		return nil, nil
+	}
+	if pns, ok := ref["namespace"].(string); ok && pns != "" {
+		ns = pns
+	}
+	if !namespaced {
+		ns = client.ClusterScope
+	}
+	if n, ok := ref["name"].(string); ok && n != "" {
+		u, err := getUnstructured(f, gvr, client.FQN(ns, n))
+		if err != nil {
+			return nil, err
+		}
+		return []*unstructured.Unstructured{u}, nil
+	}
+
+	sel := labels.Everything()
+	if m, ok := ref["selector"].(map[string]interface{}); ok {
+		var ls metav1.LabelSelector
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &ls); err != nil {
+			return nil, err
+		}
+		if sel, err = metav1.LabelSelectorAsSelector(&ls); err != nil {
+			return nil, err
+		}
+	}
+	oo, err := f.List(gvr.String(), ns, true, sel)
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]*unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			pp = append(pp, u)
+		}
+	}
+
+	return pp, nil
+}
+
+func evalBinding(f Factory, policy, b, obj *unstructured.Unstructured) []PolicyEval {
+	if _, ok, _ := unstructured.NestedMap(policy.Object, "spec", "paramKind"); !ok {
+		e := evalPolicy(policy.Object, obj.Object, nil)
+		e.Binding = b.GetName()
+		return []PolicyEval{e}
+	}
+
+	pp, err := PolicyParams(f, policy, b, obj.GetNamespace())
+	if err != nil {
+		return []PolicyEval{{Binding: b.GetName(), Skipped: "params: " + err.Error()}}
+	}
+	if len(pp) == 0 {
+		action, _, _ := unstructured.NestedString(b.Object, "spec", "paramRef", "parameterNotFoundAction")
+		e := PolicyEval{Binding: b.GetName(), Skipped: "no params found"}
+		if action == "Deny" {
+			e.Skipped = ""
+			e.Results = []PolicyResult{{Expression: "paramRef", Message: "no params found and parameterNotFoundAction is Deny"}}
+		}
+		return []PolicyEval{e}
+	}
+	ee := make([]PolicyEval, 0, len(pp))
+	for _, p := range pp {
+		e := evalPolicy(policy.Object, obj.Object, p.Object)
+		e.Binding, e.Params = b.GetName(), client.FQN(p.GetNamespace(), p.GetName())
+		ee = append(ee, e)
+	}
+
+	return ee
+}
+
+// evalPolicy evaluates a policy match conditions, variables and validations.
+func evalPolicy(policy, obj, params map[string]interface{}) PolicyEval {
+	var e PolicyEval
+	env, err := policyEnv()
+	if err != nil {
+		e.Skipped = err.Error()
+		return e
+	}
+
+	var p interface{}
+	if params != nil {
+		p = params
+	}
+	vars := make(map[string]interface{})
+	act := map[string]interface{}{
+		"object":          obj,
+		"oldObject":       nil,
+		"params":          p,
+		"request":         policyRequest(obj),
+		"namespaceObject": nil,
+		"variables":       vars,
+	}
+	for _, c := range render.NestedMaps(policy, "spec", "matchConditions") {
+		expr, _ := c["expression"].(string)
+		v, err := evalCEL(env, expr, act)
+		if err != nil {
+			e.Skipped = fmt.Sprintf("match condition %v: %s", c["name"], err)
+			return e
+		}
+		if b, ok := v.Value().(bool); ok && !b {
+			e.Skipped = fmt.Sprintf("match condition %v is false", c["name"])
+			return e
+		}
+	}
+	for _, vr := range render.NestedMaps(policy, "spec", "variables") {
+		n, _ := vr["name"].(string)
+		expr, _ := vr["expression"].(string)
+		if v, err := evalCEL(env, expr, act); err == nil {
+			vars[n] = v
+		}
+	}
+	for _, v := range render.NestedMaps(policy, "spec", "validations") {
+		e.Results = append(e.Results, evalValidation(env, v, act))
+	}
+
+	return e
+}
+
+func evalValidation(env *cel.Env, v, act map[string]interface{}) PolicyResult {
+	expr, _ := v["expression"].(string)
+	r := PolicyResult{Expression: expr}
+	out, err := evalCEL(env, expr, act)
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+	b, ok := out.Value().(bool)
+	if !ok {
+		r.Error = fmt.Sprintf("expression returned %T, expecting a bool", out.Value())
+		return r
+	}
+	if r.Passed = b; b {
+		return r
+	}
+	r.Message, _ = v["message"].(string)
+	if mx, ok := v["messageExpression"].(string); ok && mx != "" {
+		if m, err := evalCEL(env, mx, act); err == nil {
+			if s, ok := m.Value().(string); ok {
+				r.Message = s
+			}
+		}
+	}
+	if r.Message == "" {
+		r.Message = "failed expression: " + expr
+	}
+
+	return r
+}
+
+func policyEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("object", cel.DynType),
+		cel.Variable("oldObject", cel.DynType),
+		cel.Variable("params", cel.DynType),
+		cel.Variable("request", cel.DynType),
+		cel.Variable("namespaceObject", cel.DynType),
+		cel.Variable("variables", cel.MapType(cel.StringType, cel.DynType)),
+		ext.Strings(),
+		ext.Sets(),
+	)
+}
+
+func evalCEL(env *cel.Env, expr string, act map[string]interface{}) (ref.Val, error) {
+	ast, iss := env.Compile(expr)
+	if iss != nil && iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	out, _, err := prg.Eval(act)
+
+	return out, err
+}
+
+func policyRequest(obj map[string]interface{}) map[string]interface{} {
+	u := unstructured.Unstructured{Object: obj}
+	gvk := u.GroupVersionKind()
+
+	return map[string]interface{}{
+		"operation": "CREATE",
+		"name":      u.GetName(),
+		"namespace": u.GetNamespace(),
+		"kind": map[string]interface{}{
+			"group":   gvk.Group,
+			"version": gvk.Version,
+			"kind":    gvk.Kind,
+		},
+		"userInfo": map[string]interface{}{},
+	}
+}
+
+func getUnstructured(f Factory, gvr client.GVR, fqn string) (*unstructured.Unstructured, error) {
+	o, err := f.Get(gvr.String(), fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return u, nil
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvalPolicy(t *testing.T) {
+	obj := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "fred", "namespace": "default"},
+		"spec":       map[string]interface{}{"replicas": int64(7)},
+	}
+	params := map[string]interface{}{
+		"data": map[string]interface{}{"max": "10"},
+	}
+	uu := map[string]struct {
+		policy map[string]interface{}
+		params map[string]interface{}
+		e      PolicyEval
+	}{
+		"pass-fail": {
+			policy: vapSpec(nil, nil,
+				map[string]interface{}{"expression": "object.spec.replicas >= 1"},
+				map[string]interface{}{"expression": "object.spec.replicas <= 5", "message": "too many replicas"},
+				map[string]interface{}{
+					"expression":        "has(object.metadata.labels)",
+					"messageExpression": "'no labels on ' + object.metadata.name",
+				},
+			),
+			e: PolicyEval{Results: []PolicyResult{
+				{Expression: "object.spec.replicas >= 1", Passed: true},
+				{Expression: "object.spec.replicas <= 5", Message: "too many replicas"},
+				{Expression: "has(object.metadata.labels)", Message: "no labels on fred"},
+			}},
+		},
+		"params-variables": {
+			policy: vapSpec(nil,
+				[]interface{}{map[string]interface{}{"name": "max", "expression": "int(params.data.max)"}},
+				map[string]interface{}{"expression": "object.spec.replicas <= variables.max"},
+			),
+			params: params,
+			e: PolicyEval{Results: []PolicyResult{
+				{Expression: "object.spec.replicas <= variables.max", Passed: true},
+			}},
+		},
+		"no-match": {
+			policy: vapSpec(
+				[]interface{}{map[string]interface{}{"name": "kube-system", "expression": "request.namespace == 'kube-system'"}},
+				nil,
+				map[string]interface{}{"expression": "false"},
+			),
+			e: PolicyEval{Skipped: "match condition kube-system is false"},
+		},
+		"not-bool": {
+			policy: vapSpec(nil, nil, map[string]interface{}{"expression": "object.metadata.name"}),
+			e: PolicyEval{Results: []PolicyResult{
+				{Expression: "object.metadata.name", Error: "expression returned string, expecting a bool"},
+			}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, evalPolicy(u.policy, obj, u.params))
+		})
+	}
+}
+
+func TestEvalPolicyCompileError(t *testing.T) {
+	e := evalPolicy(vapSpec(nil, nil, map[string]interface{}{"expression": "object.spec.replicas <"}), map[string]interface{}{}, nil)
+
+	assert.Len(t, e.Results, 1)
+	assert.False(t, e.Results[0].Passed)
+	assert.NotEmpty(t, e.Results[0].Error)
+}
+
+func vapSpec(conditions, variables []interface{}, validations ...interface{}) map[string]interface{} {
+	spec := map[string]interface{}{"validations": validations}
+	if conditions != nil {
+		spec["matchConditions"] = conditions
+	}
+	if variables != nil {
+		spec["variables"] = variables
+	}
+
+	return map[string]interface{}{"spec": spec}
+}
@@ -8,8 +8,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -43,6 +45,9 @@ var (
 // Workload tracks a select set of resources in a given namespace.
 type Workload struct {
 	Table
+
+	fan  *FanOut
+	once sync.Once
 }
 
 func (w *Workload) Delete(ctx context.Context, path string, propagation *metav1.DeletionPropagation, grace Grace) error {
@@ -81,8 +86,9 @@ func (w *Workload) Delete(ctx context.Context, path string, propagation *metav1.
 }
 
 func (a *Workload) fetch(ctx context.Context, gvr client.GVR, ns string) (*metav1.Table, error) {
-	a.Table.gvr = gvr
-	oo, err := a.Table.List(ctx, ns)
+	var t Table
+	t.Init(a.Factory, gvr)
+	oo, err := t.List(ctx, ns)
 	if err != nil {
 		return nil, err
 	}
@@ -97,42 +103,71 @@ func (a *Workload) fetch(ctx context.Context, gvr client.GVR, ns string) (*metav
 	return tt, nil
 }
 
-// List fetch workloads.
+// List fetch workloads. Kinds are fetched concurrently and the ones slow to
+// come back are picked up on a later refresh.
 func (a *Workload) List(ctx context.Context, ns string) ([]runtime.Object, error) {
-	oo := make([]runtime.Object, 0, 100)
+	a.once.Do(func() {
+		a.fan = NewFanOut(MaxFanOut, PartialWait)
+	})
+
+	kk := make([]string, 0, len(resList))
 	for _, gvr := range resList {
-		table, err := a.fetch(ctx, gvr, ns)
-		if err != nil {
+		kk = append(kk, a.Client().ActiveContext()+":"+client.FQN(ns, gvr.String()))
+	}
+	rr, pending, err := a.fan.Load(ctx, kk, func(ctx context.Context, k string) ([]runtime.Object, error) {
+		return a.rows(ctx, resList[slices.Index(kk, k)], ns)
+	})
+	if pending > 0 {
+		log.Debug().Msgf("Workloads %d kinds still loading", pending)
+	}
+
+	oo := make([]runtime.Object, 0, 100)
+	for _, r := range rr {
+		oo = append(oo, r...)
+	}
+	if err != nil {
+		if len(oo) == 0 {
 			return nil, err
 		}
-		var (
-			ns string
-			ts metav1.Time
-		)
-		for _, r := range table.Rows {
-			if obj := r.Object.Object; obj != nil {
-				if m, err := meta.Accessor(obj); err == nil {
-					ns = m.GetNamespace()
-					ts = m.GetCreationTimestamp()
-				}
-			} else {
-				var m metav1.PartialObjectMetadata
-				if err := json.Unmarshal(r.Object.Raw, &m); err == nil {
-					ns = m.GetNamespace()
-					ts = m.CreationTimestamp
-				}
+		log.Warn().Err(err).Msg("Workloads partially loaded")
+	}
+
+	return oo, nil
+}
+
+func (a *Workload) rows(ctx context.Context, gvr client.GVR, ns string) ([]runtime.Object, error) {
+	table, err := a.fetch(ctx, gvr, ns)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(table.Rows))
+	var (
+		rns string
+		ts  metav1.Time
+	)
+	for _, r := range table.Rows {
+		if obj := r.Object.Object; obj != nil {
+			if m, err := meta.Accessor(obj); err == nil {
+				rns = m.GetNamespace()
+				ts = m.GetCreationTimestamp()
+			}
+		} else {
+			var m metav1.PartialObjectMetadata
+			if err := json.Unmarshal(r.Object.Raw, &m); err == nil {
+				rns = m.GetNamespace()
+				ts = m.CreationTimestamp
 			}
-			stat := status(gvr, r, table.ColumnDefinitions)
-			oo = append(oo, &render.WorkloadRes{Row: metav1.TableRow{Cells: []interface{}{
-				gvr.String(),
-				ns,
-				r.Cells[indexOf("Name", table.ColumnDefinitions)],
-				stat,
-				readiness(gvr, r, table.ColumnDefinitions),
-				validity(stat),
-				ts,
-			}}})
 		}
+		stat := status(gvr, r, table.ColumnDefinitions)
+		oo = append(oo, &render.WorkloadRes{Row: metav1.TableRow{Cells: []interface{}{
+			gvr.String(),
+			rns,
+			r.Cells[indexOf("Name", table.ColumnDefinitions)],
+			stat,
+			readiness(gvr, r, table.ColumnDefinitions),
+			validity(stat),
+			ts,
+		}}})
 	}
 
 	return oo, nil
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build demo
+
+package demo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Churn mutates the demo cluster at the given interval until the context
+// is canceled so views keep moving like a live cluster.
+func (c *Connection) Churn(ctx context.Context, every time.Duration) {
+	t := time.NewTicker(every)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-t.C:
+			if err := c.tick(now); err != nil {
+				log.Warn().Err(err).Msg("Demo churn failed")
+			}
+		}
+	}
+}
+
+// tick schedules pending pods then applies a random change.
+func (c *Connection) tick(now time.Time) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	pp, err := c.pods()
+	if err != nil {
+		return err
+	}
+	for i := range pp {
+		if pp[i].Status.Phase != v1.PodPending {
+			continue
+		}
+		setRunning(&pp[i], now)
+		if err := c.update(podGVR, &pp[i]); err != nil {
+			return err
+		}
+		c.event(&pp[i], v1.EventTypeNormal, "Started", "Started container "+pp[i].Spec.Containers[0].Name, now)
+	}
+
+	switch c.rand.Intn(3) {
+	case 0:
+		return c.roll(pp, now)
+	case 1:
+		return c.crash(pp, now)
+	default:
+		return nil
+	}
+}
+
+// roll replaces a random replicaset pod with a pending one.
+func (c *Connection) roll(pp []v1.Pod, now time.Time) error {
+	cc := make([]v1.Pod, 0, len(pp))
+	for _, p := range pp {
+		if len(p.OwnerReferences) == 1 && p.OwnerReferences[0].Kind == "ReplicaSet" && p.Status.Phase == v1.PodRunning {
+			cc = append(cc, p)
+		}
+	}
+	if len(cc) == 0 {
+		return nil
+	}
+
+	old := cc[c.rand.Intn(len(cc))]
+	if err := c.delete(podGVR, old.Namespace, old.Name); err != nil {
+		return err
+	}
+	c.event(&old, v1.EventTypeNormal, "Killing", "Stopping container "+old.Spec.Containers[0].Name, now)
+
+	a := app{ns: old.Namespace, name: old.Labels["app"], image: old.Spec.Containers[0].Image}
+	if pp := old.Spec.Containers[0].Ports; len(pp) > 0 {
+		a.port = pp[0].ContainerPort
+	}
+	po := newPendingPod(a, podName(old.OwnerReferences[0].Name, int(now.UnixNano()%1_000_003)), old.OwnerReferences[0], nodeName[c.rand.Intn(len(nodeName))], now)
+	if err := c.create(podGVR, po); err != nil {
+		return err
+	}
+	c.event(po, v1.EventTypeNormal, "Scheduled", fmt.Sprintf("Successfully assigned %s/%s to %s", po.Namespace, po.Name, po.Spec.NodeName), now)
+
+	return nil
+}
+
+// crash bumps the restart count of crash looping pods.
+func (c *Connection) crash(pp []v1.Pod, now time.Time) error {
+	for i := range pp {
+		cs := pp[i].Status.ContainerStatuses
+		if len(cs) == 0 || cs[0].State.Waiting == nil || cs[0].State.Waiting.Reason != "CrashLoopBackOff" {
+			continue
+		}
+		setCrashLoop(&pp[i], cs[0].RestartCount+1)
+		if err := c.update(podGVR, &pp[i]); err != nil {
+			return err
+		}
+		c.event(&pp[i], v1.EventTypeWarning, "BackOff", "Back-off restarting failed container "+pp[i].Spec.Containers[0].Name, now)
+	}
+
+	return nil
+}
+
+func (c *Connection) event(po *v1.Pod, typ, reason, msg string, now time.Time) {
+	if err := c.create(evGVR, newEvent(po.Namespace, "Pod", po.Name, typ, reason, msg, now)); err != nil {
+		log.Warn().Err(err).Msg("Demo event failed")
+	}
+}
+
+func (c *Connection) pods() ([]v1.Pod, error) {
+	o, err := c.dyn.Tracker().List(podGVR.GVR(), podGVR.GV().WithKind("Pod"), "")
+	if err != nil {
+		return nil, err
+	}
+	l, ok := o.(*unstructured.UnstructuredList)
+	if !ok {
+		return nil, fmt.Errorf("expecting an unstructured list but got %T", o)
+	}
+	pp := make([]v1.Pod, len(l.Items))
+	for i, u := range l.Items {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pp[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return pp, nil
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build demo
+
+package demo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestRoll(t *testing.T) {
+	c, err := NewConnection(nil)
+	assert.NoError(t, err)
+	pp, err := c.pods()
+	assert.NoError(t, err)
+
+	now := time.Now()
+	assert.NoError(t, c.roll(pp, now))
+	rolled, err := c.pods()
+	assert.NoError(t, err)
+	assert.Equal(t, len(pp), len(rolled))
+	assert.Equal(t, 1, countPhase(rolled, v1.PodPending))
+
+	assert.NoError(t, c.tick(now.Add(time.Second)))
+	ticked, err := c.pods()
+	assert.NoError(t, err)
+	assert.Equal(t, len(pp), len(ticked))
+}
+
+func TestCrash(t *testing.T) {
+	c, err := NewConnection(nil)
+	assert.NoError(t, err)
+	pp, err := c.pods()
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.crash(pp, time.Now()))
+	pp, err = c.pods()
+	assert.NoError(t, err)
+	var restarts int32
+	for _, p := range pp {
+		if p.Labels["app"] == "checkout" && len(p.Status.ContainerStatuses) > 0 {
+			restarts += p.Status.ContainerStatuses[0].RestartCount
+		}
+	}
+	assert.Equal(t, int32(4), restarts)
+}
+
+func countPhase(pp []v1.Pod, phase v1.PodPhase) int {
+	var n int
+	for _, p := range pp {
+		if p.Status.Phase == phase {
+			n++
+		}
+	}
+
+	return n
+}
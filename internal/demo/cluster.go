@@ -0,0 +1,591 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build demo
+
+package demo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	defaultNS  = "default"
+	registry   = "ghcr.io/k9s-demo"
+	kubeletVer = "v1.29.3"
+)
+
+var (
+	nsGVR    = client.NewGVR("v1/namespaces")
+	nodeGVR  = client.NewGVR("v1/nodes")
+	podGVR   = client.NewGVR("v1/pods")
+	svcGVR   = client.NewGVR("v1/services")
+	cmGVR    = client.NewGVR("v1/configmaps")
+	secGVR   = client.NewGVR("v1/secrets")
+	saGVR    = client.NewGVR("v1/serviceaccounts")
+	evGVR    = client.NewGVR("v1/events")
+	pvcGVR   = client.NewGVR("v1/persistentvolumeclaims")
+	dpGVR    = client.NewGVR("apps/v1/deployments")
+	rsGVR    = client.NewGVR("apps/v1/replicasets")
+	stsGVR   = client.NewGVR("apps/v1/statefulsets")
+	dsGVR    = client.NewGVR("apps/v1/daemonsets")
+	jobGVR   = client.NewGVR("batch/v1/jobs")
+	cronGVR  = client.NewGVR("batch/v1/cronjobs")
+	ingGVR   = client.NewGVR("networking.k8s.io/v1/ingresses")
+	nodeName = []string{"demo-control-plane", "demo-worker-1", "demo-worker-2"}
+)
+
+type resourceType struct {
+	gvr        client.GVR
+	kind       string
+	namespaced bool
+	short      []string
+}
+
+// catalog lists the resources served by the demo cluster.
+var catalog = []resourceType{
+	{gvr: nsGVR, kind: "Namespace", short: []string{"ns"}},
+	{gvr: nodeGVR, kind: "Node", short: []string{"no"}},
+	{gvr: podGVR, kind: "Pod", namespaced: true, short: []string{"po"}},
+	{gvr: svcGVR, kind: "Service", namespaced: true, short: []string{"svc"}},
+	{gvr: client.NewGVR("v1/endpoints"), kind: "Endpoints", namespaced: true, short: []string{"ep"}},
+	{gvr: cmGVR, kind: "ConfigMap", namespaced: true, short: []string{"cm"}},
+	{gvr: secGVR, kind: "Secret", namespaced: true},
+	{gvr: saGVR, kind: "ServiceAccount", namespaced: true, short: []string{"sa"}},
+	{gvr: evGVR, kind: "Event", namespaced: true, short: []string{"ev"}},
+	{gvr: pvcGVR, kind: "PersistentVolumeClaim", namespaced: true, short: []string{"pvc"}},
+	{gvr: client.NewGVR("v1/persistentvolumes"), kind: "PersistentVolume", short: []string{"pv"}},
+	{gvr: dpGVR, kind: "Deployment", namespaced: true, short: []string{"deploy"}},
+	{gvr: rsGVR, kind: "ReplicaSet", namespaced: true, short: []string{"rs"}},
+	{gvr: stsGVR, kind: "StatefulSet", namespaced: true, short: []string{"sts"}},
+	{gvr: dsGVR, kind: "DaemonSet", namespaced: true, short: []string{"ds"}},
+	{gvr: jobGVR, kind: "Job", namespaced: true},
+	{gvr: cronGVR, kind: "CronJob", namespaced: true, short: []string{"cj"}},
+	{gvr: ingGVR, kind: "Ingress", namespaced: true, short: []string{"ing"}},
+	{gvr: client.NewGVR("networking.k8s.io/v1/networkpolicies"), kind: "NetworkPolicy", namespaced: true, short: []string{"netpol"}},
+	{gvr: client.NewGVR("storage.k8s.io/v1/storageclasses"), kind: "StorageClass", short: []string{"sc"}},
+	{gvr: client.NewGVR("rbac.authorization.k8s.io/v1/roles"), kind: "Role", namespaced: true},
+	{gvr: client.NewGVR("rbac.authorization.k8s.io/v1/rolebindings"), kind: "RoleBinding", namespaced: true},
+	{gvr: client.NewGVR("rbac.authorization.k8s.io/v1/clusterroles"), kind: "ClusterRole"},
+	{gvr: client.NewGVR("rbac.authorization.k8s.io/v1/clusterrolebindings"), kind: "ClusterRoleBinding"},
+	{gvr: client.NewGVR("autoscaling/v2/horizontalpodautoscalers"), kind: "HorizontalPodAutoscaler", namespaced: true, short: []string{"hpa"}},
+	{gvr: client.NewGVR("policy/v1/poddisruptionbudgets"), kind: "PodDisruptionBudget", namespaced: true, short: []string{"pdb"}},
+	{gvr: client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions"), kind: "CustomResourceDefinition", short: []string{"crd", "crds"}},
+}
+
+func kindOf(gvr client.GVR) string {
+	for _, r := range catalog {
+		if r.gvr == gvr {
+			return r.kind
+		}
+	}
+
+	return ""
+}
+
+func singular(kind string) string {
+	return strings.ToLower(kind)
+}
+
+// app describes a demo workload.
+type app struct {
+	ns, name, image string
+	replicas        int
+	port            int32
+	flaky           bool
+}
+
+var apps = []app{
+	{ns: "shop", name: "frontend", image: registry + "/frontend:1.4.2", replicas: 3, port: 8080},
+	{ns: "shop", name: "cart", image: registry + "/cart:2.0.1", replicas: 2, port: 7070},
+	{ns: "shop", name: "checkout", image: registry + "/checkout:0.9.7", replicas: 2, port: 5050, flaky: true},
+	{ns: "shop", name: "payments", image: registry + "/payments:3.1.0", replicas: 1, port: 9090},
+	{ns: "kube-system", name: "coredns", image: "registry.k8s.io/coredns/coredns:v1.11.1", replicas: 2, port: 53},
+}
+
+type object struct {
+	gvr client.GVR
+	obj runtime.Object
+}
+
+// seed returns the initial demo cluster content.
+func seed(now time.Time) []object {
+	oo := make([]object, 0, 100)
+	add := func(gvr client.GVR, o runtime.Object) {
+		oo = append(oo, object{gvr: gvr, obj: o})
+	}
+
+	for _, n := range []string{defaultNS, "kube-system", "shop", "monitoring"} {
+		add(nsGVR, &v1.Namespace{
+			ObjectMeta: meta("", n, now.Add(-30*24*time.Hour), nil),
+			Status:     v1.NamespaceStatus{Phase: v1.NamespaceActive},
+		})
+		add(saGVR, &v1.ServiceAccount{ObjectMeta: meta(n, "default", now.Add(-30*24*time.Hour), nil)})
+	}
+	for i, n := range nodeName {
+		add(nodeGVR, newNode(n, i, now.Add(-30*24*time.Hour)))
+	}
+
+	for i, a := range apps {
+		born := now.Add(-time.Duration(i+2) * 24 * time.Hour)
+		dp, rs := newDeployment(a, born), newReplicaSet(a, born)
+		add(dpGVR, dp)
+		add(rsGVR, rs)
+		for j := 0; j < a.replicas; j++ {
+			po := newPod(a, podName(rs.Name, i*7+j), owner("ReplicaSet", rs.ObjectMeta), nodeName[(i+j)%len(nodeName)], born, j == 0 && a.flaky)
+			add(podGVR, po)
+			if j == 0 && a.flaky {
+				add(evGVR, newEvent(a.ns, "Pod", po.Name, v1.EventTypeWarning, "BackOff", "Back-off restarting failed container "+a.name, now.Add(-2*time.Minute)))
+			}
+		}
+		add(svcGVR, newService(a, born))
+	}
+
+	proxy := app{ns: "kube-system", name: "kube-proxy", image: "registry.k8s.io/kube-proxy:" + kubeletVer}
+	ds := newDaemonSet(proxy, now.Add(-30*24*time.Hour))
+	add(dsGVR, ds)
+	for i, n := range nodeName {
+		add(podGVR, newPod(proxy, podName(proxy.name, 31+i), owner("DaemonSet", ds.ObjectMeta), n, ds.CreationTimestamp.Time, false))
+	}
+
+	prom := app{ns: "monitoring", name: "prometheus", image: "quay.io/prometheus/prometheus:v2.51.0", replicas: 1, port: 9090}
+	sts := newStatefulSet(prom, now.Add(-10*24*time.Hour))
+	add(stsGVR, sts)
+	add(podGVR, newPod(prom, prom.name+"-0", owner("StatefulSet", sts.ObjectMeta), nodeName[2], sts.CreationTimestamp.Time, false))
+	add(svcGVR, newService(prom, sts.CreationTimestamp.Time))
+	add(pvcGVR, newPVC(prom.ns, "data-"+prom.name+"-0", sts.CreationTimestamp.Time))
+
+	report := app{ns: "shop", name: "nightly-report", image: registry + "/report:1.0.0"}
+	cj := newCronJob(report, now.Add(-7*24*time.Hour))
+	add(cronGVR, cj)
+	job := newJob(report, now.Add(-6*time.Hour), cj.ObjectMeta)
+	add(jobGVR, job)
+	add(podGVR, newCompletedPod(report, podName(job.Name, 41), owner("Job", job.ObjectMeta), nodeName[1], job.CreationTimestamp.Time))
+
+	add(ingGVR, newIngress("shop", "frontend", now.Add(-4*24*time.Hour)))
+	add(cmGVR, &v1.ConfigMap{
+		ObjectMeta: meta("shop", "frontend-config", now.Add(-4*24*time.Hour), map[string]string{"app": "frontend"}),
+		Data:       map[string]string{"THEME": "dark", "CART_URL": "http://cart:7070", "LOG_LEVEL": "info"},
+	})
+	add(secGVR, &v1.Secret{
+		ObjectMeta: meta("shop", "payments-api", now.Add(-3*24*time.Hour), map[string]string{"app": "payments"}),
+		Type:       v1.SecretTypeOpaque,
+		Data:       map[string][]byte{"api-key": []byte("sk_demo_4242424242"), "webhook-secret": []byte("whsec_demo")},
+	})
+
+	return oo
+}
+
+func meta(ns, n string, born time.Time, ll map[string]string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Namespace:         ns,
+		Name:              n,
+		UID:               types.UID(fmt.Sprintf("demo-%s-%s", ns, n)),
+		CreationTimestamp: metav1.NewTime(born),
+		Labels:            ll,
+	}
+}
+
+func owner(kind string, m metav1.ObjectMeta) metav1.OwnerReference {
+	ctrl := true
+	api := "apps/v1"
+	if kind == "Job" || kind == "CronJob" {
+		api = "batch/v1"
+	}
+
+	return metav1.OwnerReference{APIVersion: api, Kind: kind, Name: m.Name, UID: m.UID, Controller: &ctrl}
+}
+
+// podName appends a generated suffix using the k8s name alphabet.
+func podName(prefix string, seed int) string {
+	return prefix + "-" + suffix(5, seed)
+}
+
+func rsName(a app) string {
+	var seed int
+	for _, c := range a.name {
+		seed += int(c)
+	}
+
+	return a.name + "-" + suffix(9, seed)
+}
+
+func suffix(n, seed int) string {
+	const chars = "bcdfghjklmnpqrstvwxz2456789"
+	bb := make([]byte, n)
+	for i := range bb {
+		seed = (seed*31 + 7) % 1_000_003
+		bb[i] = chars[seed%len(chars)]
+	}
+
+	return string(bb)
+}
+
+func newNode(n string, i int, born time.Time) *v1.Node {
+	ll := map[string]string{"kubernetes.io/hostname": n, "kubernetes.io/os": "linux"}
+	if i == 0 {
+		ll["node-role.kubernetes.io/control-plane"] = ""
+	}
+	rl := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("4"),
+		v1.ResourceMemory: resource.MustParse("16Gi"),
+		v1.ResourcePods:   resource.MustParse("110"),
+	}
+
+	return &v1.Node{
+		ObjectMeta: meta("", n, born, ll),
+		Status: v1.NodeStatus{
+			Capacity:    rl,
+			Allocatable: rl,
+			Conditions: []v1.NodeCondition{
+				{Type: v1.NodeReady, Status: v1.ConditionTrue, Reason: "KubeletReady", LastTransitionTime: metav1.NewTime(born)},
+			},
+			Addresses: []v1.NodeAddress{
+				{Type: v1.NodeInternalIP, Address: fmt.Sprintf("10.0.0.%d", 10+i)},
+				{Type: v1.NodeHostName, Address: n},
+			},
+			NodeInfo: v1.NodeSystemInfo{
+				KubeletVersion:          kubeletVer,
+				KubeProxyVersion:        kubeletVer,
+				OSImage:                 "Debian GNU/Linux 12 (bookworm)",
+				KernelVersion:           "6.1.0-18-amd64",
+				ContainerRuntimeVersion: "containerd://1.7.13",
+				OperatingSystem:         "linux",
+				Architecture:            "amd64",
+			},
+		},
+	}
+}
+
+func appLabels(a app) map[string]string {
+	return map[string]string{"app": a.name}
+}
+
+func podSpec(a app) v1.PodSpec {
+	c := v1.Container{
+		Name:  a.name,
+		Image: a.image,
+		Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m"), v1.ResourceMemory: resource.MustParse("128Mi")},
+			Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("256Mi")},
+		},
+	}
+	if a.port > 0 {
+		c.Ports = []v1.ContainerPort{{Name: "http", ContainerPort: a.port, Protocol: v1.ProtocolTCP}}
+	}
+
+	return v1.PodSpec{Containers: []v1.Container{c}, ServiceAccountName: "default"}
+}
+
+func newDeployment(a app, born time.Time) *appsv1.Deployment {
+	r := int32(a.replicas)
+	ready := r
+	if a.flaky {
+		ready--
+	}
+
+	return &appsv1.Deployment{
+		ObjectMeta: meta(a.ns, a.name, born, appLabels(a)),
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &r,
+			Selector: &metav1.LabelSelector{MatchLabels: appLabels(a)},
+			Template: v1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: appLabels(a)}, Spec: podSpec(a)},
+		},
+		Status: appsv1.DeploymentStatus{
+			Replicas:          r,
+			ReadyReplicas:     ready,
+			UpdatedReplicas:   r,
+			AvailableReplicas: ready,
+		},
+	}
+}
+
+func newReplicaSet(a app, born time.Time) *appsv1.ReplicaSet {
+	r := int32(a.replicas)
+	m := meta(a.ns, rsName(a), born, appLabels(a))
+	m.OwnerReferences = []metav1.OwnerReference{owner("Deployment", meta(a.ns, a.name, born, nil))}
+
+	return &appsv1.ReplicaSet{
+		ObjectMeta: m,
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas: &r,
+			Selector: &metav1.LabelSelector{MatchLabels: appLabels(a)},
+			Template: v1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: appLabels(a)}, Spec: podSpec(a)},
+		},
+		Status: appsv1.ReplicaSetStatus{Replicas: r, ReadyReplicas: r, AvailableReplicas: r},
+	}
+}
+
+func newDaemonSet(a app, born time.Time) *appsv1.DaemonSet {
+	n := int32(len(nodeName))
+
+	return &appsv1.DaemonSet{
+		ObjectMeta: meta(a.ns, a.name, born, appLabels(a)),
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: appLabels(a)},
+			Template: v1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: appLabels(a)}, Spec: podSpec(a)},
+		},
+		Status: appsv1.DaemonSetStatus{
+			DesiredNumberScheduled: n,
+			CurrentNumberScheduled: n,
+			NumberReady:            n,
+			UpdatedNumberScheduled: n,
+			NumberAvailable:        n,
+		},
+	}
+}
+
+func newStatefulSet(a app, born time.Time) *appsv1.StatefulSet {
+	r := int32(a.replicas)
+
+	return &appsv1.StatefulSet{
+		ObjectMeta: meta(a.ns, a.name, born, appLabels(a)),
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    &r,
+			ServiceName: a.name,
+			Selector:    &metav1.LabelSelector{MatchLabels: appLabels(a)},
+			Template:    v1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: appLabels(a)}, Spec: podSpec(a)},
+		},
+		Status: appsv1.StatefulSetStatus{Replicas: r, ReadyReplicas: r, CurrentReplicas: r, UpdatedReplicas: r, AvailableReplicas: r},
+	}
+}
+
+func newCronJob(a app, born time.Time) *batchv1.CronJob {
+	last := metav1.NewTime(born.Add(6*24*time.Hour + 18*time.Hour))
+
+	return &batchv1.CronJob{
+		ObjectMeta: meta(a.ns, a.name, born, appLabels(a)),
+		Spec: batchv1.CronJobSpec{
+			Schedule: "0 2 * * *",
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{Template: v1.PodTemplateSpec{Spec: podSpec(a)}},
+			},
+		},
+		Status: batchv1.CronJobStatus{LastScheduleTime: &last},
+	}
+}
+
+func newJob(a app, born time.Time, cj metav1.ObjectMeta) *batchv1.Job {
+	one := int32(1)
+	m := meta(a.ns, a.name+"-28519320", born, appLabels(a))
+	m.OwnerReferences = []metav1.OwnerReference{owner("CronJob", cj)}
+	done := metav1.NewTime(born.Add(47 * time.Second))
+	start := metav1.NewTime(born)
+
+	return &batchv1.Job{
+		ObjectMeta: m,
+		Spec: batchv1.JobSpec{
+			Completions: &one,
+			Parallelism: &one,
+			Template:    v1.PodTemplateSpec{Spec: podSpec(a)},
+		},
+		Status: batchv1.JobStatus{Succeeded: 1, StartTime: &start, CompletionTime: &done},
+	}
+}
+
+func newPod(a app, n string, o metav1.OwnerReference, node string, born time.Time, flaky bool) *v1.Pod {
+	m := meta(a.ns, n, born, appLabels(a))
+	m.OwnerReferences = []metav1.OwnerReference{o}
+	spec := podSpec(a)
+	spec.NodeName = node
+	po := v1.Pod{ObjectMeta: m, Spec: spec}
+	if flaky {
+		setCrashLoop(&po, 3)
+	} else {
+		setRunning(&po, born)
+	}
+
+	return &po
+}
+
+func newCompletedPod(a app, n string, o metav1.OwnerReference, node string, born time.Time) *v1.Pod {
+	po := newPod(a, n, o, node, born, false)
+	po.Status.Phase = v1.PodSucceeded
+	po.Status.Conditions = nil
+	po.Status.ContainerStatuses[0].Ready = false
+	po.Status.ContainerStatuses[0].State = v1.ContainerState{
+		Terminated: &v1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed", StartedAt: metav1.NewTime(born), FinishedAt: metav1.NewTime(born.Add(47 * time.Second))},
+	}
+
+	return po
+}
+
+func newPendingPod(a app, n string, o metav1.OwnerReference, node string, born time.Time) *v1.Pod {
+	po := newPod(a, n, o, node, born, false)
+	po.Status = v1.PodStatus{
+		Phase: v1.PodPending,
+		ContainerStatuses: []v1.ContainerStatus{{
+			Name:  a.name,
+			Image: a.image,
+			State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"}},
+		}},
+	}
+
+	return po
+}
+
+func setRunning(po *v1.Pod, at time.Time) {
+	c := po.Spec.Containers[0]
+	start := metav1.NewTime(at)
+	po.Status = v1.PodStatus{
+		Phase:     v1.PodRunning,
+		HostIP:    nodeIP(po.Spec.NodeName),
+		PodIP:     podIP(po.Name),
+		StartTime: &start,
+		Conditions: []v1.PodCondition{
+			{Type: v1.PodReady, Status: v1.ConditionTrue, LastTransitionTime: start},
+			{Type: v1.ContainersReady, Status: v1.ConditionTrue, LastTransitionTime: start},
+		},
+		ContainerStatuses: []v1.ContainerStatus{{
+			Name:    c.Name,
+			Image:   c.Image,
+			ImageID: c.Image,
+			Ready:   true,
+			Started: boolPtr(true),
+			State:   v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: start}},
+		}},
+	}
+}
+
+func setCrashLoop(po *v1.Pod, restarts int32) {
+	c := po.Spec.Containers[0]
+	start := po.CreationTimestamp
+	po.Status = v1.PodStatus{
+		Phase:     v1.PodRunning,
+		HostIP:    nodeIP(po.Spec.NodeName),
+		PodIP:     podIP(po.Name),
+		StartTime: &start,
+		Conditions: []v1.PodCondition{
+			{Type: v1.PodReady, Status: v1.ConditionFalse, Reason: "ContainersNotReady"},
+		},
+		ContainerStatuses: []v1.ContainerStatus{{
+			Name:         c.Name,
+			Image:        c.Image,
+			ImageID:      c.Image,
+			RestartCount: restarts,
+			Started:      boolPtr(false),
+			State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{
+				Reason:  "CrashLoopBackOff",
+				Message: "back-off restarting failed container " + c.Name,
+			}},
+			LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}},
+		}},
+	}
+}
+
+func nodeIP(n string) string {
+	for i, nn := range nodeName {
+		if nn == n {
+			return fmt.Sprintf("10.0.0.%d", 10+i)
+		}
+	}
+
+	return ""
+}
+
+func podIP(n string) string {
+	var h int
+	for _, c := range n {
+		h = h*31 + int(c)
+	}
+	if h < 0 {
+		h = -h
+	}
+
+	return fmt.Sprintf("10.244.%d.%d", h%3, 2+h%250)
+}
+
+func newService(a app, born time.Time) *v1.Service {
+	return &v1.Service{
+		ObjectMeta: meta(a.ns, a.name, born, appLabels(a)),
+		Spec: v1.ServiceSpec{
+			Type:       v1.ServiceTypeClusterIP,
+			Selector:   appLabels(a),
+			ClusterIP:  podIP(a.name + "-svc"),
+			ClusterIPs: []string{podIP(a.name + "-svc")},
+			Ports: []v1.ServicePort{{
+				Name:       "http",
+				Port:       a.port,
+				TargetPort: intstr.FromInt32(a.port),
+				Protocol:   v1.ProtocolTCP,
+			}},
+		},
+	}
+}
+
+func newPVC(ns, n string, born time.Time) *v1.PersistentVolumeClaim {
+	sc := "standard"
+
+	return &v1.PersistentVolumeClaim{
+		ObjectMeta: meta(ns, n, born, nil),
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes:      []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			StorageClassName: &sc,
+			VolumeName:       "pvc-" + n,
+			Resources: v1.VolumeResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("20Gi")},
+			},
+		},
+		Status: v1.PersistentVolumeClaimStatus{
+			Phase:       v1.ClaimBound,
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			Capacity:    v1.ResourceList{v1.ResourceStorage: resource.MustParse("20Gi")},
+		},
+	}
+}
+
+func newIngress(ns, svc string, born time.Time) *netv1.Ingress {
+	pt := netv1.PathTypePrefix
+
+	return &netv1.Ingress{
+		ObjectMeta: meta(ns, svc, born, nil),
+		Spec: netv1.IngressSpec{
+			Rules: []netv1.IngressRule{{
+				Host: "shop.k9s-demo.local",
+				IngressRuleValue: netv1.IngressRuleValue{HTTP: &netv1.HTTPIngressRuleValue{
+					Paths: []netv1.HTTPIngressPath{{
+						Path:     "/",
+						PathType: &pt,
+						Backend: netv1.IngressBackend{Service: &netv1.IngressServiceBackend{
+							Name: svc,
+							Port: netv1.ServiceBackendPort{Name: "http"},
+						}},
+					}},
+				}},
+			}},
+		},
+	}
+}
+
+func newEvent(ns, kind, n, typ, reason, msg string, at time.Time) *v1.Event {
+	m := meta(ns, fmt.Sprintf("%s.%x", n, at.UnixNano()), at, nil)
+
+	return &v1.Event{
+		ObjectMeta:     m,
+		InvolvedObject: v1.ObjectReference{Kind: kind, Namespace: ns, Name: n},
+		Type:           typ,
+		Reason:         reason,
+		Message:        msg,
+		Source:         v1.EventSource{Component: "kubelet"},
+		Count:          1,
+		FirstTimestamp: metav1.NewTime(at),
+		LastTimestamp:  metav1.NewTime(at),
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,350 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build demo
+
+// Package demo provides a synthetic in-memory cluster so k9s can be
+// explored without touching a real cluster. It is only built with the demo
+// build tag so the client fakes stay out of regular binaries.
+package demo
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery/cached/disk"
+	"k8s.io/client-go/dynamic"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	restclient "k8s.io/client-go/rest"
+	versioned "k8s.io/metrics/pkg/client/clientset/versioned"
+)
+
+const (
+	// ContextName represents the demo context name.
+	ContextName = "k9s-demo"
+
+	// ChurnInterval represents the default interval between cluster changes.
+	ChurnInterval = 3 * time.Second
+
+	kubeConfigTmpl = `apiVersion: v1
+kind: Config
+clusters:
+- name: %[1]s
+  cluster:
+    server: https://%[1]s.invalid
+contexts:
+- name: %[1]s
+  context:
+    cluster: %[1]s
+    user: %[1]s
+    namespace: %[2]s
+current-context: %[1]s
+users:
+- name: %[1]s
+  user:
+    token: demo
+`
+)
+
+var errNotSupported = errors.New("not available in demo mode")
+
+// WriteKubeConfig writes a kubeconfig for the demo context to a new private
+// file in the given directory and returns its path. An empty dir defaults
+// to the os temp directory.
+func WriteKubeConfig(dir string) (string, error) {
+	f, err := os.CreateTemp(dir, ContextName+"-*.yaml")
+	if err != nil {
+		return "", err
+	}
+	if err := f.Chmod(0600); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+	if _, err := fmt.Fprintf(f, kubeConfigTmpl, ContextName, defaultNS); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+
+	return f.Name(), f.Close()
+}
+
+// Connection represents a connection to the demo cluster.
+type Connection struct {
+	config *client.Config
+	cs     *fake.Clientset
+	dyn    *dynfake.FakeDynamicClient
+	rand   *rand.Rand
+	mx     sync.Mutex
+}
+
+var _ client.Connection = (*Connection)(nil)
+
+// NewConnection returns a new demo cluster connection.
+func NewConnection(cfg *client.Config) (*Connection, error) {
+	sch := runtime.NewScheme()
+	kinds := make(map[schema.GroupVersionResource]string, len(catalog))
+	for _, r := range catalog {
+		gv := r.gvr.GV()
+		sch.AddKnownTypeWithName(gv.WithKind(r.kind), &unstructured.Unstructured{})
+		sch.AddKnownTypeWithName(gv.WithKind(r.kind+"List"), &unstructured.UnstructuredList{})
+		kinds[r.gvr.GVR()] = r.kind + "List"
+	}
+
+	c := Connection{
+		config: cfg,
+		cs:     fake.NewSimpleClientset(),
+		dyn:    dynfake.NewSimpleDynamicClientWithCustomListKinds(sch, kinds),
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	for _, o := range seed(time.Now()) {
+		if err := c.create(o.gvr, o.obj); err != nil {
+			return nil, fmt.Errorf("demo seed failed: %w", err)
+		}
+	}
+
+	return &c, nil
+}
+
+// ServerPreferredResources returns the demo cluster resources.
+func (c *Connection) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	gg := make(map[string]*metav1.APIResourceList)
+	for _, r := range catalog {
+		gv := r.gvr.GV().String()
+		l, ok := gg[gv]
+		if !ok {
+			l = &metav1.APIResourceList{GroupVersion: gv}
+			gg[gv] = l
+		}
+		l.APIResources = append(l.APIResources, metav1.APIResource{
+			Name:         r.gvr.R(),
+			SingularName: singular(r.kind),
+			Namespaced:   r.namespaced,
+			Kind:         r.kind,
+			ShortNames:   r.short,
+			Verbs:        metav1.Verbs{"get", "list", "watch", "create", "update", "patch", "delete"},
+		})
+	}
+	ll := make([]*metav1.APIResourceList, 0, len(gg))
+	for _, l := range gg {
+		ll = append(ll, l)
+	}
+	sort.Slice(ll, func(i, j int) bool {
+		return ll[i].GroupVersion < ll[j].GroupVersion
+	})
+
+	return ll, nil
+}
+
+// CanI grants all access on the demo cluster.
+func (*Connection) CanI(string, string, string, []string) (bool, error) {
+	return true, nil
+}
+
+// Config returns current config.
+func (c *Connection) Config() *client.Config {
+	return c.config
+}
+
+// ConnectionOK checks api server connection status.
+func (*Connection) ConnectionOK() bool {
+	return true
+}
+
+// Dial returns a typed client for the demo cluster.
+func (c *Connection) Dial() (kubernetes.Interface, error) {
+	return c.cs, nil
+}
+
+// DialLogs returns a typed client for the demo cluster.
+func (c *Connection) DialLogs() (kubernetes.Interface, error) {
+	return c.cs, nil
+}
+
+// SwitchContext is not supported in demo mode.
+func (*Connection) SwitchContext(string) error {
+	return fmt.Errorf("context switch %w", errNotSupported)
+}
+
+// CachedDiscovery is not supported in demo mode.
+func (*Connection) CachedDiscovery() (*disk.CachedDiscoveryClient, error) {
+	return nil, fmt.Errorf("discovery client %w", errNotSupported)
+}
+
+// RestConfig is not supported in demo mode.
+func (*Connection) RestConfig() (*restclient.Config, error) {
+	return nil, fmt.Errorf("rest client %w", errNotSupported)
+}
+
+// MXDial is not supported in demo mode.
+func (*Connection) MXDial() (*versioned.Clientset, error) {
+	return nil, fmt.Errorf("metrics %w", errNotSupported)
+}
+
+// DynDial returns a dynamic client for the demo cluster.
+func (c *Connection) DynDial() (dynamic.Interface, error) {
+	return c.dyn, nil
+}
+
+// HasMetrics checks if cluster metrics are available.
+func (*Connection) HasMetrics() bool {
+	return false
+}
+
+// HasMetricsServer checks if metrics server is available.
+func (*Connection) HasMetricsServer() bool {
+	return false
+}
+
+// SetRateLimit sets the client qps and burst.
+func (*Connection) SetRateLimit(float32, int) {}
+
+// SetProxy is not supported in demo mode.
+func (*Connection) SetProxy(client.ProxySpec) error {
+	return fmt.Errorf("proxy %w", errNotSupported)
+}
+
+// SetLowBandwidth toggles low bandwidth api calls.
+func (*Connection) SetLowBandwidth(bool) {}
+
+// ValidNamespaceNames returns all available namespace names.
+func (c *Connection) ValidNamespaceNames() (client.NamespaceNames, error) {
+	o, err := c.cs.Tracker().List(nsGVR.GVR(), nsGVR.GV().WithKind("Namespace"), "")
+	if err != nil {
+		return nil, err
+	}
+	l, ok := o.(*v1.NamespaceList)
+	if !ok {
+		return nil, fmt.Errorf("expecting a namespace list but got %T", o)
+	}
+	nns := make(client.NamespaceNames, len(l.Items))
+	for _, n := range l.Items {
+		nns[n.Name] = struct{}{}
+	}
+
+	return nns, nil
+}
+
+// IsValidNamespace checks if given namespace is known.
+func (c *Connection) IsValidNamespace(ns string) bool {
+	if client.IsClusterWide(ns) || ns == client.NotNamespaced {
+		return true
+	}
+	nns, err := c.ValidNamespaceNames()
+	if err != nil {
+		return false
+	}
+	_, ok := nns[ns]
+
+	return ok
+}
+
+// ServerVersion returns the demo server version.
+func (*Connection) ServerVersion() (*version.Info, error) {
+	return &version.Info{
+		Major:      "1",
+		Minor:      "29",
+		GitVersion: "v1.29.3-demo",
+		Platform:   "linux/amd64",
+	}, nil
+}
+
+// CheckConnectivity checks if api server connection is happy or not.
+func (*Connection) CheckConnectivity() bool {
+	return true
+}
+
+// ActiveContext returns the current context name.
+func (*Connection) ActiveContext() string {
+	return ContextName
+}
+
+// ActiveNamespace returns the current namespace.
+func (*Connection) ActiveNamespace() string {
+	return defaultNS
+}
+
+// IsActiveNamespace checks if given ns is active.
+func (*Connection) IsActiveNamespace(ns string) bool {
+	return ns == defaultNS
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// The dynamic client backs most views and is authoritative. The typed
+// client mirrors it and may drift when resources are edited from the ui.
+func (c *Connection) create(gvr client.GVR, o runtime.Object) error {
+	u, err := toUnstructured(gvr, o)
+	if err != nil {
+		return err
+	}
+	ns := namespaceOf(o)
+	if err := c.dyn.Tracker().Create(gvr.GVR(), u, ns); err != nil {
+		return err
+	}
+
+	return mirror(c.cs.Tracker().Create(gvr.GVR(), o, ns))
+}
+
+func (c *Connection) update(gvr client.GVR, o runtime.Object) error {
+	u, err := toUnstructured(gvr, o)
+	if err != nil {
+		return err
+	}
+	ns := namespaceOf(o)
+	if err := c.dyn.Tracker().Update(gvr.GVR(), u, ns); err != nil {
+		return err
+	}
+
+	return mirror(c.cs.Tracker().Update(gvr.GVR(), o, ns))
+}
+
+func (c *Connection) delete(gvr client.GVR, ns, n string) error {
+	if err := c.dyn.Tracker().Delete(gvr.GVR(), ns, n); err != nil {
+		return err
+	}
+
+	return mirror(c.cs.Tracker().Delete(gvr.GVR(), ns, n))
+}
+
+func mirror(err error) error {
+	if apierrors.IsNotFound(err) || apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+
+	return err
+}
+
+func namespaceOf(o runtime.Object) string {
+	if m, ok := o.(metav1.Object); ok {
+		return m.GetNamespace()
+	}
+
+	return ""
+}
+
+func toUnstructured(gvr client.GVR, o runtime.Object) (*unstructured.Unstructured, error) {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	if err != nil {
+		return nil, err
+	}
+	u := unstructured.Unstructured{Object: m}
+	u.SetGroupVersionKind(gvr.GV().WithKind(kindOf(gvr)))
+
+	return &u, nil
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build demo
+
+package demo_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/demo"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWriteKubeConfig(t *testing.T) {
+	path, err := demo.WriteKubeConfig(t.TempDir())
+
+	assert.NoError(t, err)
+	bb, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(filepath.Base(path), "k9s-demo-"))
+	assert.Contains(t, string(bb), "current-context: k9s-demo")
+	fi, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	path2, err := demo.WriteKubeConfig(filepath.Dir(path))
+	assert.NoError(t, err)
+	assert.NotEqual(t, path, path2)
+}
+
+func TestConnectionSeed(t *testing.T) {
+	c, err := demo.NewConnection(nil)
+	assert.NoError(t, err)
+
+	nns, err := c.ValidNamespaceNames()
+	assert.NoError(t, err)
+	assert.Equal(t, client.NamespaceNames{"default": {}, "kube-system": {}, "shop": {}, "monitoring": {}}, nns)
+	assert.True(t, c.IsValidNamespace("shop"))
+	assert.False(t, c.IsValidNamespace("fred"))
+
+	dial, err := c.Dial()
+	assert.NoError(t, err)
+	pp, err := dial.CoreV1().Pods("shop").List(context.Background(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 9, len(pp.Items))
+
+	dyn, err := c.DynDial()
+	assert.NoError(t, err)
+	uu, err := dyn.Resource(client.NewGVR("apps/v1/deployments").GVR()).Namespace("shop").List(context.Background(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(uu.Items))
+	assert.Equal(t, "Deployment", uu.Items[0].GetKind())
+}
+
+func TestConnectionEmptyList(t *testing.T) {
+	c, err := demo.NewConnection(nil)
+	assert.NoError(t, err)
+	dyn, err := c.DynDial()
+	assert.NoError(t, err)
+
+	uu, err := dyn.Resource(client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions").GVR()).List(context.Background(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(uu.Items))
+}
+
+func TestServerPreferredResources(t *testing.T) {
+	c, err := demo.NewConnection(nil)
+	assert.NoError(t, err)
+	rr, err := c.ServerPreferredResources()
+	assert.NoError(t, err)
+
+	var pods *metav1.APIResource
+	for _, l := range rr {
+		if l.GroupVersion != "v1" {
+			continue
+		}
+		for i := range l.APIResources {
+			if l.APIResources[i].Name == "pods" {
+				pods = &l.APIResources[i]
+			}
+		}
+	}
+	assert.NotNil(t, pods)
+	assert.Equal(t, "Pod", pods.Kind)
+	assert.True(t, pods.Namespaced)
+	assert.Equal(t, []string{"po"}, pods.ShortNames)
+}
@@ -21,6 +21,9 @@ const (
 
 	// S3 tracks series 3.
 	S3
+
+	// Peak tracks the busiest node usage percentage.
+	Peak
 )
 
 // Message represents a health message.
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+// Package i18n translates ui strings. English strings double as message
+// ids so anything missing from a catalog is shown untranslated.
+package i18n
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultLocale represents the locale ui strings are authored in.
+const DefaultLocale = "en"
+
+var (
+	//go:embed locales/*.yaml
+	locales embed.FS
+
+	catalog atomic.Pointer[map[string]string]
+)
+
+// Locales returns the shipped locales.
+func Locales() []string {
+	ee, err := locales.ReadDir("locales")
+	if err != nil {
+		return nil
+	}
+	ll := []string{DefaultLocale}
+	for _, e := range ee {
+		ll = append(ll, strings.TrimSuffix(e.Name(), filepath.Ext(e.Name())))
+	}
+	sort.Strings(ll)
+
+	return ll
+}
+
+// SetLocale activates the catalog for the given locale ie fr or fr_CA.UTF-8.
+// A catalog found in dir takes precedence over the shipped one. An empty
+// locale reverts to English.
+func SetLocale(locale, dir string) error {
+	ll := candidates(locale)
+	if len(ll) == 0 || ll[len(ll)-1] == DefaultLocale {
+		catalog.Store(nil)
+		return nil
+	}
+	for _, l := range ll {
+		bb, err := load(l, dir)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		var m map[string]string
+		if err := yaml.Unmarshal(bb, &m); err != nil {
+			return fmt.Errorf("invalid catalog for locale %q: %w", l, err)
+		}
+		catalog.Store(&m)
+		return nil
+	}
+
+	return fmt.Errorf("no catalog found for locale %q", locale)
+}
+
+// T returns the translation for the given message.
+func T(msg string) string {
+	m := catalog.Load()
+	if m == nil {
+		return msg
+	}
+	if s, ok := (*m)[msg]; ok && s != "" {
+		return s
+	}
+
+	return msg
+}
+
+// Tf formats the translation for the given message.
+func Tf(format string, args ...any) string {
+	return fmt.Sprintf(T(format), args...)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func load(locale, dir string) ([]byte, error) {
+	if dir != "" {
+		bb, err := os.ReadFile(filepath.Join(dir, locale+".yaml"))
+		if !errors.Is(err, fs.ErrNotExist) {
+			return bb, err
+		}
+	}
+
+	return locales.ReadFile("locales/" + locale + ".yaml")
+}
+
+// candidates lists catalog names to try for a locale, most specific first.
+func candidates(locale string) []string {
+	l := strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(l, ".@"); i >= 0 {
+		l = l[:i]
+	}
+	l = strings.ReplaceAll(l, "_", "-")
+	if l == "" || l == "c" || l == "posix" {
+		return nil
+	}
+	if i := strings.Index(l, "-"); i > 0 {
+		return []string{l, l[:i]}
+	}
+
+	return []string{l}
+}
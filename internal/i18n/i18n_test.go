@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocales(t *testing.T) {
+	assert.Equal(t, []string{"de", "en", "es", "fr", "ja", "zh"}, i18n.Locales())
+}
+
+func TestStockCatalogs(t *testing.T) {
+	defer func() { _ = i18n.SetLocale("", "") }()
+
+	for _, l := range i18n.Locales() {
+		t.Run(l, func(t *testing.T) {
+			assert.NoError(t, i18n.SetLocale(l, ""))
+			if l == i18n.DefaultLocale {
+				assert.Equal(t, "Delete", i18n.T("Delete"))
+				return
+			}
+			assert.NotEqual(t, "Delete", i18n.T("Delete"))
+		})
+	}
+}
+
+func TestSetLocale(t *testing.T) {
+	defer func() { _ = i18n.SetLocale("", "") }()
+
+	uu := map[string]struct {
+		locale, dir, msg, e string
+		err                 bool
+	}{
+		"english": {
+			locale: "en_US.UTF-8",
+			msg:    "Logs",
+			e:      "Logs",
+		},
+		"posix": {
+			locale: "C",
+			msg:    "Logs",
+			e:      "Logs",
+		},
+		"stock": {
+			locale: "de",
+			msg:    "Help",
+			e:      "Hilfe",
+		},
+		"region": {
+			locale: "es_MX.UTF-8",
+			msg:    "Delete",
+			e:      "Eliminar",
+		},
+		"custom": {
+			locale: "fr",
+			dir:    "testdata/locales",
+			msg:    "Logs",
+			e:      "Bûches",
+		},
+		"custom-new": {
+			locale: "xx",
+			dir:    "testdata/locales",
+			msg:    "Logs",
+			e:      "Xogs",
+		},
+		"empty-translation": {
+			locale: "xx",
+			dir:    "testdata/locales",
+			msg:    "Delete",
+			e:      "Delete",
+		},
+		"missing": {
+			locale: "es",
+			msg:    "Blee",
+			e:      "Blee",
+		},
+		"unknown": {
+			locale: "zz",
+			msg:    "Logs",
+			e:      "Logs",
+			err:    true,
+		},
+		"invalid": {
+			locale: "yy",
+			dir:    "testdata/locales",
+			msg:    "Logs",
+			e:      "Logs",
+			err:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_ = i18n.SetLocale("", "")
+			err := i18n.SetLocale(u.locale, u.dir)
+			if u.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, u.e, i18n.T(u.msg))
+		})
+	}
+}
+
+func TestTf(t *testing.T) {
+	defer func() { _ = i18n.SetLocale("", "") }()
+
+	assert.Equal(t, "Logs 2", i18n.Tf("Logs %d", 2))
+}
@@ -21,6 +21,7 @@ const (
 	KeyContainers    ContextKey = "containers"
 	KeyBenchCfg      ContextKey = "benchcfg"
 	KeyAliases       ContextKey = "aliases"
+	KeyHelp          ContextKey = "help"
 	KeyUID           ContextKey = "uid"
 	KeySubjectKind   ContextKey = "subjectKind"
 	KeySubjectName   ContextKey = "subjectName"
@@ -36,4 +37,9 @@ const (
 	KeyWait          ContextKey = "wait"
 	KeyPodCounting   ContextKey = "podCounting"
 	KeyEnableImgScan ContextKey = "vulScan"
+	KeyQuery         ContextKey = "query"
+	KeySubresource   ContextKey = "subresource"
+	KeyAPIPath       ContextKey = "apiPath"
+	KeyFleet         ContextKey = "fleet"
+	KeyDNSLookup     ContextKey = "dnsLookup"
 )
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MaxAlerts tracks the number of alerts kept around.
+const MaxAlerts = 100
+
+// Alert represents an in-app alert.
+type Alert struct {
+	Time    time.Time
+	Source  string
+	Message string
+}
+
+// Alerts tracks recent in-app alerts.
+type Alerts struct {
+	alerts []Alert
+	limit  int
+	mx     sync.RWMutex
+}
+
+// NewAlerts returns a new instance.
+func NewAlerts(limit int) *Alerts {
+	return &Alerts{limit: limit}
+}
+
+// Add records a new alert and evicts the oldest past capacity.
+func (a *Alerts) Add(al Alert) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	if len(a.alerts) >= a.limit {
+		a.alerts = a.alerts[1:]
+	}
+	a.alerts = append(a.alerts, al)
+}
+
+// List returns all alerts, most recent first.
+func (a *Alerts) List() []Alert {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	aa := make([]Alert, 0, len(a.alerts))
+	for i := len(a.alerts) - 1; i >= 0; i-- {
+		aa = append(aa, a.alerts[i])
+	}
+
+	return aa
+}
+
+// Dump returns a text representation of all alerts.
+func (a *Alerts) Dump() string {
+	var b strings.Builder
+	for _, al := range a.List() {
+		fmt.Fprintf(&b, "%s [%s] %s\n", al.Time.Format(time.DateTime), al.Source, al.Message)
+	}
+	if b.Len() == 0 {
+		return "No alerts."
+	}
+
+	return b.String()
+}
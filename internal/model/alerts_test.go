@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertsAdd(t *testing.T) {
+	a := model.NewAlerts(2)
+	assert.Equal(t, "No alerts.", a.Dump())
+
+	ti := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	a.Add(model.Alert{Time: ti, Source: "pulse", Message: "fred"})
+	a.Add(model.Alert{Time: ti, Source: "pulse", Message: "blee"})
+	a.Add(model.Alert{Time: ti, Source: "pulse", Message: "duh"})
+
+	aa := a.List()
+	assert.Equal(t, 2, len(aa))
+	assert.Equal(t, "duh", aa[0].Message)
+	assert.Equal(t, "blee", aa[1].Message)
+	assert.Equal(t, "2024-01-01 10:00:00 [pulse] duh\n2024-01-01 10:00:00 [pulse] blee\n", a.Dump())
+}
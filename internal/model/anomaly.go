@@ -0,0 +1,325 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// AnomalyRestarts tracks containers restarts per sample.
+	AnomalyRestarts = "restarts"
+
+	// AnomalyChurn tracks pods creations and deletions per sample.
+	AnomalyChurn = "churn"
+
+	// AnomalyEvents tracks warning events per sample.
+	AnomalyEvents = "events"
+
+	anomalyRefresh = time.Minute
+
+	// anomalyAlpha tracks the baselines smoothing factor, about a 20mn memory.
+	anomalyAlpha = 0.05
+
+	// anomalyMinSamples tracks the number of samples to learn before flagging.
+	anomalyMinSamples = 30
+
+	// anomalyMinDev prevents flat baselines from flagging tiny deviations.
+	anomalyMinDev = 1.0
+)
+
+var anomalyMetrics = []string{AnomalyRestarts, AnomalyChurn, AnomalyEvents}
+
+// Baseline tracks a metric exponentially weighted mean and variance.
+type Baseline struct {
+	Mean    float64 `json:"mean"`
+	Var     float64 `json:"var"`
+	Samples int     `json:"samples"`
+}
+
+// Update folds a new sample into the baseline.
+func (b *Baseline) Update(v float64) {
+	if b.Samples == 0 {
+		b.Mean = v
+	} else {
+		d := v - b.Mean
+		b.Mean += anomalyAlpha * d
+		b.Var = (1 - anomalyAlpha) * (b.Var + anomalyAlpha*d*d)
+	}
+	b.Samples++
+}
+
+// Score returns how many standard deviations a sample sits above the
+// baseline. Baselines still learning always score zero.
+func (b *Baseline) Score(v float64) float64 {
+	if b.Samples < anomalyMinSamples {
+		return 0
+	}
+
+	return (v - b.Mean) / math.Max(math.Sqrt(b.Var), anomalyMinDev)
+}
+
+// Baselines tracks metrics baselines per namespace.
+type Baselines map[string]map[string]*Baseline
+
+// AnomalyDetector learns namespaces activity baselines and flags namespaces
+// deviating strongly from them.
+type AnomalyDetector struct {
+	factory   dao.Factory
+	config    config.Anomalies
+	path      string
+	baselines Baselines
+	restarts  map[string]int32
+	flagged   map[string]string
+	last      time.Time
+	mx        sync.RWMutex
+}
+
+// NewAnomalyDetector returns a new detector persisting baselines to path.
+func NewAnomalyDetector(f dao.Factory, cfg config.Anomalies, path string) *AnomalyDetector {
+	return &AnomalyDetector{
+		factory:   f,
+		config:    cfg,
+		path:      path,
+		baselines: make(Baselines),
+		restarts:  make(map[string]int32),
+		flagged:   make(map[string]string),
+	}
+}
+
+// Anomaly returns why a namespace is deviating from its baseline if any.
+func (a *AnomalyDetector) Anomaly(ns string) string {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	return a.flagged[ns]
+}
+
+// Load reads persisted baselines if any.
+func (a *AnomalyDetector) Load() error {
+	bb, err := os.ReadFile(a.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var bl Baselines
+	if err := json.Unmarshal(bb, &bl); err != nil {
+		return fmt.Errorf("baselines load failed %q: %w", a.path, err)
+	}
+
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	if bl != nil {
+		a.baselines = bl
+	}
+
+	return nil
+}
+
+// Save persists the learned baselines.
+func (a *AnomalyDetector) Save() error {
+	a.mx.RLock()
+	bb, err := json.Marshal(a.baselines)
+	a.mx.RUnlock()
+	if err != nil {
+		return err
+	}
+	if err := data.EnsureDirPath(a.path, data.DefaultDirMod); err != nil {
+		return err
+	}
+
+	return os.WriteFile(a.path, bb, data.DefaultFileMod)
+}
+
+// Watch samples the cluster activity until canceled.
+func (a *AnomalyDetector) Watch(ctx context.Context) {
+	if err := a.Load(); err != nil {
+		log.Warn().Err(err).Msg("Anomaly baselines load failed")
+	}
+	defer func() {
+		if err := a.Save(); err != nil {
+			log.Warn().Err(err).Msg("Anomaly baselines save failed")
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(client.Throttle.Scale(anomalyRefresh)):
+			if err := a.refresh(); err != nil {
+				log.Warn().Err(err).Msg("Anomaly detector refresh failed")
+				continue
+			}
+			if err := a.Save(); err != nil {
+				log.Warn().Err(err).Msg("Anomaly baselines save failed")
+			}
+		}
+	}
+}
+
+func (a *AnomalyDetector) refresh() error {
+	var (
+		pp []v1.Pod
+		ee []v1.Event
+	)
+	err := a.list("v1/pods", func(u *unstructured.Unstructured) error {
+		var po v1.Pod
+		if err := kruntime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return err
+		}
+		pp = append(pp, po)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	err = a.list("v1/events", func(u *unstructured.Unstructured) error {
+		var ev v1.Event
+		if err := kruntime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ev); err != nil {
+			return err
+		}
+		ee = append(ee, ev)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	a.Sample(pp, ee, time.Now())
+
+	return nil
+}
+
+func (a *AnomalyDetector) list(gvr string, f func(*unstructured.Unstructured) error) error {
+	oo, err := a.factory.List(gvr, client.BlankNamespace, false, labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if err := f(u); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sample scores the activity since the last sample against the namespaces
+// baselines then folds it into them. The first sample only primes the
+// pods restarts counters.
+func (a *AnomalyDetector) Sample(pp []v1.Pod, ee []v1.Event, now time.Time) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	var (
+		counts   = make(map[string]map[string]float64)
+		restarts = make(map[string]int32, len(pp))
+		primed   = !a.last.IsZero()
+	)
+	inc := func(ns, metric string, v float64) {
+		if ns == "" {
+			return
+		}
+		if _, ok := counts[ns]; !ok {
+			counts[ns] = make(map[string]float64, len(anomalyMetrics))
+		}
+		counts[ns][metric] += v
+	}
+	for i := range pp {
+		po := &pp[i]
+		fqn := client.FQN(po.Namespace, po.Name)
+		var total int32
+		for _, cs := range po.Status.ContainerStatuses {
+			total += cs.RestartCount
+		}
+		restarts[fqn] = total
+		inc(po.Namespace, AnomalyRestarts, 0)
+		if !primed {
+			continue
+		}
+		prev, ok := a.restarts[fqn]
+		switch {
+		case ok && total > prev:
+			inc(po.Namespace, AnomalyRestarts, float64(total-prev))
+		case !ok && po.CreationTimestamp.Time.After(a.last):
+			inc(po.Namespace, AnomalyRestarts, float64(total))
+			inc(po.Namespace, AnomalyChurn, 1)
+		}
+	}
+	if primed {
+		for fqn := range a.restarts {
+			if _, ok := restarts[fqn]; !ok {
+				ns, _ := client.Namespaced(fqn)
+				inc(ns, AnomalyChurn, 1)
+			}
+		}
+		for i := range ee {
+			if ee[i].Type == v1.EventTypeWarning && eventTime(&ee[i]).After(a.last) {
+				inc(ee[i].InvolvedObject.Namespace, AnomalyEvents, 1)
+			}
+		}
+	}
+	a.restarts, a.last = restarts, now
+	if !primed {
+		return
+	}
+
+	for ns := range a.baselines {
+		if _, ok := counts[ns]; !ok {
+			counts[ns] = make(map[string]float64, len(anomalyMetrics))
+		}
+	}
+	flagged := make(map[string]string)
+	for ns, mm := range counts {
+		bl, ok := a.baselines[ns]
+		if !ok {
+			bl = make(map[string]*Baseline, len(anomalyMetrics))
+			a.baselines[ns] = bl
+		}
+		var reasons []string
+		for _, m := range anomalyMetrics {
+			b, ok := bl[m]
+			if !ok {
+				b = new(Baseline)
+				bl[m] = b
+			}
+			v := mm[m]
+			if b.Score(v) >= a.config.GetSensitivity() {
+				reasons = append(reasons, fmt.Sprintf("%s %g (avg %.1f)", m, v, b.Mean))
+			}
+			b.Update(v)
+		}
+		if len(reasons) > 0 {
+			sort.Strings(reasons)
+			flagged[ns] = strings.Join(reasons, ", ")
+		}
+	}
+	a.flagged = flagged
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBaselineScore(t *testing.T) {
+	var b model.Baseline
+	for i := 0; i < 29; i++ {
+		b.Update(2)
+	}
+	assert.Equal(t, 0.0, b.Score(100))
+
+	b.Update(2)
+	assert.Equal(t, 2.0, b.Mean)
+	assert.Equal(t, 3.0, b.Score(5))
+	assert.Equal(t, -2.0, b.Score(0))
+}
+
+func TestAnomalyDetectorSample(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baselines.json")
+	a := model.NewAnomalyDetector(nil, config.Anomalies{Enable: true}, path)
+
+	now := time.Now()
+	pp := []v1.Pod{makeRestartPod("fred", "p1", now.Add(-time.Hour), 1)}
+	for i := 0; i <= 30; i++ {
+		now = now.Add(time.Minute)
+		a.Sample(pp, nil, now)
+	}
+	assert.Equal(t, "", a.Anomaly("fred"))
+
+	now = now.Add(time.Minute)
+	pp = []v1.Pod{makeRestartPod("fred", "p1", now.Add(-time.Hour), 6)}
+	ee := []v1.Event{*makeEvent("Pod", "p1", "BackOff", now.Add(-time.Second))}
+	ee[0].InvolvedObject.Namespace = "fred"
+	a.Sample(pp, ee, now)
+	assert.Equal(t, "restarts 5 (avg 0.0)", a.Anomaly("fred"))
+
+	a.Sample(pp, ee, now.Add(time.Minute))
+	assert.Equal(t, "", a.Anomaly("fred"))
+
+	assert.NoError(t, a.Save())
+	a1 := model.NewAnomalyDetector(nil, config.Anomalies{Sensitivity: 2}, path)
+	assert.NoError(t, a1.Load())
+	now = now.Add(time.Hour)
+	a1.Sample(pp, nil, now)
+	pp = append(pp, makeRestartPod("fred", "p2", now.Add(time.Second), 0), makeRestartPod("fred", "p3", now.Add(time.Second), 0))
+	a1.Sample(pp, nil, now.Add(time.Minute))
+	assert.Equal(t, "churn 2 (avg 0.0)", a1.Anomaly("fred"))
+}
+
+// Helpers...
+
+func makeRestartPod(ns, n string, created time.Time, restarts int32) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n, CreationTimestamp: metav1.NewTime(created)},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{{Name: "c1", RestartCount: restarts}},
+		},
+	}
+}
@@ -186,7 +186,17 @@ func (d *Describe) describe(ctx context.Context, gvr client.GVR, path string) (s
 		desc.SetDecode(d.decode)
 	}
 
-	return desc.Describe(path)
+	s, err := desc.Describe(path)
+	if err != nil {
+		return "", err
+	}
+	if f, ok := ctx.Value(internal.KeyFactory).(dao.Factory); ok {
+		if hh, err := dao.ResourceHints(f, gvr, path); err == nil {
+			s = dao.RenderHints(hh) + s
+		}
+	}
+
+	return s, nil
 }
 
 // AddListener adds a new model listener.
@@ -161,6 +161,17 @@ func (l *Log) Restart(ctx context.Context) {
 	l.Start(ctx)
 }
 
+// Retarget tails the logs of another resource or container.
+func (l *Log) Retarget(ctx context.Context, gvr client.GVR, path, co string) {
+	l.Stop()
+	l.gvr = gvr
+	l.logOptions.Path, l.logOptions.Container = path, co
+	l.logOptions.AllContainers = co == ""
+	l.Clear()
+	l.fireLogResume()
+	l.Start(ctx)
+}
+
 // Start starts logging.
 func (l *Log) Start(ctx context.Context) {
 	if err := l.load(ctx); err != nil {
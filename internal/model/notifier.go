@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+const notifyRefresh = 10 * time.Second
+
+// NotifyFunc sends a notification.
+type NotifyFunc func(title, msg string) error
+
+// Notifier forwards matching cluster events to desktop notifications.
+type Notifier struct {
+	factory dao.Factory
+	config  config.Notifications
+	sendFn  NotifyFunc
+	since   time.Time
+	sent    map[string]time.Time
+	window  []time.Time
+	mx      sync.Mutex
+}
+
+// NewNotifier returns a new events notifier.
+func NewNotifier(f dao.Factory, cfg config.Notifications) *Notifier {
+	return &Notifier{
+		factory: f,
+		config:  cfg,
+		sendFn:  DesktopNotify,
+		since:   time.Now(),
+		sent:    make(map[string]time.Time),
+	}
+}
+
+// SetNotifyFn overrides the notification sender.
+func (n *Notifier) SetNotifyFn(f NotifyFunc) {
+	n.sendFn = f
+}
+
+// Watch polls cluster events until canceled.
+func (n *Notifier) Watch(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(client.Throttle.Scale(notifyRefresh)):
+			if err := n.refresh(); err != nil {
+				log.Warn().Err(err).Msg("Event notifier refresh failed")
+			}
+		}
+	}
+}
+
+func (n *Notifier) refresh() error {
+	oo, err := n.factory.List("v1/events", client.BlankNamespace, false, labels.Everything())
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var ev v1.Event
+		if err := kruntime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ev); err != nil {
+			return err
+		}
+		n.Notify(&ev, now)
+	}
+
+	return nil
+}
+
+// Notify sends a notification if the event matches a rule and is not rate limited.
+func (n *Notifier) Notify(ev *v1.Event, now time.Time) bool {
+	if eventTime(ev).Before(n.since) {
+		return false
+	}
+	obj := ev.InvolvedObject
+	for _, r := range n.config.Rules {
+		if !r.Matches(ev.Type, obj.Kind, obj.Namespace, ev.Reason, ev.Message) {
+			continue
+		}
+		if !n.allow(r.Name+":"+obj.Kind+":"+client.FQN(obj.Namespace, obj.Name)+":"+ev.Reason, now) {
+			return false
+		}
+		title := fmt.Sprintf("K9s %s: %s %s", ev.Reason, strings.ToLower(obj.Kind), client.FQN(obj.Namespace, obj.Name))
+		if err := n.sendFn(title, ev.Message); err != nil {
+			log.Warn().Err(err).Msg("Desktop notification failed")
+		}
+		return true
+	}
+
+	return false
+}
+
+func (n *Notifier) allow(key string, now time.Time) bool {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	if t, ok := n.sent[key]; ok && now.Sub(t) < n.config.GetRateLimit() {
+		return false
+	}
+	w := n.window[:0]
+	for _, t := range n.window {
+		if now.Sub(t) < time.Minute {
+			w = append(w, t)
+		}
+	}
+	n.window = w
+	if len(n.window) >= n.config.GetMaxPerMin() {
+		return false
+	}
+	n.sent[key], n.window = now, append(n.window, now)
+
+	return true
+}
+
+func eventTime(ev *v1.Event) time.Time {
+	switch {
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	default:
+		return ev.FirstTimestamp.Time
+	}
+}
+
+// DesktopNotify raises an OS notification.
+func DesktopNotify(title, msg string) error {
+	return notifyCmd(runtime.GOOS, title, msg).Run()
+}
+
+// notifyCmd returns the command raising a notification. The title and
+// message are never interpolated in a script, they are handed over as
+// arguments or env vars.
+func notifyCmd(goos, title, msg string) *exec.Cmd {
+	switch goos {
+	case "darwin":
+		return exec.Command("osascript",
+			"-e", "on run argv",
+			"-e", "display notification (item 2 of argv) with title (item 1 of argv)",
+			"-e", "end run",
+			title, msg,
+		)
+	case "windows":
+		cmd := exec.Command("powershell", "-NoProfile", "-Command",
+			"New-BurntToastNotification -Text $env:K9S_NOTIFY_TITLE,$env:K9S_NOTIFY_MSG")
+		cmd.Env = append(os.Environ(), "K9S_NOTIFY_TITLE="+title, "K9S_NOTIFY_MSG="+msg)
+		return cmd
+	default:
+		return exec.Command("notify-send", "--app-name=k9s", "--", title, msg)
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifyCmd(t *testing.T) {
+	title, msg := "K9s ‘); Remove-Item -Recurse ~ #", "-q $(reboot)"
+	uu := map[string]struct {
+		args []string
+		env  bool
+	}{
+		"darwin": {
+			args: []string{"osascript", "-e", "on run argv", "-e", "display notification (item 2 of argv) with title (item 1 of argv)", "-e", "end run", title, msg},
+		},
+		"windows": {
+			args: []string{"powershell", "-NoProfile", "-Command", "New-BurntToastNotification -Text $env:K9S_NOTIFY_TITLE,$env:K9S_NOTIFY_MSG"},
+			env:  true,
+		},
+		"linux": {
+			args: []string{"notify-send", "--app-name=k9s", "--", title, msg},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cmd := notifyCmd(k, title, msg)
+			assert.Equal(t, u.args, cmd.Args)
+			if !u.env {
+				return
+			}
+			assert.Contains(t, cmd.Env, "K9S_NOTIFY_TITLE="+title)
+			assert.Contains(t, cmd.Env, "K9S_NOTIFY_MSG="+msg)
+			assert.False(t, strings.Contains(strings.Join(cmd.Args, " "), title))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNotifierNotify(t *testing.T) {
+	cfg := config.Notifications{
+		Rules: []config.NotifyRule{
+			{Name: "nodes", Kinds: []string{"Node"}, Reasons: []string{"NodeNotReady"}},
+		},
+	}
+	n := model.NewNotifier(nil, cfg)
+	var titles []string
+	n.SetNotifyFn(func(title, _ string) error {
+		titles = append(titles, title)
+		return nil
+	})
+
+	now := time.Now().Add(time.Second)
+	assert.True(t, n.Notify(makeEvent("Node", "n1", "NodeNotReady", now), now))
+	assert.False(t, n.Notify(makeEvent("Node", "n1", "NodeNotReady", now), now.Add(time.Minute)))
+	assert.True(t, n.Notify(makeEvent("Node", "n2", "NodeNotReady", now), now))
+	assert.False(t, n.Notify(makeEvent("Pod", "p1", "NodeNotReady", now), now))
+	assert.False(t, n.Notify(makeEvent("Node", "n3", "NodeNotReady", now.Add(-time.Hour)), now))
+	assert.True(t, n.Notify(makeEvent("Node", "n1", "NodeNotReady", now), now.Add(6*time.Minute)))
+
+	assert.Equal(t, []string{
+		"K9s NodeNotReady: node n1",
+		"K9s NodeNotReady: node n2",
+		"K9s NodeNotReady: node n1",
+	}, titles)
+}
+
+func TestNotifierMaxPerMin(t *testing.T) {
+	cfg := config.Notifications{
+		MaxPerMin: 2,
+		Rules:     []config.NotifyRule{{Name: "all"}},
+	}
+	n := model.NewNotifier(nil, cfg)
+	var count int
+	n.SetNotifyFn(func(_, _ string) error {
+		count++
+		return nil
+	})
+
+	now := time.Now().Add(time.Second)
+	for _, p := range []string{"p1", "p2", "p3"} {
+		n.Notify(makeEvent("Pod", p, "BackOff", now), now)
+	}
+	assert.Equal(t, 2, count)
+
+	n.Notify(makeEvent("Pod", "p4", "BackOff", now), now.Add(2*time.Minute))
+	assert.Equal(t, 3, count)
+}
+
+func makeEvent(kind, name, reason string, t time.Time) *v1.Event {
+	return &v1.Event{
+		Type:          "Warning",
+		Reason:        reason,
+		Message:       "blee",
+		LastTimestamp: metav1.NewTime(t),
+		InvolvedObject: v1.ObjectReference{
+			Kind: kind,
+			Name: name,
+		},
+	}
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/health"
+	"github.com/rs/zerolog/log"
+)
+
+// PulseAlarmSource tags alerts raised by pulse alarms.
+const PulseAlarmSource = "Pulses"
+
+// PulseAlarmListener represents a pulse alarm listener.
+type PulseAlarmListener interface {
+	// PulseAlarmTripped notifies a resource alarms were raised.
+	PulseAlarmTripped(gvr string, mm []string, bell bool)
+}
+
+// PulseAlarms evaluates pulse alarms whether or not the pulse view is active.
+type PulseAlarms struct {
+	pulse     *Pulse
+	alarms    config.PulseAlarms
+	alerts    *Alerts
+	tripped   map[string]bool
+	listeners []PulseAlarmListener
+	mx        sync.RWMutex
+}
+
+// NewPulseAlarms returns a new instance.
+func NewPulseAlarms(aa config.PulseAlarms, alerts *Alerts) *PulseAlarms {
+	p := PulseAlarms{
+		pulse:   NewPulse("pulses"),
+		alarms:  aa,
+		alerts:  alerts,
+		tripped: make(map[string]bool),
+	}
+	p.pulse.AddListener(&p)
+
+	return &p
+}
+
+// Watch monitors the cluster pulses until canceled.
+func (p *PulseAlarms) Watch(ctx context.Context) {
+	p.pulse.Watch(ctx)
+}
+
+// AddListener adds a listener.
+func (p *PulseAlarms) AddListener(l PulseAlarmListener) {
+	p.listeners = append(p.listeners, l)
+}
+
+// IsTripped checks if a pulse resource alarm is currently raised.
+func (p *PulseAlarms) IsTripped(gvr string) bool {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.tripped[gvr]
+}
+
+// PulseChanged evaluates the alarms for a resource. Alarms are raised once
+// when tripped and cleared once the metric recovers.
+func (p *PulseAlarms) PulseChanged(c *health.Check) {
+	mm, bell := p.check(c)
+
+	p.mx.Lock()
+	was := p.tripped[c.GVR]
+	p.tripped[c.GVR] = len(mm) > 0
+	p.mx.Unlock()
+	if len(mm) == 0 || was {
+		return
+	}
+
+	for _, m := range mm {
+		p.alerts.Add(Alert{Time: time.Now(), Source: PulseAlarmSource, Message: m})
+	}
+	for _, l := range p.listeners {
+		l.PulseAlarmTripped(c.GVR, mm, bell)
+	}
+}
+
+// PulseFailed notifies the pulse check failed.
+func (*PulseAlarms) PulseFailed(err error) {
+	log.Warn().Err(err).Msg("Pulse alarms check failed")
+}
+
+func (p *PulseAlarms) check(c *health.Check) ([]string, bool) {
+	var (
+		mm   []string
+		bell bool
+	)
+	for _, a := range p.alarms.For(c.GVR) {
+		metric := a.MetricFor(c.GVR)
+		v := alarmValue(c, metric)
+		if !a.Exceeded(v) {
+			continue
+		}
+		mm = append(mm, fmt.Sprintf("%s %s %d > %d", client.NewGVR(c.GVR).R(), metric, v, a.Above))
+		bell = bell || a.Bell
+	}
+
+	return mm, bell
+}
+
+// alarmValue returns the check value an alarm metric compares against.
+// Cpu and mem percentages are checked against the busiest node.
+func alarmValue(c *health.Check, metric string) int64 {
+	if metric != config.AlarmPercent {
+		return c.Tally(health.S2)
+	}
+	if v, ok := c.Counts[health.Peak]; ok {
+		return v
+	}
+
+	return int64(client.ToPercentage(c.Tally(health.S1), c.Tally(health.S2)))
+}
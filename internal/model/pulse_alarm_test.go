@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/health"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPulseAlarmsChanged(t *testing.T) {
+	alerts := model.NewAlerts(model.MaxAlerts)
+	p := model.NewPulseAlarms(config.PulseAlarms{
+		{Resource: "pods", Above: 2, Bell: true},
+		{Resource: "cpu", Above: 90},
+	}, alerts)
+	var l alarmListener
+	p.AddListener(&l)
+
+	po := health.NewCheck("v1/pods")
+	po.Set(health.S2, 3)
+	p.PulseChanged(po)
+	p.PulseChanged(po)
+	assert.True(t, p.IsTripped("v1/pods"))
+	assert.Equal(t, []string{"pods failed 3 > 2"}, l.mm)
+	assert.Equal(t, 1, l.count)
+	assert.True(t, l.bell)
+
+	po.Set(health.S2, 1)
+	p.PulseChanged(po)
+	assert.False(t, p.IsTripped("v1/pods"))
+
+	cpu := health.NewCheck("cpu")
+	cpu.Set(health.S1, 500)
+	cpu.Set(health.S2, 1000)
+	cpu.Set(health.Peak, 95)
+	p.PulseChanged(cpu)
+	assert.True(t, p.IsTripped("cpu"))
+	assert.Equal(t, []string{"cpu percent 95 > 90"}, l.mm)
+	assert.Equal(t, 2, l.count)
+	assert.False(t, l.bell)
+	assert.Equal(t, 2, len(alerts.List()))
+}
+
+type alarmListener struct {
+	mm    []string
+	bell  bool
+	count int
+}
+
+func (l *alarmListener) PulseAlarmTripped(_ string, mm []string, bell bool) {
+	l.mm, l.bell = mm, bell
+	l.count++
+}
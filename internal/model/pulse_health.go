@@ -79,7 +79,7 @@ func (h *PulseHealth) checkMetrics(ctx context.Context) (health.Checks, error) {
 	mx := make(client.NodesMetrics, len(nn.Items))
 	dial.NodesMetrics(nn, nmx, mx)
 
-	var ccpu, cmem, acpu, amem, tcpu, tmem int64
+	var ccpu, cmem, acpu, amem, tcpu, tmem, pcpu, pmem int64
 	for _, m := range mx {
 		ccpu += m.CurrentCPU
 		cmem += m.CurrentMEM
@@ -87,15 +87,23 @@ func (h *PulseHealth) checkMetrics(ctx context.Context) (health.Checks, error) {
 		amem += m.AllocatableMEM
 		tcpu += m.TotalCPU
 		tmem += m.TotalMEM
+		if p := int64(client.ToPercentage(m.CurrentCPU, m.AllocatableCPU)); p > pcpu {
+			pcpu = p
+		}
+		if p := int64(client.ToPercentage(m.CurrentMEM, m.AllocatableMEM)); p > pmem {
+			pmem = p
+		}
 	}
 	c1 := health.NewCheck("cpu")
 	c1.Set(health.S1, ccpu)
 	c1.Set(health.S2, acpu)
 	c1.Set(health.S3, tcpu)
+	c1.Set(health.Peak, pcpu)
 	c2 := health.NewCheck("mem")
 	c2.Set(health.S1, cmem)
 	c2.Set(health.S2, amem)
 	c2.Set(health.S3, tmem)
+	c2.Set(health.Peak, pmem)
 
 	return health.Checks{c1, c2}, nil
 }
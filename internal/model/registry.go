@@ -50,6 +50,18 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Context{},
 		Renderer: &render.Context{},
 	},
+	"fleet": {
+		DAO:      &dao.Fleet{},
+		Renderer: &render.Fleet{},
+	},
+	"tlsexpiry": {
+		DAO:      &dao.TLSExpiry{},
+		Renderer: &render.TLSExpiry{},
+	},
+	"dnsrecords": {
+		DAO:      &dao.DNSRecord{},
+		Renderer: &render.DNSRecord{},
+	},
 	"screendumps": {
 		DAO:      &dao.ScreenDump{},
 		Renderer: &render.ScreenDump{},
@@ -82,6 +94,46 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Alias{},
 		Renderer: &render.Alias{},
 	},
+	"help": {
+		DAO:      &dao.Help{},
+		Renderer: &render.Help{},
+	},
+	"finalizers": {
+		DAO:      &dao.Finalizer{},
+		Renderer: &render.Finalizer{},
+	},
+	"changes": {
+		DAO:      &dao.Change{},
+		Renderer: &render.Change{},
+	},
+	"finds": {
+		DAO:      &dao.Finder{},
+		Renderer: &render.Find{},
+	},
+	"orphans": {
+		DAO:      &dao.Orphan{},
+		Renderer: &render.Orphan{},
+	},
+	"subresources": {
+		DAO:      &dao.Subresource{},
+		Renderer: &render.Subresource{},
+	},
+	"apipaths": {
+		DAO:      &dao.APIPath{},
+		Renderer: &render.APIPath{},
+	},
+	"inventory": {
+		DAO:      &dao.Inventory{},
+		Renderer: &render.Inventory{},
+	},
+	"templates": {
+		DAO:      &dao.Template{},
+		Renderer: &render.Template{},
+	},
+	"datakeys": {
+		DAO:      &dao.DataKey{},
+		Renderer: &render.DataKey{},
+	},
 	// !!BOZO!! Popeye
 	//"popeye": {
 	//	DAO:      &dao.Popeye{},
@@ -162,6 +214,132 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.NetworkPolicy{},
 	},
 
+	// Admission Policies...
+	"admissionregistration.k8s.io/v1/validatingadmissionpolicies": {
+		Renderer: &render.ValidatingAdmissionPolicy{},
+	},
+	"admissionregistration.k8s.io/v1/validatingadmissionpolicybindings": {
+		Renderer: &render.ValidatingAdmissionPolicyBinding{},
+	},
+	"admissionregistration.k8s.io/v1beta1/validatingadmissionpolicies": {
+		Renderer: &render.ValidatingAdmissionPolicy{},
+	},
+	"admissionregistration.k8s.io/v1beta1/validatingadmissionpolicybindings": {
+		Renderer: &render.ValidatingAdmissionPolicyBinding{},
+	},
+
+	// API Priority And Fairness...
+	"flowcontrol.apiserver.k8s.io/v1/flowschemas": {
+		DAO:      &dao.FlowControl{},
+		Renderer: &render.FlowSchema{},
+	},
+	"flowcontrol.apiserver.k8s.io/v1/prioritylevelconfigurations": {
+		DAO:      &dao.FlowControl{},
+		Renderer: &render.PriorityLevel{},
+	},
+	"flowcontrol.apiserver.k8s.io/v1beta3/flowschemas": {
+		DAO:      &dao.FlowControl{},
+		Renderer: &render.FlowSchema{},
+	},
+	"flowcontrol.apiserver.k8s.io/v1beta3/prioritylevelconfigurations": {
+		DAO:      &dao.FlowControl{},
+		Renderer: &render.PriorityLevel{},
+	},
+
+	// Meshes and CNIs...
+	"networking.istio.io/v1/virtualservices": {
+		Renderer: &render.VirtualService{},
+	},
+	"networking.istio.io/v1/gateways": {
+		Renderer: &render.IstioGateway{},
+	},
+	"networking.istio.io/v1beta1/virtualservices": {
+		Renderer: &render.VirtualService{},
+	},
+	"networking.istio.io/v1beta1/gateways": {
+		Renderer: &render.IstioGateway{},
+	},
+	"networking.istio.io/v1alpha3/virtualservices": {
+		Renderer: &render.VirtualService{},
+	},
+	"networking.istio.io/v1alpha3/gateways": {
+		Renderer: &render.IstioGateway{},
+	},
+	"cilium.io/v2/ciliumnetworkpolicies": {
+		Renderer: &render.CiliumNetworkPolicy{},
+	},
+	"cilium.io/v2/ciliumclusterwidenetworkpolicies": {
+		Renderer: &render.CiliumNetworkPolicy{},
+	},
+	"cilium.io/v2/ciliumendpoints": {
+		Renderer: &render.CiliumEndpoint{},
+	},
+	"crd.projectcalico.org/v1/networkpolicies": {
+		Renderer: &render.CalicoPolicy{},
+	},
+	"crd.projectcalico.org/v1/globalnetworkpolicies": {
+		Renderer: &render.CalicoPolicy{},
+	},
+	"projectcalico.org/v3/networkpolicies": {
+		Renderer: &render.CalicoPolicy{},
+	},
+	"projectcalico.org/v3/globalnetworkpolicies": {
+		Renderer: &render.CalicoPolicy{},
+	},
+
+	// Cluster API...
+	"cluster.x-k8s.io/v1beta1/clusters": {
+		Renderer: &render.CAPICluster{},
+	},
+	"cluster.x-k8s.io/v1beta1/machinedeployments": {
+		Renderer: &render.MachineDeployment{},
+	},
+	"cluster.x-k8s.io/v1beta1/machinesets": {
+		Renderer: &render.MachineSet{},
+	},
+	"cluster.x-k8s.io/v1beta1/machines": {
+		Renderer: &render.Machine{},
+	},
+
+	// OpenShift...
+	"project.openshift.io/v1/projects": {
+		Renderer: &render.Project{},
+	},
+	"route.openshift.io/v1/routes": {
+		Renderer: &render.Route{},
+	},
+	"apps.openshift.io/v1/deploymentconfigs": {
+		Renderer: &render.DeploymentConfig{},
+	},
+	"image.openshift.io/v1/imagestreams": {
+		Renderer: &render.ImageStream{},
+	},
+
+	// cert-manager...
+	"cert-manager.io/v1/certificates": {
+		Renderer: &render.Certificate{},
+	},
+	"cert-manager.io/v1/certificaterequests": {
+		DAO:      &dao.CertManagerChild{},
+		Renderer: &render.CertificateRequest{},
+	},
+	"acme.cert-manager.io/v1/orders": {
+		DAO:      &dao.CertManagerChild{},
+		Renderer: &render.Order{},
+	},
+	"acme.cert-manager.io/v1/challenges": {
+		DAO:      &dao.CertManagerChild{},
+		Renderer: &render.Challenge{},
+	},
+
+	// KEDA...
+	"keda.sh/v1alpha1/scaledobjects": {
+		Renderer: &render.ScaledObject{},
+	},
+	"keda.sh/v1alpha1/scaledjobs": {
+		Renderer: &render.ScaledJob{},
+	},
+
 	// Batch...
 	"batch/v1/cronjobs": {
 		DAO:      &dao.CronJob{},
@@ -178,6 +356,11 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.CustomResourceDefinition{},
 	},
 
+	// Scheduling...
+	"scheduling.k8s.io/v1/priorityclasses": {
+		Renderer: &render.PriorityClass{},
+	},
+
 	// Storage...
 	"storage.k8s.io/v1/storageclasses": {
 		Renderer: &render.StorageClass{},
@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/redact"
+	"github.com/derailed/k9s/internal/vul"
+)
+
+const (
+	// ReportMarkdown renders a report as markdown.
+	ReportMarkdown = "md"
+
+	// ReportHTML renders a report as html.
+	ReportHTML = "html"
+
+	// ReportScansTitle titles the image scans report section.
+	ReportScansTitle = "Image Scans"
+)
+
+// ReportSection represents a resource listed in a report.
+type ReportSection struct {
+	Title string
+	GVR   client.GVR
+	// Toast only keeps rows in an invalid state.
+	Toast bool
+}
+
+// ReportSections tracks the default report sections.
+var ReportSections = []ReportSection{
+	{Title: "Nodes", GVR: client.NewGVR("v1/nodes")},
+	{Title: "Failing Pods", GVR: client.NewGVR("v1/pods"), Toast: true},
+	{Title: "Failing Deployments", GVR: client.NewGVR("apps/v1/deployments"), Toast: true},
+	{Title: "Failing StatefulSets", GVR: client.NewGVR("apps/v1/statefulsets"), Toast: true},
+	{Title: "Failing DaemonSets", GVR: client.NewGVR("apps/v1/daemonsets"), Toast: true},
+	{Title: "Failing Jobs", GVR: client.NewGVR("batch/v1/jobs"), Toast: true},
+	{Title: "Warning Events", GVR: client.NewGVR("v1/events"), Toast: true},
+}
+
+// ReportTable represents a report section content.
+type ReportTable struct {
+	Title  string
+	Header []string
+	Rows   [][]string
+	Err    error
+}
+
+// Report represents a cluster summary report.
+type Report struct {
+	Context   string
+	Namespace string
+	Time      time.Time
+	Tables    []ReportTable
+}
+
+// NewReport returns a new report.
+func NewReport(ct, ns string) *Report {
+	return &Report{
+		Context:   ct,
+		Namespace: ns,
+		Time:      time.Now(),
+	}
+}
+
+// Build collects the report sections using the resources renderers and
+// optionally the image scans summary. Sections that fail to load are
+// reported but do not abort the report.
+func (r *Report) Build(ctx context.Context, ss []ReportSection, scans bool) {
+	for _, s := range ss {
+		t := ReportTable{Title: s.Title}
+		data, err := loadReportTable(ctx, s.GVR, r.Namespace)
+		if err != nil {
+			t.Err = err
+		} else {
+			if _, ok := data.IndexOfHeader("VALID"); ok && s.Toast {
+				data = data.Filter(model1.FilterOpts{Toast: true})
+			}
+			t.Header, t.Rows = reportRows(data)
+		}
+		r.Tables = append(r.Tables, t)
+	}
+	if scans && vul.ImgScanner != nil {
+		r.Tables = append(r.Tables, ReportTable{
+			Title:  ReportScansTitle,
+			Header: []string{"IMAGE", "CRITICAL", "HIGH", "MEDIUM", "LOW", "NEGLIGIBLE"},
+			Rows:   vul.ImgScanner.Summary(),
+		})
+	}
+}
+
+// Render renders the report in the given format.
+func (r *Report) Render(format string) (string, error) {
+	switch format {
+	case ReportMarkdown:
+		return r.Markdown(), nil
+	case ReportHTML:
+		return r.HTML(), nil
+	default:
+		return "", fmt.Errorf("unsupported report format %q (md|html)", format)
+	}
+}
+
+// Markdown renders the report as markdown.
+func (r *Report) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Cluster Report: %s\n\n", r.Context)
+	fmt.Fprintf(&b, "* Namespace: %s\n* Generated: %s\n", r.namespace(), r.Time.Format(time.RFC3339))
+	for _, t := range r.Tables {
+		fmt.Fprintf(&b, "\n## %s\n\n", t.Title)
+		if t.Err != nil {
+			fmt.Fprintf(&b, "_Unavailable: %s_\n", mdEscape(t.Err.Error()))
+			continue
+		}
+		if len(t.Rows) == 0 {
+			b.WriteString("_None_\n")
+			continue
+		}
+		fmt.Fprintf(&b, "| %s |\n", strings.Join(mdEscapeAll(t.Header), " | "))
+		fmt.Fprintf(&b, "|%s\n", strings.Repeat(" --- |", len(t.Header)))
+		for _, row := range t.Rows {
+			fmt.Fprintf(&b, "| %s |\n", strings.Join(mdEscapeAll(row), " | "))
+		}
+	}
+
+	return b.String()
+}
+
+// HTML renders the report as a standalone html page.
+func (r *Report) HTML() string {
+	var b strings.Builder
+	title := html.EscapeString("Cluster Report: " + r.Context)
+	fmt.Fprintf(&b, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n", title)
+	b.WriteString("<style>body{font-family:sans-serif}table{border-collapse:collapse}th,td{border:1px solid #ccc;padding:2px 6px;text-align:left}</style>\n")
+	fmt.Fprintf(&b, "</head>\n<body>\n<h1>%s</h1>\n", title)
+	fmt.Fprintf(&b, "<ul>\n<li>Namespace: %s</li>\n<li>Generated: %s</li>\n</ul>\n",
+		html.EscapeString(r.namespace()),
+		r.Time.Format(time.RFC3339),
+	)
+	for _, t := range r.Tables {
+		fmt.Fprintf(&b, "<h2>%s</h2>\n", html.EscapeString(t.Title))
+		if t.Err != nil {
+			fmt.Fprintf(&b, "<p><em>Unavailable: %s</em></p>\n", html.EscapeString(t.Err.Error()))
+			continue
+		}
+		if len(t.Rows) == 0 {
+			b.WriteString("<p><em>None</em></p>\n")
+			continue
+		}
+		b.WriteString("<table>\n<tr>")
+		for _, h := range t.Header {
+			fmt.Fprintf(&b, "<th>%s</th>", html.EscapeString(h))
+		}
+		b.WriteString("</tr>\n")
+		for _, row := range t.Rows {
+			b.WriteString("<tr>")
+			for _, f := range row {
+				fmt.Fprintf(&b, "<td>%s</td>", html.EscapeString(f))
+			}
+			b.WriteString("</tr>\n")
+		}
+		b.WriteString("</table>\n")
+	}
+	b.WriteString("</body>\n</html>\n")
+
+	return b.String()
+}
+
+func (r *Report) namespace() string {
+	if client.IsAllNamespaces(r.Namespace) {
+		return client.NamespaceAll
+	}
+
+	return r.Namespace
+}
+
+func loadReportTable(ctx context.Context, gvr client.GVR, ns string) (*model1.TableData, error) {
+	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return nil, fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
+	}
+	meta := resourceMeta(gvr)
+	meta.DAO.Init(factory, gvr)
+
+	ns = client.CleanseNamespace(ns)
+	if m, err := dao.MetaAccess.MetaFor(gvr); err != nil || !m.Namespaced || client.IsClusterScoped(ns) {
+		ns = client.BlankNamespace
+	}
+	oo, err := meta.DAO.List(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	data := model1.NewTableData(gvr)
+	data.Reset(ns)
+	if err := data.Reconcile(ctx, meta.Renderer, oo); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// reportRows extracts the visible columns from a table.
+func reportRows(data *model1.TableData) ([]string, [][]string) {
+	h := data.Header()
+	var (
+		idx []int
+		hh  []string
+	)
+	for i, c := range h {
+		if c.Wide || c.Hide || c.Name == "VALID" {
+			continue
+		}
+		idx, hh = append(idx, i), append(hh, c.Name)
+	}
+
+	rows := make([][]string, 0, data.RowCount())
+	data.RowsRange(func(_ int, re model1.RowEvent) bool {
+		row := make([]string, 0, len(idx))
+		for _, i := range idx {
+			var f string
+			if i < len(re.Row.Fields) {
+				f = re.Row.Fields[i]
+			}
+			f = redact.Field(data.GVR().String(), h[i].Name, f)
+			if h[i].Decorator != nil {
+				f = h[i].Decorator(f)
+			}
+			row = append(row, f)
+		}
+		rows = append(rows, row)
+		return true
+	})
+
+	return hh, rows
+}
+
+func mdEscapeAll(ss []string) []string {
+	ee := make([]string, 0, len(ss))
+	for _, s := range ss {
+		ee = append(ee, mdEscape(s))
+	}
+
+	return ee
+}
+
+func mdEscape(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeReport() *model.Report {
+	r := model.NewReport("ct-1", "")
+	r.Time = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	r.Tables = []model.ReportTable{
+		{
+			Title:  "Failing Pods",
+			Header: []string{"NAMESPACE", "NAME", "STATUS"},
+			Rows:   [][]string{{"default", "fred", "CrashLoopBackOff"}, {"default", "blee|zorg", "<b>Error</b>"}},
+		},
+		{Title: "Warning Events"},
+		{Title: "Nodes", Err: errors.New("forbidden")},
+	}
+
+	return r
+}
+
+func TestReportMarkdown(t *testing.T) {
+	e := `# Cluster Report: ct-1
+
+* Namespace: all
+* Generated: 2024-01-01T10:00:00Z
+
+## Failing Pods
+
+| NAMESPACE | NAME | STATUS |
+| --- | --- | --- |
+| default | fred | CrashLoopBackOff |
+| default | blee\|zorg | <b>Error</b> |
+
+## Warning Events
+
+_None_
+
+## Nodes
+
+_Unavailable: forbidden_
+`
+	s, err := makeReport().Render(model.ReportMarkdown)
+
+	assert.NoError(t, err)
+	assert.Equal(t, e, s)
+}
+
+func TestReportHTML(t *testing.T) {
+	s, err := makeReport().Render(model.ReportHTML)
+
+	assert.NoError(t, err)
+	assert.Contains(t, s, "<title>Cluster Report: ct-1</title>")
+	assert.Contains(t, s, "<tr><td>default</td><td>blee|zorg</td><td>&lt;b&gt;Error&lt;/b&gt;</td></tr>")
+	assert.Contains(t, s, "<p><em>None</em></p>")
+	assert.Contains(t, s, "<p><em>Unavailable: forbidden</em></p>")
+}
+
+func TestReportRenderFormat(t *testing.T) {
+	_, err := makeReport().Render("pdf")
+
+	assert.Error(t, err)
+}
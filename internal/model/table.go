@@ -40,15 +40,24 @@ type Table struct {
 	refreshRate time.Duration
 	instance    string
 	labelFilter string
+	staleSince  time.Time
+	history     *TableHistory
 	mx          sync.RWMutex
 }
 
+// StaleTracker tracks resources whose watch dropped.
+type StaleTracker interface {
+	// StaleSince returns when a resource watch dropped if still down.
+	StaleSince(ns, gvr string) (time.Time, bool)
+}
+
 // NewTable returns a new table model.
 func NewTable(gvr client.GVR) *Table {
 	return &Table{
 		gvr:         gvr,
 		data:        model1.NewTableData(gvr),
 		refreshRate: 2 * time.Second,
+		history:     NewTableHistory(HistoryWindow),
 	}
 }
 
@@ -58,6 +67,7 @@ func (t *Table) SetLabelFilter(f string) {
 	defer t.mx.Unlock()
 
 	t.labelFilter = f
+	t.history.Clear()
 }
 
 // GetLabelFilter sets the labels filter.
@@ -71,6 +81,7 @@ func (t *Table) GetLabelFilter() string {
 // SetInstance sets a single entry table.
 func (t *Table) SetInstance(path string) {
 	t.instance = path
+	t.history.Clear()
 }
 
 // AddListener adds a new model listener.
@@ -146,6 +157,7 @@ func (t *Table) GetNamespace() string {
 // SetNamespace sets up model namespace.
 func (t *Table) SetNamespace(ns string) {
 	t.data.Reset(ns)
+	t.history.Clear()
 }
 
 // InNamespace checks if current namespace matches desired namespace.
@@ -190,7 +202,7 @@ func (t *Table) updater(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-time.After(rate):
-			rate = t.refreshRate
+			rate = client.Throttle.Scale(t.refreshRate)
 			err := backoff.Retry(func() error {
 				return t.refresh(ctx)
 			}, backoff.WithContext(bf, ctx))
@@ -214,14 +226,74 @@ func (t *Table) refresh(ctx context.Context) error {
 	}
 	defer atomic.StoreInt32(&t.inUpdate, 0)
 
+	t.checkStale(ctx)
 	if err := t.reconcile(ctx); err != nil {
 		return err
 	}
-	t.fireTableChanged(t.Peek())
+	data := t.Peek()
+	t.history.Record(time.Now(), data)
+	if !t.history.IsLive() {
+		return nil
+	}
+	t.fireTableChanged(data.Clone())
 
 	return nil
 }
 
+// Rewind replays the table state a given duration back in time.
+func (t *Table) Rewind(d time.Duration) bool {
+	data, ok := t.history.Rewind(d)
+	if ok {
+		t.fireTableChanged(data.Clone())
+	}
+
+	return ok
+}
+
+// FastForward replays the table state a given duration forward in time.
+// Live updates resume once caught up.
+func (t *Table) FastForward(d time.Duration) bool {
+	data, ok := t.history.FastForward(d)
+	if ok {
+		t.fireTableChanged(data.Clone())
+	}
+
+	return ok
+}
+
+// HistoryAt returns the time of the replayed table state if not live.
+func (t *Table) HistoryAt() (time.Time, bool) {
+	return t.history.At()
+}
+
+// StaleSince returns when the model data went stale if any.
+func (t *Table) StaleSince() time.Time {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.staleSince
+}
+
+func (t *Table) checkStale(ctx context.Context) {
+	st, ok := ctx.Value(internal.KeyFactory).(StaleTracker)
+	if !ok {
+		return
+	}
+	ns := client.CleanseNamespace(t.data.GetNamespace())
+	if client.IsClusterScoped(ns) {
+		ns = client.BlankNamespace
+	}
+	since, _ := st.StaleSince(ns, t.gvr.String())
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	if !t.staleSince.IsZero() && since.IsZero() {
+		log.Info().Msgf("Resyncing %q after watch recovery", t.gvr)
+		t.data.Clear()
+	}
+	t.staleSince = since
+}
+
 func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, error) {
 	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
 	if !ok {
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+)
+
+const (
+	// HistoryWindow tracks how far back table states are kept.
+	HistoryWindow = 5 * time.Minute
+
+	// RewindStep tracks how far back or forth a rewind moves.
+	RewindStep = 10 * time.Second
+)
+
+type tableSnapshot struct {
+	at   time.Time
+	data *model1.TableData
+}
+
+// TableHistory tracks a bounded history of table states.
+type TableHistory struct {
+	window time.Duration
+	snaps  []tableSnapshot
+	cursor int
+	mx     sync.RWMutex
+}
+
+// NewTableHistory returns a new instance.
+func NewTableHistory(window time.Duration) *TableHistory {
+	return &TableHistory{
+		window: window,
+		cursor: -1,
+	}
+}
+
+// Record records a table state and evicts states past the history window.
+func (h *TableHistory) Record(at time.Time, data *model1.TableData) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	h.snaps = append(h.snaps, tableSnapshot{at: at, data: data})
+	var evict int
+	for evict < len(h.snaps)-1 && at.Sub(h.snaps[evict].at) > h.window {
+		evict++
+	}
+	if evict == 0 {
+		return
+	}
+	h.snaps = h.snaps[evict:]
+	if h.cursor >= 0 {
+		h.cursor = max(h.cursor-evict, 0)
+	}
+}
+
+// IsLive checks if the history is not being replayed.
+func (h *TableHistory) IsLive() bool {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	return h.cursor < 0
+}
+
+// At returns the time of the replayed state if any.
+func (h *TableHistory) At() (time.Time, bool) {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	if h.cursor < 0 {
+		return time.Time{}, false
+	}
+
+	return h.snaps[h.cursor].at, true
+}
+
+// Rewind moves back in time and returns the matching state if any.
+func (h *TableHistory) Rewind(d time.Duration) (*model1.TableData, bool) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	current := h.cursor
+	if current < 0 {
+		current = len(h.snaps) - 1
+	}
+	if current <= 0 {
+		return nil, false
+	}
+	target := h.snaps[current].at.Add(-d)
+	idx := 0
+	for i := current - 1; i >= 0; i-- {
+		if !h.snaps[i].at.After(target) {
+			idx = i
+			break
+		}
+	}
+	h.cursor = idx
+
+	return h.snaps[idx].data, true
+}
+
+// FastForward moves forward in time and returns the matching state. Once
+// caught up, the history goes back to live.
+func (h *TableHistory) FastForward(d time.Duration) (*model1.TableData, bool) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	if h.cursor < 0 {
+		return nil, false
+	}
+	target := h.snaps[h.cursor].at.Add(d)
+	for i := h.cursor + 1; i < len(h.snaps)-1; i++ {
+		if !h.snaps[i].at.Before(target) {
+			h.cursor = i
+			return h.snaps[i].data, true
+		}
+	}
+	h.cursor = -1
+
+	return h.snaps[len(h.snaps)-1].data, true
+}
+
+// Live resumes live updates.
+func (h *TableHistory) Live() {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	h.cursor = -1
+}
+
+// Clear clears out the history.
+func (h *TableHistory) Clear() {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	h.snaps, h.cursor = nil, -1
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableHistoryRewind(t *testing.T) {
+	h := model.NewTableHistory(time.Minute)
+	now := time.Now()
+	dd := make([]*model1.TableData, 0, 7)
+	for i := 6; i >= 0; i-- {
+		d := model1.NewTableDataFull(client.NewGVR("v1/pods"), "ns", model1.Header{}, model1.NewRowEvents(0))
+		dd = append(dd, d)
+		h.Record(now.Add(-time.Duration(i)*5*time.Second), d)
+	}
+	assert.True(t, h.IsLive())
+
+	d, ok := h.Rewind(10 * time.Second)
+	assert.True(t, ok)
+	assert.True(t, d == dd[4])
+	at, ok := h.At()
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(-10*time.Second), at)
+
+	d, ok = h.Rewind(time.Hour)
+	assert.True(t, ok)
+	assert.True(t, d == dd[0])
+	_, ok = h.Rewind(time.Second)
+	assert.False(t, ok)
+
+	d, ok = h.FastForward(5 * time.Second)
+	assert.True(t, ok)
+	assert.True(t, d == dd[1])
+	assert.False(t, h.IsLive())
+
+	d, ok = h.FastForward(time.Hour)
+	assert.True(t, ok)
+	assert.True(t, d == dd[6])
+	assert.True(t, h.IsLive())
+	_, ok = h.FastForward(time.Second)
+	assert.False(t, ok)
+}
+
+func TestTableHistoryEvict(t *testing.T) {
+	h := model.NewTableHistory(10 * time.Second)
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		h.Record(now.Add(time.Duration(i)*5*time.Second), model1.NewTableData(client.NewGVR("v1/pods")))
+	}
+
+	_, ok := h.Rewind(time.Hour)
+	assert.True(t, ok)
+	at, ok := h.At()
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(35*time.Second), at)
+
+	h.Clear()
+	assert.True(t, h.IsLive())
+	_, ok = h.Rewind(time.Second)
+	assert.False(t, ok)
+}
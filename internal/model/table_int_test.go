@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -40,6 +41,26 @@ func TestTableReconcile(t *testing.T) {
 	assert.Equal(t, client.NamespaceAll, data.GetNamespace())
 }
 
+func TestTableCheckStale(t *testing.T) {
+	ta := NewTable(client.NewGVR("v1/pods"))
+	ta.SetNamespace("blee")
+
+	since := time.Now().Add(-time.Minute)
+	f := staleFactory{testFactory: makeFactory(), since: since}
+	f.rows = []runtime.Object{load(t, "p1")}
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, &f)
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, false)
+	assert.Nil(t, ta.refresh(ctx))
+	assert.Equal(t, since, ta.StaleSince())
+	assert.Equal(t, 1, ta.Peek().RowCount())
+
+	f.since = time.Time{}
+	assert.Nil(t, ta.refresh(ctx))
+	assert.True(t, ta.StaleSince().IsZero())
+	assert.Equal(t, 1, ta.Peek().RowCount())
+}
+
 func TestTableList(t *testing.T) {
 	ta := NewTable(client.NewGVR("v1/pods"))
 	ta.SetNamespace("blee")
@@ -165,6 +186,17 @@ func (f testFactory) Forwarders() watch.Forwarders {
 }
 func (f testFactory) DeleteForwarder(string) {}
 
+type staleFactory struct {
+	testFactory
+	since time.Time
+}
+
+var _ StaleTracker = (*staleFactory)(nil)
+
+func (f *staleFactory) StaleSince(string, string) (time.Time, bool) {
+	return f.since, !f.since.IsZero()
+}
+
 // ----------------------------------------------------------------------------
 
 type accessor struct {
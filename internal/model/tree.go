@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -18,11 +19,21 @@ import (
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 const initTreeRefreshRate = 500 * time.Millisecond
 
+// xrayRefs tracks the resources xray renderers cross reference.
+var xrayRefs = []string{
+	"v1/pods",
+	"v1/configmaps",
+	"v1/secrets",
+	"v1/serviceaccounts",
+	"v1/persistentvolumeclaims",
+}
+
 // TreeListener represents a tree model listener.
 type TreeListener interface {
 	// TreeChanged notifies the model data changed.
@@ -41,6 +52,8 @@ type Tree struct {
 	inUpdate    int32
 	refreshRate time.Duration
 	query       string
+	fan         *dao.FanOut
+	pending     int32
 }
 
 // NewTree returns a new model.
@@ -48,6 +61,7 @@ func NewTree(gvr client.GVR) *Tree {
 	return &Tree{
 		gvr:         gvr,
 		refreshRate: 2 * time.Second,
+		fan:         dao.NewFanOut(dao.MaxFanOut, dao.PartialWait),
 	}
 }
 
@@ -171,8 +185,11 @@ func (t *Tree) updater(ctx context.Context) {
 			t.root = nil
 			return
 		case <-time.After(rate):
-			rate = t.refreshRate
 			t.refresh(ctx)
+			rate = t.refreshRate
+			if atomic.LoadInt32(&t.pending) > 0 {
+				rate = initTreeRefreshRate
+			}
 		}
 	}
 }
@@ -201,8 +218,33 @@ func (t *Tree) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, erro
 	return a.List(ctx, client.CleanseNamespace(t.namespace))
 }
 
+// prefetch warms the caches of cross referenced resources concurrently.
+// The tree renders with what's loaded and refreshes faster while the rest
+// streams in.
+func (t *Tree) prefetch(ctx context.Context) {
+	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return
+	}
+	ns := client.CleanseNamespace(t.namespace)
+	kk := make([]string, 0, len(xrayRefs))
+	for _, gvr := range xrayRefs {
+		kk = append(kk, client.FQN(ns, gvr))
+	}
+	_, pending, err := t.fan.Load(ctx, kk, func(_ context.Context, k string) ([]runtime.Object, error) {
+		return factory.List(xrayRefs[slices.Index(kk, k)], ns, true, labels.Everything())
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("XRay prefetch failed")
+	}
+	atomic.StoreInt32(&t.pending, int32(pending))
+}
+
 func (t *Tree) reconcile(ctx context.Context) error {
 	meta := t.resourceMeta()
+	if _, ok := meta.TreeRenderer.(*xray.Generic); !ok {
+		t.prefetch(ctx)
+	}
 	oo, err := t.list(ctx, meta.DAO)
 	if err != nil {
 		return err
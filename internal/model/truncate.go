@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// LargeObjectSize represents the manifest size past which values get truncated.
+	LargeObjectSize = 512 * 1024
+
+	// ExpandOpts tracks whether large values should be shown in full.
+	ExpandOpts = "Expand"
+
+	maxValueLen   = 1024
+	maxBlockLines = 100
+)
+
+// truncateYAML clips long values and caps multi-line block scalars and
+// sequences so huge manifests stay responsive. Mapping keys are never
+// dropped so every key remains visible.
+func truncateYAML(lines []string) ([]string, bool) {
+	var truncated bool
+	out := make([]string, 0, len(lines))
+	for i := 0; i < len(lines); i++ {
+		l, clipped := clipLine(lines[i])
+		truncated = truncated || clipped
+		out = append(out, l)
+
+		if i+1 >= len(lines) || !isBlockStart(lines[i], lines[i+1]) {
+			continue
+		}
+		ind := indentOf(lines[i])
+		end := i + 1
+		for end < len(lines) && isChildOf(lines[end], ind) {
+			end++
+		}
+		if end-i-1 <= maxBlockLines {
+			continue
+		}
+		for _, c := range lines[i+1 : i+1+maxBlockLines] {
+			c, _ = clipLine(c)
+			out = append(out, c)
+		}
+		var size int
+		for _, c := range lines[i+1+maxBlockLines : end] {
+			size += len(c) + 1
+		}
+		out = append(out, fmt.Sprintf("%s# ... %d more lines (%s) truncated",
+			strings.Repeat(" ", ind+2),
+			end-i-1-maxBlockLines,
+			HumanSize(size),
+		))
+		truncated, i = true, end-1
+	}
+
+	return out, truncated
+}
+
+// HumanSize returns a human readable byte size.
+func HumanSize(n int) string {
+	switch {
+	case n >= 1024*1024:
+		return fmt.Sprintf("%.1fMiB", float64(n)/(1024*1024))
+	case n >= 1024:
+		return fmt.Sprintf("%.1fKiB", float64(n)/1024)
+	default:
+		return fmt.Sprintf("%dB", n)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func clipLine(l string) (string, bool) {
+	if len(l) <= maxValueLen {
+		return l, false
+	}
+	cut := maxValueLen
+	for cut > 0 && !utf8.RuneStart(l[cut]) {
+		cut--
+	}
+
+	return fmt.Sprintf("%s ... [+%s]", l[:cut], HumanSize(len(l)-cut)), true
+}
+
+// isBlockStart checks if a line opens a block scalar or a sequence.
+func isBlockStart(l, next string) bool {
+	t := strings.TrimSpace(l)
+	for _, ind := range []string{": |", ": >"} {
+		if i := strings.LastIndex(t, ind); i >= 0 && len(t)-i <= len(ind)+2 {
+			return true
+		}
+	}
+	if !strings.HasSuffix(t, ":") {
+		return false
+	}
+
+	return strings.HasPrefix(strings.TrimSpace(next), "- ") && indentOf(next) >= indentOf(l)
+}
+
+func isChildOf(l string, ind int) bool {
+	if strings.TrimSpace(l) == "" {
+		return true
+	}
+	i := indentOf(l)
+
+	return i > ind || (i == ind && strings.HasPrefix(l[i:], "- "))
+}
+
+func indentOf(l string) int {
+	return len(l) - len(strings.TrimLeft(l, " "))
+}
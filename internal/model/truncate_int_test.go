@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_truncateYAML(t *testing.T) {
+	uu := map[string]struct {
+		lines     []string
+		e         []string
+		truncated bool
+	}{
+		"small": {
+			lines: []string{"data:", "  fred: blee"},
+			e:     []string{"data:", "  fred: blee"},
+		},
+		"long-value": {
+			lines: []string{"data:", "  fred: " + strings.Repeat("x", 2000), "  zorg: duh"},
+			e: []string{
+				"data:",
+				"  fred: " + strings.Repeat("x", maxValueLen-8) + " ... [+984B]",
+				"  zorg: duh",
+			},
+			truncated: true,
+		},
+		"block-scalar": {
+			lines: append(append([]string{"data:", "  fred: |"}, repeat("    line", 150)...), "  zorg: duh"),
+			e: append(append(append([]string{"data:", "  fred: |"}, repeat("    line", maxBlockLines)...),
+				"    # ... 50 more lines (450B) truncated"), "  zorg: duh"),
+			truncated: true,
+		},
+		"sequence": {
+			lines: append(append([]string{"status:", "  conditions:"}, repeat("  - type: x", 120)...), "  phase: ok"),
+			e: append(append(append([]string{"status:", "  conditions:"}, repeat("  - type: x", maxBlockLines)...),
+				"    # ... 20 more lines (240B) truncated"), "  phase: ok"),
+			truncated: true,
+		},
+		"short-sequence": {
+			lines: []string{"spec:", "  ports:", "  - port: 80", "  - port: 443"},
+			e:     []string{"spec:", "  ports:", "  - port: 80", "  - port: 443"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ll, ok := truncateYAML(u.lines)
+			assert.Equal(t, u.truncated, ok)
+			assert.Equal(t, u.e, ll)
+		})
+	}
+}
+
+func TestHumanSize(t *testing.T) {
+	uu := map[int]string{
+		0:               "0B",
+		1023:            "1023B",
+		1536:            "1.5KiB",
+		5 * 1024 * 1024: "5.0MiB",
+	}
+
+	for n, e := range uu {
+		t.Run(fmt.Sprintf("%d", n), func(t *testing.T) {
+			assert.Equal(t, e, HumanSize(n))
+		})
+	}
+}
+
+func repeat(l string, n int) []string {
+	ll := make([]string, n)
+	for i := range ll {
+		ll[i] = l
+	}
+
+	return ll
+}
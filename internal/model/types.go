@@ -51,6 +51,27 @@ type EncDecResourceViewer interface {
 	Toggle()
 }
 
+// LargeResourceViewer represents a resource viewer that truncates large
+// resources unless expanded via ExpandOpts.
+type LargeResourceViewer interface {
+	ResourceViewer
+
+	// Size returns the resource manifest size in bytes.
+	Size() int
+
+	// Truncated checks if the manifest values were truncated.
+	Truncated() bool
+}
+
+// CleanResourceViewer represents a resource viewer that strips server
+// populated fields via CleanOpts.
+type CleanResourceViewer interface {
+	ResourceViewer
+
+	// SetExportRules sets the clean export rules.
+	SetExportRules(dao.ExportRules)
+}
+
 // Igniter represents a runnable view.
 type Igniter interface {
 	// Start starts a component.
@@ -20,8 +20,13 @@ import (
 	"github.com/sahilm/fuzzy"
 )
 
-// ManagedFieldsOpts tracks managed fields.
-const ManagedFieldsOpts = "ManagedFields"
+const (
+	// ManagedFieldsOpts tracks managed fields.
+	ManagedFieldsOpts = "ManagedFields"
+
+	// CleanOpts tracks whether server populated fields should be stripped.
+	CleanOpts = "Clean"
+)
 
 // YAML tracks yaml resource representations.
 type YAML struct {
@@ -32,6 +37,9 @@ type YAML struct {
 	lines     []string
 	listeners []ResourceViewerListener
 	options   ViewerToggleOpts
+	rules     dao.ExportRules
+	size      int
+	truncated bool
 }
 
 // NewYAML return a new yaml resource model.
@@ -60,6 +68,21 @@ func (y *YAML) SetOptions(ctx context.Context, opts ViewerToggleOpts) {
 	}
 }
 
+// SetExportRules sets the clean export rules.
+func (y *YAML) SetExportRules(rr dao.ExportRules) {
+	y.rules = rr
+}
+
+// Size returns the resource manifest size in bytes.
+func (y *YAML) Size() int {
+	return y.size
+}
+
+// Truncated checks if the manifest values were truncated.
+func (y *YAML) Truncated() bool {
+	return y.truncated
+}
+
 // Filter filters the model.
 func (y *YAML) Filter(q string) {
 	y.query = q
@@ -164,7 +187,16 @@ func (y *YAML) reconcile(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if y.options[CleanOpts] {
+		if s, err = y.rules.CleanYAML(s); err != nil {
+			return err
+		}
+	}
 	lines := strings.Split(s, "\n")
+	y.size, y.truncated = len(s), false
+	if y.size > LargeObjectSize && !y.options[ExpandOpts] {
+		lines, y.truncated = truncateYAML(lines)
+	}
 	if reflect.DeepEqual(lines, y.lines) {
 		return nil
 	}
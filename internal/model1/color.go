@@ -29,6 +29,9 @@ var (
 
 	// CompletedColor row completed color.
 	CompletedColor tcell.Color
+
+	// AnomalyColor row deviating from baseline color.
+	AnomalyColor tcell.Color
 )
 
 // DefaultColorer set the default table row colors.
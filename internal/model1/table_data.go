@@ -225,6 +225,11 @@ func (t *TableData) filterToast() *RowEvents {
 	return rr
 }
 
+// GVR returns the table resource.
+func (t *TableData) GVR() client.GVR {
+	return t.gvr
+}
+
 func (t *TableData) GetNamespace() string {
 	t.mx.RLock()
 	defer t.mx.RUnlock()
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -36,6 +37,8 @@ type Benchmark struct {
 	config   config.BenchConfig
 	worker   *requester.Work
 	cancelFn context.CancelFunc
+	stopFn   func()
+	exporter *Exporter
 	mx       sync.RWMutex
 }
 
@@ -50,7 +53,7 @@ func NewBenchmark(base, version string, cfg config.BenchConfig) (*Benchmark, err
 
 func (b *Benchmark) init(base, version string) error {
 	var ctx context.Context
-	ctx, b.cancelFn = context.WithTimeout(context.Background(), benchTimeout)
+	ctx, b.cancelFn = context.WithTimeout(context.Background(), benchTimeout+b.config.Duration)
 	req, err := http.NewRequestWithContext(ctx, b.config.HTTP.Method, base, nil)
 	if err != nil {
 		return err
@@ -82,6 +85,10 @@ func (b *Benchmark) init(base, version string) error {
 		C:           b.config.C,
 		H2:          b.config.HTTP.HTTP2,
 	}
+	// Duration based runs keep firing requests until the time is up.
+	if b.config.Duration > 0 {
+		b.worker.N = math.MaxInt32
+	}
 
 	return nil
 }
@@ -99,6 +106,9 @@ func (b *Benchmark) Cancel() {
 		b.cancelFn()
 		b.cancelFn = nil
 	}
+	if b.stopFn != nil {
+		b.stopFn()
+	}
 }
 
 // Canceled checks if the benchmark was canceled.
@@ -106,22 +116,54 @@ func (b *Benchmark) Canceled() bool {
 	return b.canceled
 }
 
+// SetExporter publishes the benchmark results once completed.
+func (b *Benchmark) SetExporter(e *Exporter) {
+	b.exporter = e
+}
+
 // Run starts a benchmark.
 func (b *Benchmark) Run(cluster, context string, done func()) {
 	log.Debug().Msgf("Running benchmark on context %s", cluster)
 	buff := new(bytes.Buffer)
 	b.worker.Writer = buff
+	// Stopping a worker more than once blocks, so all stops go through here.
+	stop := sync.OnceFunc(b.worker.Stop)
+	b.mx.Lock()
+	b.stopFn = stop
+	b.mx.Unlock()
+	if b.config.Duration > 0 {
+		t := time.AfterFunc(b.config.Duration, stop)
+		defer t.Stop()
+	}
 	// this call will block until the benchmark is complete or times out.
 	b.worker.Run()
-	b.worker.Stop()
+	stop()
 	if buff.Len() > 0 {
+		report := buff.String()
 		if err := b.save(cluster, context, buff); err != nil {
 			log.Error().Err(err).Msg("Saving Benchmark")
 		}
+		if err := b.export(context, report); err != nil {
+			log.Error().Err(err).Msg("Exporting Benchmark")
+		}
 	}
 	done()
 }
 
+func (b *Benchmark) export(ctx, report string) error {
+	if b.exporter == nil || b.Canceled() {
+		return nil
+	}
+	ns, n := client.Namespaced(b.config.Name)
+	ll := []Label{
+		{Name: "context", Value: ctx},
+		{Name: "namespace", Value: ns},
+		{Name: "name", Value: n},
+	}
+
+	return b.exporter.Export(context.Background(), "bench", ll, BenchMetrics(report))
+}
+
 func (b *Benchmark) save(cluster, context string, r io.Reader) error {
 	ns, n := client.Namespaced(b.config.Name)
 	n = strings.Replace(n, "|", "_", -1)
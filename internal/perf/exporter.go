@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const (
+	exportTimeout = 5 * time.Second
+	metricPrefix  = "k9s"
+)
+
+var (
+	invalidNameRx = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+	benchTotalRx = regexp.MustCompile(`Total:\s+([0-9.]+)\ssecs`)
+	benchAvgRx   = regexp.MustCompile(`Average:\s+([0-9.]+)\ssecs`)
+	benchSlowRx  = regexp.MustCompile(`Slowest:\s+([0-9.]+)\ssecs`)
+	benchFastRx  = regexp.MustCompile(`Fastest:\s+([0-9.]+)\ssecs`)
+	benchReqRx   = regexp.MustCompile(`Requests/sec:\s+([0-9.]+)`)
+	benchOKRx    = regexp.MustCompile(`\[2\d{2}\]\s+(\d+)\s+responses`)
+	benchErrRx   = regexp.MustCompile(`\[[4-5]\d{2}\]\s+(\d+)\s+responses`)
+)
+
+// Label represents a metric label.
+type Label struct {
+	Name, Value string
+}
+
+// Metric represents a gauge to export.
+type Metric struct {
+	Name  string
+	Value float64
+}
+
+// Exporter publishes metrics to a pushgateway and/or statsd endpoint.
+type Exporter struct {
+	config config.Exporter
+	client *http.Client
+}
+
+// NewExporter returns a new exporter or nil if no endpoints are configured.
+func NewExporter(cfg config.Exporter) *Exporter {
+	if !cfg.IsEnabled() {
+		return nil
+	}
+
+	return &Exporter{
+		config: cfg,
+		client: &http.Client{Timeout: exportTimeout},
+	}
+}
+
+// Export publishes a group of metrics sharing the same labels.
+func (e *Exporter) Export(ctx context.Context, group string, ll []Label, mm []Metric) error {
+	if e == nil || len(mm) == 0 {
+		return nil
+	}
+
+	var errs error
+	if e.config.PushGateway != "" {
+		errs = errors.Join(errs, e.push(ctx, group, ll, mm))
+	}
+	if e.config.StatsD != "" {
+		errs = errors.Join(errs, e.send(group, ll, mm))
+	}
+
+	return errs
+}
+
+// push replaces the metrics group on the pushgateway. Labels are part of
+// the grouping key so each group is tracked independently.
+func (e *Exporter) push(ctx context.Context, group string, ll []Label, mm []Metric) error {
+	u := strings.TrimSuffix(e.config.PushGateway, "/") + "/metrics/job/" + url.PathEscape(e.config.JobName())
+	for _, l := range ll {
+		u += "/" + sanitize(l.Name) + "/" + url.PathEscape(l.Value)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u, bytes.NewBufferString(PromFormat(group, mm)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; version=0.0.4")
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("pushgateway %s returned %s", u, resp.Status)
+	}
+
+	return nil
+}
+
+// send emits the metrics as statsd gauges.
+func (e *Exporter) send(group string, ll []Label, mm []Metric) error {
+	conn, err := net.DialTimeout("udp", e.config.StatsD, exportTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Write([]byte(StatsDFormat(group, ll, mm)))
+
+	return err
+}
+
+// PromFormat renders metrics using the prometheus text exposition format.
+func PromFormat(group string, mm []Metric) string {
+	var b strings.Builder
+	for _, m := range mm {
+		n := metricName("_", group, m.Name)
+		fmt.Fprintf(&b, "# TYPE %s gauge\n", n)
+		fmt.Fprintf(&b, "%s %s\n", n, strconv.FormatFloat(m.Value, 'f', -1, 64))
+	}
+
+	return b.String()
+}
+
+// StatsDFormat renders metrics as statsd gauges. Label values are folded
+// into the metric path since plain statsd has no notion of tags.
+func StatsDFormat(group string, ll []Label, mm []Metric) string {
+	ss := []string{group}
+	for _, l := range ll {
+		ss = append(ss, l.Value)
+	}
+
+	var b strings.Builder
+	for _, m := range mm {
+		fmt.Fprintf(&b, "%s:%s|g\n", metricName(".", append(ss, m.Name)...), strconv.FormatFloat(m.Value, 'f', -1, 64))
+	}
+
+	return b.String()
+}
+
+// BenchMetrics extracts metrics from a benchmark report.
+func BenchMetrics(report string) []Metric {
+	stats := []struct {
+		name string
+		rx   *regexp.Regexp
+	}{
+		{"total_seconds", benchTotalRx},
+		{"average_seconds", benchAvgRx},
+		{"slowest_seconds", benchSlowRx},
+		{"fastest_seconds", benchFastRx},
+		{"requests_per_sec", benchReqRx},
+	}
+	mm := make([]Metric, 0, len(stats)+2)
+	for _, s := range stats {
+		if m := s.rx.FindStringSubmatch(report); len(m) > 1 {
+			if v, err := strconv.ParseFloat(m[1], 64); err == nil {
+				mm = append(mm, Metric{Name: s.name, Value: v})
+			}
+		}
+	}
+	mm = append(mm,
+		Metric{Name: "ok_responses", Value: sumResponses(benchOKRx, report)},
+		Metric{Name: "error_responses", Value: sumResponses(benchErrRx, report)},
+	)
+
+	return mm
+}
+
+func sumResponses(rx *regexp.Regexp, report string) float64 {
+	var sum float64
+	for _, m := range rx.FindAllStringSubmatch(report, -1) {
+		if v, err := strconv.Atoi(m[1]); err == nil {
+			sum += float64(v)
+		}
+	}
+
+	return sum
+}
+
+func metricName(sep string, ss ...string) string {
+	nn := []string{metricPrefix}
+	for _, s := range ss {
+		nn = append(nn, sanitize(s))
+	}
+
+	return strings.Join(nn, sep)
+}
+
+func sanitize(s string) string {
+	return invalidNameRx.ReplaceAllString(s, "_")
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+)
+
+const benchReport = `
+Summary:
+  Total:	0.5011 secs
+  Slowest:	0.0252 secs
+  Fastest:	0.0011 secs
+  Average:	0.0043 secs
+  Requests/sec:	399.1234
+
+Status code distribution:
+  [200]	180 responses
+  [404]	15 responses
+  [503]	5 responses
+`
+
+func TestNewExporter(t *testing.T) {
+	assert.Nil(t, perf.NewExporter(config.Exporter{}))
+	assert.NotNil(t, perf.NewExporter(config.Exporter{StatsD: "localhost:8125"}))
+}
+
+func TestBenchMetrics(t *testing.T) {
+	mm := perf.BenchMetrics(benchReport)
+
+	assert.Equal(t, []perf.Metric{
+		{Name: "total_seconds", Value: 0.5011},
+		{Name: "average_seconds", Value: 0.0043},
+		{Name: "slowest_seconds", Value: 0.0252},
+		{Name: "fastest_seconds", Value: 0.0011},
+		{Name: "requests_per_sec", Value: 399.1234},
+		{Name: "ok_responses", Value: 180},
+		{Name: "error_responses", Value: 20},
+	}, mm)
+}
+
+func TestStatsDFormat(t *testing.T) {
+	ll := []perf.Label{{Name: "context", Value: "ct-1"}, {Name: "resource", Value: "pods"}}
+	mm := []perf.Metric{{Name: "ok", Value: 10}, {Name: "failed", Value: 2}}
+
+	assert.Equal(t, "k9s.pulse.ct_1.pods.ok:10|g\nk9s.pulse.ct_1.pods.failed:2|g\n", perf.StatsDFormat("pulse", ll, mm))
+}
+
+func TestExportPushGateway(t *testing.T) {
+	var path, body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		bb, _ := io.ReadAll(r.Body)
+		body = string(bb)
+	}))
+	defer srv.Close()
+
+	e := perf.NewExporter(config.Exporter{PushGateway: srv.URL})
+	ll := []perf.Label{{Name: "context", Value: "ct-1"}, {Name: "resource", Value: "pods"}}
+	mm := []perf.Metric{{Name: "ok", Value: 10}, {Name: "failed", Value: 2}}
+
+	assert.NoError(t, e.Export(context.Background(), "pulse", ll, mm))
+	assert.Equal(t, "/metrics/job/k9s/context/ct-1/resource/pods", path)
+	assert.Equal(t, "# TYPE k9s_pulse_ok gauge\nk9s_pulse_ok 10\n# TYPE k9s_pulse_failed gauge\nk9s_pulse_failed 2\n", body)
+}
+
+func TestExportPushGatewayFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	e := perf.NewExporter(config.Exporter{PushGateway: srv.URL, Job: "fred"})
+
+	assert.Error(t, e.Export(context.Background(), "bench", nil, []perf.Metric{{Name: "ok_responses", Value: 1}}))
+}
@@ -0,0 +1,312 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+// Package redact masks sensitive cluster details so screens can be shared.
+// Values are swapped for pseudonyms that remain stable for the session so
+// the same node or ip reads the same across views and screen dumps.
+package redact
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Masked represents a redacted secret value.
+const Masked = "********"
+
+const (
+	nodesGVR   = "v1/nodes"
+	secretsGVR = "v1/secrets"
+
+	lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+var (
+	ipRX    = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+	imageRX = regexp.MustCompile(`(?i)(image(?:id)?:\s*"?(?:[a-z-]+://)?)([^/\s"]+)/`)
+
+	enabled atomic.Bool
+	salt    = newSalt()
+	nodes   sync.Map
+)
+
+func newSalt() []byte {
+	bb := make([]byte, 16)
+	_, _ = rand.Read(bb)
+
+	return bb
+}
+
+// IsEnabled returns true if redaction is on.
+func IsEnabled() bool {
+	return enabled.Load()
+}
+
+// Set turns redaction on or off.
+func Set(b bool) {
+	enabled.Store(b)
+}
+
+// Toggle flips redaction and returns the new state.
+func Toggle() bool {
+	for {
+		b := enabled.Load()
+		if enabled.CompareAndSwap(b, !b) {
+			return !b
+		}
+	}
+}
+
+// Pseudonym returns a stable alias for a value.
+func Pseudonym(kind, v string) string {
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(v))
+
+	return kind + "-" + hex.EncodeToString(h.Sum(nil))[:6]
+}
+
+// Node registers a node name and returns its pseudonym.
+func Node(n string) string {
+	if n == "" || strings.HasPrefix(n, "<") || n == "n/a" {
+		return n
+	}
+	p := Pseudonym("node", n)
+	nodes.Store(n, p)
+
+	return p
+}
+
+// Field redacts a table cell given its resource and column.
+func Field(gvr, col, v string) string {
+	if !IsEnabled() {
+		return v
+	}
+
+	switch {
+	case col == "NODE" || col == "NOMINATED NODE" || (gvr == nodesGVR && col == "NAME"):
+		return Node(v)
+	case strings.Contains(col, "IMAGE"):
+		ii := strings.Split(v, ",")
+		for i, img := range ii {
+			ii[i] = Image(img)
+		}
+		return strings.Join(ii, ",")
+	default:
+		return Text(v)
+	}
+}
+
+// Data redacts a configmap or secret key value. Secret values are masked
+// while other values are scrubbed like free text.
+func Data(gvr string, v []byte) []byte {
+	if !IsEnabled() {
+		return v
+	}
+	if gvr == secretsGVR {
+		return []byte(Masked)
+	}
+
+	return []byte(scrubText(string(v)))
+}
+
+// Image masks an image registry host.
+func Image(img string) string {
+	lead := len(img) - len(strings.TrimLeft(img, " "))
+	host, rest, ok := strings.Cut(img[lead:], "/")
+	if !ok || !isRegistry(host) {
+		return img
+	}
+
+	return img[:lead] + Pseudonym("registry", host) + "/" + rest
+}
+
+func isRegistry(host string) bool {
+	return host == "localhost" || strings.ContainsAny(host, ".:")
+}
+
+// Text masks node names, ips and image registries found in free text.
+func Text(s string) string {
+	if !IsEnabled() {
+		return s
+	}
+
+	return scrubText(s)
+}
+
+func scrubText(s string) string {
+	nn := make([]string, 0, 10)
+	nodes.Range(func(k, _ any) bool {
+		nn = append(nn, k.(string))
+		return true
+	})
+	sort.Slice(nn, func(i, j int) bool {
+		return len(nn[i]) > len(nn[j])
+	})
+	for _, n := range nn {
+		if p, ok := nodes.Load(n); ok {
+			s = strings.ReplaceAll(s, n, p.(string))
+		}
+	}
+	s = imageRX.ReplaceAllStringFunc(s, func(m string) string {
+		mm := imageRX.FindStringSubmatch(m)
+		if !isRegistry(mm[2]) {
+			return m
+		}
+		return mm[1] + Pseudonym("registry", mm[2]) + "/"
+	})
+
+	return ipRX.ReplaceAllStringFunc(s, func(ip string) string {
+		return Pseudonym("ip", ip)
+	})
+}
+
+// Manifest redacts a resource manifest masking secrets data. Yaml and json
+// manifests are decoded so secrets are masked regardless of the output format.
+func Manifest(s string) string {
+	if !IsEnabled() {
+		return s
+	}
+
+	return Scrub(s)
+}
+
+// Scrub masks secrets data, node names, ips and image registries whether or
+// not session redaction is on ie for content sent out of k9s.
+func Scrub(s string) string {
+	if m, ok := maskManifest(s); ok {
+		s = m
+	} else if strings.Contains(s, "kind: Secret") {
+		s = secretData(s)
+	}
+
+	return scrubText(s)
+}
+
+// maskManifest decodes a yaml or json manifest and masks its secrets data,
+// re-encoding it in its original format. It returns false when the text is
+// not a manifest or holds no secrets.
+func maskManifest(s string) (string, bool) {
+	var o map[string]interface{}
+	err := yaml.Unmarshal([]byte(s), &o, func(d *json.Decoder) *json.Decoder {
+		d.UseNumber()
+		return d
+	})
+	if err != nil || o == nil {
+		return s, false
+	}
+	if !maskSecrets(o) {
+		return s, false
+	}
+
+	var (
+		raw []byte
+		t   = strings.TrimSpace(s)
+	)
+	if strings.HasPrefix(t, "{") {
+		raw, err = json.MarshalIndent(o, "", "  ")
+	} else {
+		raw, err = yaml.Marshal(o)
+	}
+	if err != nil {
+		return s, false
+	}
+	m := strings.TrimSuffix(string(raw), "\n")
+	if strings.HasSuffix(s, "\n") {
+		m += "\n"
+	}
+
+	return m, true
+}
+
+// maskSecrets masks the data of a decoded secret or of the secrets found
+// in a list. SecretList items carry no kind so they are masked based on the
+// list kind. It returns true if anything was masked.
+func maskSecrets(o map[string]interface{}) bool {
+	if ii, ok := o["items"].([]interface{}); ok {
+		var masked bool
+		for _, i := range ii {
+			m, ok := i.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if o["kind"] == "SecretList" {
+				MaskData(m)
+				masked = true
+				continue
+			}
+			if maskSecrets(m) {
+				masked = true
+			}
+		}
+		return masked
+	}
+	if o["kind"] != "Secret" {
+		return false
+	}
+	MaskData(o)
+
+	return true
+}
+
+// MaskData masks a decoded resource data, stringData and binaryData values
+// along with its last applied configuration which carries a copy of them.
+func MaskData(o map[string]interface{}) {
+	for _, k := range []string{"data", "stringData", "binaryData"} {
+		if d, ok := o[k].(map[string]interface{}); ok {
+			for kk := range d {
+				d[kk] = Masked
+			}
+		}
+	}
+	if md, ok := o["metadata"].(map[string]interface{}); ok {
+		if aa, ok := md["annotations"].(map[string]interface{}); ok {
+			if _, ok := aa[lastAppliedAnnotation]; ok {
+				aa[lastAppliedAnnotation] = Masked
+			}
+		}
+	}
+}
+
+// secretData masks values in data, stringData sections and the last applied
+// configuration which carries a copy of the secret.
+func secretData(s string) string {
+	ll := strings.Split(s, "\n")
+	block, keys := -1, false
+	for i, l := range ll {
+		t := strings.TrimLeft(l, " ")
+		indent := len(l) - len(t)
+		if block >= 0 {
+			if indent > block && t != "" {
+				if k, _, ok := strings.Cut(t, ":"); ok && keys && indent == block+2 {
+					ll[i] = l[:indent] + k + ": " + Masked
+				} else {
+					ll[i] = l[:indent] + Masked
+				}
+				continue
+			}
+			block = -1
+		}
+		switch {
+		case t == "data:" || t == "stringData:":
+			block, keys = indent, true
+		case strings.HasPrefix(t, lastAppliedAnnotation+":"):
+			if strings.HasSuffix(t, "|") {
+				block, keys = indent, false
+			} else {
+				ll[i] = l[:indent] + lastAppliedAnnotation + ": " + Masked
+			}
+		}
+	}
+
+	return strings.Join(ll, "\n")
+}
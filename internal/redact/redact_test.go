@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package redact_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/redact"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldDisabled(t *testing.T) {
+	redact.Set(false)
+
+	assert.Equal(t, "10.0.0.1", redact.Field("v1/pods", "IP", "10.0.0.1"))
+	assert.Equal(t, "n1", redact.Field("v1/pods", "NODE", "n1"))
+}
+
+func TestField(t *testing.T) {
+	redact.Set(true)
+	defer redact.Set(false)
+
+	uu := map[string]struct {
+		gvr, col, v, e string
+	}{
+		"ip": {
+			gvr: "v1/pods", col: "IP", v: "10.0.0.1:8080",
+			e: redact.Pseudonym("ip", "10.0.0.1") + ":8080",
+		},
+		"node": {
+			gvr: "v1/pods", col: "NODE", v: "n1",
+			e: redact.Pseudonym("node", "n1"),
+		},
+		"node-name": {
+			gvr: "v1/nodes", col: "NAME", v: "n1",
+			e: redact.Pseudonym("node", "n1"),
+		},
+		"no-node": {
+			gvr: "v1/pods", col: "NODE", v: "<none>",
+			e: "<none>",
+		},
+		"images": {
+			gvr: "apps/v1/deployments", col: "IMAGES", v: "nginx:1.25, registry.acme.io/team/app:1.0",
+			e: "nginx:1.25, " + redact.Pseudonym("registry", "registry.acme.io") + "/team/app:1.0",
+		},
+		"name": {
+			gvr: "v1/pods", col: "NAME", v: "fred",
+			e: "fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, redact.Field(u.gvr, u.col, u.v))
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	redact.Set(true)
+	defer redact.Set(false)
+
+	redact.Node("node-blee")
+	s := redact.Text("spec:\n  nodeName: node-blee\n  image: ghcr.io/acme/app:1.0\n  image: busybox/sh\nstatus:\n  podIP: 10.1.2.3\n")
+
+	assert.Equal(t, "spec:\n  nodeName: "+redact.Pseudonym("node", "node-blee")+
+		"\n  image: "+redact.Pseudonym("registry", "ghcr.io")+"/acme/app:1.0\n  image: busybox/sh\nstatus:\n  podIP: "+
+		redact.Pseudonym("ip", "10.1.2.3")+"\n", s)
+}
+
+func TestData(t *testing.T) {
+	v := []byte("10.1.2.3")
+	assert.Equal(t, v, redact.Data("v1/secrets", v))
+
+	redact.Set(true)
+	defer redact.Set(false)
+
+	assert.Equal(t, []byte(redact.Masked), redact.Data("v1/secrets", v))
+	assert.Equal(t, []byte(redact.Pseudonym("ip", "10.1.2.3")), redact.Data("v1/configmaps", v))
+}
+
+func TestManifestSecret(t *testing.T) {
+	redact.Set(true)
+	defer redact.Set(false)
+
+	s := `apiVersion: v1
+data:
+  password: cGFzc3dvcmQ=
+  cert: |
+    LS0tLS1CRUdJTg==
+kind: Secret
+metadata:
+  annotations:
+    kubectl.kubernetes.io/last-applied-configuration: |
+      {"apiVersion":"v1","data":{"password":"cGFzc3dvcmQ="}}
+  name: fred
+type: Opaque`
+
+	assert.Equal(t, `apiVersion: v1
+data:
+  cert: '********'
+  password: '********'
+kind: Secret
+metadata:
+  annotations:
+    kubectl.kubernetes.io/last-applied-configuration: '********'
+  name: fred
+type: Opaque`, redact.Manifest(s))
+}
+
+func TestManifestSecretJSON(t *testing.T) {
+	redact.Set(true)
+	defer redact.Set(false)
+
+	s := `{"apiVersion": "v1", "kind": "List", "items": [
+  {"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "fred"}, "stringData": {"password": "blee"}},
+  {"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "zorg"}, "data": {"a": "b"}}
+]}
+`
+
+	assert.Equal(t, `{
+  "apiVersion": "v1",
+  "items": [
+    {
+      "apiVersion": "v1",
+      "kind": "Secret",
+      "metadata": {
+        "name": "fred"
+      },
+      "stringData": {
+        "password": "********"
+      }
+    },
+    {
+      "apiVersion": "v1",
+      "data": {
+        "a": "b"
+      },
+      "kind": "ConfigMap",
+      "metadata": {
+        "name": "zorg"
+      }
+    }
+  ],
+  "kind": "List"
+}
+`, redact.Manifest(s))
+}
+
+func TestManifestSecretList(t *testing.T) {
+	redact.Set(true)
+	defer redact.Set(false)
+
+	s := `{"apiVersion": "v1", "kind": "SecretList", "items": [
+  {"metadata": {"name": "fred"}, "data": {"password": "YmxlZQ=="}}
+]}`
+
+	assert.Equal(t, `{
+  "apiVersion": "v1",
+  "items": [
+    {
+      "data": {
+        "password": "********"
+      },
+      "metadata": {
+        "name": "fred"
+      }
+    }
+  ],
+  "kind": "SecretList"
+}`, redact.Manifest(s))
+}
+
+func TestManifestSecretMultiDocs(t *testing.T) {
+	redact.Set(true)
+	defer redact.Set(false)
+
+	s := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: zorg
+---
+apiVersion: v1
+data:
+  password: cGFzc3dvcmQ=
+kind: Secret`
+
+	assert.Equal(t, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: zorg
+---
+apiVersion: v1
+data:
+  password: ********
+kind: Secret`, redact.Manifest(s))
+}
+
+func TestScrub(t *testing.T) {
+	s := redact.Scrub(`apiVersion: v1
+data:
+  password: cGFzc3dvcmQ=
+kind: Secret`)
+
+	assert.False(t, redact.IsEnabled())
+	assert.Equal(t, `apiVersion: v1
+data:
+  password: '********'
+kind: Secret`, s)
+	assert.Equal(t, "dial "+redact.Pseudonym("ip", "10.0.3.4"), redact.Scrub("dial 10.0.3.4"))
+}
+
+func TestMaskData(t *testing.T) {
+	o := map[string]interface{}{
+		"kind":       "ConfigMap",
+		"data":       map[string]interface{}{"a": "b"},
+		"binaryData": map[string]interface{}{"c": "ZA=="},
+	}
+	redact.MaskData(o)
+
+	assert.Equal(t, map[string]interface{}{
+		"kind":       "ConfigMap",
+		"data":       map[string]interface{}{"a": redact.Masked},
+		"binaryData": map[string]interface{}{"c": redact.Masked},
+	}, o)
+}
+
+func TestPseudonymStable(t *testing.T) {
+	assert.Equal(t, redact.Pseudonym("ip", "10.0.0.1"), redact.Pseudonym("ip", "10.0.0.1"))
+	assert.NotEqual(t, redact.Pseudonym("ip", "10.0.0.1"), redact.Pseudonym("ip", "10.0.0.2"))
+}
+
+func TestToggle(t *testing.T) {
+	redact.Set(false)
+
+	assert.True(t, redact.Toggle())
+	assert.True(t, redact.IsEnabled())
+	assert.False(t, redact.Toggle())
+}
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// FlowSchema renders an API priority and fairness flow schema to screen.
+type FlowSchema struct {
+	Base
+}
+
+// Header returns a header row.
+func (FlowSchema) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "PRIORITYLEVEL"},
+		model1.HeaderColumn{Name: "PRECEDENCE", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "DISTINGUISHER"},
+		model1.HeaderColumn{Name: "INQUEUE", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "EXECUTING", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "REJECTED", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (f FlowSchema) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(*FlowControlRes)
+	if !ok {
+		return fmt.Errorf("expected FlowControlRes, but got %T", o)
+	}
+
+	u := res.Raw.Object
+	pl, _, _ := unstructured.NestedString(u, "spec", "priorityLevelConfiguration", "name")
+	dist, _, _ := unstructured.NestedString(u, "spec", "distinguisherMethod", "type")
+	r.ID = client.FQN(client.ClusterScope, res.Raw.GetName())
+	r.Fields = model1.Fields{
+		res.Raw.GetName(),
+		pl,
+		intField(u, "spec", "matchingPrecedence"),
+		na(dist),
+	}
+	r.Fields = append(r.Fields, res.MX.fields(true, res.Raw.GetName())...)
+	r.Fields = append(r.Fields,
+		AsStatus(f.diagnose(u)),
+		ToAge(res.Raw.GetCreationTimestamp()),
+	)
+
+	return nil
+}
+
+func (FlowSchema) diagnose(u map[string]interface{}) error {
+	for _, c := range NestedMaps(u, "status", "conditions") {
+		if c["type"] == "Dangling" && c["status"] == "True" {
+			return fmt.Errorf("dangling: %v", c["message"])
+		}
+	}
+
+	return nil
+}
+
+// PriorityLevel renders an API priority and fairness priority level to screen.
+type PriorityLevel struct {
+	Base
+}
+
+// Header returns a header row.
+func (PriorityLevel) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "TYPE"},
+		model1.HeaderColumn{Name: "SHARES", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "LIMIT-RESPONSE"},
+		model1.HeaderColumn{Name: "QUEUES", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "HANDSIZE", Align: tview.AlignRight, Wide: true},
+		model1.HeaderColumn{Name: "QUEUE-LENGTH", Align: tview.AlignRight, Wide: true},
+		model1.HeaderColumn{Name: "INQUEUE", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "EXECUTING", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "REJECTED", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (PriorityLevel) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(*FlowControlRes)
+	if !ok {
+		return fmt.Errorf("expected FlowControlRes, but got %T", o)
+	}
+
+	u := res.Raw.Object
+	typ, _, _ := unstructured.NestedString(u, "spec", "type")
+	limit, _, _ := unstructured.NestedString(u, "spec", "limited", "limitResponse", "type")
+	shares := intField(u, "spec", "limited", "nominalConcurrencyShares")
+	if shares == "" {
+		// v1beta2 and prior.
+		shares = intField(u, "spec", "limited", "assuredConcurrencyShares")
+	}
+	r.ID = client.FQN(client.ClusterScope, res.Raw.GetName())
+	r.Fields = model1.Fields{
+		res.Raw.GetName(),
+		typ,
+		shares,
+		limit,
+		intField(u, "spec", "limited", "limitResponse", "queuing", "queues"),
+		intField(u, "spec", "limited", "limitResponse", "queuing", "handSize"),
+		intField(u, "spec", "limited", "limitResponse", "queuing", "queueLengthLimit"),
+	}
+	r.Fields = append(r.Fields, res.MX.fields(false, res.Raw.GetName())...)
+	r.Fields = append(r.Fields, ToAge(res.Raw.GetCreationTimestamp()))
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// FlowControlRes represents a flow schema or priority level with its live
+// api server metrics.
+type FlowControlRes struct {
+	Raw *unstructured.Unstructured
+	MX  *APFMetrics
+}
+
+// GetObjectKind returns a schema object.
+func (*FlowControlRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (f *FlowControlRes) DeepCopyObject() runtime.Object {
+	return f
+}
+
+// APFStats tracks the requests of a flow schema or priority level.
+type APFStats struct {
+	InQueue, Executing, Rejected int64
+}
+
+// APFMetrics tracks the api server flow control metrics.
+type APFMetrics struct {
+	FlowSchemas    map[string]APFStats
+	PriorityLevels map[string]APFStats
+}
+
+// NewAPFMetrics returns a new instance.
+func NewAPFMetrics() *APFMetrics {
+	return &APFMetrics{
+		FlowSchemas:    make(map[string]APFStats),
+		PriorityLevels: make(map[string]APFStats),
+	}
+}
+
+func (a *APFMetrics) fields(flowSchema bool, n string) model1.Fields {
+	if a == nil {
+		return model1.Fields{NAValue, NAValue, NAValue}
+	}
+	s := a.PriorityLevels[n]
+	if flowSchema {
+		s = a.FlowSchemas[n]
+	}
+
+	return model1.Fields{
+		strconv.FormatInt(s.InQueue, 10),
+		strconv.FormatInt(s.Executing, 10),
+		strconv.FormatInt(s.Rejected, 10),
+	}
+}
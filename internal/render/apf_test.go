@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFlowSchemaRender(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "service-accounts"},
+		"spec": map[string]interface{}{
+			"matchingPrecedence":         int64(9000),
+			"priorityLevelConfiguration": map[string]interface{}{"name": "workload-low"},
+			"distinguisherMethod":        map[string]interface{}{"type": "ByUser"},
+		},
+	}}
+	mx := render.NewAPFMetrics()
+	mx.FlowSchemas["service-accounts"] = render.APFStats{InQueue: 3, Executing: 2, Rejected: 8}
+
+	uu := map[string]struct {
+		mx *render.APFMetrics
+		e  model1.Fields
+	}{
+		"metrics": {
+			mx: mx,
+			e:  model1.Fields{"service-accounts", "workload-low", "9000", "ByUser", "3", "2", "8", ""},
+		},
+		"no-metrics": {
+			e: model1.Fields{"service-accounts", "workload-low", "9000", "ByUser", "n/a", "n/a", "n/a", ""},
+		},
+	}
+
+	for k := range uu {
+		u1 := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var f render.FlowSchema
+			r := model1.NewRow(9)
+			assert.NoError(t, f.Render(&render.FlowControlRes{Raw: &u, MX: u1.mx}, "", &r))
+			assert.Equal(t, "-/service-accounts", r.ID)
+			assert.Equal(t, u1.e, r.Fields[:8])
+		})
+	}
+}
+
+func TestPriorityLevelRender(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "workload-low"},
+		"spec": map[string]interface{}{
+			"type": "Limited",
+			"limited": map[string]interface{}{
+				"nominalConcurrencyShares": int64(100),
+				"limitResponse": map[string]interface{}{
+					"type": "Queue",
+					"queuing": map[string]interface{}{
+						"queues":           int64(128),
+						"handSize":         int64(6),
+						"queueLengthLimit": int64(50),
+					},
+				},
+			},
+		},
+	}}
+	mx := render.NewAPFMetrics()
+	mx.PriorityLevels["workload-low"] = render.APFStats{InQueue: 3, Executing: 2, Rejected: 10}
+
+	var p render.PriorityLevel
+	r := model1.NewRow(11)
+	assert.NoError(t, p.Render(&render.FlowControlRes{Raw: &u, MX: mx}, "", &r))
+	assert.Equal(t, "-/workload-low", r.ID)
+	assert.Equal(t, model1.Fields{"workload-low", "Limited", "100", "Queue", "128", "6", "50", "3", "2", "10"}, r.Fields[:10])
+}
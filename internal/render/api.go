@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// APIPath renders an API server path.
+type APIPath struct {
+	Base
+}
+
+// Header returns a header row.
+func (APIPath) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "PATH"},
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "NAMESPACED"},
+		model1.HeaderColumn{Name: "VERBS"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (APIPath) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(APIPathRes)
+	if !ok {
+		return fmt.Errorf("expected APIPathRes, but got %T", o)
+	}
+
+	r.ID = res.Path
+	r.Fields = model1.Fields{
+		res.Path,
+		res.Kind,
+		"",
+		strings.Join(res.Verbs, ","),
+	}
+	if res.Verbs != nil {
+		r.Fields[2] = boolToStr(res.Namespaced)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// APIPathRes represents an API server path.
+type APIPathRes struct {
+	Path       string
+	Kind       string
+	Namespaced bool
+	Verbs      []string
+}
+
+// GetObjectKind returns a schema object.
+func (APIPathRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a APIPathRes) DeepCopyObject() runtime.Object {
+	return a
+}
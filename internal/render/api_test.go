@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIPathRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.APIPathRes
+		e   model1.Fields
+	}{
+		"path": {
+			res: render.APIPathRes{Path: "/healthz"},
+			e:   model1.Fields{"/healthz", "", "", ""},
+		},
+		"resource": {
+			res: render.APIPathRes{
+				Path:       "/api/v1/pods",
+				Kind:       "Pod",
+				Namespaced: true,
+				Verbs:      []string{"get", "list"},
+			},
+			e: model1.Fields{"/api/v1/pods", "Pod", "true", "get,list"},
+		},
+	}
+
+	var a render.APIPath
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			assert.Nil(t, a.Render(u.res, "", &r))
+			assert.Equal(t, u.res.Path, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
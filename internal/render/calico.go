@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// CalicoPolicy renders a Calico network policy to screen.
+type CalicoPolicy struct {
+	Base
+}
+
+// Header returns a header row.
+func (CalicoPolicy) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "TIER"},
+		model1.HeaderColumn{Name: "ORDER"},
+		model1.HeaderColumn{Name: "SELECTOR"},
+		model1.HeaderColumn{Name: "TYPES"},
+		model1.HeaderColumn{Name: "INGRESS"},
+		model1.HeaderColumn{Name: "EGRESS"},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (CalicoPolicy) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected CalicoPolicy, but got %T", o)
+	}
+
+	tier, _, _ := unstructured.NestedString(u.Object, "spec", "tier")
+	sel, _, _ := unstructured.NestedString(u.Object, "spec", "selector")
+	tt, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "types")
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		check(tier, "default"),
+		calicoOrder(u),
+		check(sel, "all()"),
+		naStrings(tt),
+		calicoActions(NestedMaps(u.Object, "spec", "ingress")),
+		calicoActions(NestedMaps(u.Object, "spec", "egress")),
+		mapToStr(u.GetLabels()),
+		"",
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func calicoOrder(u *unstructured.Unstructured) string {
+	v, ok, _ := unstructured.NestedFieldNoCopy(u.Object, "spec", "order")
+	if !ok {
+		return NAValue
+	}
+	switch o := v.(type) {
+	case int64:
+		return strconv.FormatInt(o, 10)
+	case float64:
+		return strconv.FormatFloat(o, 'f', -1, 64)
+	default:
+		return fmt.Sprint(o)
+	}
+}
+
+// calicoActions tallies rules by action, ie Allow:2,Deny:1.
+func calicoActions(rr []map[string]interface{}) string {
+	if len(rr) == 0 {
+		return NAValue
+	}
+	counts := make(map[string]int, 4)
+	for _, r := range rr {
+		a, _, _ := unstructured.NestedString(r, "action")
+		counts[check(a, "Allow")]++
+	}
+	aa := make([]string, 0, len(counts))
+	for a, c := range counts {
+		aa = append(aa, a+":"+strconv.Itoa(c))
+	}
+	sort.Strings(aa)
+
+	return strings.Join(aa, ",")
+}
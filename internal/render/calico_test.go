@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalicoPolicyRender(t *testing.T) {
+	c := render.CalicoPolicy{}
+	r := model1.NewRow(11)
+
+	assert.NoError(t, c.Render(load(t, "calico_np"), "", &r))
+	assert.Equal(t, "default/default.fred", r.ID)
+	assert.Equal(t, model1.Fields{"default", "default.fred", "default", "100", "app == 'fred'", "Ingress,Egress", "Allow:2,Deny:1", "Allow:1"}, r.Fields[:8])
+}
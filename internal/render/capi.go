@@ -0,0 +1,280 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// CAPIClusterLabel tracks the cluster a Cluster API resource belongs to.
+	CAPIClusterLabel = "cluster.x-k8s.io/cluster-name"
+
+	// CAPIDeploymentLabel tracks the machine deployment owning a machine set or machine.
+	CAPIDeploymentLabel = "cluster.x-k8s.io/deployment-name"
+
+	// CAPISetLabel tracks the machine set owning a machine.
+	CAPISetLabel = "cluster.x-k8s.io/set-name"
+)
+
+// CAPICluster renders a Cluster API cluster to screen.
+type CAPICluster struct {
+	Base
+}
+
+// Header returns a header row.
+func (CAPICluster) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "CLUSTERCLASS"},
+		model1.HeaderColumn{Name: "PHASE"},
+		model1.HeaderColumn{Name: "READY"},
+		model1.HeaderColumn{Name: "CP-READY"},
+		model1.HeaderColumn{Name: "INFRA-READY"},
+		model1.HeaderColumn{Name: "VERSION"},
+		model1.HeaderColumn{Name: "REASON", Wide: true},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (CAPICluster) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected CAPICluster, but got %T", o)
+	}
+
+	class, _, _ := unstructured.NestedString(u.Object, "spec", "topology", "class")
+	version, _, _ := unstructured.NestedString(u.Object, "spec", "topology", "version")
+	phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+	cpReady, _, _ := unstructured.NestedBool(u.Object, "status", "controlPlaneReady")
+	infraReady, _, _ := unstructured.NestedBool(u.Object, "status", "infrastructureReady")
+	ready, reason := StatusCondition(u, "Ready")
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		na(class),
+		missing(phase),
+		ready,
+		boolToStr(cpReady),
+		boolToStr(infraReady),
+		na(version),
+		na(reason),
+		mapToStr(u.GetLabels()),
+		AsStatus(capiDiagnose(u)),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// MachineDeployment renders a Cluster API machine deployment to screen.
+type MachineDeployment struct {
+	Base
+}
+
+// Header returns a header row.
+func (MachineDeployment) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "CLUSTER"},
+		model1.HeaderColumn{Name: "READY", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "UP-TO-DATE", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "UNAVAILABLE", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "PHASE"},
+		model1.HeaderColumn{Name: "VERSION"},
+		model1.HeaderColumn{Name: "REASON", Wide: true},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (MachineDeployment) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected MachineDeployment, but got %T", o)
+	}
+
+	cluster, _, _ := unstructured.NestedString(u.Object, "spec", "clusterName")
+	version, _, _ := unstructured.NestedString(u.Object, "spec", "template", "spec", "version")
+	phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+	updated, _, _ := unstructured.NestedInt64(u.Object, "status", "updatedReplicas")
+	unavailable, _, _ := unstructured.NestedInt64(u.Object, "status", "unavailableReplicas")
+	_, reason := StatusCondition(u, "Ready")
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		missing(cluster),
+		capiReplicas(u),
+		strconv.FormatInt(updated, 10),
+		strconv.FormatInt(unavailable, 10),
+		missing(phase),
+		na(version),
+		na(reason),
+		mapToStr(u.GetLabels()),
+		AsStatus(capiDiagnose(u)),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// MachineSet renders a Cluster API machine set to screen.
+type MachineSet struct {
+	Base
+}
+
+// Header returns a header row.
+func (MachineSet) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "CLUSTER"},
+		model1.HeaderColumn{Name: "READY", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "AVAILABLE", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "VERSION"},
+		model1.HeaderColumn{Name: "REASON", Wide: true},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (MachineSet) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected MachineSet, but got %T", o)
+	}
+
+	cluster, _, _ := unstructured.NestedString(u.Object, "spec", "clusterName")
+	version, _, _ := unstructured.NestedString(u.Object, "spec", "template", "spec", "version")
+	available, _, _ := unstructured.NestedInt64(u.Object, "status", "availableReplicas")
+	_, reason := StatusCondition(u, "Ready")
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		missing(cluster),
+		capiReplicas(u),
+		strconv.FormatInt(available, 10),
+		na(version),
+		na(reason),
+		mapToStr(u.GetLabels()),
+		AsStatus(capiDiagnose(u)),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// Machine renders a Cluster API machine to screen.
+type Machine struct {
+	Base
+}
+
+// Header returns a header row.
+func (Machine) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "CLUSTER"},
+		model1.HeaderColumn{Name: "NODE"},
+		model1.HeaderColumn{Name: "PHASE"},
+		model1.HeaderColumn{Name: "READY"},
+		model1.HeaderColumn{Name: "VERSION"},
+		model1.HeaderColumn{Name: "PROVIDER-ID", Wide: true},
+		model1.HeaderColumn{Name: "REASON", Wide: true},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Machine) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Machine, but got %T", o)
+	}
+
+	cluster, _, _ := unstructured.NestedString(u.Object, "spec", "clusterName")
+	version, _, _ := unstructured.NestedString(u.Object, "spec", "version")
+	providerID, _, _ := unstructured.NestedString(u.Object, "spec", "providerID")
+	phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+	ready, reason := StatusCondition(u, "Ready")
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		missing(cluster),
+		na(MachineNode(u)),
+		missing(phase),
+		ready,
+		na(version),
+		na(providerID),
+		na(reason),
+		mapToStr(u.GetLabels()),
+		AsStatus(capiDiagnose(u)),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// MachineNode returns the name of the node a machine provisioned if any.
+func MachineNode(u *unstructured.Unstructured) string {
+	node, _, _ := unstructured.NestedString(u.Object, "status", "nodeRef", "name")
+
+	return node
+}
+
+// capiReplicas returns ready/desired replicas.
+func capiReplicas(u *unstructured.Unstructured) string {
+	desired, _, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	ready, _, _ := unstructured.NestedInt64(u.Object, "status", "readyReplicas")
+
+	return strconv.FormatInt(ready, 10) + "/" + strconv.FormatInt(desired, 10)
+}
+
+// capiDiagnose reports terminal failures and failed conditions of error severity.
+func capiDiagnose(u *unstructured.Unstructured) error {
+	if reason, _, _ := unstructured.NestedString(u.Object, "status", "failureReason"); reason != "" {
+		msg, _, _ := unstructured.NestedString(u.Object, "status", "failureMessage")
+		return fmt.Errorf("%s: %s", reason, msg)
+	}
+	if phase, _, _ := unstructured.NestedString(u.Object, "status", "phase"); phase == "Failed" {
+		return errors.New("provisioning failed")
+	}
+	for _, c := range NestedMaps(u.Object, "status", "conditions") {
+		status, _, _ := unstructured.NestedString(c, "status")
+		severity, _, _ := unstructured.NestedString(c, "severity")
+		if status == "False" && severity == "Error" {
+			t, _, _ := unstructured.NestedString(c, "type")
+			reason, _, _ := unstructured.NestedString(c, "reason")
+			return fmt.Errorf("%s: %s", t, reason)
+		}
+	}
+
+	return nil
+}
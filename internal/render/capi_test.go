@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCAPIClusterRender(t *testing.T) {
+	c := render.CAPICluster{}
+	r := model1.NewRow(12)
+
+	assert.NoError(t, c.Render(load(t, "capi_cluster"), "", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, model1.Fields{"default", "fred", "quick-start", "Provisioned", "True", "true", "true", "v1.30.0", "n/a"}, r.Fields[:9])
+	assert.Equal(t, "", r.Fields[10])
+}
+
+func TestMachineDeploymentRender(t *testing.T) {
+	c := render.MachineDeployment{}
+	r := model1.NewRow(12)
+
+	assert.NoError(t, c.Render(load(t, "capi_md"), "", &r))
+	assert.Equal(t, "default/fred-md-0", r.ID)
+	assert.Equal(t, model1.Fields{"default", "fred-md-0", "fred", "2/3", "3", "1", "ScalingUp", "v1.30.0"}, r.Fields[:8])
+	assert.Equal(t, "InvalidConfiguration: bad bootstrap", r.Fields[10])
+}
+
+func TestMachineRender(t *testing.T) {
+	c := render.Machine{}
+	r := model1.NewRow(12)
+
+	assert.NoError(t, c.Render(load(t, "capi_machine"), "", &r))
+	assert.Equal(t, "default/fred-md-0-x7k2p", r.ID)
+	assert.Equal(t, model1.Fields{
+		"default",
+		"fred-md-0-x7k2p",
+		"fred",
+		"ip-10-0-1-12",
+		"Running",
+		"False",
+		"v1.30.0",
+		"aws:///us-east-1a/i-0abc",
+		"NodeNotReady: kubelet stopped posting",
+	}, r.Fields[:9])
+	assert.Equal(t, "", r.Fields[10])
+}
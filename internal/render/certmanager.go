@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// CertManagerIssuing tracks certificates being issued.
+	CertManagerIssuing = "Issuing"
+
+	// CertManagerPending tracks cert-manager resources yet to report a status.
+	CertManagerPending = "Pending"
+
+	certManagerReady = "Ready"
+)
+
+// certManagerHealthy tracks cert-manager states that are not failures.
+var certManagerHealthy = map[string]struct{}{
+	certManagerReady:   {},
+	CertManagerIssuing: {},
+	CertManagerPending: {},
+	"pending":          {},
+	"ready":            {},
+	"processing":       {},
+	"valid":            {},
+}
+
+// Certificate renders a cert-manager certificate to screen.
+type Certificate struct {
+	Base
+}
+
+// Header returns a header row.
+func (Certificate) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "SECRET"},
+		model1.HeaderColumn{Name: "ISSUER"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "EXPIRES"},
+		model1.HeaderColumn{Name: "RENEWAL"},
+		model1.HeaderColumn{Name: "DNS NAMES", Wide: true},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Certificate) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Certificate, but got %T", o)
+	}
+
+	secret, _, _ := unstructured.NestedString(u.Object, "spec", "secretName")
+	dns, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "dnsNames")
+	status, _ := CertManagerStatus(u)
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		secret,
+		CertManagerIssuer(u),
+		status,
+		certManagerDate(u, "status", "notAfter"),
+		certManagerDate(u, "status", "renewalTime"),
+		naStrings(dns),
+		mapToStr(u.GetLabels()),
+		AsStatus(certManagerDiagnose(u)),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// CertificateRequest renders a cert-manager certificate request to screen.
+type CertificateRequest struct {
+	Base
+}
+
+// Header returns a header row.
+func (CertificateRequest) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "ISSUER"},
+		model1.HeaderColumn{Name: "APPROVED"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "REASON"},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (CertificateRequest) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected CertificateRequest, but got %T", o)
+	}
+
+	approved, _ := StatusCondition(u, "Approved")
+	status, reason := CertManagerStatus(u)
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		CertManagerIssuer(u),
+		approved,
+		status,
+		na(reason),
+		mapToStr(u.GetLabels()),
+		AsStatus(certManagerDiagnose(u)),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// Order renders a cert-manager ACME order to screen.
+type Order struct {
+	Base
+}
+
+// Header returns a header row.
+func (Order) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATE"},
+		model1.HeaderColumn{Name: "ISSUER"},
+		model1.HeaderColumn{Name: "REASON"},
+		model1.HeaderColumn{Name: "DNS NAMES", Wide: true},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Order) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Order, but got %T", o)
+	}
+
+	state, reason := CertManagerStatus(u)
+	dns, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "dnsNames")
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		state,
+		CertManagerIssuer(u),
+		na(reason),
+		naStrings(dns),
+		mapToStr(u.GetLabels()),
+		AsStatus(certManagerDiagnose(u)),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// Challenge renders a cert-manager ACME challenge to screen.
+type Challenge struct {
+	Base
+}
+
+// Header returns a header row.
+func (Challenge) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATE"},
+		model1.HeaderColumn{Name: "DOMAIN"},
+		model1.HeaderColumn{Name: "TYPE"},
+		model1.HeaderColumn{Name: "PRESENTED"},
+		model1.HeaderColumn{Name: "REASON"},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Challenge) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Challenge, but got %T", o)
+	}
+
+	state, reason := CertManagerStatus(u)
+	domain, _, _ := unstructured.NestedString(u.Object, "spec", "dnsName")
+	kind, _, _ := unstructured.NestedString(u.Object, "spec", "type")
+	presented, _, _ := unstructured.NestedBool(u.Object, "status", "presented")
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		state,
+		domain,
+		na(kind),
+		boolToStr(presented),
+		na(reason),
+		mapToStr(u.GetLabels()),
+		AsStatus(certManagerDiagnose(u)),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// CertManagerIssuer returns a cert-manager resource issuer as kind/name.
+func CertManagerIssuer(u *unstructured.Unstructured) string {
+	n, _, _ := unstructured.NestedString(u.Object, "spec", "issuerRef", "name")
+	if n == "" {
+		return NAValue
+	}
+	kind, _, _ := unstructured.NestedString(u.Object, "spec", "issuerRef", "kind")
+	if kind == "" {
+		kind = "Issuer"
+	}
+
+	return kind + "/" + n
+}
+
+// CertManagerStatus returns a cert-manager resource status along with the
+// reason it is not progressing if any. ACME orders and challenges report
+// their state while certificates and requests report their conditions.
+func CertManagerStatus(u *unstructured.Unstructured) (string, string) {
+	switch u.GetKind() {
+	case "Order", "Challenge":
+		state, _, _ := unstructured.NestedString(u.Object, "status", "state")
+		if state == "" {
+			state = "pending"
+		}
+		reason, _, _ := unstructured.NestedString(u.Object, "status", "reason")
+		return state, reason
+	case "CertificateRequest":
+		if status, _, msg := certManagerCondition(u, "Denied"); status == "True" {
+			return "Denied", msg
+		}
+	}
+
+	if status, _, msg := certManagerCondition(u, CertManagerIssuing); status == "True" {
+		return CertManagerIssuing, msg
+	}
+	switch status, reason, msg := certManagerCondition(u, certManagerReady); status {
+	case "True":
+		return certManagerReady, ""
+	case "False":
+		return check(reason, "NotReady"), msg
+	default:
+		return CertManagerPending, ""
+	}
+}
+
+// certManagerDiagnose reports cert-manager resources that failed.
+func certManagerDiagnose(u *unstructured.Unstructured) error {
+	status, msg := CertManagerStatus(u)
+	if _, ok := certManagerHealthy[status]; ok {
+		return nil
+	}
+	if u.GetKind() == "CertificateRequest" && status != "Failed" && status != "Denied" {
+		return nil
+	}
+	if msg == "" {
+		msg = status
+	}
+
+	return errors.New(msg)
+}
+
+// certManagerCondition returns a condition status, reason and message.
+func certManagerCondition(u *unstructured.Unstructured, kind string) (string, string, string) {
+	for _, c := range NestedMaps(u.Object, "status", "conditions") {
+		if t, _, _ := unstructured.NestedString(c, "type"); t != kind {
+			continue
+		}
+		status, _, _ := unstructured.NestedString(c, "status")
+		reason, _, _ := unstructured.NestedString(c, "reason")
+		msg, _, _ := unstructured.NestedString(c, "message")
+		return status, reason, msg
+	}
+
+	return "", "", ""
+}
+
+func certManagerDate(u *unstructured.Unstructured, fields ...string) string {
+	s, _, _ := unstructured.NestedString(u.Object, fields...)
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return NAValue
+	}
+
+	return t.UTC().Format(tlsExpiryFmt)
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCertificateRender(t *testing.T) {
+	c := render.Certificate{}
+	r := model1.NewRow(11)
+
+	assert.NoError(t, c.Render(load(t, "cm_cert"), "", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, model1.Fields{
+		"default",
+		"fred",
+		"fred-tls",
+		"ClusterIssuer/letsencrypt",
+		"Failed",
+		"2024-04-01",
+		"2024-03-02",
+		"fred.example.com,www.fred.example.com",
+	}, r.Fields[:8])
+	assert.Equal(t, "The certificate request has failed to complete and will be retried", r.Fields[9])
+}
+
+func TestCertificateRequestRender(t *testing.T) {
+	c := render.CertificateRequest{}
+	r := model1.NewRow(9)
+
+	assert.NoError(t, c.Render(load(t, "cm_cr"), "", &r))
+	assert.Equal(t, "default/fred-1", r.ID)
+	assert.Equal(t, model1.Fields{
+		"default",
+		"fred-1",
+		"ClusterIssuer/letsencrypt",
+		"True",
+		"Failed",
+		"Failed to wait for order resource to become ready",
+	}, r.Fields[:6])
+	assert.Equal(t, "Failed to wait for order resource to become ready", r.Fields[7])
+}
+
+func TestChallengeRender(t *testing.T) {
+	c := render.Challenge{}
+	r := model1.NewRow(10)
+
+	assert.NoError(t, c.Render(load(t, "cm_challenge"), "", &r))
+	assert.Equal(t, "default/fred-1-2-3", r.ID)
+	assert.Equal(t, model1.Fields{
+		"default",
+		"fred-1-2-3",
+		"invalid",
+		"fred.example.com",
+		"HTTP-01",
+		"true",
+		"Error accepting authorization: 404 Not Found",
+	}, r.Fields[:7])
+	assert.Equal(t, "Error accepting authorization: 404 Not Found", r.Fields[8])
+}
+
+func TestCertManagerStatus(t *testing.T) {
+	uu := map[string]struct {
+		o              map[string]interface{}
+		status, reason string
+	}{
+		"cert-pending": {
+			o:      map[string]interface{}{"kind": "Certificate"},
+			status: "Pending",
+		},
+		"cert-issuing": {
+			o: map[string]interface{}{
+				"kind": "Certificate",
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Ready", "status": "False", "reason": "DoesNotExist"},
+						map[string]interface{}{"type": "Issuing", "status": "True", "message": "Issuing certificate as Secret does not exist"},
+					},
+				},
+			},
+			status: "Issuing",
+			reason: "Issuing certificate as Secret does not exist",
+		},
+		"cert-ready": {
+			o: map[string]interface{}{
+				"kind": "Certificate",
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Ready", "status": "True", "reason": "Ready"},
+					},
+				},
+			},
+			status: "Ready",
+		},
+		"cr-denied": {
+			o: map[string]interface{}{
+				"kind": "CertificateRequest",
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Denied", "status": "True", "message": "policy violation"},
+					},
+				},
+			},
+			status: "Denied",
+			reason: "policy violation",
+		},
+		"order-pending": {
+			o:      map[string]interface{}{"kind": "Order"},
+			status: "pending",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			status, reason := render.CertManagerStatus(&unstructured.Unstructured{Object: u.o})
+			assert.Equal(t, u.status, status)
+			assert.Equal(t, u.reason, reason)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const changeSep = "@"
+
+// Change renders recent resource changes.
+type Change struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Change) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		idx, ok := h.IndexOf("CHANGE", true)
+		if !ok {
+			return model1.StdColor
+		}
+		switch strings.TrimSpace(re.Row.Fields[idx]) {
+		case "ADDED":
+			return model1.AddColor
+		case "DELETED":
+			return model1.KillColor
+		default:
+			return model1.ModColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Change) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "GVR"},
+		model1.HeaderColumn{Name: "CHANGE"},
+		model1.HeaderColumn{Name: "FIELDS"},
+		model1.HeaderColumn{Name: "MANAGER"},
+		model1.HeaderColumn{Name: "OPERATION", Wide: true},
+		model1.HeaderColumn{Name: "WHEN", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Change) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(ChangeRes)
+	if !ok {
+		return fmt.Errorf("expected ChangeRes, but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = model1.Fields{
+		res.Namespace,
+		res.Name,
+		res.GVR,
+		res.Type,
+		naStrings(res.Fields),
+		na(res.Manager),
+		na(res.Operation),
+		duration.HumanDuration(time.Since(res.Time)),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ChangeRes represents a resource change.
+type ChangeRes struct {
+	Seq       int64
+	Time      time.Time
+	Type      string
+	GVR       string
+	Namespace string
+	Name      string
+	Manager   string
+	Operation string
+	Fields    []string
+}
+
+// ID returns the row identifier as gvr|fqn@seq.
+func (c ChangeRes) ID() string {
+	ns := c.Namespace
+	if ns == "" {
+		ns = client.ClusterScope
+	}
+
+	return ResourceID(c.GVR, client.FQN(ns, c.Name)) + changeSep + strconv.FormatInt(c.Seq, 10)
+}
+
+// ParseChangeID extracts the gvr and resource path from a change row identifier.
+func ParseChangeID(id string) (string, string, bool) {
+	if i := strings.LastIndex(id, changeSep); i >= 0 {
+		id = id[:i]
+	}
+
+	return ParseResourceID(id)
+}
+
+// GetObjectKind returns a schema object.
+func (ChangeRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c ChangeRes) DeepCopyObject() runtime.Object {
+	return c
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeRender(t *testing.T) {
+	c := render.ChangeRes{
+		Seq:       3,
+		Time:      time.Now().Add(-2 * time.Minute),
+		Type:      "UPDATED",
+		GVR:       "apps/v1/deployments",
+		Namespace: "default",
+		Name:      "fred",
+		Manager:   "kubectl",
+		Operation: "Update",
+		Fields:    []string{"metadata.labels", "spec"},
+	}
+
+	var r model1.Row
+	assert.NoError(t, render.Change{}.Render(c, "", &r))
+	assert.Equal(t, "apps/v1/deployments|default/fred@3", r.ID)
+	assert.Equal(t, model1.Fields{"default", "fred", "apps/v1/deployments", "UPDATED", "metadata.labels,spec", "kubectl", "Update", "2m"}, r.Fields)
+
+	gvr, path, ok := render.ParseChangeID(r.ID)
+	assert.True(t, ok)
+	assert.Equal(t, "apps/v1/deployments", gvr)
+	assert.Equal(t, "default/fred", path)
+}
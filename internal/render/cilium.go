@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// CiliumNetworkPolicy renders a Cilium network policy to screen.
+type CiliumNetworkPolicy struct {
+	Base
+}
+
+// Header returns a header row.
+func (CiliumNetworkPolicy) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "SELECTOR"},
+		model1.HeaderColumn{Name: "INGRESS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "EGRESS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "DENY", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (CiliumNetworkPolicy) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected CiliumNetworkPolicy, but got %T", o)
+	}
+
+	var (
+		sels             []string
+		ing, egr, denies int
+	)
+	for _, spec := range ciliumSpecs(u) {
+		sel := CiliumSelector(spec)
+		if len(sel) == 0 {
+			sels = append(sels, "*")
+		} else {
+			sels = append(sels, mapToStr(sel))
+		}
+		ing += len(NestedMaps(spec, "ingress"))
+		egr += len(NestedMaps(spec, "egress"))
+		denies += len(NestedMaps(spec, "ingressDeny")) + len(NestedMaps(spec, "egressDeny"))
+	}
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		naStrings(sels),
+		strconv.Itoa(ing),
+		strconv.Itoa(egr),
+		strconv.Itoa(denies),
+		mapToStr(u.GetLabels()),
+		"",
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ciliumSpecs returns a policy spec along with any additional specs.
+func ciliumSpecs(u *unstructured.Unstructured) []map[string]interface{} {
+	ss := NestedMaps(u.Object, "specs")
+	if spec, ok, _ := unstructured.NestedMap(u.Object, "spec"); ok {
+		ss = append([]map[string]interface{}{spec}, ss...)
+	}
+
+	return ss
+}
+
+// CiliumSelector returns a policy spec endpoint or node selector labels
+// stripped of their source prefix.
+func CiliumSelector(spec map[string]interface{}) map[string]string {
+	sel, ok, _ := unstructured.NestedStringMap(spec, "endpointSelector", "matchLabels")
+	if !ok {
+		sel, _, _ = unstructured.NestedStringMap(spec, "nodeSelector", "matchLabels")
+	}
+	ll := make(map[string]string, len(sel))
+	for k, v := range sel {
+		ll[ciliumKey(k)] = v
+	}
+
+	return ll
+}
+
+// CiliumPolicySelectors returns all endpoint selectors of a Cilium policy,
+// including their match expressions.
+func CiliumPolicySelectors(u *unstructured.Unstructured) ([]*metav1.LabelSelector, error) {
+	specs := ciliumSpecs(u)
+	ss := make([]*metav1.LabelSelector, 0, len(specs))
+	for _, spec := range specs {
+		m, ok, _ := unstructured.NestedMap(spec, "endpointSelector")
+		if !ok {
+			m, _, _ = unstructured.NestedMap(spec, "nodeSelector")
+		}
+		var sel metav1.LabelSelector
+		if m != nil {
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &sel); err != nil {
+				return nil, err
+			}
+		}
+		ll := make(map[string]string, len(sel.MatchLabels))
+		for k, v := range sel.MatchLabels {
+			ll[ciliumKey(k)] = v
+		}
+		sel.MatchLabels = ll
+		for i := range sel.MatchExpressions {
+			sel.MatchExpressions[i].Key = ciliumKey(sel.MatchExpressions[i].Key)
+		}
+		ss = append(ss, &sel)
+	}
+
+	return ss, nil
+}
+
+// ciliumKey strips a Cilium label source prefix ie k8s:app -> app.
+func ciliumKey(k string) string {
+	if src, key, ok := strings.Cut(k, ":"); ok && !strings.Contains(src, "/") {
+		return key
+	}
+
+	return k
+}
+
+// CiliumEndpoint renders a Cilium endpoint to screen.
+type CiliumEndpoint struct {
+	Base
+}
+
+// Header returns a header row.
+func (CiliumEndpoint) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "ENDPOINT-ID", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "IDENTITY", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "INGRESS-ENFORCED"},
+		model1.HeaderColumn{Name: "EGRESS-ENFORCED"},
+		model1.HeaderColumn{Name: "IPV4"},
+		model1.HeaderColumn{Name: "IPV6", Wide: true},
+		model1.HeaderColumn{Name: "STATE"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (CiliumEndpoint) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected CiliumEndpoint, but got %T", o)
+	}
+
+	id, _, _ := unstructured.NestedInt64(u.Object, "status", "id")
+	ident, _, _ := unstructured.NestedInt64(u.Object, "status", "identity", "id")
+	ing, _, _ := unstructured.NestedBool(u.Object, "status", "policy", "ingress", "enforcing")
+	egr, _, _ := unstructured.NestedBool(u.Object, "status", "policy", "egress", "enforcing")
+	state, _, _ := unstructured.NestedString(u.Object, "status", "state")
+	var v4, v6 []string
+	for _, a := range NestedMaps(u.Object, "status", "networking", "addressing") {
+		if ip, ok, _ := unstructured.NestedString(a, "ipv4"); ok {
+			v4 = append(v4, ip)
+		}
+		if ip, ok, _ := unstructured.NestedString(a, "ipv6"); ok {
+			v6 = append(v6, ip)
+		}
+	}
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		strconv.FormatInt(id, 10),
+		strconv.FormatInt(ident, 10),
+		boolToStr(ing),
+		boolToStr(egr),
+		naStrings(v4),
+		naStrings(v6),
+		na(state),
+		ciliumEndpointValid(state),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func ciliumEndpointValid(state string) string {
+	switch state {
+	case "ready", "":
+		return ""
+	default:
+		return "endpoint is " + state
+	}
+}
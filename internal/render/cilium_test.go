@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCiliumNetworkPolicyRender(t *testing.T) {
+	c := render.CiliumNetworkPolicy{}
+	r := model1.NewRow(9)
+
+	assert.NoError(t, c.Render(load(t, "cnp"), "", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, model1.Fields{"default", "fred", "app=fred,app=zorg", "2", "1", "1"}, r.Fields[:6])
+}
+
+func TestCiliumPolicySelectors(t *testing.T) {
+	ss, err := render.CiliumPolicySelectors(load(t, "cnp"))
+
+	assert.NoError(t, err)
+	assert.Len(t, ss, 2)
+	assert.Equal(t, map[string]string{"app": "fred"}, ss[0].MatchLabels)
+	assert.Equal(t, "tier", ss[1].MatchExpressions[0].Key)
+}
+
+func TestCiliumEndpointRender(t *testing.T) {
+	c := render.CiliumEndpoint{}
+	r := model1.NewRow(11)
+
+	assert.NoError(t, c.Render(load(t, "cep"), "", &r))
+	assert.Equal(t, "default/fred-7d9f8", r.ID)
+	assert.Equal(t, model1.Fields{"default", "fred-7d9f8", "1234", "5678", "true", "false", "10.0.1.12", "n/a", "ready", ""}, r.Fields[:10])
+}
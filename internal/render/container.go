@@ -38,6 +38,10 @@ type ContainerWithMetrics interface {
 	IsInit() bool
 }
 
+// NearLimitThreshold represents the percentage of a limit past which a
+// container is flagged as nearing it.
+const NearLimitThreshold = 90
+
 // Container renders a K8s Container to screen.
 type Container struct {
 	Base
@@ -62,6 +66,9 @@ func (c Container) ColorerFunc() model1.ColorerFunc {
 		case Completed:
 			return model1.CompletedColor
 		case Running:
+			if idx, ok := h.IndexOf("NEAR-LIMIT", true); ok && re.Row.Fields[idx] != "" {
+				return model1.HighlightColor
+			}
 			return c
 		default:
 			return model1.ErrColor
@@ -78,8 +85,11 @@ func (Container) Header(ns string) model1.Header {
 		model1.HeaderColumn{Name: "READY"},
 		model1.HeaderColumn{Name: "STATE"},
 		model1.HeaderColumn{Name: "INIT"},
+		model1.HeaderColumn{Name: "TYPE"},
 		model1.HeaderColumn{Name: "RESTARTS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "LAST-STATE"},
 		model1.HeaderColumn{Name: "PROBES(L:R)"},
+		model1.HeaderColumn{Name: "PROBES", Wide: true},
 		model1.HeaderColumn{Name: "CPU", Align: tview.AlignRight, MX: true},
 		model1.HeaderColumn{Name: "MEM", Align: tview.AlignRight, MX: true},
 		model1.HeaderColumn{Name: "CPU/R:L", Align: tview.AlignRight},
@@ -88,7 +98,9 @@ func (Container) Header(ns string) model1.Header {
 		model1.HeaderColumn{Name: "%CPU/L", Align: tview.AlignRight, MX: true},
 		model1.HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight, MX: true},
 		model1.HeaderColumn{Name: "%MEM/L", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "NEAR-LIMIT", MX: true},
 		model1.HeaderColumn{Name: "PORTS"},
+		model1.HeaderColumn{Name: "SECURITY", Wide: true},
 		model1.HeaderColumn{Name: "VALID", Wide: true},
 		model1.HeaderColumn{Name: "AGE", Time: true},
 	}
@@ -102,9 +114,10 @@ func (c Container) Render(o interface{}, name string, r *model1.Row) error {
 	}
 
 	cur, res := gatherMetrics(co.Container, co.MX)
-	ready, state, restarts := "false", MissingValue, "0"
+	ready, state, restarts, last := "false", MissingValue, "0", ""
 	if co.Status != nil {
 		ready, state, restarts = boolToStr(co.Status.Ready), ToContainerState(co.Status.State), strconv.Itoa(int(co.Status.RestartCount))
+		last = ToLastTermination(co.Status.LastTerminationState)
 	}
 
 	r.ID = co.Container.Name
@@ -115,8 +128,11 @@ func (c Container) Render(o interface{}, name string, r *model1.Row) error {
 		ready,
 		state,
 		boolToStr(co.IsInit),
+		ContainerType(co.Container, co.IsInit),
 		restarts,
+		last,
 		probe(co.Container.LivenessProbe) + ":" + probe(co.Container.ReadinessProbe),
+		ProbesSpec(co.Container),
 		toMc(cur.cpu),
 		toMi(cur.mem),
 		toMc(res.cpu) + ":" + toMc(res.lcpu),
@@ -125,7 +141,9 @@ func (c Container) Render(o interface{}, name string, r *model1.Row) error {
 		client.ToPercentageStr(cur.cpu, res.lcpu),
 		client.ToPercentageStr(cur.mem, res.mem),
 		client.ToPercentageStr(cur.mem, res.lmem),
+		ToNearLimit(cur.cpu, res.lcpu, cur.mem, res.lmem),
 		ToContainerPorts(co.Container.Ports),
+		ContainerSecurityOf(co.PodSecurity, co.Container).String(),
 		AsStatus(c.diagnose(state, ready)),
 		ToAge(co.Age),
 	}
@@ -187,6 +205,21 @@ func gatherMetrics(co *v1.Container, mx *mv1beta1.ContainerMetrics) (c, r metric
 	return
 }
 
+// ToNearLimit flags containers whose usage is nearing their cpu or memory
+// limits. Cpu usage close to its limit hints the container may be throttled
+// while memory close to its limit hints at an upcoming OOM kill.
+func ToNearLimit(cpu, lcpu, mem, lmem int64) string {
+	ff := make([]string, 0, 2)
+	if lcpu > 0 && client.ToPercentage(cpu, lcpu) >= NearLimitThreshold {
+		ff = append(ff, "cpu")
+	}
+	if lmem > 0 && client.ToPercentage(mem, lmem) >= NearLimitThreshold {
+		ff = append(ff, "mem")
+	}
+
+	return strings.Join(ff, ",")
+}
+
 // ToContainerPorts returns container ports as a string.
 func ToContainerPorts(pp []v1.ContainerPort) string {
 	ports := make([]string, len(pp))
@@ -224,6 +257,79 @@ func ToContainerState(s v1.ContainerState) string {
 	}
 }
 
+// ToLastTermination returns a container previous termination reason and exit code.
+func ToLastTermination(s v1.ContainerState) string {
+	t := s.Terminated
+	if t == nil {
+		return ""
+	}
+
+	return check(t.Reason, "Terminated") + "(" + strconv.Itoa(int(t.ExitCode)) + ")"
+}
+
+const (
+	// InitContainer tags a regular init container.
+	InitContainer = "init"
+
+	// SidecarContainer tags an init container restarted for the pod lifetime.
+	SidecarContainer = "sidecar"
+
+	// AppContainer tags a regular container.
+	AppContainer = "app"
+)
+
+// ContainerType classifies a container as init, sidecar or app.
+func ContainerType(co *v1.Container, isInit bool) string {
+	switch {
+	case !isInit:
+		return AppContainer
+	case restartableInitCO(co.RestartPolicy):
+		return SidecarContainer
+	default:
+		return InitContainer
+	}
+}
+
+// ProbesSpec summarizes a container startup, liveness and readiness probes.
+func ProbesSpec(co *v1.Container) string {
+	pp := []struct {
+		tag string
+		p   *v1.Probe
+	}{
+		{"S", co.StartupProbe},
+		{"L", co.LivenessProbe},
+		{"R", co.ReadinessProbe},
+	}
+	ss := make([]string, 0, len(pp))
+	for _, p := range pp {
+		if p.p != nil {
+			ss = append(ss, p.tag+"="+ProbeHandler(p.p))
+		}
+	}
+
+	return strings.Join(ss, " ")
+}
+
+// ProbeHandler returns a human readable probe action.
+func ProbeHandler(p *v1.Probe) string {
+	switch {
+	case p.HTTPGet != nil:
+		scheme := strings.ToLower(string(p.HTTPGet.Scheme))
+		if scheme == "" {
+			scheme = "http"
+		}
+		return scheme + ":" + p.HTTPGet.Port.String() + p.HTTPGet.Path
+	case p.TCPSocket != nil:
+		return "tcp:" + p.TCPSocket.Port.String()
+	case p.GRPC != nil:
+		return "grpc:" + strconv.Itoa(int(p.GRPC.Port))
+	case p.Exec != nil:
+		return "exec:" + strings.Join(p.Exec.Command, " ")
+	default:
+		return NAValue
+	}
+}
+
 const (
 	on  = "on"
 	off = "off"
@@ -238,11 +344,12 @@ func probe(p *v1.Probe) string {
 
 // ContainerRes represents a container and its metrics.
 type ContainerRes struct {
-	Container *v1.Container
-	Status    *v1.ContainerStatus
-	MX        *mv1beta1.ContainerMetrics
-	IsInit    bool
-	Age       metav1.Time
+	Container   *v1.Container
+	Status      *v1.ContainerStatus
+	MX          *mv1beta1.ContainerMetrics
+	IsInit      bool
+	Age         metav1.Time
+	PodSecurity *v1.PodSecurityContext
 }
 
 // GetObjectKind returns a schema object.
@@ -14,6 +14,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
@@ -37,8 +38,11 @@ func TestContainer(t *testing.T) {
 		"false",
 		"Running",
 		"false",
+		"app",
 		"0",
+		"",
 		"off:off",
+		"",
 		"10",
 		"20",
 		"20:20",
@@ -48,12 +52,117 @@ func TestContainer(t *testing.T) {
 		"20",
 		"20",
 		"",
+		"",
+		"root",
 		"container is not ready",
 	},
 		r.Fields[:len(r.Fields)-1],
 	)
 }
 
+func TestToNearLimit(t *testing.T) {
+	uu := map[string]struct {
+		cpu, lcpu, mem, lmem int64
+		e                    string
+	}{
+		"no-limits": {
+			cpu: 100,
+			mem: 100,
+		},
+		"happy": {
+			cpu: 10, lcpu: 100,
+			mem: 10, lmem: 100,
+		},
+		"cpu": {
+			cpu: 95, lcpu: 100,
+			mem: 10, lmem: 100,
+			e: "cpu",
+		},
+		"both": {
+			cpu: 100, lcpu: 100,
+			mem: 90, lmem: 100,
+			e: "cpu,mem",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.ToNearLimit(u.cpu, u.lcpu, u.mem, u.lmem))
+		})
+	}
+}
+
+func TestContainerType(t *testing.T) {
+	always := v1.ContainerRestartPolicyAlways
+	uu := map[string]struct {
+		co     v1.Container
+		isInit bool
+		e      string
+	}{
+		"app": {
+			e: render.AppContainer,
+		},
+		"init": {
+			isInit: true,
+			e:      render.InitContainer,
+		},
+		"sidecar": {
+			co:     v1.Container{RestartPolicy: &always},
+			isInit: true,
+			e:      render.SidecarContainer,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.ContainerType(&u.co, u.isInit))
+		})
+	}
+}
+
+func TestToLastTermination(t *testing.T) {
+	uu := map[string]struct {
+		s v1.ContainerState
+		e string
+	}{
+		"none": {},
+		"oom": {
+			s: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+			e: "OOMKilled(137)",
+		},
+		"no-reason": {
+			s: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1}},
+			e: "Terminated(1)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.ToLastTermination(u.s))
+		})
+	}
+}
+
+func TestProbesSpec(t *testing.T) {
+	co := v1.Container{
+		StartupProbe: &v1.Probe{ProbeHandler: v1.ProbeHandler{
+			Exec: &v1.ExecAction{Command: []string{"cat", "/tmp/ready"}},
+		}},
+		LivenessProbe: &v1.Probe{ProbeHandler: v1.ProbeHandler{
+			HTTPGet: &v1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt32(8080)},
+		}},
+		ReadinessProbe: &v1.Probe{ProbeHandler: v1.ProbeHandler{
+			TCPSocket: &v1.TCPSocketAction{Port: intstr.FromString("http")},
+		}},
+	}
+
+	assert.Equal(t, "S=exec:cat /tmp/ready L=http:8080/healthz R=tcp:http", render.ProbesSpec(&co))
+	assert.Equal(t, "", render.ProbesSpec(&v1.Container{}))
+}
+
 func BenchmarkContainerRender(b *testing.B) {
 	var c render.Container
 
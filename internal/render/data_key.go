@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// DataKey renders configmap and secret keys to screen.
+type DataKey struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (DataKey) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		idx, ok := h.IndexOf("BINARY", true)
+		if ok && idx < len(re.Row.Fields) && re.Row.Fields[idx] == "true" {
+			return tcell.ColorMediumPurple
+		}
+
+		return tcell.ColorCadetBlue
+	}
+}
+
+// Header returns a header row.
+func (DataKey) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "KEY"},
+		model1.HeaderColumn{Name: "SOURCE"},
+		model1.HeaderColumn{Name: "TYPE"},
+		model1.HeaderColumn{Name: "BINARY"},
+		model1.HeaderColumn{Name: "SIZE", Align: tview.AlignRight},
+	}
+}
+
+// Render renders a data key to screen.
+func (DataKey) Render(o interface{}, ns string, r *model1.Row) error {
+	k, ok := o.(DataKeyRes)
+	if !ok {
+		return fmt.Errorf("expected DataKeyRes, but got %T", o)
+	}
+
+	r.ID = k.Key
+	r.Fields = model1.Fields{
+		k.Key,
+		k.Source,
+		k.Type,
+		strconv.FormatBool(k.Binary),
+		strconv.Itoa(len(k.Value)),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// DataKeyRes represents a configmap or secret key.
+type DataKeyRes struct {
+	Key    string
+	Source string
+	Type   string
+	Binary bool
+	Value  []byte
+}
+
+// GetObjectKind returns a schema object.
+func (DataKeyRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a data key copy.
+func (d DataKeyRes) DeepCopyObject() runtime.Object {
+	return d
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataKeyRender(t *testing.T) {
+	o := render.DataKeyRes{
+		Key:    "logo.png",
+		Source: "binaryData",
+		Type:   "image/png",
+		Binary: true,
+		Value:  []byte{0x89, 'P', 'N', 'G'},
+	}
+
+	var (
+		k render.DataKey
+		r model1.Row
+	)
+	assert.Nil(t, k.Render(o, "", &r))
+	assert.Equal(t, "logo.png", r.ID)
+	assert.Equal(t, model1.Fields{"logo.png", "binaryData", "image/png", "true", "4"}, r.Fields)
+}
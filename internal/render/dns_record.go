@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// DNSUnchecked tracks records not looked up.
+	DNSUnchecked = "Unchecked"
+
+	// DNSResolving tracks records being looked up.
+	DNSResolving = "Resolving"
+
+	// DNSNoTarget tracks records whose source has no load balancer address yet.
+	DNSNoTarget = "NoTarget"
+
+	// DNSNotPropagated tracks records that do not resolve.
+	DNSNotPropagated = "NotPropagated"
+
+	// DNSStale tracks records resolving to addresses other than their targets.
+	DNSStale = "Stale"
+
+	// DNSSynced tracks records resolving to their targets.
+	DNSSynced = "Synced"
+
+	// DNSResolved tracks records resolving to addresses that could not be
+	// checked against their targets.
+	DNSResolved = "Resolved"
+)
+
+// DNSRecord renders external-dns records to screen.
+type DNSRecord struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (DNSRecord) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		if c == model1.ErrColor {
+			return c
+		}
+		idx, ok := h.IndexOf("STATUS", true)
+		if !ok {
+			return c
+		}
+		switch re.Row.Fields[idx] {
+		case DNSNoTarget, DNSResolving:
+			return model1.PendingColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (DNSRecord) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "HOSTNAME"},
+		model1.HeaderColumn{Name: "TARGETS"},
+		model1.HeaderColumn{Name: "RESOLVED"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "TTL", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (DNSRecord) Render(o interface{}, _ string, r *model1.Row) error {
+	rec, ok := o.(*DNSRecordRes)
+	if !ok {
+		return fmt.Errorf("expected *DNSRecordRes, but got %T", o)
+	}
+
+	r.ID = rec.ID()
+	r.Fields = model1.Fields{
+		rec.Namespace,
+		rec.Name,
+		rec.Kind,
+		rec.Hostname,
+		naStrings(rec.Targets),
+		naStrings(rec.Resolved),
+		rec.Status(),
+		na(rec.TTL),
+		AsStatus(rec.Diagnose()),
+	}
+
+	return nil
+}
+
+// DNSRecordRes represents a hostname published by external-dns.
+type DNSRecordRes struct {
+	GVR       string
+	Namespace string
+	Name      string
+	Kind      string
+	Hostname  string
+	TTL       string
+
+	// Targets tracks the addresses the record should point at.
+	Targets []string
+
+	// TargetIPs tracks the IP targets along with the hostname targets addresses.
+	TargetIPs []string
+
+	Checked   bool
+	Resolving bool
+	Resolved  []string
+	LookupErr error
+}
+
+// ID returns the row identifier as gvr|fqn|hostname.
+func (r *DNSRecordRes) ID() string {
+	return strings.Join([]string{r.GVR, client.FQN(r.Namespace, r.Name), r.Hostname}, "|")
+}
+
+// Status returns the record propagation status.
+func (r *DNSRecordRes) Status() string {
+	switch {
+	case len(r.Targets) == 0:
+		return DNSNoTarget
+	case !r.Checked:
+		return DNSUnchecked
+	case r.Resolving:
+		return DNSResolving
+	case r.LookupErr != nil:
+		return DNSNotPropagated
+	case len(r.TargetIPs) == 0:
+		return DNSResolved
+	case r.stale():
+		return DNSStale
+	default:
+		return DNSSynced
+	}
+}
+
+// Diagnose reports records that did not propagate or point at stale addresses.
+func (r *DNSRecordRes) Diagnose() error {
+	switch r.Status() {
+	case DNSNotPropagated:
+		return fmt.Errorf("not propagated: %w", r.LookupErr)
+	case DNSStale:
+		return fmt.Errorf("stale record points at %s, expected %s", strings.Join(r.Resolved, ","), strings.Join(r.TargetIPs, ","))
+	default:
+		return nil
+	}
+}
+
+// stale checks if none of the resolved addresses match the targets.
+func (r *DNSRecordRes) stale() bool {
+	tt := make(map[string]struct{}, len(r.TargetIPs))
+	for _, ip := range r.TargetIPs {
+		tt[ip] = struct{}{}
+	}
+	for _, ip := range r.Resolved {
+		if _, ok := tt[ip]; ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetObjectKind returns a schema object.
+func (*DNSRecordRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r *DNSRecordRes) DeepCopyObject() runtime.Object {
+	return r
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSRecordRender(t *testing.T) {
+	uu := map[string]struct {
+		r render.DNSRecordRes
+		e model1.Fields
+	}{
+		"no-target": {
+			r: render.DNSRecordRes{},
+			e: model1.Fields{"default", "fred", "Ingress", "a.example.com", "n/a", "n/a", render.DNSNoTarget, "n/a", ""},
+		},
+		"unchecked": {
+			r: render.DNSRecordRes{Targets: []string{"10.0.0.1"}},
+			e: model1.Fields{"default", "fred", "Ingress", "a.example.com", "10.0.0.1", "n/a", render.DNSUnchecked, "n/a", ""},
+		},
+		"resolving": {
+			r: render.DNSRecordRes{Targets: []string{"10.0.0.1"}, Checked: true, Resolving: true},
+			e: model1.Fields{"default", "fred", "Ingress", "a.example.com", "10.0.0.1", "n/a", render.DNSResolving, "n/a", ""},
+		},
+		"not-propagated": {
+			r: render.DNSRecordRes{
+				Targets:   []string{"10.0.0.1"},
+				TargetIPs: []string{"10.0.0.1"},
+				Checked:   true,
+				LookupErr: errors.New("no such host"),
+			},
+			e: model1.Fields{"default", "fred", "Ingress", "a.example.com", "10.0.0.1", "n/a", render.DNSNotPropagated, "n/a", "not propagated: no such host"},
+		},
+		"stale": {
+			r: render.DNSRecordRes{
+				Targets:   []string{"lb.example.com"},
+				TargetIPs: []string{"10.0.0.1", "10.0.0.2"},
+				Checked:   true,
+				Resolved:  []string{"10.0.0.9"},
+			},
+			e: model1.Fields{"default", "fred", "Ingress", "a.example.com", "lb.example.com", "10.0.0.9", render.DNSStale, "n/a", "stale record points at 10.0.0.9, expected 10.0.0.1,10.0.0.2"},
+		},
+		"synced": {
+			r: render.DNSRecordRes{
+				Targets:   []string{"lb.example.com"},
+				TargetIPs: []string{"10.0.0.1", "10.0.0.2"},
+				Checked:   true,
+				Resolved:  []string{"10.0.0.2"},
+				TTL:       "60",
+			},
+			e: model1.Fields{"default", "fred", "Ingress", "a.example.com", "lb.example.com", "10.0.0.2", render.DNSSynced, "60", ""},
+		},
+		"unverified": {
+			r: render.DNSRecordRes{
+				Targets:  []string{"lb.example.com"},
+				Checked:  true,
+				Resolved: []string{"10.0.0.2"},
+			},
+			e: model1.Fields{"default", "fred", "Ingress", "a.example.com", "lb.example.com", "10.0.0.2", render.DNSResolved, "n/a", ""},
+		},
+	}
+
+	var re render.DNSRecord
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.r.GVR, u.r.Namespace, u.r.Name, u.r.Kind, u.r.Hostname = "networking.k8s.io/v1/ingresses", "default", "fred", "Ingress", "a.example.com"
+			var r model1.Row
+			assert.NoError(t, re.Render(&u.r, "", &r))
+			assert.Equal(t, "networking.k8s.io/v1/ingresses|default/fred|a.example.com", r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Finalizer renders resources stuck in a terminating state.
+type Finalizer struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Finalizer) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, _ model1.Header, re *model1.RowEvent) tcell.Color {
+		return model1.KillColor
+	}
+}
+
+// Header returns a header row.
+func (Finalizer) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "GVR"},
+		model1.HeaderColumn{Name: "FINALIZERS"},
+		model1.HeaderColumn{Name: "TERMINATING", Time: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Finalizer) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(FinalizerRes)
+	if !ok {
+		return fmt.Errorf("expected FinalizerRes, but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = model1.Fields{
+		res.Object.GetNamespace(),
+		res.Object.GetName(),
+		res.GVR,
+		strings.Join(res.Object.GetFinalizers(), ","),
+		terminatingSince(res.Object),
+		ToAge(res.Object.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func terminatingSince(u *unstructured.Unstructured) string {
+	if ts := u.GetDeletionTimestamp(); ts != nil {
+		return ToAge(*ts)
+	}
+
+	return UnknownValue
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// FinalizerRes represents a resource pending finalization.
+type FinalizerRes struct {
+	GVR    string
+	Object *unstructured.Unstructured
+}
+
+// ID returns the row identifier as gvr|fqn.
+func (f FinalizerRes) ID() string {
+	ns := f.Object.GetNamespace()
+	if ns == "" {
+		ns = client.ClusterScope
+	}
+
+	return ResourceID(f.GVR, client.FQN(ns, f.Object.GetName()))
+}
+
+// GetObjectKind returns a schema object.
+func (FinalizerRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (f FinalizerRes) DeepCopyObject() runtime.Object {
+	return f
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFinalizerRender(t *testing.T) {
+	uu := map[string]struct {
+		ns, n string
+		ff    []string
+		id    string
+		e     model1.Fields
+	}{
+		"namespaced": {
+			ns: "fred",
+			n:  "blee",
+			ff: []string{"kubernetes.io/pvc-protection"},
+			id: "v1/persistentvolumeclaims|fred/blee",
+			e:  model1.Fields{"fred", "blee", "v1/persistentvolumeclaims", "kubernetes.io/pvc-protection"},
+		},
+		"cluster": {
+			n:  "blee",
+			ff: []string{"a", "b"},
+			id: "v1/persistentvolumeclaims|-/blee",
+			e:  model1.Fields{"", "blee", "v1/persistentvolumeclaims", "a,b"},
+		},
+	}
+
+	var f render.Finalizer
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var o unstructured.Unstructured
+			o.SetNamespace(u.ns)
+			o.SetName(u.n)
+			o.SetFinalizers(u.ff)
+			ts := metav1.NewTime(time.Now().Add(-time.Hour))
+			o.SetDeletionTimestamp(&ts)
+
+			var r model1.Row
+			assert.Nil(t, f.Render(render.FinalizerRes{GVR: "v1/persistentvolumeclaims", Object: &o}, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields[:4])
+			assert.Equal(t, "60m", r.Fields[4])
+		})
+	}
+}
+
+func TestParseResourceID(t *testing.T) {
+	gvr, path, ok := render.ParseResourceID(render.ResourceID("apps/v1/deployments", "fred/blee"))
+	assert.True(t, ok)
+	assert.Equal(t, "apps/v1/deployments", gvr)
+	assert.Equal(t, "fred/blee", path)
+
+	_, _, ok = render.ParseResourceID("fred/blee")
+	assert.False(t, ok)
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+)
+
+// Find renders resources matching a search across kinds.
+type Find struct {
+	Base
+}
+
+// Header returns a header row.
+func (Find) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "GVR", Wide: true},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Find) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(InventoryRes)
+	if !ok {
+		return fmt.Errorf("expected InventoryRes, but got %T", o)
+	}
+
+	r.ID = ResourceID(res.GVR, client.FQN(res.Object.GetNamespace(), res.Object.GetName()))
+	r.Fields = model1.Fields{
+		kindOf(res),
+		res.Object.GetNamespace(),
+		res.Object.GetName(),
+		res.GVR,
+		mapToStr(res.Object.GetLabels()),
+		ToAge(res.Object.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func kindOf(res InventoryRes) string {
+	if k := res.Object.GetKind(); k != "" {
+		return k
+	}
+
+	return client.NewGVR(res.GVR).R()
+}
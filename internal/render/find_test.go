@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFindRender(t *testing.T) {
+	uu := map[string]struct {
+		kind string
+		e    model1.Fields
+	}{
+		"kind": {
+			kind: "Pod",
+			e:    model1.Fields{"Pod", "fred", "blee", "v1/pods", "app=zorg"},
+		},
+		"no-kind": {
+			e: model1.Fields{"pods", "fred", "blee", "v1/pods", "app=zorg"},
+		},
+	}
+
+	var f render.Find
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var o unstructured.Unstructured
+			o.SetKind(u.kind)
+			o.SetNamespace("fred")
+			o.SetName("blee")
+			o.SetLabels(map[string]string{"app": "zorg"})
+
+			var r model1.Row
+			assert.Nil(t, f.Render(render.InventoryRes{GVR: "v1/pods", Object: &o}, "", &r))
+			assert.Equal(t, "v1/pods|fred/blee", r.ID)
+			assert.Equal(t, u.e, r.Fields[:5])
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// FleetProbing tracks a cluster still being probed.
+	FleetProbing = "Probing"
+
+	// FleetReachable tracks a cluster whose api server responded.
+	FleetReachable = "Reachable"
+
+	// FleetUnreachable tracks a cluster whose api server did not respond.
+	FleetUnreachable = "Unreachable"
+)
+
+// Fleet renders a cluster health summary to screen.
+type Fleet struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Fleet) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, r *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, r)
+		if c == model1.ErrColor {
+			return c
+		}
+		if idx, ok := h.IndexOf("STATUS", true); ok && r.Row.Fields[idx] == FleetProbing {
+			return model1.PendingColor
+		}
+		if idx, ok := h.IndexOf("CURRENT", true); ok && r.Row.Fields[idx] == "*" {
+			return model1.HighlightColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Fleet) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "CONTEXT"},
+		model1.HeaderColumn{Name: "CURRENT"},
+		model1.HeaderColumn{Name: "CLUSTER"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "VERSION"},
+		model1.HeaderColumn{Name: "LATENCY", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "NODES", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "FAILING", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "WORKLOADS", Align: tview.AlignRight, Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Fleet) Render(o interface{}, _ string, r *model1.Row) error {
+	h, ok := o.(*ClusterHealth)
+	if !ok {
+		return fmt.Errorf("expected *ClusterHealth, but got %T", o)
+	}
+
+	var current string
+	if h.Current {
+		current = "*"
+	}
+	r.ID = h.Context
+	r.Fields = model1.Fields{
+		h.Context,
+		current,
+		h.Cluster,
+		h.Status(),
+		na(h.Version),
+		NAValue,
+		NAValue,
+		NAValue,
+		NAValue,
+		AsStatus(h.Diagnose()),
+		NAValue,
+	}
+	if h.Probing || h.Err != nil {
+		return nil
+	}
+	r.Fields[5] = h.Latency.Round(time.Millisecond).String()
+	r.Fields[6] = strconv.Itoa(h.ReadyNodes) + "/" + strconv.Itoa(h.Nodes)
+	r.Fields[7] = strconv.Itoa(h.FailingWorkloads)
+	r.Fields[8] = strconv.Itoa(h.Workloads)
+	r.Fields[10] = ToAge(metav1.NewTime(h.ProbedAt))
+
+	return nil
+}
+
+// ClusterHealth represents a context cluster health summary.
+type ClusterHealth struct {
+	Context          string
+	Cluster          string
+	Current          bool
+	Probing          bool
+	Version          string
+	Latency          time.Duration
+	Nodes            int
+	ReadyNodes       int
+	Workloads        int
+	FailingWorkloads int
+	ProbedAt         time.Time
+	Err              error
+}
+
+// Status returns the cluster api reachability.
+func (h *ClusterHealth) Status() string {
+	switch {
+	case h.Probing:
+		return FleetProbing
+	case h.Err != nil:
+		return FleetUnreachable
+	default:
+		return FleetReachable
+	}
+}
+
+// Diagnose reports probe failures, not ready nodes and failing workloads.
+func (h *ClusterHealth) Diagnose() error {
+	switch {
+	case h.Probing:
+		return nil
+	case h.Err != nil:
+		return h.Err
+	case h.ReadyNodes < h.Nodes:
+		return fmt.Errorf("%d node(s) not ready", h.Nodes-h.ReadyNodes)
+	case h.FailingWorkloads > 0:
+		return fmt.Errorf("%d workload(s) failing", h.FailingWorkloads)
+	default:
+		return nil
+	}
+}
+
+// GetObjectKind returns a schema object.
+func (*ClusterHealth) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (h *ClusterHealth) DeepCopyObject() runtime.Object {
+	return h
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFleetRender(t *testing.T) {
+	uu := map[string]struct {
+		h render.ClusterHealth
+		e model1.Fields
+	}{
+		"probing": {
+			h: render.ClusterHealth{Context: "c1", Cluster: "k1", Probing: true},
+			e: model1.Fields{"c1", "", "k1", "Probing", "n/a", "n/a", "n/a", "n/a", "n/a", ""},
+		},
+		"unreachable": {
+			h: render.ClusterHealth{Context: "c1", Cluster: "k1", Err: errors.New("boom")},
+			e: model1.Fields{"c1", "", "k1", "Unreachable", "n/a", "n/a", "n/a", "n/a", "n/a", "boom"},
+		},
+		"degraded": {
+			h: render.ClusterHealth{
+				Context:          "c1",
+				Cluster:          "k1",
+				Current:          true,
+				Version:          "v1.30.0",
+				Latency:          12 * time.Millisecond,
+				Nodes:            3,
+				ReadyNodes:       3,
+				Workloads:        10,
+				FailingWorkloads: 2,
+				ProbedAt:         time.Now(),
+			},
+			e: model1.Fields{"c1", "*", "k1", "Reachable", "v1.30.0", "12ms", "3/3", "2", "10", "2 workload(s) failing"},
+		},
+	}
+
+	var f render.Fleet
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := model1.NewRow(11)
+			assert.NoError(t, f.Render(&u.h, "", &r))
+			assert.Equal(t, "c1", r.ID)
+			assert.Equal(t, u.e, r.Fields[:10])
+		})
+	}
+}
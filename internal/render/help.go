@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Help renders a help index to screen.
+type Help struct {
+	Base
+}
+
+// Header returns a header row.
+func (Help) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "SECTION"},
+		model1.HeaderColumn{Name: "KEY"},
+		model1.HeaderColumn{Name: "DESCRIPTION"},
+	}
+}
+
+// Render renders a help entry to screen.
+func (Help) Render(o interface{}, ns string, r *model1.Row) error {
+	h, ok := o.(HelpRes)
+	if !ok {
+		return fmt.Errorf("expected HelpRes, but got %T", o)
+	}
+
+	r.ID = h.ID()
+	r.Fields = append(r.Fields,
+		h.Section,
+		h.Key,
+		h.Description,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// HelpRes represents a help index entry.
+type HelpRes struct {
+	Section     string
+	Key         string
+	Description string
+}
+
+// ID returns the entry identifier. Fuzzy filters match against it so it
+// spells out the key and description.
+func (h HelpRes) ID() string {
+	return strings.Join([]string{h.Key, h.Description, h.Section}, " ")
+}
+
+// GetObjectKind returns a schema object.
+func (HelpRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (h HelpRes) DeepCopyObject() runtime.Object {
+	return h
+}
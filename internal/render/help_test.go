@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHelpRender(t *testing.T) {
+	var h render.Help
+
+	o := render.HelpRes{
+		Section:     "RESOURCE",
+		Key:         "<l>",
+		Description: "Logs",
+	}
+
+	var r model1.Row
+	assert.Nil(t, h.Render(o, "", &r))
+	assert.Equal(t, model1.Row{ID: "<l> Logs RESOURCE", Fields: model1.Fields{"RESOURCE", "<l>", "Logs"}}, r)
+}
+
+func TestHelpRenderInvalid(t *testing.T) {
+	var h render.Help
+
+	var r model1.Row
+	assert.Error(t, h.Render(render.AliasRes{}, "", &r))
+}
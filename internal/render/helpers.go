@@ -19,6 +19,7 @@ import (
 	"golang.org/x/text/message"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/duration"
 )
 
@@ -149,6 +150,43 @@ func IntToStr(p int) string {
 	return strconv.Itoa(int(p))
 }
 
+// NestedMaps returns the objects of a nested slice, skipping any non object items.
+func NestedMaps(o map[string]interface{}, fields ...string) []map[string]interface{} {
+	ii, ok, _ := unstructured.NestedSlice(o, fields...)
+	if !ok {
+		return nil
+	}
+	mm := make([]map[string]interface{}, 0, len(ii))
+	for _, i := range ii {
+		if m, ok := i.(map[string]interface{}); ok {
+			mm = append(mm, m)
+		}
+	}
+
+	return mm
+}
+
+// StatusCondition returns a status condition along with its reason and
+// message when the condition is not met.
+func StatusCondition(u *unstructured.Unstructured, kind string) (string, string) {
+	for _, c := range NestedMaps(u.Object, "status", "conditions") {
+		if t, _, _ := unstructured.NestedString(c, "type"); t != kind {
+			continue
+		}
+		status, _, _ := unstructured.NestedString(c, "status")
+		if status == "True" {
+			return status, ""
+		}
+		reason, _, _ := unstructured.NestedString(c, "reason")
+		if msg, _, _ := unstructured.NestedString(c, "message"); msg != "" {
+			reason += ": " + msg
+		}
+		return status, reason
+	}
+
+	return "Unknown", ""
+}
+
 func missing(s string) string {
 	return check(s, MissingValue)
 }
@@ -315,6 +353,21 @@ func Pad(s string, width int) string {
 	return s + strings.Repeat(" ", width-len(s))
 }
 
+// ResourceID builds a row identifier for views mixing several resource kinds.
+func ResourceID(gvr, fqn string) string {
+	return gvr + "|" + fqn
+}
+
+// ParseResourceID extracts the gvr and resource path from a row identifier.
+func ParseResourceID(id string) (string, string, bool) {
+	tokens := strings.SplitN(id, "|", 2)
+	if len(tokens) != 2 {
+		return "", "", false
+	}
+
+	return tokens[0], tokens[1], true
+}
+
 // // Converts labels string to map.
 // func labelize(labels string) map[string]string {
 // 	ll := strings.Split(labels, ",")
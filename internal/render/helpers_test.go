@@ -419,6 +419,34 @@ func TestIntToStr(t *testing.T) {
 	}
 }
 
+func TestStatusCondition(t *testing.T) {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True"},
+				map[string]interface{}{"type": "Active", "status": "False", "reason": "NoMetrics", "message": "scaler failed"},
+			},
+		},
+	}}
+
+	uu := map[string]struct {
+		kind, status, reason string
+	}{
+		"met":     {kind: "Ready", status: "True"},
+		"unmet":   {kind: "Active", status: "False", reason: "NoMetrics: scaler failed"},
+		"missing": {kind: "Fallback", status: "Unknown"},
+	}
+
+	for k := range uu {
+		u1 := uu[k]
+		t.Run(k, func(t *testing.T) {
+			status, reason := StatusCondition(u, u1.kind)
+			assert.Equal(t, u1.status, status)
+			assert.Equal(t, u1.reason, reason)
+		})
+	}
+}
+
 func BenchmarkIntToStr(b *testing.B) {
 	v := 10
 	b.ResetTimer()
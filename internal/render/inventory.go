@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Inventory renders all resources living in a namespace.
+type Inventory struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Inventory) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		idx, ok := h.IndexOf("OWNER", true)
+		if ok && idx < len(re.Row.Fields) && re.Row.Fields[idx] != "" {
+			return model1.CompletedColor
+		}
+
+		return model1.StdColor
+	}
+}
+
+// Header returns a header row.
+func (Inventory) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "GVR"},
+		model1.HeaderColumn{Name: "OWNER"},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Inventory) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(InventoryRes)
+	if !ok {
+		return fmt.Errorf("expected InventoryRes, but got %T", o)
+	}
+
+	r.ID = ResourceID(res.GVR, client.FQN(res.Object.GetNamespace(), res.Object.GetName()))
+	r.Fields = model1.Fields{
+		res.Object.GetNamespace(),
+		res.Object.GetName(),
+		res.GVR,
+		ownerOf(res.Object.GetOwnerReferences()),
+		ToAge(res.Object.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func ownerOf(refs []metav1.OwnerReference) string {
+	for _, ref := range refs {
+		if ref.Controller != nil && *ref.Controller {
+			return strings.ToLower(ref.Kind) + "/" + ref.Name
+		}
+	}
+	if len(refs) > 0 {
+		return strings.ToLower(refs[0].Kind) + "/" + refs[0].Name
+	}
+
+	return ""
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// InventoryRes represents a namespaced resource of any kind.
+type InventoryRes struct {
+	GVR    string
+	Object *unstructured.Unstructured
+}
+
+// GetObjectKind returns a schema object.
+func (InventoryRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (i InventoryRes) DeepCopyObject() runtime.Object {
+	return i
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestInventoryRender(t *testing.T) {
+	yes := true
+	uu := map[string]struct {
+		refs []metav1.OwnerReference
+		e    model1.Fields
+	}{
+		"standalone": {
+			e: model1.Fields{"fred", "blee", "v1/pods", ""},
+		},
+		"owned": {
+			refs: []metav1.OwnerReference{
+				{Kind: "ConfigMap", Name: "zorg"},
+				{Kind: "ReplicaSet", Name: "duh", Controller: &yes},
+			},
+			e: model1.Fields{"fred", "blee", "v1/pods", "replicaset/duh"},
+		},
+	}
+
+	var i render.Inventory
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var o unstructured.Unstructured
+			o.SetNamespace("fred")
+			o.SetName("blee")
+			o.SetOwnerReferences(u.refs)
+
+			var r model1.Row
+			assert.Nil(t, i.Render(render.InventoryRes{GVR: "v1/pods", Object: &o}, "", &r))
+			assert.Equal(t, "v1/pods|fred/blee", r.ID)
+			assert.Equal(t, u.e, r.Fields[:4])
+		})
+	}
+}
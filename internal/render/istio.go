@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// VirtualService renders an Istio VirtualService to screen.
+type VirtualService struct {
+	Base
+}
+
+// Header returns a header row.
+func (VirtualService) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "GATEWAYS"},
+		model1.HeaderColumn{Name: "HOSTS"},
+		model1.HeaderColumn{Name: "ROUTES"},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (VirtualService) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected VirtualService, but got %T", o)
+	}
+
+	gg, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "gateways")
+	hh, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "hosts")
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		naStrings(gg),
+		naStrings(hh),
+		VirtualServiceRoutes(u),
+		mapToStr(u.GetLabels()),
+		"",
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// VirtualServiceRoutes summarizes a VirtualService http, tls and tcp routes.
+func VirtualServiceRoutes(u *unstructured.Unstructured) string {
+	rr := make([]string, 0, 5)
+	for _, h := range NestedMaps(u.Object, "spec", "http") {
+		match := make([]string, 0, 1)
+		for _, m := range NestedMaps(h, "match") {
+			match = append(match, istioMatch(m))
+		}
+		dests := istioDestinations(NestedMaps(h, "route"))
+		if len(dests) == 0 {
+			if rd, ok, _ := unstructured.NestedMap(h, "redirect"); ok {
+				dests = append(dests, "redirect:"+fmt.Sprint(rd["uri"]))
+			}
+		}
+		rr = append(rr, join([]string{strings.Join(match, "|"), strings.Join(dests, "+")}, "->"))
+	}
+	for _, proto := range []string{"tls", "tcp"} {
+		for _, t := range NestedMaps(u.Object, "spec", proto) {
+			rr = append(rr, proto+"->"+strings.Join(istioDestinations(NestedMaps(t, "route")), "+"))
+		}
+	}
+
+	return naStrings(rr)
+}
+
+func istioMatch(m map[string]interface{}) string {
+	for _, k := range []string{"exact", "prefix", "regex"} {
+		if v, ok, _ := unstructured.NestedString(m, "uri", k); ok {
+			if k == "prefix" {
+				return v + "*"
+			}
+			return v
+		}
+	}
+	if p, ok, _ := unstructured.NestedInt64(m, "port"); ok {
+		return ":" + strconv.FormatInt(p, 10)
+	}
+
+	return "*"
+}
+
+func istioDestinations(rr []map[string]interface{}) []string {
+	dd := make([]string, 0, len(rr))
+	for _, r := range rr {
+		host, _, _ := unstructured.NestedString(r, "destination", "host")
+		if p, ok, _ := unstructured.NestedInt64(r, "destination", "port", "number"); ok {
+			host += ":" + strconv.FormatInt(p, 10)
+		}
+		if s, ok, _ := unstructured.NestedString(r, "destination", "subset"); ok {
+			host += "/" + s
+		}
+		if w, ok, _ := unstructured.NestedInt64(r, "weight"); ok && len(rr) > 1 {
+			host += "(" + strconv.FormatInt(w, 10) + "%)"
+		}
+		dd = append(dd, host)
+	}
+
+	return dd
+}
+
+// IstioGateway renders an Istio Gateway to screen.
+type IstioGateway struct {
+	Base
+}
+
+// Header returns a header row.
+func (IstioGateway) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "SELECTOR"},
+		model1.HeaderColumn{Name: "SERVERS"},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (IstioGateway) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Gateway, but got %T", o)
+	}
+
+	sel, _, _ := unstructured.NestedStringMap(u.Object, "spec", "selector")
+	ss := make([]string, 0, 2)
+	for _, s := range NestedMaps(u.Object, "spec", "servers") {
+		port, _, _ := unstructured.NestedInt64(s, "port", "number")
+		proto, _, _ := unstructured.NestedString(s, "port", "protocol")
+		hh, _, _ := unstructured.NestedStringSlice(s, "hosts")
+		ss = append(ss, fmt.Sprintf("%d/%s[%s]", port, proto, strings.Join(hh, ",")))
+	}
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		mapToStr(sel),
+		naStrings(ss),
+		mapToStr(u.GetLabels()),
+		"",
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVirtualServiceRender(t *testing.T) {
+	c := render.VirtualService{}
+	r := model1.NewRow(8)
+
+	assert.NoError(t, c.Render(load(t, "vs"), "", &r))
+	assert.Equal(t, "default/reviews", r.ID)
+	assert.Equal(t, model1.Fields{
+		"default",
+		"reviews",
+		"bookinfo-gateway",
+		"reviews.example.com",
+		"/api*->reviews:9080/v1(80%)+reviews:9080/v2(20%),ratings,tcp->mongo.db.svc.cluster.local:27017",
+	}, r.Fields[:5])
+}
+
+func TestIstioGatewayRender(t *testing.T) {
+	c := render.IstioGateway{}
+	r := model1.NewRow(7)
+
+	assert.NoError(t, c.Render(load(t, "gw"), "", &r))
+	assert.Equal(t, "default/bookinfo-gateway", r.ID)
+	assert.Equal(t, model1.Fields{
+		"default",
+		"bookinfo-gateway",
+		"istio=ingressgateway",
+		"80/HTTP[*.example.com],443/HTTPS[a.example.com,b.example.com]",
+	}, r.Fields[:4])
+}
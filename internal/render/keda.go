@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// KEDAPausedAnnotation pauses a KEDA scaled object or job.
+	KEDAPausedAnnotation = "autoscaling.keda.sh/paused"
+
+	// KEDAPausedReplicasAnnotation pauses a KEDA scaled object at a given replica count.
+	KEDAPausedReplicasAnnotation = "autoscaling.keda.sh/paused-replicas"
+
+	// KEDAScaledJobLabel tracks the scaled job owning a job.
+	KEDAScaledJobLabel = "scaledjob.keda.sh/name"
+)
+
+// ScaledObject renders a KEDA scaled object to screen.
+type ScaledObject struct {
+	Base
+}
+
+// Header returns a header row.
+func (ScaledObject) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "TARGET"},
+		model1.HeaderColumn{Name: "MIN", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "MAX", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "TRIGGERS"},
+		model1.HeaderColumn{Name: "READY"},
+		model1.HeaderColumn{Name: "ACTIVE"},
+		model1.HeaderColumn{Name: "PAUSED"},
+		model1.HeaderColumn{Name: "FALLBACK", Wide: true},
+		model1.HeaderColumn{Name: "HPA", Wide: true},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ScaledObject) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected ScaledObject, but got %T", o)
+	}
+
+	kind, name := KEDAScaleTarget(u)
+	lo, _, _ := unstructured.NestedInt64(u.Object, "spec", "minReplicaCount")
+	hi := kedaMaxReplicas(u)
+	ready, _ := StatusCondition(u, "Ready")
+	active, _ := StatusCondition(u, "Active")
+	fallback, _ := StatusCondition(u, "Fallback")
+	hpa, _, _ := unstructured.NestedString(u.Object, "status", "hpaName")
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		kind + "/" + name,
+		strconv.FormatInt(lo, 10),
+		strconv.FormatInt(hi, 10),
+		naStrings(KEDATriggers(u)),
+		ready,
+		active,
+		KEDAPaused(u),
+		fallback,
+		na(hpa),
+		mapToStr(u.GetLabels()),
+		AsStatus(kedaDiagnose(u)),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ScaledJob renders a KEDA scaled job to screen.
+type ScaledJob struct {
+	Base
+}
+
+// Header returns a header row.
+func (ScaledJob) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "MAX", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "STRATEGY"},
+		model1.HeaderColumn{Name: "TRIGGERS"},
+		model1.HeaderColumn{Name: "READY"},
+		model1.HeaderColumn{Name: "ACTIVE"},
+		model1.HeaderColumn{Name: "PAUSED"},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ScaledJob) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected ScaledJob, but got %T", o)
+	}
+
+	strategy, _, _ := unstructured.NestedString(u.Object, "spec", "scalingStrategy", "strategy")
+	if strategy == "" {
+		strategy = "default"
+	}
+	ready, _ := StatusCondition(u, "Ready")
+	active, _ := StatusCondition(u, "Active")
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		strconv.FormatInt(kedaMaxReplicas(u), 10),
+		strategy,
+		naStrings(KEDATriggers(u)),
+		ready,
+		active,
+		KEDAPaused(u),
+		mapToStr(u.GetLabels()),
+		AsStatus(kedaDiagnose(u)),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// KEDAScaleTarget returns a scaled object target kind and name. Targets
+// default to deployments.
+func KEDAScaleTarget(u *unstructured.Unstructured) (string, string) {
+	kind, _, _ := unstructured.NestedString(u.Object, "spec", "scaleTargetRef", "kind")
+	if kind == "" {
+		kind = "Deployment"
+	}
+	name, _, _ := unstructured.NestedString(u.Object, "spec", "scaleTargetRef", "name")
+
+	return kind, name
+}
+
+// kedaMaxReplicas returns a scaled resource max replicas. KEDA defaults to 100.
+func kedaMaxReplicas(u *unstructured.Unstructured) int64 {
+	if n, ok, _ := unstructured.NestedInt64(u.Object, "spec", "maxReplicaCount"); ok {
+		return n
+	}
+
+	return 100
+}
+
+// KEDATriggers returns a scaled resource trigger types.
+func KEDATriggers(u *unstructured.Unstructured) []string {
+	tt := NestedMaps(u.Object, "spec", "triggers")
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		kind, _, _ := unstructured.NestedString(t, "type")
+		ss = append(ss, kind)
+	}
+
+	return ss
+}
+
+// KEDAPaused returns whether a scaled resource is paused. Scaled objects
+// paused at a replica count report that count.
+func KEDAPaused(u *unstructured.Unstructured) string {
+	aa := u.GetAnnotations()
+	if n, ok := aa[KEDAPausedReplicasAnnotation]; ok {
+		return "true(" + n + ")"
+	}
+	if p, err := strconv.ParseBool(aa[KEDAPausedAnnotation]); err == nil && p {
+		return "true"
+	}
+
+	return "false"
+}
+
+// kedaDiagnose reports scaled resources that are not ready or failing to
+// fetch their trigger metrics.
+func kedaDiagnose(u *unstructured.Unstructured) error {
+	if status, reason := StatusCondition(u, "Ready"); status == "False" {
+		return errors.New(reason)
+	}
+	health, _, _ := unstructured.NestedMap(u.Object, "status", "health")
+	failing := make([]string, 0, len(health))
+	for m, h := range health {
+		hm, ok := h.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if s, _, _ := unstructured.NestedString(hm, "status"); s == "Failing" {
+			failing = append(failing, m)
+		}
+	}
+	if len(failing) > 0 {
+		sort.Strings(failing)
+		return fmt.Errorf("failing metrics: %s", strings.Join(failing, ","))
+	}
+
+	return nil
+}
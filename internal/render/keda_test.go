@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScaledObjectRender(t *testing.T) {
+	c := render.ScaledObject{}
+	r := model1.NewRow(14)
+
+	assert.NoError(t, c.Render(load(t, "keda_so"), "", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, model1.Fields{
+		"default",
+		"fred",
+		"Deployment/fred-worker",
+		"1",
+		"100",
+		"rabbitmq,cpu",
+		"True",
+		"False",
+		"true(2)",
+		"False",
+		"keda-hpa-fred",
+	}, r.Fields[:11])
+	assert.Equal(t, "failing metrics: s0-rabbitmq-jobs", r.Fields[12])
+}
+
+func TestScaledJobRender(t *testing.T) {
+	c := render.ScaledJob{}
+	r := model1.NewRow(11)
+
+	assert.NoError(t, c.Render(load(t, "keda_sj"), "", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, model1.Fields{"default", "fred", "10", "accurate", "aws-sqs-queue", "False", "Unknown", "false"}, r.Fields[:8])
+	assert.Equal(t, "ScaledJobCheckFailed: missing credentials", r.Fields[9])
+}
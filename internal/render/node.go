@@ -36,6 +36,7 @@ func (Node) Header(string) model1.Header {
 		model1.HeaderColumn{Name: "NAME"},
 		model1.HeaderColumn{Name: "STATUS"},
 		model1.HeaderColumn{Name: "ROLE"},
+		model1.HeaderColumn{Name: "OS", Wide: true},
 		model1.HeaderColumn{Name: "ARCH", Wide: true},
 		model1.HeaderColumn{Name: "TAINTS"},
 		model1.HeaderColumn{Name: "VERSION"},
@@ -92,6 +93,7 @@ func (n Node) Render(o interface{}, ns string, r *model1.Row) error {
 		no.Name,
 		join(statuses, ","),
 		join(roles, ","),
+		no.Status.NodeInfo.OperatingSystem,
 		no.Status.NodeInfo.Architecture,
 		strconv.Itoa(len(no.Spec.Taints)),
 		no.Status.NodeInfo.KubeletVersion,
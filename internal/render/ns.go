@@ -16,6 +16,17 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+const (
+	// HNCTreeLabelSuffix tracks HNC namespace ancestors depth labels.
+	HNCTreeLabelSuffix = ".tree.hnc.x-k8s.io/depth"
+
+	// HNCSubnamespaceOf tracks the parent of an HNC subnamespace.
+	HNCSubnamespaceOf = "hnc.x-k8s.io/subnamespace-of"
+
+	// AnomalyCol tracks the namespace activity anomaly column.
+	AnomalyCol = "ANOMALY"
+)
+
 // Namespace renders a K8s Namespace to screen.
 type Namespace struct {
 	Base
@@ -34,6 +45,9 @@ func (n Namespace) ColorerFunc() model1.ColorerFunc {
 		if strings.Contains(strings.TrimSpace(re.Row.Fields[0]), "*") {
 			c = model1.HighlightColor
 		}
+		if idx, ok := h.IndexOf(AnomalyCol, true); ok && strings.TrimSpace(re.Row.Fields[idx]) != "" {
+			c = model1.AnomalyColor
+		}
 
 		return c
 	}
@@ -44,8 +58,10 @@ func (Namespace) Header(string) model1.Header {
 	return model1.Header{
 		model1.HeaderColumn{Name: "NAME"},
 		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "PARENT", Wide: true},
 		model1.HeaderColumn{Name: "LABELS", Wide: true},
 		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: AnomalyCol, Wide: true},
 		model1.HeaderColumn{Name: "AGE", Time: true},
 	}
 }
@@ -66,8 +82,10 @@ func (n Namespace) Render(o interface{}, _ string, r *model1.Row) error {
 	r.Fields = model1.Fields{
 		ns.Name,
 		string(ns.Status.Phase),
+		na(NamespaceParent(&ns)),
 		mapToStr(ns.Labels),
 		AsStatus(n.diagnose(ns.Status.Phase)),
+		"",
 		ToAge(ns.GetCreationTimestamp()),
 	}
 
@@ -81,3 +99,17 @@ func (Namespace) diagnose(phase v1.NamespacePhase) error {
 
 	return nil
 }
+
+// NamespaceParent returns the parent of an HNC hierarchical namespace if any.
+func NamespaceParent(ns *v1.Namespace) string {
+	if p, ok := ns.Annotations[HNCSubnamespaceOf]; ok {
+		return p
+	}
+	for k, v := range ns.Labels {
+		if p, ok := strings.CutSuffix(k, HNCTreeLabelSuffix); ok && v == "1" {
+			return p
+		}
+	}
+
+	return ""
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tcell/v2"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestNSColorer(t *testing.T) {
@@ -69,6 +71,26 @@ func TestNSColorer(t *testing.T) {
 	}
 }
 
+func TestNSColorerAnomaly(t *testing.T) {
+	defer func(c tcell.Color) { model1.AnomalyColor = c }(model1.AnomalyColor)
+	model1.AnomalyColor = tcell.ColorHotPink
+
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: render.AnomalyCol, Wide: true},
+	}
+	re := model1.RowEvent{
+		Kind: model1.EventUnchanged,
+		Row:  model1.Row{Fields: model1.Fields{"blee", "Active", "restarts 5 (avg 0.0)"}},
+	}
+
+	var r render.Namespace
+	assert.Equal(t, tcell.ColorHotPink, r.ColorerFunc()("", h, &re))
+	re.Row.Fields[2] = ""
+	assert.NotEqual(t, tcell.ColorHotPink, r.ColorerFunc()("", h, &re))
+}
+
 func TestNamespaceRender(t *testing.T) {
 	c := render.Namespace{}
 	r := model1.NewRow(3)
@@ -77,3 +99,37 @@ func TestNamespaceRender(t *testing.T) {
 	assert.Equal(t, "-/kube-system", r.ID)
 	assert.Equal(t, model1.Fields{"kube-system", "Active"}, r.Fields[:2])
 }
+
+func TestNamespaceParent(t *testing.T) {
+	uu := map[string]struct {
+		ns v1.Namespace
+		e  string
+	}{
+		"none": {},
+		"tree": {
+			ns: v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name: "team-a-dev",
+				Labels: map[string]string{
+					"org.tree.hnc.x-k8s.io/depth":        "2",
+					"team-a.tree.hnc.x-k8s.io/depth":     "1",
+					"team-a-dev.tree.hnc.x-k8s.io/depth": "0",
+				},
+			}},
+			e: "team-a",
+		},
+		"subnamespace": {
+			ns: v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:        "team-a-dev",
+				Annotations: map[string]string{"hnc.x-k8s.io/subnamespace-of": "team-a"},
+			}},
+			e: "team-a",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.NamespaceParent(&u.ns))
+		})
+	}
+}
@@ -0,0 +1,377 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// ProjectDisplayName tracks an OpenShift project display name.
+	ProjectDisplayName = "openshift.io/display-name"
+
+	// ProjectDescription tracks an OpenShift project description.
+	ProjectDescription = "openshift.io/description"
+
+	// ProjectRequester tracks the user who requested an OpenShift project.
+	ProjectRequester = "openshift.io/requester"
+
+	// DeploymentConfigLabel tracks the deployment config owning a pod.
+	DeploymentConfigLabel = "deploymentconfig"
+)
+
+// Route renders an OpenShift route to screen.
+type Route struct {
+	Base
+}
+
+// Header returns a header row.
+func (Route) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "HOST"},
+		model1.HeaderColumn{Name: "PATH"},
+		model1.HeaderColumn{Name: "SERVICES"},
+		model1.HeaderColumn{Name: "PORT"},
+		model1.HeaderColumn{Name: "TERMINATION"},
+		model1.HeaderColumn{Name: "ADMITTED"},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Route) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Route, but got %T", o)
+	}
+
+	host, _, _ := unstructured.NestedString(u.Object, "spec", "host")
+	path, _, _ := unstructured.NestedString(u.Object, "spec", "path")
+	termination, _, _ := unstructured.NestedString(u.Object, "spec", "tls", "termination")
+	if insecure, _, _ := unstructured.NestedString(u.Object, "spec", "tls", "insecureEdgeTerminationPolicy"); insecure != "" {
+		termination += "/" + insecure
+	}
+	admitted, err := RouteAdmitted(u)
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		missing(host),
+		na(path),
+		naStrings(RouteServices(u)),
+		na(routePort(u)),
+		na(termination),
+		admitted,
+		mapToStr(u.GetLabels()),
+		AsStatus(err),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// RouteServices returns a route backing services along with their weights
+// when traffic is split.
+func RouteServices(u *unstructured.Unstructured) []string {
+	to, ok, _ := unstructured.NestedMap(u.Object, "spec", "to")
+	if !ok {
+		return nil
+	}
+	bb := append([]map[string]interface{}{to}, NestedMaps(u.Object, "spec", "alternateBackends")...)
+	if len(bb) == 1 {
+		n, _, _ := unstructured.NestedString(to, "name")
+		return []string{n}
+	}
+	ss := make([]string, 0, len(bb))
+	for _, b := range bb {
+		n, _, _ := unstructured.NestedString(b, "name")
+		w, ok, _ := unstructured.NestedInt64(b, "weight")
+		if !ok {
+			w = 100
+		}
+		ss = append(ss, n+"("+strconv.FormatInt(w, 10)+")")
+	}
+
+	return ss
+}
+
+func routePort(u *unstructured.Unstructured) string {
+	v, ok, _ := unstructured.NestedFieldNoCopy(u.Object, "spec", "port", "targetPort")
+	if !ok {
+		return ""
+	}
+	switch p := v.(type) {
+	case string:
+		return p
+	case int64:
+		return strconv.FormatInt(p, 10)
+	case float64:
+		return strconv.FormatInt(int64(p), 10)
+	default:
+		return fmt.Sprintf("%v", p)
+	}
+}
+
+// RouteAdmitted returns whether the routers serving a route admitted it.
+func RouteAdmitted(u *unstructured.Unstructured) (string, error) {
+	ii := NestedMaps(u.Object, "status", "ingress")
+	if len(ii) == 0 {
+		return "Unknown", nil
+	}
+	for _, i := range ii {
+		router, _, _ := unstructured.NestedString(i, "routerName")
+		for _, c := range NestedMaps(i, "conditions") {
+			t, _, _ := unstructured.NestedString(c, "type")
+			status, _, _ := unstructured.NestedString(c, "status")
+			if t != "Admitted" || status == "True" {
+				continue
+			}
+			reason, _, _ := unstructured.NestedString(c, "reason")
+			if msg, _, _ := unstructured.NestedString(c, "message"); msg != "" {
+				reason += ": " + msg
+			}
+			return status, fmt.Errorf("router %s: %s", router, reason)
+		}
+	}
+
+	return "True", nil
+}
+
+// DeploymentConfig renders an OpenShift deployment config to screen.
+type DeploymentConfig struct {
+	Base
+}
+
+// Header returns a header row.
+func (DeploymentConfig) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "REVISION", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "READY", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "UP-TO-DATE", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "TRIGGERS"},
+		model1.HeaderColumn{Name: "STRATEGY", Wide: true},
+		model1.HeaderColumn{Name: "SELECTOR", Wide: true},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (DeploymentConfig) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected DeploymentConfig, but got %T", o)
+	}
+
+	revision, _, _ := unstructured.NestedInt64(u.Object, "status", "latestVersion")
+	desired, _, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	ready, _, _ := unstructured.NestedInt64(u.Object, "status", "readyReplicas")
+	updated, _, _ := unstructured.NestedInt64(u.Object, "status", "updatedReplicas")
+	strategy, _, _ := unstructured.NestedString(u.Object, "spec", "strategy", "type")
+	sel, _, _ := unstructured.NestedStringMap(u.Object, "spec", "selector")
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		strconv.FormatInt(revision, 10),
+		strconv.FormatInt(ready, 10) + "/" + strconv.FormatInt(desired, 10),
+		strconv.FormatInt(updated, 10),
+		naStrings(DeploymentConfigTriggers(u)),
+		na(strategy),
+		na(toSelector(sel)),
+		mapToStr(u.GetLabels()),
+		AsStatus(dcDiagnose(u, ready, desired)),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// DeploymentConfigTriggers returns a deployment config triggers. Image
+// triggers are listed along with the image stream tag they follow.
+func DeploymentConfigTriggers(u *unstructured.Unstructured) []string {
+	tt := NestedMaps(u.Object, "spec", "triggers")
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		kind, _, _ := unstructured.NestedString(t, "type")
+		switch kind {
+		case "ConfigChange":
+			ss = append(ss, "config")
+		case "ImageChange":
+			tag, _, _ := unstructured.NestedString(t, "imageChangeParams", "from", "name")
+			ss = append(ss, "image("+tag+")")
+		default:
+			ss = append(ss, strings.ToLower(kind))
+		}
+	}
+
+	return ss
+}
+
+func dcDiagnose(u *unstructured.Unstructured, ready, desired int64) error {
+	for _, c := range NestedMaps(u.Object, "status", "conditions") {
+		t, _, _ := unstructured.NestedString(c, "type")
+		status, _, _ := unstructured.NestedString(c, "status")
+		if t == "Progressing" && status == "False" {
+			reason, _, _ := unstructured.NestedString(c, "reason")
+			msg, _, _ := unstructured.NestedString(c, "message")
+			return fmt.Errorf("%s: %s", reason, msg)
+		}
+	}
+	if ready < desired {
+		return fmt.Errorf("ready replicas %d/%d", ready, desired)
+	}
+
+	return nil
+}
+
+// Project renders an OpenShift project to screen.
+type Project struct {
+	Base
+}
+
+// Header returns a header row.
+func (Project) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "DISPLAY NAME"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "REQUESTER", Wide: true},
+		model1.HeaderColumn{Name: "DESCRIPTION", Wide: true},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Project) Render(o interface{}, _ string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Project, but got %T", o)
+	}
+
+	phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+	aa := u.GetAnnotations()
+
+	r.ID = client.FQN(client.ClusterScope, u.GetName())
+	r.Fields = model1.Fields{
+		u.GetName(),
+		na(aa[ProjectDisplayName]),
+		missing(phase),
+		na(aa[ProjectRequester]),
+		na(aa[ProjectDescription]),
+		mapToStr(u.GetLabels()),
+		AsStatus(Namespace{}.diagnose(v1.NamespacePhase(phase))),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ImageStream renders an OpenShift image stream to screen.
+type ImageStream struct {
+	Base
+}
+
+// Header returns a header row.
+func (ImageStream) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "IMAGE REPOSITORY"},
+		model1.HeaderColumn{Name: "TAGS"},
+		model1.HeaderColumn{Name: "UPDATED"},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ImageStream) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected ImageStream, but got %T", o)
+	}
+
+	repo, _, _ := unstructured.NestedString(u.Object, "status", "publicDockerImageRepository")
+	if repo == "" {
+		repo, _, _ = unstructured.NestedString(u.Object, "status", "dockerImageRepository")
+	}
+	tags, updated := ImageStreamTags(u)
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		na(repo),
+		naStrings(tags),
+		toAgeHuman(updated),
+		mapToStr(u.GetLabels()),
+		AsStatus(imageStreamDiagnose(u)),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ImageStreamTags returns an image stream tags along with the last time
+// one of them was updated.
+func ImageStreamTags(u *unstructured.Unstructured) ([]string, string) {
+	var (
+		tt     []string
+		latest string
+	)
+	for _, t := range NestedMaps(u.Object, "status", "tags") {
+		n, _, _ := unstructured.NestedString(t, "tag")
+		tt = append(tt, n)
+		items := NestedMaps(t, "items")
+		if len(items) == 0 {
+			continue
+		}
+		// RFC3339 UTC timestamps sort lexically.
+		if c, _, _ := unstructured.NestedString(items[0], "created"); c > latest {
+			latest = c
+		}
+	}
+	sort.Strings(tt)
+
+	return tt, latest
+}
+
+func imageStreamDiagnose(u *unstructured.Unstructured) error {
+	for _, t := range NestedMaps(u.Object, "status", "tags") {
+		for _, c := range NestedMaps(t, "conditions") {
+			kind, _, _ := unstructured.NestedString(c, "type")
+			status, _, _ := unstructured.NestedString(c, "status")
+			if kind != "ImportSuccess" || status != "False" {
+				continue
+			}
+			tag, _, _ := unstructured.NestedString(t, "tag")
+			msg, _, _ := unstructured.NestedString(c, "message")
+			return fmt.Errorf("tag %s import failed: %s", tag, msg)
+		}
+	}
+
+	return nil
+}
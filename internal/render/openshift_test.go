@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteRender(t *testing.T) {
+	c := render.Route{}
+	r := model1.NewRow(11)
+
+	assert.NoError(t, c.Render(load(t, "os_route"), "", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, model1.Fields{
+		"default",
+		"fred",
+		"fred.apps.example.com",
+		"/api",
+		"fred(80),fred-canary(20)",
+		"http",
+		"edge/Redirect",
+		"False",
+	}, r.Fields[:8])
+	assert.Equal(t, "router default: HostAlreadyClaimed: route blee already exposes fred.apps.example.com", r.Fields[9])
+}
+
+func TestDeploymentConfigRender(t *testing.T) {
+	c := render.DeploymentConfig{}
+	r := model1.NewRow(11)
+
+	assert.NoError(t, c.Render(load(t, "os_dc"), "", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, model1.Fields{"default", "fred", "4", "3/3", "3", "config,image(fred:latest)", "Rolling", "deploymentconfig=fred"}, r.Fields[:8])
+	assert.Equal(t, "", r.Fields[9])
+}
+
+func TestProjectRender(t *testing.T) {
+	c := render.Project{}
+	r := model1.NewRow(8)
+
+	assert.NoError(t, c.Render(load(t, "os_project"), "", &r))
+	assert.Equal(t, "-/fred", r.ID)
+	assert.Equal(t, model1.Fields{"fred", "Fred Team", "Active", "blee", "Fred services"}, r.Fields[:5])
+	assert.Equal(t, "", r.Fields[6])
+}
+
+func TestImageStreamRender(t *testing.T) {
+	c := render.ImageStream{}
+	r := model1.NewRow(8)
+
+	assert.NoError(t, c.Render(load(t, "os_is"), "", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, model1.Fields{
+		"default",
+		"fred",
+		"image-registry.openshift-image-registry.svc:5000/default/fred",
+		"latest,next,v1",
+	}, r.Fields[:4])
+	assert.Equal(t, "tag next import failed: manifest unknown", r.Fields[6])
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Orphan renders resources no longer in use.
+type Orphan struct {
+	Base
+}
+
+// Header returns a header row.
+func (Orphan) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "GVR"},
+		model1.HeaderColumn{Name: "REASON"},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Orphan) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(OrphanRes)
+	if !ok {
+		return fmt.Errorf("expected OrphanRes, but got %T", o)
+	}
+
+	r.ID = ResourceID(res.GVR, client.FQN(res.Object.GetNamespace(), res.Object.GetName()))
+	r.Fields = model1.Fields{
+		res.Object.GetNamespace(),
+		res.Object.GetName(),
+		res.GVR,
+		res.Reason,
+		ToAge(res.Object.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// OrphanRes represents a resource no longer in use.
+type OrphanRes struct {
+	GVR    string
+	Object *unstructured.Unstructured
+	Reason string
+}
+
+// GetObjectKind returns a schema object.
+func (OrphanRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (o OrphanRes) DeepCopyObject() runtime.Object {
+	return o
+}
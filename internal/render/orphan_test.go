@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestOrphanRender(t *testing.T) {
+	var o unstructured.Unstructured
+	o.SetNamespace("fred")
+	o.SetName("blee")
+
+	var (
+		r  model1.Row
+		or render.Orphan
+	)
+	assert.Nil(t, or.Render(render.OrphanRes{GVR: "v1/configmaps", Object: &o, Reason: "Unreferenced"}, "", &r))
+	assert.Equal(t, "v1/configmaps|fred/blee", r.ID)
+	assert.Equal(t, model1.Fields{"fred", "blee", "v1/configmaps", "Unreferenced"}, r.Fields[:4])
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PriorityClass renders a K8s PriorityClass to screen.
+type PriorityClass struct {
+	Base
+}
+
+// Header returns a header row.
+func (PriorityClass) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "VALUE", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "GLOBAL-DEFAULT"},
+		model1.HeaderColumn{Name: "PREEMPTION"},
+		model1.HeaderColumn{Name: "DESCRIPTION", Wide: true},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (PriorityClass) Render(o interface{}, ns string, r *model1.Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected PriorityClass, but got %T", o)
+	}
+	var pc schedulingv1.PriorityClass
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &pc)
+	if err != nil {
+		return err
+	}
+
+	preempt := "PreemptLowerPriority"
+	if pc.PreemptionPolicy != nil {
+		preempt = string(*pc.PreemptionPolicy)
+	}
+
+	r.ID = client.FQN(client.ClusterScope, pc.Name)
+	r.Fields = model1.Fields{
+		pc.Name,
+		strconv.Itoa(int(pc.Value)),
+		boolToStr(pc.GlobalDefault),
+		preempt,
+		pc.Description,
+		mapToStr(pc.Labels),
+		"",
+		ToAge(pc.GetCreationTimestamp()),
+	}
+
+	return nil
+}
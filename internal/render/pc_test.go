@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityClassRender(t *testing.T) {
+	c := render.PriorityClass{}
+	r := model1.NewRow(5)
+
+	assert.NoError(t, c.Render(load(t, "pc"), "", &r))
+	assert.Equal(t, "-/batch-low", r.ID)
+	assert.Equal(t, model1.Fields{"batch-low", "-10", "false", "Never", "Low priority preemptible batch jobs."}, r.Fields[:5])
+}
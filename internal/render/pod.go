@@ -26,6 +26,9 @@ const (
 	// cannot be confirmed as kubelet is unresponsive on the node it is (was) running.
 	NodeUnreachablePodReason = "NodeLost" // k8s.io/kubernetes/pkg/util/node.NodeUnreachablePodReason
 	vulIdx                   = 2
+
+	osLabel   = "kubernetes.io/os"
+	archLabel = "kubernetes.io/arch"
 )
 
 const (
@@ -105,6 +108,11 @@ func (p Pod) Header(ns string) model1.Header {
 		model1.HeaderColumn{Name: "NOMINATED NODE", Wide: true},
 		model1.HeaderColumn{Name: "READINESS GATES", Wide: true},
 		model1.HeaderColumn{Name: "QOS", Wide: true},
+		model1.HeaderColumn{Name: "PRIORITY", Align: tview.AlignRight, Wide: true},
+		model1.HeaderColumn{Name: "PSS", Wide: true},
+		model1.HeaderColumn{Name: "SECURITY", Wide: true},
+		model1.HeaderColumn{Name: "OS", Wide: true},
+		model1.HeaderColumn{Name: "ARCH", Wide: true},
 		model1.HeaderColumn{Name: "LABELS", Wide: true},
 		model1.HeaderColumn{Name: "VALID", Wide: true},
 		model1.HeaderColumn{Name: "AGE", Time: true},
@@ -157,6 +165,11 @@ func (p Pod) Render(o interface{}, ns string, row *model1.Row) error {
 		asNominated(po.Status.NominatedNodeName),
 		asReadinessGate(po),
 		p.mapQOS(po.Status.QOSClass),
+		asPriority(po.Spec.Priority),
+		PSSLevel(&po.Spec),
+		PodSecurityOf(&po.Spec).String(),
+		PodOS(&po.Spec),
+		PodArch(&po.Spec),
 		mapToStr(po.Labels),
 		AsStatus(p.diagnose(phase, cr, len(cs))),
 		ToAge(po.GetCreationTimestamp()),
@@ -186,6 +199,41 @@ func asNominated(n string) string {
 	return n
 }
 
+func asPriority(p *int32) string {
+	if p == nil {
+		return NAValue
+	}
+	return strconv.Itoa(int(*p))
+}
+
+// PodOS returns the operating system a pod targets either via its spec or
+// its node selector.
+func PodOS(spec *v1.PodSpec) string {
+	if spec.OS != nil && spec.OS.Name != "" {
+		return string(spec.OS.Name)
+	}
+	if os, ok := spec.NodeSelector[osLabel]; ok {
+		return os
+	}
+	if os, ok := spec.NodeSelector["beta."+osLabel]; ok {
+		return os
+	}
+
+	return NAValue
+}
+
+// PodArch returns the architecture a pod is pinned to via its node selector.
+func PodArch(spec *v1.PodSpec) string {
+	if arch, ok := spec.NodeSelector[archLabel]; ok {
+		return arch
+	}
+	if arch, ok := spec.NodeSelector["beta."+archLabel]; ok {
+		return arch
+	}
+
+	return NAValue
+}
+
 func asReadinessGate(pod v1.Pod) string {
 	if len(pod.Spec.ReadinessGates) == 0 {
 		return MissingValue
@@ -668,3 +668,51 @@ func makeRes(c, m string) v1.ResourceList {
 		v1.ResourceMemory: mem,
 	}
 }
+
+func TestPodPlatform(t *testing.T) {
+	uu := map[string]struct {
+		spec     v1.PodSpec
+		os, arch string
+	}{
+		"none": {
+			os:   render.NAValue,
+			arch: render.NAValue,
+		},
+		"spec": {
+			spec: v1.PodSpec{
+				OS:           &v1.PodOS{Name: v1.Windows},
+				NodeSelector: map[string]string{"kubernetes.io/os": "linux"},
+			},
+			os:   "windows",
+			arch: render.NAValue,
+		},
+		"selector": {
+			spec: v1.PodSpec{
+				NodeSelector: map[string]string{
+					"kubernetes.io/os":   "linux",
+					"kubernetes.io/arch": "arm64",
+				},
+			},
+			os:   "linux",
+			arch: "arm64",
+		},
+		"beta": {
+			spec: v1.PodSpec{
+				NodeSelector: map[string]string{
+					"beta.kubernetes.io/os":   "windows",
+					"beta.kubernetes.io/arch": "amd64",
+				},
+			},
+			os:   "windows",
+			arch: "amd64",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.os, render.PodOS(&u.spec))
+			assert.Equal(t, u.arch, render.PodArch(&u.spec))
+		})
+	}
+}
@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// PSSPrivileged tracks the unrestricted Pod Security Standards level.
+	PSSPrivileged = "privileged"
+
+	// PSSBaseline tracks the baseline Pod Security Standards level.
+	PSSBaseline = "baseline"
+
+	// PSSRestricted tracks the restricted Pod Security Standards level.
+	PSSRestricted = "restricted"
+)
+
+var (
+	// pssBaselineCaps lists the capabilities the baseline level permits.
+	pssBaselineCaps = map[v1.Capability]struct{}{
+		"AUDIT_WRITE":      {},
+		"CHOWN":            {},
+		"DAC_OVERRIDE":     {},
+		"FOWNER":           {},
+		"FSETID":           {},
+		"KILL":             {},
+		"MKNOD":            {},
+		"NET_BIND_SERVICE": {},
+		"SETFCAP":          {},
+		"SETGID":           {},
+		"SETPCAP":          {},
+		"SETUID":           {},
+		"SYS_CHROOT":       {},
+	}
+
+	// pssRestrictedVolumes lists the volume types the restricted level permits.
+	pssRestrictedVolumes = []func(v v1.VolumeSource) bool{
+		func(v v1.VolumeSource) bool { return v.ConfigMap != nil },
+		func(v v1.VolumeSource) bool { return v.CSI != nil },
+		func(v v1.VolumeSource) bool { return v.DownwardAPI != nil },
+		func(v v1.VolumeSource) bool { return v.EmptyDir != nil },
+		func(v v1.VolumeSource) bool { return v.Ephemeral != nil },
+		func(v v1.VolumeSource) bool { return v.PersistentVolumeClaim != nil },
+		func(v v1.VolumeSource) bool { return v.Projected != nil },
+		func(v v1.VolumeSource) bool { return v.Secret != nil },
+	}
+)
+
+// ContainerSecurity tracks a container security sensitive settings.
+type ContainerSecurity struct {
+	RunAsRoot  bool
+	Privileged bool
+	Caps       []string
+}
+
+// String returns the container security flags.
+func (c ContainerSecurity) String() string {
+	ff := make([]string, 0, 3)
+	if c.RunAsRoot {
+		ff = append(ff, "root")
+	}
+	if c.Privileged {
+		ff = append(ff, "privileged")
+	}
+	if len(c.Caps) > 0 {
+		ff = append(ff, "caps:"+strings.Join(c.Caps, "|"))
+	}
+
+	return strings.Join(ff, ",")
+}
+
+// ContainerSecurityOf returns a container security settings given its pod
+// security context.
+func ContainerSecurityOf(psc *v1.PodSecurityContext, co *v1.Container) ContainerSecurity {
+	var cs ContainerSecurity
+	sc := co.SecurityContext
+
+	nonRoot, uid := (*bool)(nil), (*int64)(nil)
+	if psc != nil {
+		nonRoot, uid = psc.RunAsNonRoot, psc.RunAsUser
+	}
+	if sc != nil {
+		if sc.RunAsNonRoot != nil {
+			nonRoot = sc.RunAsNonRoot
+		}
+		if sc.RunAsUser != nil {
+			uid = sc.RunAsUser
+		}
+		cs.Privileged = sc.Privileged != nil && *sc.Privileged
+		if sc.Capabilities != nil {
+			for _, c := range sc.Capabilities.Add {
+				cs.Caps = append(cs.Caps, string(c))
+			}
+			sort.Strings(cs.Caps)
+		}
+	}
+	switch {
+	case uid != nil:
+		cs.RunAsRoot = *uid == 0
+	default:
+		cs.RunAsRoot = nonRoot == nil || !*nonRoot
+	}
+
+	return cs
+}
+
+// PodSecurity tracks a pod security sensitive settings.
+type PodSecurity struct {
+	RunAsRoot   bool
+	Privileged  bool
+	Caps        []string
+	HostPaths   []string
+	HostNetwork bool
+	HostPID     bool
+	HostIPC     bool
+}
+
+// String returns the pod security flags.
+func (p PodSecurity) String() string {
+	ff := make([]string, 0, 6)
+	if p.RunAsRoot {
+		ff = append(ff, "root")
+	}
+	if p.Privileged {
+		ff = append(ff, "privileged")
+	}
+	if len(p.Caps) > 0 {
+		ff = append(ff, "caps:"+strings.Join(p.Caps, "|"))
+	}
+	if len(p.HostPaths) > 0 {
+		ff = append(ff, "hostPath:"+strings.Join(p.HostPaths, "|"))
+	}
+	if p.HostNetwork {
+		ff = append(ff, "hostNetwork")
+	}
+	if p.HostPID {
+		ff = append(ff, "hostPID")
+	}
+	if p.HostIPC {
+		ff = append(ff, "hostIPC")
+	}
+
+	return strings.Join(ff, ",")
+}
+
+// PodSecurityOf returns a pod security settings across all its containers.
+func PodSecurityOf(spec *v1.PodSpec) PodSecurity {
+	ps := PodSecurity{
+		HostNetwork: spec.HostNetwork,
+		HostPID:     spec.HostPID,
+		HostIPC:     spec.HostIPC,
+	}
+	caps := make(map[string]struct{})
+	podContainers(spec, func(co *v1.Container) {
+		cs := ContainerSecurityOf(spec.SecurityContext, co)
+		ps.RunAsRoot = ps.RunAsRoot || cs.RunAsRoot
+		ps.Privileged = ps.Privileged || cs.Privileged
+		for _, c := range cs.Caps {
+			caps[c] = struct{}{}
+		}
+	})
+	for c := range caps {
+		ps.Caps = append(ps.Caps, c)
+	}
+	sort.Strings(ps.Caps)
+	for _, v := range spec.Volumes {
+		if v.HostPath != nil {
+			ps.HostPaths = append(ps.HostPaths, v.HostPath.Path)
+		}
+	}
+
+	return ps
+}
+
+// PSSLevel returns the most restrictive Pod Security Standards level a pod
+// spec complies with.
+func PSSLevel(spec *v1.PodSpec) string {
+	if len(PSSViolations(spec, PSSBaseline)) > 0 {
+		return PSSPrivileged
+	}
+	if len(PSSViolations(spec, PSSRestricted)) > 0 {
+		return PSSBaseline
+	}
+
+	return PSSRestricted
+}
+
+// PSSViolations lists the checks a pod spec fails for a given level.
+func PSSViolations(spec *v1.PodSpec, level string) []string {
+	switch level {
+	case PSSBaseline:
+		return pssBaseline(spec)
+	case PSSRestricted:
+		return append(pssBaseline(spec), pssRestricted(spec)...)
+	default:
+		return nil
+	}
+}
+
+func pssBaseline(spec *v1.PodSpec) []string {
+	vv := make([]string, 0, 5)
+	if spec.HostNetwork || spec.HostPID || spec.HostIPC {
+		vv = append(vv, "host namespaces")
+	}
+	for _, v := range spec.Volumes {
+		if v.HostPath != nil {
+			vv = append(vv, "hostPath volume "+v.Name)
+		}
+	}
+	if psc := spec.SecurityContext; psc != nil && psc.SeccompProfile != nil && psc.SeccompProfile.Type == v1.SeccompProfileTypeUnconfined {
+		vv = append(vv, "unconfined seccomp profile")
+	}
+	podContainers(spec, func(co *v1.Container) {
+		for _, p := range co.Ports {
+			if p.HostPort != 0 {
+				vv = append(vv, "host port on "+co.Name)
+				break
+			}
+		}
+		sc := co.SecurityContext
+		if sc == nil {
+			return
+		}
+		if sc.Privileged != nil && *sc.Privileged {
+			vv = append(vv, "privileged container "+co.Name)
+		}
+		if sc.Capabilities != nil {
+			for _, c := range sc.Capabilities.Add {
+				if _, ok := pssBaselineCaps[c]; !ok {
+					vv = append(vv, "capability "+string(c)+" on "+co.Name)
+				}
+			}
+		}
+		if sc.SeccompProfile != nil && sc.SeccompProfile.Type == v1.SeccompProfileTypeUnconfined {
+			vv = append(vv, "unconfined seccomp profile on "+co.Name)
+		}
+		if sc.ProcMount != nil && *sc.ProcMount != v1.DefaultProcMount {
+			vv = append(vv, "unmasked proc mount on "+co.Name)
+		}
+	})
+
+	return vv
+}
+
+func pssRestricted(spec *v1.PodSpec) []string {
+	vv := make([]string, 0, 5)
+	for _, v := range spec.Volumes {
+		if v.HostPath == nil && !pssRestrictedVolume(v.VolumeSource) {
+			vv = append(vv, "restricted volume type "+v.Name)
+		}
+	}
+	psc := spec.SecurityContext
+	if psc == nil {
+		psc = &v1.PodSecurityContext{}
+	}
+	podContainers(spec, func(co *v1.Container) {
+		sc := co.SecurityContext
+		if sc == nil {
+			sc = &v1.SecurityContext{}
+		}
+		nonRoot := psc.RunAsNonRoot
+		if sc.RunAsNonRoot != nil {
+			nonRoot = sc.RunAsNonRoot
+		}
+		if nonRoot == nil || !*nonRoot {
+			vv = append(vv, "runAsNonRoot not set on "+co.Name)
+		}
+		if uid := sc.RunAsUser; (uid != nil && *uid == 0) || (uid == nil && psc.RunAsUser != nil && *psc.RunAsUser == 0) {
+			vv = append(vv, "runAsUser 0 on "+co.Name)
+		}
+		if sc.AllowPrivilegeEscalation == nil || *sc.AllowPrivilegeEscalation {
+			vv = append(vv, "privilege escalation allowed on "+co.Name)
+		}
+		seccomp := psc.SeccompProfile
+		if sc.SeccompProfile != nil {
+			seccomp = sc.SeccompProfile
+		}
+		if seccomp == nil {
+			vv = append(vv, "seccomp profile not set on "+co.Name)
+		}
+		if !dropsAllCaps(sc.Capabilities) {
+			vv = append(vv, "capabilities not dropped on "+co.Name)
+		}
+		if sc.Capabilities != nil {
+			for _, c := range sc.Capabilities.Add {
+				if _, ok := pssBaselineCaps[c]; ok && c != "NET_BIND_SERVICE" {
+					vv = append(vv, "capability "+string(c)+" on "+co.Name)
+				}
+			}
+		}
+	})
+
+	return vv
+}
+
+func pssRestrictedVolume(v v1.VolumeSource) bool {
+	for _, ok := range pssRestrictedVolumes {
+		if ok(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func dropsAllCaps(c *v1.Capabilities) bool {
+	if c == nil {
+		return false
+	}
+	for _, d := range c.Drop {
+		if d == "ALL" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func podContainers(spec *v1.PodSpec, f func(*v1.Container)) {
+	for _, cc := range [][]v1.Container{spec.InitContainers, spec.Containers} {
+		for i := range cc {
+			f(&cc[i])
+		}
+	}
+}
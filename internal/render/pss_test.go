@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestPSSLevel(t *testing.T) {
+	yes, no, root := true, false, int64(0)
+	uu := map[string]struct {
+		spec v1.PodSpec
+		e    string
+	}{
+		"default": {
+			spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+			e:    render.PSSBaseline,
+		},
+		"host-network": {
+			spec: v1.PodSpec{HostNetwork: true, Containers: []v1.Container{{Name: "c1"}}},
+			e:    render.PSSPrivileged,
+		},
+		"privileged": {
+			spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:            "c1",
+				SecurityContext: &v1.SecurityContext{Privileged: &yes},
+			}}},
+			e: render.PSSPrivileged,
+		},
+		"sys-admin": {
+			spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:            "c1",
+				SecurityContext: &v1.SecurityContext{Capabilities: &v1.Capabilities{Add: []v1.Capability{"SYS_ADMIN"}}},
+			}}},
+			e: render.PSSPrivileged,
+		},
+		"restricted": {
+			spec: v1.PodSpec{
+				SecurityContext: &v1.PodSecurityContext{
+					RunAsNonRoot:   &yes,
+					SeccompProfile: &v1.SeccompProfile{Type: v1.SeccompProfileTypeRuntimeDefault},
+				},
+				Containers: []v1.Container{{
+					Name: "c1",
+					SecurityContext: &v1.SecurityContext{
+						AllowPrivilegeEscalation: &no,
+						Capabilities:             &v1.Capabilities{Drop: []v1.Capability{"ALL"}, Add: []v1.Capability{"NET_BIND_SERVICE"}},
+					},
+				}},
+			},
+			e: render.PSSRestricted,
+		},
+		"restricted-root-uid": {
+			spec: v1.PodSpec{
+				SecurityContext: &v1.PodSecurityContext{
+					RunAsNonRoot:   &yes,
+					RunAsUser:      &root,
+					SeccompProfile: &v1.SeccompProfile{Type: v1.SeccompProfileTypeRuntimeDefault},
+				},
+				Containers: []v1.Container{{
+					Name: "c1",
+					SecurityContext: &v1.SecurityContext{
+						AllowPrivilegeEscalation: &no,
+						Capabilities:             &v1.Capabilities{Drop: []v1.Capability{"ALL"}},
+					},
+				}},
+			},
+			e: render.PSSBaseline,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.PSSLevel(&u.spec))
+		})
+	}
+}
+
+func TestPodSecurityOf(t *testing.T) {
+	yes, uid := true, int64(1000)
+	spec := v1.PodSpec{
+		HostNetwork:     true,
+		SecurityContext: &v1.PodSecurityContext{RunAsUser: &uid},
+		Volumes: []v1.Volume{
+			{Name: "v1", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/var/run"}}},
+		},
+		Containers: []v1.Container{
+			{Name: "c1"},
+			{
+				Name: "c2",
+				SecurityContext: &v1.SecurityContext{
+					Privileged:   &yes,
+					Capabilities: &v1.Capabilities{Add: []v1.Capability{"NET_ADMIN", "SYS_TIME"}},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, "privileged,caps:NET_ADMIN|SYS_TIME,hostPath:/var/run,hostNetwork", render.PodSecurityOf(&spec).String())
+	assert.Equal(t, "", render.ContainerSecurityOf(spec.SecurityContext, &spec.Containers[0]).String())
+	assert.Equal(t, "root", render.ContainerSecurityOf(nil, &spec.Containers[0]).String())
+}
@@ -30,6 +30,8 @@ func (StatefulSet) Header(ns string) model1.Header {
 		model1.HeaderColumn{Name: "SERVICE"},
 		model1.HeaderColumn{Name: "CONTAINERS", Wide: true},
 		model1.HeaderColumn{Name: "IMAGES", Wide: true},
+		model1.HeaderColumn{Name: "PARTITION", Wide: true},
+		model1.HeaderColumn{Name: "PVC-RETENTION", Wide: true},
 		model1.HeaderColumn{Name: "LABELS", Wide: true},
 		model1.HeaderColumn{Name: "VALID", Wide: true},
 		model1.HeaderColumn{Name: "AGE", Time: true},
@@ -58,6 +60,8 @@ func (s StatefulSet) Render(o interface{}, ns string, r *model1.Row) error {
 		na(sts.Spec.ServiceName),
 		podContainerNames(sts.Spec.Template.Spec, true),
 		podImageNames(sts.Spec.Template.Spec, true),
+		partition(&sts),
+		pvcRetention(&sts),
 		mapToStr(sts.Labels),
 		AsStatus(s.diagnose(sts.Spec.Replicas, sts.Status.Replicas, sts.Status.ReadyReplicas)),
 		ToAge(sts.GetCreationTimestamp()),
@@ -76,3 +80,40 @@ func (StatefulSet) diagnose(w *int32, d, r int32) error {
 
 	return nil
 }
+
+// PVCRetention returns a StatefulSet PVC retention policy when deleted and
+// when scaled down.
+func PVCRetention(sts *appsv1.StatefulSet) (string, string) {
+	whenDeleted := string(appsv1.RetainPersistentVolumeClaimRetentionPolicyType)
+	whenScaled := whenDeleted
+	if p := sts.Spec.PersistentVolumeClaimRetentionPolicy; p != nil {
+		if p.WhenDeleted != "" {
+			whenDeleted = string(p.WhenDeleted)
+		}
+		if p.WhenScaled != "" {
+			whenScaled = string(p.WhenScaled)
+		}
+	}
+
+	return whenDeleted, whenScaled
+}
+
+func pvcRetention(sts *appsv1.StatefulSet) string {
+	if len(sts.Spec.VolumeClaimTemplates) == 0 {
+		return NAValue
+	}
+	whenDeleted, whenScaled := PVCRetention(sts)
+
+	return whenDeleted + "/" + whenScaled
+}
+
+func partition(sts *appsv1.StatefulSet) string {
+	if sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return string(appsv1.OnDeleteStatefulSetStrategyType)
+	}
+	if ru := sts.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+		return strconv.Itoa(int(*ru.Partition))
+	}
+
+	return "0"
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Subresource renders the status or scale subresource of resources.
+type Subresource struct {
+	Base
+}
+
+// Header returns a header row.
+func (Subresource) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "GENERATION", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "OBSERVED", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "CONDITIONS"},
+		model1.HeaderColumn{Name: "DESIRED", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "CURRENT", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "SELECTOR", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Subresource) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(SubresourceRes)
+	if !ok {
+		return fmt.Errorf("expected SubresourceRes, but got %T", o)
+	}
+
+	u := res.Object.UnstructuredContent()
+	r.ID = client.FQN(res.Object.GetNamespace(), res.Object.GetName())
+	r.Fields = model1.Fields{
+		res.Object.GetNamespace(),
+		res.Object.GetName(),
+		intField(u, "metadata", "generation"),
+		intField(u, "status", "observedGeneration"),
+		trueConditions(u),
+		intField(u, "spec", "replicas"),
+		intField(u, "status", "replicas"),
+		selectorOf(u),
+		ToAge(res.Object.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// SubresourceRes represents a resource viewed through a subresource.
+type SubresourceRes struct {
+	GVR    client.GVR
+	Object *unstructured.Unstructured
+}
+
+// GetObjectKind returns a schema object.
+func (SubresourceRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s SubresourceRes) DeepCopyObject() runtime.Object {
+	return s
+}
+
+func intField(u map[string]interface{}, path ...string) string {
+	v, ok, err := unstructured.NestedInt64(u, path...)
+	if err != nil || !ok {
+		return ""
+	}
+
+	return strconv.FormatInt(v, 10)
+}
+
+// trueConditions lists the status conditions currently holding.
+func trueConditions(u map[string]interface{}) string {
+	cc, ok, err := unstructured.NestedSlice(u, "status", "conditions")
+	if err != nil || !ok {
+		return ""
+	}
+	tt := make([]string, 0, len(cc))
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok || m["status"] != "True" {
+			continue
+		}
+		if t, ok := m["type"].(string); ok {
+			tt = append(tt, t)
+		}
+	}
+
+	return strings.Join(tt, ",")
+}
+
+func selectorOf(u map[string]interface{}) string {
+	if s, ok, _ := unstructured.NestedString(u, "status", "selector"); ok {
+		return s
+	}
+	ll, ok, err := unstructured.NestedStringMap(u, "spec", "selector", "matchLabels")
+	if err != nil || !ok {
+		return ""
+	}
+
+	return mapToStr(ll)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSubresourceRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"namespace":  "fred",
+			"name":       "blee",
+			"generation": int64(3),
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(2),
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "blee"},
+			},
+		},
+		"status": map[string]interface{}{
+			"observedGeneration": int64(2),
+			"replicas":           int64(1),
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Available", "status": "True"},
+				map[string]interface{}{"type": "Progressing", "status": "False"},
+			},
+		},
+	}}
+
+	var (
+		r  model1.Row
+		sr render.Subresource
+	)
+	res := render.SubresourceRes{GVR: client.NewGVR("apps/v1/deployments:scale"), Object: &o}
+	assert.Nil(t, sr.Render(res, "", &r))
+	assert.Equal(t, "fred/blee", r.ID)
+	assert.Equal(t, model1.Fields{"fred", "blee", "3", "2", "Available", "2", "1", "app=blee"}, r.Fields[:8])
+}
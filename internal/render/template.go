@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var tplKindRX = regexp.MustCompile(`(?m)^kind:\s*(\S+)`)
+
+// Template renders resource creation templates to screen.
+type Template struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Template) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		idx, ok := h.IndexOf("SOURCE", true)
+		if ok && idx < len(re.Row.Fields) && re.Row.Fields[idx] == config.UserTemplate {
+			return tcell.ColorAquamarine
+		}
+
+		return tcell.ColorCadetBlue
+	}
+}
+
+// Header returns a header row.
+func (Template) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "SOURCE"},
+		model1.HeaderColumn{Name: "VARIABLES"},
+	}
+}
+
+// Render renders a template to screen.
+func (Template) Render(o interface{}, ns string, r *model1.Row) error {
+	t, ok := o.(TemplateRes)
+	if !ok {
+		return fmt.Errorf("expected TemplateRes, but got %T", o)
+	}
+
+	vv := t.Template.Vars()
+	names := make([]string, 0, len(vv))
+	for _, v := range vv {
+		n := v.Name
+		if v.Default == "" {
+			n += "*"
+		}
+		names = append(names, n)
+	}
+
+	r.ID = t.Template.Name
+	r.Fields = model1.Fields{
+		t.Template.Name,
+		templateKind(t.Template.Body),
+		t.Template.Source,
+		strings.Join(names, ","),
+	}
+
+	return nil
+}
+
+func templateKind(body string) string {
+	kk := make([]string, 0, 1)
+	for _, m := range tplKindRX.FindAllStringSubmatch(body, -1) {
+		kk = append(kk, m[1])
+	}
+	if len(kk) == 0 {
+		return UnknownValue
+	}
+
+	return strings.Join(kk, ",")
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// TemplateRes represents a resource creation template.
+type TemplateRes struct {
+	Template config.ResourceTemplate
+}
+
+// GetObjectKind returns a schema object.
+func (TemplateRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (t TemplateRes) DeepCopyObject() runtime.Object {
+	return t
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateRender(t *testing.T) {
+	o := render.TemplateRes{
+		Template: config.ResourceTemplate{
+			Name:   "fred",
+			Source: config.UserTemplate,
+			Body:   "apiVersion: v1\nkind: Pod\nmetadata:\n  name: ${NAME}\n  namespace: ${NAMESPACE=default}\n",
+		},
+	}
+
+	var (
+		tpl render.Template
+		r   model1.Row
+	)
+	assert.Nil(t, tpl.Render(o, "", &r))
+	assert.Equal(t, "fred", r.ID)
+	assert.Equal(t, model1.Fields{"fred", "Pod", "user", "NAME*,NAMESPACE"}, r.Fields)
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// TLSExpiryWarnDays tracks how many days ahead certificates expiry is flagged.
+	TLSExpiryWarnDays = 30
+
+	// TLSExpiryErrDays tracks how many days ahead certificates expiry is reported.
+	TLSExpiryErrDays = 7
+
+	// TLSRenewalManual tracks certificates not renewed by cert-manager.
+	TLSRenewalManual = "Manual"
+
+	tlsExpiryFmt = "2006-01-02"
+)
+
+// TLSExpiry renders TLS certificates expiry to screen.
+type TLSExpiry struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (TLSExpiry) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		if c == model1.ErrColor {
+			return c
+		}
+		idx, ok := h.IndexOf("DAYS", true)
+		if !ok {
+			return c
+		}
+		if d, err := strconv.Atoi(re.Row.Fields[idx]); err == nil && d <= TLSExpiryWarnDays {
+			return model1.PendingColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (TLSExpiry) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "HOSTS"},
+		model1.HeaderColumn{Name: "SECRET"},
+		model1.HeaderColumn{Name: "SUBJECT", Wide: true},
+		model1.HeaderColumn{Name: "ISSUER", Wide: true},
+		model1.HeaderColumn{Name: "EXPIRES"},
+		model1.HeaderColumn{Name: "DAYS", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "RENEWAL"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (TLSExpiry) Render(o interface{}, _ string, r *model1.Row) error {
+	c, ok := o.(*TLSCert)
+	if !ok {
+		return fmt.Errorf("expected *TLSCert, but got %T", o)
+	}
+
+	r.ID = c.ID()
+	r.Fields = model1.Fields{
+		c.Namespace,
+		c.Name,
+		c.Kind,
+		naStrings(c.Hosts),
+		c.Secret,
+		na(c.Subject),
+		na(c.Issuer),
+		NAValue,
+		NAValue,
+		na(c.Renewal),
+		AsStatus(c.Diagnose(time.Now())),
+	}
+	if c.NotAfter.IsZero() {
+		return nil
+	}
+	r.Fields[7] = c.NotAfter.UTC().Format(tlsExpiryFmt)
+	r.Fields[8] = strconv.Itoa(c.DaysLeft(time.Now()))
+
+	return nil
+}
+
+// TLSCert represents a certificate served by an ingress or a gateway.
+type TLSCert struct {
+	GVR       string
+	Namespace string
+	Name      string
+	Kind      string
+	Hosts     []string
+	Secret    string
+	Subject   string
+	Issuer    string
+	NotAfter  time.Time
+	Renewal   string
+	Failing   string
+	Err       error
+}
+
+// ID returns the row identifier as gvr|fqn|secret.
+func (c *TLSCert) ID() string {
+	return strings.Join([]string{c.GVR, client.FQN(c.Namespace, c.Name), c.Secret}, "|")
+}
+
+// DaysLeft returns the number of days until the certificate expires.
+// Expired certificates report negative days.
+func (c *TLSCert) DaysLeft(now time.Time) int {
+	return int(math.Floor(c.NotAfter.Sub(now).Hours() / 24))
+}
+
+// Diagnose reports missing or expiring certificates and failing renewals.
+func (c *TLSCert) Diagnose(now time.Time) error {
+	switch {
+	case c.Err != nil:
+		return c.Err
+	case c.Failing != "":
+		return fmt.Errorf("renewal failing: %s", c.Failing)
+	case c.NotAfter.IsZero():
+		return nil
+	}
+	switch d := c.DaysLeft(now); {
+	case d < 0:
+		return fmt.Errorf("expired %d day(s) ago", -d)
+	case d <= TLSExpiryErrDays:
+		return fmt.Errorf("expires in %d day(s)", d)
+	default:
+		return nil
+	}
+}
+
+// GetObjectKind returns a schema object.
+func (*TLSCert) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c *TLSCert) DeepCopyObject() runtime.Object {
+	return c
+}
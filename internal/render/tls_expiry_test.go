@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTLSExpiryRender(t *testing.T) {
+	notAfter := time.Now().Add(90*24*time.Hour + time.Hour)
+	uu := map[string]struct {
+		c render.TLSCert
+		e model1.Fields
+	}{
+		"no-secret": {
+			c: render.TLSCert{
+				GVR:       "networking.k8s.io/v1/ingresses",
+				Namespace: "default",
+				Name:      "fred",
+				Kind:      "Ingress",
+				Secret:    "fred-tls",
+				Renewal:   render.TLSRenewalManual,
+				Err:       errors.New("secret default/fred-tls not found"),
+			},
+			e: model1.Fields{"default", "fred", "Ingress", "n/a", "fred-tls", "n/a", "n/a", "n/a", "n/a", "Manual", "secret default/fred-tls not found"},
+		},
+		"auto": {
+			c: render.TLSCert{
+				GVR:       "networking.k8s.io/v1/ingresses",
+				Namespace: "default",
+				Name:      "fred",
+				Kind:      "Ingress",
+				Hosts:     []string{"a.example.com", "b.example.com"},
+				Secret:    "fred-tls",
+				Subject:   "a.example.com",
+				Issuer:    "R3",
+				NotAfter:  notAfter,
+				Renewal:   "Auto 2026-12-01",
+			},
+			e: model1.Fields{"default", "fred", "Ingress", "a.example.com,b.example.com", "fred-tls", "a.example.com", "R3", notAfter.UTC().Format("2006-01-02"), "90", "Auto 2026-12-01", ""},
+		},
+	}
+
+	var r render.TLSExpiry
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			row := model1.NewRow(11)
+			assert.NoError(t, r.Render(&u.c, "", &row))
+			assert.Equal(t, "networking.k8s.io/v1/ingresses|default/fred|fred-tls", row.ID)
+			assert.Equal(t, u.e, row.Fields)
+		})
+	}
+}
+
+func TestTLSCertDiagnose(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		c render.TLSCert
+		e string
+	}{
+		"ok": {
+			c: render.TLSCert{NotAfter: now.Add(60 * 24 * time.Hour)},
+		},
+		"expiring": {
+			c: render.TLSCert{NotAfter: now.Add(3*24*time.Hour + time.Hour)},
+			e: "expires in 3 day(s)",
+		},
+		"expired": {
+			c: render.TLSCert{NotAfter: now.Add(-36 * time.Hour)},
+			e: "expired 2 day(s) ago",
+		},
+		"failing": {
+			c: render.TLSCert{NotAfter: now.Add(60 * 24 * time.Hour), Failing: "Failed: rate limited"},
+			e: "renewal failing: Failed: rate limited",
+		},
+		"pending": {
+			c: render.TLSCert{Renewal: "Issuing"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := u.c.Diagnose(now)
+			if u.e == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.e)
+		})
+	}
+}
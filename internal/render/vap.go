@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ValidatingAdmissionPolicy renders a CEL validating admission policy to screen.
+type ValidatingAdmissionPolicy struct {
+	Base
+}
+
+// Header returns a header row.
+func (ValidatingAdmissionPolicy) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "VALIDATIONS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "PARAMKIND"},
+		model1.HeaderColumn{Name: "FAILURE-POLICY"},
+		model1.HeaderColumn{Name: "RESOURCES"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (p ValidatingAdmissionPolicy) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected ValidatingAdmissionPolicy, but got %T", o)
+	}
+
+	fp, _, _ := unstructured.NestedString(u.Object, "spec", "failurePolicy")
+	r.ID = client.FQN(client.ClusterScope, u.GetName())
+	r.Fields = model1.Fields{
+		u.GetName(),
+		strconv.Itoa(len(NestedMaps(u.Object, "spec", "validations"))),
+		na(PolicyParamKind(u)),
+		na(fp),
+		PolicyResources(u),
+		AsStatus(p.diagnose(u)),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (ValidatingAdmissionPolicy) diagnose(u *unstructured.Unstructured) error {
+	ww := NestedMaps(u.Object, "status", "typeChecking", "expressionWarnings")
+	if len(ww) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d type checking warnings", len(ww))
+}
+
+// ValidatingAdmissionPolicyBinding renders a CEL policy binding to screen.
+type ValidatingAdmissionPolicyBinding struct {
+	Base
+}
+
+// Header returns a header row.
+func (ValidatingAdmissionPolicyBinding) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "POLICY"},
+		model1.HeaderColumn{Name: "PARAMREF"},
+		model1.HeaderColumn{Name: "ACTIONS"},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ValidatingAdmissionPolicyBinding) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected ValidatingAdmissionPolicyBinding, but got %T", o)
+	}
+
+	pn, _, _ := unstructured.NestedString(u.Object, "spec", "policyName")
+	aa, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "validationActions")
+	r.ID = client.FQN(client.ClusterScope, u.GetName())
+	r.Fields = model1.Fields{
+		u.GetName(),
+		pn,
+		na(PolicyParamRef(u)),
+		naStrings(aa),
+		ToAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// PolicyParamKind returns a policy param kind ie v1/ConfigMap.
+func PolicyParamKind(u *unstructured.Unstructured) string {
+	kind, ok, _ := unstructured.NestedStringMap(u.Object, "spec", "paramKind")
+	if !ok {
+		return ""
+	}
+
+	return kind["apiVersion"] + "/" + kind["kind"]
+}
+
+// PolicyResources summarizes the resources a policy matches.
+func PolicyResources(u *unstructured.Unstructured) string {
+	rr := make([]string, 0, 2)
+	for _, rule := range NestedMaps(u.Object, "spec", "matchConstraints", "resourceRules") {
+		ss, _, _ := unstructured.NestedStringSlice(rule, "resources")
+		rr = append(rr, ss...)
+	}
+
+	return naStrings(rr)
+}
+
+// PolicyParamRef summarizes a binding param reference ie ns/name or a
+// label selector.
+func PolicyParamRef(u *unstructured.Unstructured) string {
+	ref, ok, _ := unstructured.NestedMap(u.Object, "spec", "paramRef")
+	if !ok {
+		return ""
+	}
+	ns, _ := ref["namespace"].(string)
+	if n, ok := ref["name"].(string); ok && n != "" {
+		return client.FQN(ns, n)
+	}
+	ll, _, _ := unstructured.NestedStringMap(ref, "selector", "matchLabels")
+	sel := mapToStr(ll)
+	if sel == "" {
+		sel = "*"
+	}
+
+	return strings.TrimPrefix(ns+"/"+sel, "/")
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestValidatingAdmissionPolicyRender(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "max-replicas"},
+		"spec": map[string]interface{}{
+			"failurePolicy": "Fail",
+			"paramKind":     map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap"},
+			"matchConstraints": map[string]interface{}{
+				"resourceRules": []interface{}{
+					map[string]interface{}{"resources": []interface{}{"deployments", "statefulsets"}},
+				},
+			},
+			"validations": []interface{}{
+				map[string]interface{}{"expression": "object.spec.replicas <= 5"},
+			},
+		},
+		"status": map[string]interface{}{
+			"typeChecking": map[string]interface{}{
+				"expressionWarnings": []interface{}{
+					map[string]interface{}{"fieldRef": "spec.validations[0].expression", "warning": "blee"},
+				},
+			},
+		},
+	}}
+
+	var p render.ValidatingAdmissionPolicy
+	r := model1.NewRow(7)
+	assert.NoError(t, p.Render(&u, "", &r))
+	assert.Equal(t, "-/max-replicas", r.ID)
+	assert.Equal(t, model1.Fields{"max-replicas", "1", "v1/ConfigMap", "Fail", "deployments,statefulsets", "1 type checking warnings"}, r.Fields[:6])
+}
+
+func TestValidatingAdmissionPolicyBindingRender(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "max-replicas-binding"},
+		"spec": map[string]interface{}{
+			"policyName":        "max-replicas",
+			"validationActions": []interface{}{"Deny", "Audit"},
+			"paramRef":          map[string]interface{}{"name": "limits", "namespace": "default"},
+		},
+	}}
+
+	var b render.ValidatingAdmissionPolicyBinding
+	r := model1.NewRow(5)
+	assert.NoError(t, b.Render(&u, "", &r))
+	assert.Equal(t, "-/max-replicas-binding", r.ID)
+	assert.Equal(t, model1.Fields{"max-replicas-binding", "max-replicas", "default/limits", "Deny,Audit"}, r.Fields[:4])
+}
+
+func TestPolicyParamRef(t *testing.T) {
+	uu := map[string]struct {
+		ref map[string]interface{}
+		e   string
+	}{
+		"none": {},
+		"cluster": {
+			ref: map[string]interface{}{"name": "limits"},
+			e:   "limits",
+		},
+		"selector": {
+			ref: map[string]interface{}{
+				"namespace": "fred",
+				"selector":  map[string]interface{}{"matchLabels": map[string]interface{}{"app": "blee"}},
+			},
+			e: "fred/app=blee",
+		},
+		"all": {
+			ref: map[string]interface{}{"selector": map[string]interface{}{}},
+			e:   "*",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]interface{}{"spec": map[string]interface{}{}}}
+			if u.ref != nil {
+				o.Object["spec"] = map[string]interface{}{"paramRef": u.ref}
+			}
+			assert.Equal(t, u.e, render.PolicyParamRef(&o))
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package trace
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+type (
+	jaegerResponse struct {
+		Data []jaegerTrace `json:"data"`
+	}
+
+	jaegerTrace struct {
+		TraceID   string                   `json:"traceID"`
+		Spans     []jaegerSpan             `json:"spans"`
+		Processes map[string]jaegerProcess `json:"processes"`
+	}
+
+	jaegerSpan struct {
+		SpanID        string      `json:"spanID"`
+		OperationName string      `json:"operationName"`
+		References    []any       `json:"references"`
+		StartTime     int64       `json:"startTime"`
+		Duration      int64       `json:"duration"`
+		ProcessID     string      `json:"processID"`
+		Tags          []jaegerTag `json:"tags"`
+	}
+
+	jaegerTag struct {
+		Key   string `json:"key"`
+		Value any    `json:"value"`
+	}
+
+	jaegerProcess struct {
+		ServiceName string `json:"serviceName"`
+	}
+)
+
+type jaeger struct {
+	cfg    config.Tracing
+	client *http.Client
+}
+
+// Find returns the most recent traces for a service via the jaeger query api.
+func (j *jaeger) Find(ctx context.Context, service string) ([]Trace, error) {
+	// The query api wants an explicit time range in microseconds.
+	now := time.Now()
+	q := url.Values{}
+	q.Set("service", service)
+	q.Set("limit", strconv.Itoa(j.cfg.GetLimit()))
+	q.Set("lookback", "custom")
+	q.Set("start", strconv.FormatInt(now.Add(-j.cfg.GetLookback()).UnixMicro(), 10))
+	q.Set("end", strconv.FormatInt(now.UnixMicro(), 10))
+	u := strings.TrimSuffix(j.cfg.URL, "/") + "/api/traces?" + q.Encode()
+
+	var resp jaegerResponse
+	if err := getJSON(ctx, j.client, u, &resp); err != nil {
+		return nil, err
+	}
+	tt := make([]Trace, 0, len(resp.Data))
+	for _, t := range resp.Data {
+		tt = append(tt, t.toTrace())
+	}
+
+	return tt, nil
+}
+
+func (t jaegerTrace) toTrace() Trace {
+	tr := Trace{ID: t.TraceID, Spans: make([]Span, 0, len(t.Spans))}
+	var start, end int64
+	for _, s := range t.Spans {
+		sp := Span{
+			Service:   t.Processes[s.ProcessID].ServiceName,
+			Operation: s.OperationName,
+			Duration:  time.Duration(s.Duration) * time.Microsecond,
+			Error:     s.hasError(),
+		}
+		if sp.Error {
+			tr.Errors++
+		}
+		tr.Spans = append(tr.Spans, sp)
+		if len(s.References) == 0 {
+			tr.Service, tr.Operation = sp.Service, sp.Operation
+		}
+		if start == 0 || s.StartTime < start {
+			start = s.StartTime
+		}
+		end = max(end, s.StartTime+s.Duration)
+	}
+	tr.Start = time.UnixMicro(start)
+	tr.Duration = time.Duration(end-start) * time.Microsecond
+
+	return tr
+}
+
+func (s jaegerSpan) hasError() bool {
+	for _, t := range s.Tags {
+		if t.Key != "error" {
+			continue
+		}
+		switch v := t.Value.(type) {
+		case bool:
+			return v
+		case string:
+			return v == "true"
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package trace
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+type (
+	tempoResponse struct {
+		Traces []tempoTrace `json:"traces"`
+	}
+
+	tempoTrace struct {
+		TraceID           string       `json:"traceID"`
+		RootServiceName   string       `json:"rootServiceName"`
+		RootTraceName     string       `json:"rootTraceName"`
+		StartTimeUnixNano string       `json:"startTimeUnixNano"`
+		DurationMs        int64        `json:"durationMs"`
+		SpanSet           tempoSpanSet `json:"spanSet"`
+	}
+
+	tempoSpanSet struct {
+		Spans []tempoSpan `json:"spans"`
+	}
+
+	tempoSpan struct {
+		Name          string `json:"name"`
+		DurationNanos string `json:"durationNanos"`
+	}
+)
+
+type tempo struct {
+	cfg    config.Tracing
+	client *http.Client
+}
+
+// Find returns the most recent traces for a service via the tempo search api.
+func (t *tempo) Find(ctx context.Context, service string) ([]Trace, error) {
+	now := time.Now()
+	q := url.Values{}
+	q.Set("tags", "service.name="+service)
+	q.Set("limit", strconv.Itoa(t.cfg.GetLimit()))
+	q.Set("start", strconv.FormatInt(now.Add(-t.cfg.GetLookback()).Unix(), 10))
+	q.Set("end", strconv.FormatInt(now.Unix(), 10))
+	u := strings.TrimSuffix(t.cfg.URL, "/") + "/api/search?" + q.Encode()
+
+	var resp tempoResponse
+	if err := getJSON(ctx, t.client, u, &resp); err != nil {
+		return nil, err
+	}
+	tt := make([]Trace, 0, len(resp.Traces))
+	for _, tr := range resp.Traces {
+		tt = append(tt, tr.toTrace(service))
+	}
+
+	return tt, nil
+}
+
+func (t tempoTrace) toTrace(service string) Trace {
+	tr := Trace{
+		ID:        t.TraceID,
+		Service:   t.RootServiceName,
+		Operation: t.RootTraceName,
+		Duration:  time.Duration(t.DurationMs) * time.Millisecond,
+	}
+	if ns, err := strconv.ParseInt(t.StartTimeUnixNano, 10, 64); err == nil {
+		tr.Start = time.Unix(0, ns)
+	}
+	for _, s := range t.SpanSet.Spans {
+		d, _ := strconv.ParseInt(s.DurationNanos, 10, 64)
+		tr.Spans = append(tr.Spans, Span{
+			Service:   service,
+			Operation: s.Name,
+			Duration:  time.Duration(d),
+		})
+	}
+
+	return tr
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const fetchTimeout = 10 * time.Second
+
+// ServiceLabels tracks pod labels holding a service name, by precedence.
+var ServiceLabels = []string{
+	"app.kubernetes.io/name",
+	"app",
+	"k8s-app",
+}
+
+// Span represents a trace span summary.
+type Span struct {
+	Service   string
+	Operation string
+	Duration  time.Duration
+	Error     bool
+}
+
+// Trace represents a trace summary.
+type Trace struct {
+	ID        string
+	Service   string
+	Operation string
+	Start     time.Time
+	Duration  time.Duration
+	Spans     []Span
+	Errors    int
+}
+
+// Finder looks up recent traces for a service.
+type Finder interface {
+	// Find returns the most recent traces for a service.
+	Find(ctx context.Context, service string) ([]Trace, error)
+}
+
+// NewFinder returns a finder for the configured tracing backend.
+func NewFinder(cfg config.Tracing) (Finder, error) {
+	if !cfg.IsEnabled() {
+		return nil, fmt.Errorf("no tracing backend configured")
+	}
+	c := &http.Client{Timeout: fetchTimeout}
+	switch cfg.GetProvider() {
+	case config.JaegerProvider:
+		return &jaeger{cfg: cfg, client: c}, nil
+	case config.TempoProvider:
+		return &tempo{cfg: cfg, client: c}, nil
+	default:
+		return nil, fmt.Errorf("unsupported tracing provider %q (jaeger|tempo)", cfg.Provider)
+	}
+}
+
+// ServiceName guesses a pod service name following the OpenTelemetry
+// convention of naming services after the app label, falling back on the
+// owning workload name.
+func ServiceName(ll map[string]string, generateName, name string) string {
+	for _, l := range ServiceLabels {
+		if s, ok := ll[l]; ok && s != "" {
+			return s
+		}
+	}
+	base := strings.TrimSuffix(generateName, "-")
+	if base == "" {
+		return name
+	}
+	if h, ok := ll["pod-template-hash"]; ok {
+		base = strings.TrimSuffix(base, "-"+h)
+	}
+
+	return base
+}
+
+// Render renders traces summaries as text.
+func Render(cfg config.Tracing, service string, tt []Trace) string {
+	if len(tt) == 0 {
+		return fmt.Sprintf("No traces found for service %q in the last %s\n", service, cfg.GetLookback())
+	}
+	sort.Slice(tt, func(i, j int) bool {
+		return tt[i].Start.After(tt[j].Start)
+	})
+
+	var b strings.Builder
+	for _, t := range tt {
+		fmt.Fprintf(&b, "Trace %s\n", t.ID)
+		fmt.Fprintf(&b, "  Root:     %s %s\n", t.Service, t.Operation)
+		fmt.Fprintf(&b, "  Start:    %s\n", t.Start.Format(time.RFC3339))
+		fmt.Fprintf(&b, "  Duration: %s\n", t.Duration)
+		fmt.Fprintf(&b, "  Spans:    %d (%d errors)\n", len(t.Spans), t.Errors)
+		if u := cfg.TraceURL(t.ID); u != "" {
+			fmt.Fprintf(&b, "  Link:     %s\n", u)
+		}
+		if len(t.Spans) > 0 {
+			w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+			for _, s := range t.Spans {
+				var status string
+				if s.Error {
+					status = "ERROR"
+				}
+				fmt.Fprintf(w, "    %s\t%s\t%s\t%s\n", s.Service, s.Operation, s.Duration, status)
+			}
+			_ = w.Flush()
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func getJSON(ctx context.Context, c *http.Client, u string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("trace query %s returned %s", u, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package trace_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/trace"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	jaegerTraces = `{"data":[{"traceID":"t1","spans":[
+{"spanID":"s1","operationName":"GET /api","references":[],"startTime":1000,"duration":5000,"processID":"p1","tags":[]},
+{"spanID":"s2","operationName":"SELECT","references":[{"refType":"CHILD_OF"}],"startTime":2000,"duration":1000,"processID":"p2","tags":[{"key":"error","value":true}]}
+],"processes":{"p1":{"serviceName":"fred"},"p2":{"serviceName":"db"}}}]}`
+
+	tempoTraces = `{"traces":[{"traceID":"t2","rootServiceName":"fred","rootTraceName":"GET /api",
+"startTimeUnixNano":"1000000000","durationMs":12,"spanSet":{"spans":[{"name":"GET /api","durationNanos":"12000000"}]}}]}`
+)
+
+func TestServiceName(t *testing.T) {
+	uu := map[string]struct {
+		labels         map[string]string
+		generate, name string
+		e              string
+	}{
+		"label": {
+			labels: map[string]string{"app": "blee", "app.kubernetes.io/name": "fred"},
+			name:   "fred-abc",
+			e:      "fred",
+		},
+		"deployment": {
+			labels:   map[string]string{"pod-template-hash": "5d8f7"},
+			generate: "zorg-5d8f7-",
+			name:     "zorg-5d8f7-x1x2",
+			e:        "zorg",
+		},
+		"statefulset": {
+			generate: "db-",
+			name:     "db-0",
+			e:        "db",
+		},
+		"bare": {
+			name: "duh",
+			e:    "duh",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, trace.ServiceName(u.labels, u.generate, u.name))
+		})
+	}
+}
+
+func TestJaegerFind(t *testing.T) {
+	var (
+		path  string
+		query url.Values
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, query = r.URL.Path, r.URL.Query()
+		_, _ = w.Write([]byte(jaegerTraces))
+	}))
+	defer srv.Close()
+
+	f, err := trace.NewFinder(config.Tracing{URL: srv.URL, Limit: 5})
+	assert.NoError(t, err)
+	tt, err := f.Find(context.Background(), "fred")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "/api/traces", path)
+	assert.Equal(t, "fred", query.Get("service"))
+	assert.Equal(t, "5", query.Get("limit"))
+	assert.Equal(t, "custom", query.Get("lookback"))
+	start, err := strconv.ParseInt(query.Get("start"), 10, 64)
+	assert.NoError(t, err)
+	end, err := strconv.ParseInt(query.Get("end"), 10, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour.Microseconds(), end-start)
+	assert.Equal(t, 1, len(tt))
+	assert.Equal(t, "t1", tt[0].ID)
+	assert.Equal(t, "fred", tt[0].Service)
+	assert.Equal(t, "GET /api", tt[0].Operation)
+	assert.Equal(t, 5*time.Millisecond, tt[0].Duration)
+	assert.Equal(t, 1, tt[0].Errors)
+	assert.Equal(t, []trace.Span{
+		{Service: "fred", Operation: "GET /api", Duration: 5 * time.Millisecond},
+		{Service: "db", Operation: "SELECT", Duration: time.Millisecond, Error: true},
+	}, tt[0].Spans)
+}
+
+func TestTempoFind(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/search", r.URL.Path)
+		assert.Equal(t, "service.name=fred", r.URL.Query().Get("tags"))
+		_, _ = w.Write([]byte(tempoTraces))
+	}))
+	defer srv.Close()
+
+	f, err := trace.NewFinder(config.Tracing{Provider: config.TempoProvider, URL: srv.URL})
+	assert.NoError(t, err)
+	tt, err := f.Find(context.Background(), "fred")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(tt))
+	assert.Equal(t, "t2", tt[0].ID)
+	assert.Equal(t, time.Unix(1, 0), tt[0].Start)
+	assert.Equal(t, 12*time.Millisecond, tt[0].Duration)
+	assert.Equal(t, 1, len(tt[0].Spans))
+}
+
+func TestFindFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	f, err := trace.NewFinder(config.Tracing{URL: srv.URL})
+	assert.NoError(t, err)
+	_, err = f.Find(context.Background(), "fred")
+	assert.Error(t, err)
+}
+
+func TestNewFinder(t *testing.T) {
+	_, err := trace.NewFinder(config.Tracing{})
+	assert.Error(t, err)
+
+	_, err = trace.NewFinder(config.Tracing{Provider: "zipkin", URL: "http://zipkin"})
+	assert.Error(t, err)
+}
+
+func TestRender(t *testing.T) {
+	cfg := config.Tracing{URL: "http://jaeger:16686"}
+	tt := []trace.Trace{
+		{
+			ID:        "t1",
+			Service:   "fred",
+			Operation: "GET /api",
+			Start:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Duration:  5 * time.Millisecond,
+			Spans:     []trace.Span{{Service: "db", Operation: "SELECT", Duration: time.Millisecond, Error: true}},
+			Errors:    1,
+		},
+	}
+
+	assert.Equal(t, `Trace t1
+  Root:     fred GET /api
+  Start:    2024-01-01T00:00:00Z
+  Duration: 5ms
+  Spans:    1 (1 errors)
+  Link:     http://jaeger:16686/trace/t1
+    db  SELECT  1ms  ERROR
+
+`, trace.Render(cfg, "fred", tt))
+	assert.Equal(t, "No traces found for service \"fred\" in the last 1h0m0s\n", trace.Render(cfg, "fred", nil))
+}
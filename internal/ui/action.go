@@ -21,11 +21,19 @@ type (
 
 	// ActionOpts tracks various action options.
 	ActionOpts struct {
-		Visible   bool
-		Shared    bool
-		Plugin    bool
-		HotKey    bool
-		Dangerous bool
+		Visible     bool
+		Shared      bool
+		Plugin      bool
+		HotKey      bool
+		QuickAction bool
+		Opener      bool
+		Dangerous   bool
+
+		// Verbs lists the API verbs the action requires on the viewed resource.
+		Verbs []string
+
+		// AnyVerbs requires only one of the listed verbs rather than all of them.
+		AnyVerbs bool
 	}
 
 	// KeyAction represents a keyboard action.
@@ -169,6 +177,18 @@ func (a *KeyActions) ClearDanger() {
 	}
 }
 
+// Prune removes actions matching the given predicate.
+func (a *KeyActions) Prune(drop func(KeyAction) bool) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	for k, v := range a.actions {
+		if drop(v) {
+			delete(a.actions, k)
+		}
+	}
+}
+
 // Set replace actions with new ones.
 func (a *KeyActions) Set(aa *KeyActions) {
 	a.mx.Lock()
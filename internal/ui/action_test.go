@@ -23,3 +23,19 @@ func TestKeyActionsHints(t *testing.T) {
 	assert.Equal(t, 3, len(hh))
 	assert.Equal(t, model.MenuHint{Mnemonic: "b", Description: "blee", Visible: true}, hh[0])
 }
+
+func TestKeyActionsPrune(t *testing.T) {
+	kk := ui.NewKeyActionsFromMap(ui.KeyMap{
+		ui.KeyF: ui.NewKeyAction("fred", nil, true),
+		ui.KeyB: ui.NewKeyActionWithOpts("blee", nil, ui.ActionOpts{Visible: true, Verbs: []string{"patch"}}),
+		ui.KeyZ: ui.NewKeyActionWithOpts("zorg", nil, ui.ActionOpts{Verbs: []string{"get"}}),
+	})
+
+	kk.Prune(func(a ui.KeyAction) bool {
+		return len(a.Opts.Verbs) > 0 && a.Opts.Verbs[0] == "patch"
+	})
+
+	assert.Equal(t, 2, kk.Len())
+	_, ok := kk.Get(ui.KeyB)
+	assert.False(t, ok)
+}
@@ -4,6 +4,7 @@
 package ui
 
 import (
+	"io"
 	"os"
 	"sync"
 
@@ -25,6 +26,7 @@ type App struct {
 	actions *KeyActions
 	views   map[string]tview.Primitive
 	cmdBuff *model.FishBuff
+	tty     tcell.Tty
 	running bool
 	mx      sync.RWMutex
 }
@@ -60,6 +62,42 @@ func (a *App) Init() {
 	a.SetRoot(a.Main, true).EnableMouse(a.Config.K9s.UI.EnableMouse)
 }
 
+// InitScreen sets up the terminal screen on the controlling tty and retains
+// the tty so escape sequences can be sent to the terminal. The default screen
+// is used when no tty is available.
+func (a *App) InitScreen() {
+	tty, err := newTty()
+	if err != nil {
+		log.Debug().Err(err).Msg("Using default screen")
+		return
+	}
+	s, err := tcell.NewTerminfoScreenFromTty(tty)
+	if err != nil {
+		log.Debug().Err(err).Msg("Using default screen")
+		return
+	}
+	if err := s.Init(); err != nil {
+		log.Debug().Err(err).Msg("Using default screen")
+		return
+	}
+	if a.Config.K9s.UI.EnableMouse {
+		s.EnableMouse()
+	}
+	a.tty = tty
+	a.SetScreen(s)
+}
+
+// Terminal returns the terminal tty to send escape sequences to or nil if
+// unavailable. Writes must happen on the ui goroutine so they do not
+// interleave with screen draws.
+func (a *App) Terminal() io.Writer {
+	if a.tty == nil {
+		return nil
+	}
+
+	return a.tty
+}
+
 // QueueUpdate queues up a ui action.
 func (a *App) QueueUpdate(f func()) {
 	if a.Application == nil {
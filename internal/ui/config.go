@@ -281,4 +281,5 @@ func (c *Configurator) updateStyles(f string) {
 	model1.HighlightColor = c.Styles.Frame().Status.HighlightColor.Color()
 	model1.KillColor = c.Styles.Frame().Status.KillColor.Color()
 	model1.CompletedColor = c.Styles.Frame().Status.CompletedColor.Color()
+	model1.AnomalyColor = c.Styles.Frame().Status.AnomalyColor.Color()
 }
@@ -5,6 +5,7 @@ package dialog
 
 import (
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 )
@@ -23,7 +24,7 @@ func ShowConfirmAck(app *ui.App, pages *ui.Pages, acceptStr string, override boo
 		SetButtonTextColor(styles.ButtonFgColor.Color()).
 		SetLabelColor(styles.LabelFgColor.Color()).
 		SetFieldTextColor(styles.FieldFgColor.Color())
-	f.AddButton("Cancel", func() {
+	f.AddButton(i18n.T("Cancel"), func() {
 		dismissConfirm(pages)
 		cancel()
 	})
@@ -38,7 +39,7 @@ func ShowConfirmAck(app *ui.App, pages *ui.Pages, acceptStr string, override boo
 		accept = true
 	}
 
-	f.AddButton("OK", func() {
+	f.AddButton(i18n.T("OK"), func() {
 		if !accept {
 			return
 		}
@@ -75,11 +76,11 @@ func ShowConfirm(styles config.Dialog, pages *ui.Pages, title, msg string, ack c
 		SetButtonTextColor(styles.ButtonFgColor.Color()).
 		SetLabelColor(styles.LabelFgColor.Color()).
 		SetFieldTextColor(styles.FieldFgColor.Color())
-	f.AddButton("Cancel", func() {
+	f.AddButton(i18n.T("Cancel"), func() {
 		dismiss(pages)
 		cancel()
 	})
-	f.AddButton("OK", func() {
+	f.AddButton(i18n.T("OK"), func() {
 		ack()
 		dismiss(pages)
 		cancel()
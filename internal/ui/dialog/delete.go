@@ -5,6 +5,7 @@ package dialog
 
 import (
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -48,11 +49,11 @@ func ShowDelete(styles config.Dialog, pages *ui.Pages, msg string, ok okFunc, ca
 	f.AddCheckbox("Force:", force, func(_ string, checked bool) {
 		force = checked
 	})
-	f.AddButton("Cancel", func() {
+	f.AddButton(i18n.T("Cancel"), func() {
 		dismiss(pages)
 		cancel()
 	})
-	f.AddButton("OK", func() {
+	f.AddButton(i18n.T("OK"), func() {
 		switch propagation {
 		case noDeletePropagation:
 			ok(nil, force)
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+// ShowPicker shows a list of options and calls back with the index of the
+// picked option or -1 if the dialog was dismissed.
+func ShowPicker(styles config.Dialog, pages *ui.Pages, title string, options []string, action SelectAction) {
+	list := tview.NewList()
+	list.ShowSecondaryText(false)
+	list.SetSelectedTextColor(styles.ButtonFocusFgColor.Color())
+	list.SetSelectedBackgroundColor(styles.ButtonFocusBgColor.Color())
+	for _, o := range options {
+		list.AddItem(o, "", 0, nil)
+	}
+
+	modal := ui.NewModalList("<"+title+">", list)
+	modal.SetDoneFunc(func(i int, _ string) {
+		dismiss(pages)
+		action(i)
+	})
+
+	pages.AddPage(dialogKey, modal, false, false)
+	pages.ShowPage(dialogKey)
+}
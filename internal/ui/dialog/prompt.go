@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 )
@@ -25,7 +26,7 @@ func ShowPrompt(styles config.Dialog, pages *ui.Pages, title, msg string, action
 
 	ctx, cancelCtx := context.WithCancel(context.Background())
 
-	f.AddButton("Cancel", func() {
+	f.AddButton(i18n.T("Cancel"), func() {
 		dismiss(pages)
 		cancelCtx()
 		cancel()
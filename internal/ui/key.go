@@ -13,6 +13,9 @@ func initKeys() {
 	tcell.KeyNames[KeyHelp] = "?"
 	tcell.KeyNames[KeySlash] = "/"
 	tcell.KeyNames[KeySpace] = "space"
+	tcell.KeyNames[KeyLeftBracket] = "["
+	tcell.KeyNames[KeyRightBracket] = "]"
+	tcell.KeyNames[KeyPipe] = "|"
 
 	initNumbKeys()
 	initStdKeys()
@@ -80,6 +83,10 @@ const (
 	KeySlash = 47
 	KeyColon = 58
 	KeySpace = 32
+
+	KeyLeftBracket  = 91
+	KeyRightBracket = 93
+	KeyPipe         = 124
 )
 
 // Define Shift Keys.
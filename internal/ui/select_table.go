@@ -138,6 +138,11 @@ func (s *SelectTable) ClearMarks() {
 	}
 }
 
+// HasMarks checks if any rows are marked.
+func (s *SelectTable) HasMarks() bool {
+	return len(s.marks) > 0
+}
+
 // DeleteMark delete a marked item.
 func (s *SelectTable) DeleteMark(k string) {
 	delete(s.marks, k)
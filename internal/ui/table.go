@@ -7,12 +7,14 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/redact"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/vul"
 	"github.com/derailed/tcell/v2"
@@ -20,6 +22,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 const maxTruncate = 50
@@ -42,6 +45,7 @@ type Table struct {
 	manualSort bool
 	Path       string
 	Extras     string
+	Alias      string
 	*SelectTable
 	actions     *KeyActions
 	cmdBuff     *model.FishBuff
@@ -186,6 +190,11 @@ func (t *Table) ToggleWide() {
 	t.Refresh()
 }
 
+// IsWide returns true if wide columns are displayed.
+func (t *Table) IsWide() bool {
+	return t.wide
+}
+
 // Actions returns active menu bindings.
 func (t *Table) Actions() *KeyActions {
 	return t.actions
@@ -347,6 +356,7 @@ func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads M
 			continue
 		}
 
+		field = redact.Field(t.GVR().String(), h[c].Name, field)
 		if !re.Deltas.IsBlank() && !h.IsTimeCol(c) {
 			field += Deltas(re.Deltas[c], field)
 		}
@@ -481,7 +491,11 @@ func (t *Table) styleTitle() string {
 		rc--
 	}
 
-	base := cases.Title(language.Und, cases.NoLower).String(t.gvr.R())
+	res := t.gvr.R()
+	if t.Alias != "" {
+		res = t.Alias
+	}
+	base := cases.Title(language.Und, cases.NoLower).String(res)
 	ns := t.GetModel().GetNamespace()
 	if client.IsClusterWide(ns) || ns == client.NotNamespaced {
 		ns = client.NamespaceAll
@@ -505,6 +519,17 @@ func (t *Table) styleTitle() string {
 		title = SkinTitle(fmt.Sprintf(NSTitleFmt, base, ns, render.AsThousands(rc)), t.styles.Frame())
 	}
 
+	if s, ok := t.GetModel().(Staler); ok {
+		if since := s.StaleSince(); !since.IsZero() {
+			title += SkinTitle(fmt.Sprintf(StaleFmt, duration.HumanDuration(time.Since(since))), t.styles.Frame())
+		}
+	}
+	if r, ok := t.GetModel().(Rewinder); ok {
+		if at, ok := r.HistoryAt(); ok {
+			title += SkinTitle(fmt.Sprintf(HistoryFmt, at.Format(time.TimeOnly), duration.HumanDuration(time.Since(at))), t.styles.Frame())
+		}
+	}
+
 	buff := t.cmdBuff.GetText()
 	if internal.IsLabelSelector(buff) {
 		buff = render.Truncate(TrimLabelSelector(buff), maxTruncate)
@@ -20,6 +20,12 @@ const (
 	// SearchFmt represents a filter view title.
 	SearchFmt = "<[filter:bg:r]/%s[fg:bg:-]> "
 
+	// StaleFmt represents a stale data view title.
+	StaleFmt = "<[red::b]data as of %s ago[fg:bg:-]> "
+
+	// HistoryFmt represents a historical view title.
+	HistoryFmt = "<[orange::b]historical view %s (%s ago)[fg:bg:-]> "
+
 	// NSTitleFmt represents a namespaced view title.
 	NSTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-][[count:bg:b]%s[fg:bg:-]][fg:bg:-] "
 
@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build !(aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris || zos)
+
+package ui
+
+import (
+	"errors"
+
+	"github.com/derailed/tcell/v2"
+)
+
+func newTty() (tcell.Tty, error) {
+	return nil, errors.New("no tty available on this platform")
+}
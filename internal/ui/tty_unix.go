@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris || zos
+
+package ui
+
+import "github.com/derailed/tcell/v2"
+
+func newTty() (tcell.Tty, error) {
+	return tcell.NewDevTty()
+}
@@ -35,6 +35,24 @@ type Lister interface {
 	Get(ctx context.Context, path string) (runtime.Object, error)
 }
 
+// Staler represents a model whose data can go stale.
+type Staler interface {
+	// StaleSince returns when the data went stale if any.
+	StaleSince() time.Time
+}
+
+// Rewinder represents a model able to replay past states.
+type Rewinder interface {
+	// Rewind replays the state a given duration back in time.
+	Rewind(time.Duration) bool
+
+	// FastForward replays the state a given duration forward in time.
+	FastForward(time.Duration) bool
+
+	// HistoryAt returns the time of the replayed state if not live.
+	HistoryAt() (time.Time, bool)
+}
+
 // Tabular represents a tabular model.
 type Tabular interface {
 	Namespaceable
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// APIPath presents the API server paths.
+type APIPath struct {
+	ResourceViewer
+
+	prefix string
+}
+
+// NewAPIPath returns a new viewer.
+func NewAPIPath(gvr client.GVR) ResourceViewer {
+	return newAPIPath(gvr, "")
+}
+
+func newAPIPath(gvr client.GVR, prefix string) *APIPath {
+	a := APIPath{
+		ResourceViewer: NewBrowser(gvr),
+		prefix:         prefix,
+	}
+	a.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	a.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	a.GetTable().SetSortCol("PATH", true)
+	a.GetTable().SetEnterFn(a.open)
+	a.AddBindKeysFn(a.bindKeys)
+	a.SetContextFn(a.apiContext)
+
+	return &a
+}
+
+// Init initializes the view.
+func (a *APIPath) Init(ctx context.Context) error {
+	if err := a.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	a.GetTable().GetModel().SetNamespace(client.NotNamespaced)
+
+	return nil
+}
+
+func (a *APIPath) apiContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyAPIPath, a.prefix)
+}
+
+func (a *APIPath) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlD, ui.KeyE)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyY:      ui.NewKeyAction("Get", a.getCmd, true),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Path", a.GetTable().SortColCmd("PATH", true), false),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", a.GetTable().SortColCmd("KIND", true), false),
+	})
+}
+
+// open browses a group version resources or gets any other path.
+func (a *APIPath) open(app *App, _ ui.Tabular, gvr client.GVR, path string) {
+	if _, ok := dao.GroupVersionPath(path); ok {
+		if err := app.inject(newAPIPath(gvr, path), false); err != nil {
+			app.Flash().Err(err)
+		}
+		return
+	}
+	showAPIGet(app, "API", path)
+}
+
+func (a *APIPath) getCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := a.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showAPIGet(a.App(), "API", path)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// showAPIGet issues a GET request off the UI goroutine and shows the
+// response once it lands.
+func showAPIGet(app *App, title, path string) {
+	var acc dao.APIPath
+	acc.Init(app.factory, client.NewGVR("apipaths"))
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		defer cancel()
+		raw, err := acc.Raw(ctx, path)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			details := NewDetails(app, title, path, contentJSON, true).Update(string(raw))
+			if err := app.inject(details, false); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+	}()
+}
@@ -20,7 +20,10 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/redact"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/view/cmd"
@@ -52,7 +55,19 @@ type App struct {
 	clusterModel  *model.ClusterInfo
 	cmdHistory    *model.History
 	filterHistory *model.History
+	alarms        *model.Alerts
+	notifier      *model.Notifier
+	anomalies     *model.AnomalyDetector
+	pulseAlarms   *model.PulseAlarms
+	bell          int32
 	conRetry      int32
+	loggingIn     int32
+	loginAfter    int64
+	loginBackOff  int64
+	locked        int32
+	lastActive    int64
+	touring       bool
+	openFiles     openFiles
 	showHeader    bool
 	showLogo      bool
 	showCrumbs    bool
@@ -64,12 +79,14 @@ func NewApp(cfg *config.Config) *App {
 		App:           ui.NewApp(cfg, cfg.K9s.ActiveContextName()),
 		cmdHistory:    model.NewHistory(model.MaxHistory),
 		filterHistory: model.NewHistory(model.MaxHistory),
+		alarms:        model.NewAlerts(model.MaxAlerts),
 		Content:       NewPageStack(),
 	}
 	a.ReloadStyles()
 
 	a.Views()["statusIndicator"] = ui.NewStatusIndicator(a.App, a.Styles)
 	a.Views()["clusterInfo"] = NewClusterInfo(&a)
+	a.Views()["statusBar"] = NewStatusBar(&a)
 
 	return &a
 }
@@ -103,6 +120,13 @@ func (a *App) Init(version string, rate int) error {
 	a.Content.Stack.AddListener(a.Menu())
 
 	a.App.Init()
+	a.SetAfterDrawFunc(a.ringBell)
+	a.touch()
+	setClipboardMode(a.Config.K9s.Clipboard)
+	redact.Set(a.Config.K9s.UI.Redact)
+	if err := i18n.SetLocale(a.Config.K9s.UI.Locale, config.AppLocalesDir); err != nil {
+		log.Warn().Err(err).Msg("Locale load failed")
+	}
 	a.SetInputCapture(a.keyboard)
 	a.bindKeys()
 	if a.Conn() == nil {
@@ -111,8 +135,14 @@ func (a *App) Init(version string, rate int) error {
 	ns := a.Config.ActiveNamespace()
 
 	a.factory = watch.NewFactory(a.Conn())
+	a.applyRateLimit()
+	a.applyLowBandwidth()
 	a.initFactory(ns)
 
+	if a.Config.K9s.Notifications.IsEnabled() {
+		a.notifier = model.NewNotifier(a.factory, a.Config.K9s.Notifications)
+	}
+
 	a.clusterModel = model.NewClusterInfo(a.factory, a.version, a.Config.K9s)
 	a.clusterModel.AddListener(a.clusterInfo())
 	a.clusterModel.AddListener(a.statusIndicator())
@@ -168,6 +198,8 @@ func (a *App) layout(ctx context.Context) {
 	a.Main.AddPage("main", main, true, false)
 	a.Main.AddPage("splash", ui.NewSplash(a.Styles, a.version), true, true)
 	a.toggleHeader(!a.Config.K9s.IsHeadless(), !a.Config.K9s.IsLogoless())
+	a.statusBar().Init(ctx)
+	a.toggleStatusBar()
 }
 
 func (a *App) initSignals() {
@@ -231,6 +263,10 @@ func (a *App) contextNames() ([]string, error) {
 }
 
 func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	a.touch()
+	if a.isLocked() || a.touring {
+		return evt
+	}
 	if k, ok := a.HasAction(ui.AsKey(evt)); ok && !a.Content.IsTopDialog() {
 		return k.Action(evt)
 	}
@@ -287,11 +323,43 @@ func (a *App) toggleCrumbs(flag bool) {
 		if _, ok := flex.ItemAt(2).(*ui.Crumbs); !ok {
 			flex.AddItemAtIndex(2, a.Crumbs(), 1, 1, false)
 		}
-	} else {
+	} else if _, ok := flex.ItemAt(2).(*ui.Crumbs); ok {
 		flex.RemoveItemAtIndex(2)
 	}
 }
 
+// toggleStatusBar shows the status bar when widgets are configured or low
+// bandwidth mode is on and hides it otherwise.
+func (a *App) toggleStatusBar() {
+	flex, ok := a.Main.GetPrimitive("main").(*tview.Flex)
+	if !ok {
+		log.Fatal().Msg("Expecting valid flex view")
+	}
+	idx, n := -1, 0
+	for ; flex.ItemAt(n) != nil; n++ {
+		if _, ok := flex.ItemAt(n).(*StatusBar); ok {
+			idx = n
+		}
+	}
+
+	ww := a.Config.K9s.StatusBar.Widgets
+	if len(ww) == 0 && a.Config.LowBandwidth().IsEnabled() {
+		ww = []config.Widget{{Kind: config.WidgetBandwidth}}
+	}
+	if len(ww) == 0 {
+		a.statusBar().Stop()
+		if idx >= 0 {
+			flex.RemoveItemAtIndex(idx)
+		}
+		return
+	}
+	// The flash bar always comes last.
+	if idx < 0 {
+		flex.AddItemAtIndex(n-1, a.statusBar(), 1, 1, false)
+	}
+	a.statusBar().Watch(ww)
+}
+
 func (a *App) buildHeader() tview.Primitive {
 	header := tview.NewFlex()
 	header.SetBackgroundColor(a.Styles.BgColor())
@@ -334,6 +402,19 @@ func (a *App) Resume() {
 	ctx, a.cancelFn = context.WithCancel(context.Background())
 
 	go a.clusterUpdater(ctx)
+	if a.notifier != nil {
+		go a.notifier.Watch(ctx)
+	}
+	if a.Config.K9s.IdleLock.IsEnabled() {
+		a.touch()
+		go a.idleWatcher(ctx)
+	}
+	if a.Config.K9s.Anomalies.Enable {
+		a.watchAnomalies(ctx)
+	}
+	if len(a.Config.K9s.PulseAlarms) > 0 {
+		a.watchPulseAlarms(ctx)
+	}
 
 	if a.Config.K9s.UI.Reactive {
 		if err := a.ConfigWatcher(ctx, a); err != nil {
@@ -348,6 +429,40 @@ func (a *App) Resume() {
 	}
 }
 
+// watchAnomalies learns the current context namespaces activity baselines.
+func (a *App) watchAnomalies(ctx context.Context) {
+	path, err := a.Config.ContextBaselinesFile()
+	if err != nil {
+		log.Warn().Err(err).Msg("Anomaly baselines file lookup failed")
+		return
+	}
+	a.anomalies = model.NewAnomalyDetector(a.factory, a.Config.K9s.Anomalies, path)
+	go a.anomalies.Watch(ctx)
+}
+
+// watchPulseAlarms evaluates pulse alarms regardless of the active view.
+func (a *App) watchPulseAlarms(ctx context.Context) {
+	a.pulseAlarms = model.NewPulseAlarms(a.Config.K9s.PulseAlarms, a.alarms)
+	a.pulseAlarms.AddListener(a)
+	go a.pulseAlarms.Watch(context.WithValue(ctx, internal.KeyFactory, a.factory))
+}
+
+// PulseAlarmTripped notifies a pulse alarm was raised.
+func (a *App) PulseAlarmTripped(_ string, mm []string, bell bool) {
+	if bell {
+		atomic.StoreInt32(&a.bell, 1)
+	}
+	a.QueueUpdateDraw(func() {
+		a.Flash().Warnf("Pulse alarm: %s", strings.Join(mm, ", "))
+	})
+}
+
+func (a *App) ringBell(sc tcell.Screen) {
+	if atomic.CompareAndSwapInt32(&a.bell, 1, 0) {
+		_ = sc.Beep()
+	}
+}
+
 func (a *App) clusterUpdater(ctx context.Context) {
 	if err := a.refreshCluster(ctx); err != nil {
 		log.Error().Err(err).Msgf("Cluster updater failed!")
@@ -355,7 +470,7 @@ func (a *App) clusterUpdater(ctx context.Context) {
 	}
 
 	bf := model.NewExpBackOff(ctx, clusterRefresh, 2*time.Minute)
-	delay := clusterRefresh
+	delay := a.clusterRefreshRate()
 	for {
 		select {
 		case <-ctx.Done():
@@ -370,7 +485,7 @@ func (a *App) clusterUpdater(ctx context.Context) {
 				}
 			} else {
 				bf.Reset()
-				delay = clusterRefresh
+				delay = client.Throttle.Scale(a.clusterRefreshRate())
 			}
 		}
 	}
@@ -381,6 +496,7 @@ func (a *App) refreshCluster(context.Context) error {
 	if ok := a.Conn().CheckConnectivity(); ok {
 		if atomic.LoadInt32(&a.conRetry) > 0 {
 			atomic.StoreInt32(&a.conRetry, 0)
+			a.resetLoginBackOff()
 			a.Status(model.FlashInfo, "K8s connectivity OK")
 			if c != nil {
 				c.Start()
@@ -392,10 +508,11 @@ func (a *App) refreshCluster(context.Context) error {
 	} else if c != nil {
 		atomic.AddInt32(&a.conRetry, 1)
 		c.Stop()
+		a.promptLogin()
 	}
 
 	count, maxConnRetry := atomic.LoadInt32(&a.conRetry), int32(a.Config.K9s.MaxConnRetry)
-	if count >= maxConnRetry {
+	if count >= maxConnRetry && atomic.LoadInt32(&a.loggingIn) == 0 {
 		log.Error().Msgf("Conn check failed (%d/%d). Bailing out!", count, maxConnRetry)
 		ExitStatus = fmt.Sprintf("Lost K8s connection (%d). Bailing out!", count)
 		a.BailOut()
@@ -427,6 +544,12 @@ func (a *App) switchNS(ns string) error {
 	if ns == client.ClusterScope {
 		ns = client.BlankNamespace
 	}
+	if !dao.NSScope.CanAccessNamespace(ns) {
+		if ns == client.BlankNamespace {
+			ns = client.NamespaceAll
+		}
+		return fmt.Errorf("namespace %q is not accessible in namespace scoped mode", ns)
+	}
 	if err := a.Config.SetActiveNamespace(ns); err != nil {
 		return err
 	}
@@ -473,6 +596,9 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 		} else {
 			log.Debug().Msgf("Saved context config for: %q", name)
 		}
+		a.applyRateLimit()
+		a.applyLowBandwidth()
+		a.toggleStatusBar()
 		a.initFactory(ns)
 		if err := a.command.Reset(a.Config.ContextAliasesPath(), true); err != nil {
 			return err
@@ -488,6 +614,36 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 	return nil
 }
 
+func (a *App) applyRateLimit() {
+	rl := a.Config.RateLimit()
+	a.Conn().SetRateLimit(rl.QPS, rl.Burst)
+	a.factory.SetMaxWatches(rl.MaxWatches)
+	client.Throttle.SetAdaptive(rl.Adaptive)
+}
+
+func (a *App) applyMemoryBudget() {
+	m := a.Config.K9s.Memory
+	a.factory.SetBudget(watch.Budget{
+		MaxObjects: m.GetMaxCachedObjects(),
+		EvictAfter: a.Config.LowBandwidth().GetEvictAfter(m.GetEvictAfter()),
+	})
+}
+
+func (a *App) applyLowBandwidth() {
+	a.Conn().SetLowBandwidth(a.Config.LowBandwidth().IsEnabled())
+	a.applyMemoryBudget()
+}
+
+// clusterRefreshRate returns the cluster info refresh interval, slowed down
+// in low bandwidth mode.
+func (a *App) clusterRefreshRate() time.Duration {
+	if !a.Config.LowBandwidth().IsEnabled() {
+		return clusterRefresh
+	}
+
+	return 4 * clusterRefresh
+}
+
 func (a *App) initFactory(ns string) {
 	a.factory.Terminate()
 	a.factory.Start(ns)
@@ -510,7 +666,12 @@ func (a *App) BailOut() {
 	}
 
 	a.stopImgScanner()
+	a.openFiles.clear()
 	a.factory.Terminate()
+	// Closes any ssh bastion tunnel.
+	if err := a.Conn().SetProxy(client.ProxySpec{}); err != nil {
+		log.Error().Err(err).Msgf("closing proxy")
+	}
 	a.App.BailOut()
 }
 
@@ -525,13 +686,17 @@ func (a *App) Run() error {
 			// if command bar is already active, focus it
 			if a.CmdBuff().IsActive() {
 				a.SetFocus(a.Prompt())
+				return
 			}
+			a.firstRunTour()
 		})
 	}()
 
 	if err := a.command.defaultCmd(); err != nil {
 		return err
 	}
+	a.InitScreen()
+	clipboardTerm = a.Terminal()
 	a.SetRunning(true)
 	if err := a.Application.Run(); err != nil {
 		return err
@@ -664,7 +829,7 @@ func (a *App) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	top := a.Content.Top()
-	if top != nil && top.Name() == "help" {
+	if top != nil && top.Name() == helpTitle {
 		a.Content.Pop()
 		return nil
 	}
@@ -715,6 +880,10 @@ func (a *App) clusterInfo() *ClusterInfo {
 	return a.Views()["clusterInfo"].(*ClusterInfo)
 }
 
+func (a *App) statusBar() *StatusBar {
+	return a.Views()["statusBar"].(*StatusBar)
+}
+
 func (a *App) statusIndicator() *ui.StatusIndicator {
 	return a.Views()["statusIndicator"].(*ui.StatusIndicator)
 }
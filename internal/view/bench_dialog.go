@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+)
+
+const (
+	benchKey      = "bench"
+	benchFieldLen = 50
+)
+
+// BenchFunc represents a benchmark dialog callback.
+type BenchFunc func(url string, cfg config.BenchConfig) error
+
+// ShowBench pops a benchmark run configuration dialog.
+func ShowBench(v ResourceViewer, path, u string, cfg config.BenchConfig, okFn BenchFunc) {
+	f := newStyledForm(v.App().Styles.Dialog())
+
+	if cfg.HTTP.Method == "" {
+		cfg.HTTP.Method = config.DefaultMethod
+	}
+	f.AddInputField("URL:", u, benchFieldLen, nil, func(s string) {
+		u = s
+	})
+	if u == "" {
+		f.GetFormItemByLabel("URL:").(*tview.InputField).SetPlaceholder("Enter a url ie http://host:port/path")
+	}
+	f.AddInputField("Concurrency:", fmt.Sprintf("%d", cfg.C), benchFieldLen, nil, func(s string) {
+		c, err := asIntOpt(s)
+		if err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		v.App().Flash().Clear()
+		cfg.C = c
+	})
+	f.AddInputField("Requests:", fmt.Sprintf("%d", cfg.N), benchFieldLen, nil, func(s string) {
+		n, err := asIntOpt(s)
+		if err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		v.App().Flash().Clear()
+		cfg.N = n
+	})
+	f.AddInputField("Duration:", benchDuration(cfg), benchFieldLen, nil, func(s string) {
+		if s == "" {
+			cfg.Duration = 0
+			return
+		}
+		d, err := asDurOpt(s)
+		if err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		v.App().Flash().Clear()
+		cfg.Duration = d
+	})
+	f.GetFormItemByLabel("Duration:").(*tview.InputField).SetPlaceholder("ie 30s. Overrides requests")
+	f.AddInputField("Method:", cfg.HTTP.Method, benchFieldLen, nil, func(s string) {
+		cfg.HTTP.Method = strings.ToUpper(s)
+	})
+	f.AddInputField("Headers:", formatHeaders(cfg.HTTP.Headers), benchFieldLen, nil, func(s string) {
+		cfg.HTTP.Headers = parseHeaders(s)
+	})
+	f.GetFormItemByLabel("Headers:").(*tview.InputField).SetPlaceholder("ie Accept:text/html;X-Fred:blee")
+	f.AddInputField("Body:", cfg.HTTP.Body, benchFieldLen, nil, func(s string) {
+		cfg.HTTP.Body = s
+	})
+	f.AddCheckbox("HTTP2:", cfg.HTTP.HTTP2, func(_ string, b bool) {
+		cfg.HTTP.HTTP2 = b
+	})
+
+	f.AddButton("OK", func() {
+		if err := checkBench(u, cfg); err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		dismissModalForm(v.App(), benchKey)
+		if err := okFn(u, cfg); err != nil {
+			v.App().Flash().Errf("Benchmark failed %v", err)
+		}
+	})
+	f.AddButton("Cancel", func() {
+		dismissModalForm(v.App(), benchKey)
+	})
+
+	showModalForm(v.App(), benchKey, "<Benchmark>", path, f)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func benchDuration(cfg config.BenchConfig) string {
+	if cfg.Duration == 0 {
+		return ""
+	}
+
+	return cfg.Duration.String()
+}
+
+func checkBench(s string, cfg config.BenchConfig) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("invalid benchmark url %q", s)
+	}
+	if cfg.C <= 0 {
+		return fmt.Errorf("concurrency must be greater than 0")
+	}
+	if cfg.Duration == 0 && cfg.N < cfg.C {
+		return fmt.Errorf("requests (%d) must be greater than concurrency (%d)", cfg.N, cfg.C)
+	}
+
+	return nil
+}
+
+func formatHeaders(hh http.Header) string {
+	kk := make([]string, 0, len(hh))
+	for k := range hh {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	ss := make([]string, 0, len(kk))
+	for _, k := range kk {
+		for _, v := range hh[k] {
+			ss = append(ss, k+":"+v)
+		}
+	}
+
+	return strings.Join(ss, ";")
+}
+
+func parseHeaders(s string) http.Header {
+	hh := make(http.Header)
+	for _, h := range strings.Split(s, ";") {
+		k, v, ok := strings.Cut(h, ":")
+		if !ok || strings.TrimSpace(k) == "" {
+			continue
+		}
+		hh.Add(strings.TrimSpace(k), strings.TrimSpace(v))
+	}
+	if len(hh) == 0 {
+		return nil
+	}
+
+	return hh
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckBench(t *testing.T) {
+	uu := map[string]struct {
+		url string
+		cfg config.BenchConfig
+		err bool
+	}{
+		"happy": {
+			url: "http://localhost:8080/",
+			cfg: config.BenchConfig{C: 1, N: 200},
+		},
+		"duration": {
+			url: "https://fred.com",
+			cfg: config.BenchConfig{C: 10, Duration: 10 * time.Second},
+		},
+		"no-scheme": {
+			url: "localhost:8080",
+			cfg: config.BenchConfig{C: 1, N: 200},
+			err: true,
+		},
+		"no-host": {
+			url: "http:///blee",
+			cfg: config.BenchConfig{C: 1, N: 200},
+			err: true,
+		},
+		"no-concurrency": {
+			url: "http://localhost:8080/",
+			cfg: config.BenchConfig{N: 200},
+			err: true,
+		},
+		"too-few-requests": {
+			url: "http://localhost:8080/",
+			cfg: config.BenchConfig{C: 10, N: 2},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := checkBench(u.url, u.cfg)
+			assert.Equal(t, u.err, err != nil)
+		})
+	}
+}
+
+func TestBenchHeaders(t *testing.T) {
+	uu := map[string]struct {
+		s  string
+		hh http.Header
+		e  string
+	}{
+		"empty": {},
+		"single": {
+			s:  "Accept:text/html",
+			hh: http.Header{"Accept": []string{"text/html"}},
+			e:  "Accept:text/html",
+		},
+		"multi": {
+			s:  " x-fred: blee ;Accept:text/html;toast",
+			hh: http.Header{"Accept": []string{"text/html"}, "X-Fred": []string{"blee"}},
+			e:  "Accept:text/html;X-Fred:blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			hh := parseHeaders(u.s)
+			assert.Equal(t, u.hh, hh)
+			assert.Equal(t, u.e, formatHeaders(hh))
+		})
+	}
+}
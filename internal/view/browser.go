@@ -6,6 +6,7 @@ package view
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -13,11 +14,13 @@ import (
 	"time"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/assist"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/redact"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
@@ -75,7 +78,7 @@ func (b *Browser) Init(ctx context.Context) error {
 	}
 	ns := client.CleanseNamespace(b.app.Config.ActiveNamespace())
 	if dao.IsK8sMeta(b.meta) && b.app.ConOK() {
-		if _, e := b.app.factory.CanForResource(ns, b.GVR().String(), client.ListAccess); e != nil {
+		if e := canList(b.app.factory, ns, b.GVR().String()); e != nil {
 			return e
 		}
 	}
@@ -318,6 +321,154 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) cpYAMLCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	raw, err := model.NewYAML(b.GVR(), path).ToYAML(b.defaultContext(), b.GVR(), path, false)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	if err := clipboardWrite(redact.Manifest(raw)); err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	b.app.Flash().Info("Resource YAML copied to clipboard...")
+
+	return nil
+}
+
+// kustomizeCmd exports the marked resources or all the filtered ones as a
+// kustomize base and an overlay named after the active context.
+func (b *Browser) kustomizeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := b.GetSelectedItems()
+	if !b.HasMarks() {
+		paths = make([]string, 0, b.GetFilteredData().RowCount())
+		b.GetFilteredData().RowsRange(func(_ int, re model1.RowEvent) bool {
+			paths = append(paths, re.Row.ID)
+			return true
+		})
+	}
+	if len(paths) == 0 {
+		return evt
+	}
+
+	x := b.app.Config.K9s.Export
+	dir := filepath.Join(b.app.Config.K9s.ContextScreenDumpDir(), "kustomize", fmt.Sprintf("%s-%d", b.GVR().R(), time.Now().Unix()))
+	overlay := data.SanitizeFileName(b.app.Config.ActiveContextName())
+	if err := dao.KustomizeExport(b.app.factory, b.GVR(), paths, dao.NewExportRules(x.Strip, x.Keep), dir, overlay); err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	b.app.Flash().Infof("Exported %d %s to %s", len(paths), b.GVR().R(), dir)
+
+	return nil
+}
+
+// runbookCmd shows the runbooks attached to the selected resource.
+func (b *Browser) runbookCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	app, gvr, rr := b.app, b.GVR(), b.app.Config.K9s.Runbooks.For(b.GVR().String())
+	go func() {
+		s, err := dao.ResourceRunbooks(context.Background(), app.factory, gvr, path, rr)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			details := NewDetails(app, "Runbook", path, contentTXT, true).Update(s)
+			if err := app.inject(details, false); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+// assistCmd collects the selected resource manifest, events and recent logs
+// and, once the user confirmed what is sent, asks the configured LLM endpoint
+// for a diagnosis.
+func (b *Browser) assistCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	cfg := b.app.Config.K9s.Assist
+	a, err := assist.NewAsker(cfg)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+
+	app, gvr := b.app, b.GVR()
+	app.Flash().Infof("Collecting %s diagnostics...", path)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		s, err := dao.AssistSubject(ctx, app.factory, gvr, path, cfg.GetLogLines())
+		cancel()
+		var p string
+		if err == nil {
+			p, err = assist.Prompt(cfg, s)
+		}
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			app.Flash().Clear()
+			dialog.ShowConfirm(app.Styles.Dialog(), app.Content.Pages, "Confirm Assist", assist.Disclosure(cfg, s), func() {
+				b.ask(a, cfg.URL, path, p)
+			}, func() {})
+		})
+	}()
+
+	return nil
+}
+
+// ask sends a prompt to the assistant and shows its response.
+func (b *Browser) ask(a assist.Asker, url, path, prompt string) {
+	details := NewDetails(b.app, "Assist", path, contentTXT, true).Update("Asking " + url + "...")
+	details.Actions().Add(ui.KeyP, ui.NewKeyAction("Show Prompt", func(*tcell.EventKey) *tcell.EventKey {
+		details.Update(prompt)
+		return nil
+	}, true))
+	if err := b.app.inject(details, false); err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+
+	app := b.app
+	go func() {
+		resp, err := a.Ask(context.Background(), prompt)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				details.Update(err.Error())
+				return
+			}
+			details.Update(resp)
+		})
+	}()
+}
+
+func (b *Browser) cpTableCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if err := clipboardWrite(tableTSV(b.GetTable().Table)); err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	b.app.Flash().Info("Table copied to clipboard...")
+
+	return nil
+}
+
 func (b *Browser) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if b.CmdBuff().InCmdMode() {
 		return nil
@@ -376,6 +527,33 @@ func (b *Browser) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) rewindCmd(evt *tcell.EventKey) *tcell.EventKey {
+	r, ok := b.GetModel().(ui.Rewinder)
+	if !ok {
+		return evt
+	}
+	if !r.Rewind(model.RewindStep) {
+		b.app.Flash().Warn("No older history available")
+	}
+
+	return nil
+}
+
+func (b *Browser) forwardCmd(evt *tcell.EventKey) *tcell.EventKey {
+	r, ok := b.GetModel().(ui.Rewinder)
+	if !ok {
+		return evt
+	}
+	if !r.FastForward(model.RewindStep) {
+		return nil
+	}
+	if _, ok := r.HistoryAt(); !ok {
+		b.app.Flash().Info("Back to live view")
+	}
+
+	return nil
+}
+
 func (b *Browser) refreshCmd(*tcell.EventKey) *tcell.EventKey {
 	b.app.Flash().Info("Refreshing...")
 	b.refresh()
@@ -389,23 +567,39 @@ func (b *Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	b.Stop()
-	defer b.Start()
-	{
-		msg := fmt.Sprintf("Delete %s %s?", b.GVR().R(), selections[0])
-		if len(selections) > 1 {
-			msg = fmt.Sprintf("Delete %d marked %s?", len(selections), b.GVR())
-		}
-		if !dao.IsK8sMeta(b.meta) {
-			b.simpleDelete(selections, msg)
-			return nil
-		}
-		b.resourceDelete(selections, msg)
+	msg := fmt.Sprintf("Delete %s %s?", b.GVR().R(), selections[0])
+	if len(selections) > 1 {
+		msg = fmt.Sprintf("Delete %d marked %s?", len(selections), b.GVR())
+	}
+	w, ok := b.accessor.(dao.DeleteWarner)
+	if !ok {
+		b.showDelete(selections, msg)
+		return nil
 	}
+	go func() {
+		for _, sel := range selections {
+			if warn := w.DeleteWarning(sel); warn != "" {
+				msg += "\n\n" + warn
+			}
+		}
+		b.app.QueueUpdateDraw(func() {
+			b.showDelete(selections, msg)
+		})
+	}()
 
 	return nil
 }
 
+func (b *Browser) showDelete(selections []string, msg string) {
+	b.Stop()
+	defer b.Start()
+	if !dao.IsK8sMeta(b.meta) {
+		b.simpleDelete(selections, msg)
+		return
+	}
+	b.resourceDelete(selections, msg)
+}
+
 func (b *Browser) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := b.GetSelectedItem()
 	if path == "" {
@@ -452,6 +646,9 @@ func editRes(app *App, gvr client.GVR, path string) error {
 	if ns != client.BlankNamespace {
 		args = append(args, "-n", ns)
 	}
+	if sr := gvr.SubResource(); sr != "" {
+		args = append(args, "--subresource", sr)
+	}
 	if err := runK(app, shellOpts{clear: true, args: args}); err != nil {
 		app.Flash().Errf("Edit command failed: %s", err)
 	}
@@ -485,7 +682,7 @@ func (b *Browser) switchNamespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 	b.setNamespace(ns)
-	b.app.Flash().Infof("Viewing namespace `%s`...", ns)
+	b.app.Flash().Infof("Viewing %s `%s`...", dao.NSTerm(), ns)
 	b.refresh()
 	b.UpdateTitle()
 	b.SelectRow(1, 0, true)
@@ -533,22 +730,32 @@ func (b *Browser) refreshActions() {
 		tcell.KeyEnter: ui.NewKeyAction("View", b.enterCmd, false),
 		tcell.KeyCtrlR: ui.NewKeyAction("Refresh", b.refreshCmd, false),
 	})
+	if _, ok := b.GetModel().(ui.Rewinder); ok {
+		aa.Add(ui.KeyLeftBracket, ui.NewKeyAction("Rewind", b.rewindCmd, true))
+		aa.Add(ui.KeyRightBracket, ui.NewKeyAction("Forward", b.forwardCmd, false))
+	}
 
 	if b.app.ConOK() {
 		b.namespaceActions(aa)
 		if !b.app.Config.K9s.IsReadOnly() {
-			if client.Can(b.meta.Verbs, "edit") {
-				aa.Add(ui.KeyE, ui.NewKeyActionWithOpts("Edit", b.editCmd,
-					ui.ActionOpts{
-						Visible:   true,
-						Dangerous: true,
-					}))
-			}
-			if client.Can(b.meta.Verbs, "delete") {
-				aa.Add(tcell.KeyCtrlD, ui.NewKeyActionWithOpts("Delete", b.deleteCmd,
+			aa.Add(ui.KeyE, ui.NewKeyActionWithOpts("Edit", b.editCmd,
+				ui.ActionOpts{
+					Visible:   true,
+					Dangerous: true,
+					Verbs:     []string{client.PatchVerb, client.UpdateVerb},
+					AnyVerbs:  true,
+				}))
+			aa.Add(tcell.KeyCtrlD, ui.NewKeyActionWithOpts("Delete", b.deleteCmd,
+				ui.ActionOpts{
+					Visible:   true,
+					Dangerous: true,
+					Verbs:     []string{client.DeleteVerb},
+				}))
+			if !dao.IsK9sMeta(b.meta) {
+				aa.Add(tcell.KeyCtrlN, ui.NewKeyActionWithOpts("Copy To", b.copyToCmd,
 					ui.ActionOpts{
-						Visible:   true,
 						Dangerous: true,
+						Verbs:     client.GetAccess,
 					}))
 			}
 		} else {
@@ -556,13 +763,30 @@ func (b *Browser) refreshActions() {
 		}
 	}
 	if !dao.IsK9sMeta(b.meta) {
-		aa.Add(ui.KeyY, ui.NewKeyAction(yamlAction, b.viewCmd, true))
-		aa.Add(ui.KeyD, ui.NewKeyAction("Describe", b.describeCmd, true))
+		aa.Add(ui.KeyY, ui.NewKeyActionWithOpts(yamlAction, b.viewCmd,
+			ui.ActionOpts{Visible: true, Verbs: client.GetAccess}))
+		aa.Add(ui.KeyD, ui.NewKeyActionWithOpts("Describe", b.describeCmd,
+			ui.ActionOpts{Visible: true, Verbs: client.GetAccess}))
+		aa.Add(tcell.KeyCtrlY, ui.NewKeyActionWithOpts("Copy YAML", b.cpYAMLCmd,
+			ui.ActionOpts{Verbs: client.GetAccess}))
+		// ctrl-b pages up tables so the export sits on ctrl-].
+		aa.Add(tcell.KeyCtrlRightSq, ui.NewKeyActionWithOpts("Kustomize Export", b.kustomizeCmd,
+			ui.ActionOpts{Verbs: client.GetAccess}))
+		if len(b.app.Config.K9s.Runbooks.For(b.GVR().String())) > 0 {
+			aa.Add(tcell.KeyCtrlV, ui.NewKeyActionWithOpts("Runbook", b.runbookCmd,
+				ui.ActionOpts{Visible: true, Verbs: client.GetAccess}))
+		}
+		if b.app.Config.K9s.Assist.IsEnabled() {
+			aa.Add(ui.KeyShiftQ, ui.NewKeyActionWithOpts("Assist", b.assistCmd,
+				ui.ActionOpts{Visible: true, Verbs: client.GetAccess}))
+		}
 	}
+	aa.Add(tcell.KeyCtrlT, ui.NewKeyAction("Copy Table", b.cpTableCmd, false))
 	for _, f := range b.bindKeysFn {
 		f(aa)
 	}
 	b.Actions().Merge(aa)
+	b.pruneActions(b.Actions())
 
 	if err := pluginActions(b, b.Actions()); err != nil {
 		log.Warn().Msgf("Plugins load failed: %s", err)
@@ -572,9 +796,54 @@ func (b *Browser) refreshActions() {
 		log.Warn().Msgf("Hotkeys load failed: %s", err)
 		b.app.Logo().Warn("HotKeys load failed!")
 	}
+	if err := quickActions(b, b.Actions()); err != nil {
+		log.Warn().Msgf("Quick actions load failed: %s", err)
+		b.app.Logo().Warn("Quick actions load failed!")
+	}
+	if err := openerActions(b, b.Actions()); err != nil {
+		log.Warn().Msgf("Openers load failed: %s", err)
+		b.app.Logo().Warn("Openers load failed!")
+	}
 	b.app.Menu().HydrateMenu(b.Hints())
 }
 
+// pruneActions drops the actions requiring verbs the viewed resource does
+// not support ie watch-only or aggregated APIs, or the user is not granted.
+func (b *Browser) pruneActions(aa *ui.KeyActions) {
+	aa.Prune(func(a ui.KeyAction) bool {
+		if len(a.Opts.Verbs) == 0 {
+			return false
+		}
+		if !a.Opts.AnyVerbs {
+			return !b.allowed(a.Opts.Verbs)
+		}
+		for _, v := range a.Opts.Verbs {
+			if b.allowed([]string{v}) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// allowed checks the viewed resource supports all the given verbs and the
+// user is granted them.
+func (b *Browser) allowed(verbs []string) bool {
+	return client.HasVerbs(b.meta.Verbs, verbs) && b.canI(verbs)
+}
+
+// canI checks the user RBAC grants on the viewed resource when menus
+// trimming is on. Otherwise actions are shown and fail on use if denied.
+func (b *Browser) canI(verbs []string) bool {
+	if !b.app.Config.K9s.TrimMenus() {
+		return true
+	}
+	ok, _ := b.app.factory.Client().CanI(b.GetModel().GetNamespace(), b.GVR().String(), "", verbs)
+
+	return ok
+}
+
 func (b *Browser) namespaceActions(aa *ui.KeyActions) {
 	if !b.meta.Namespaced || b.GetTable().Path != "" {
 		return
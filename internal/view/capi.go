@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	capiMachineDeploymentGVR = "cluster.x-k8s.io/v1beta1/machinedeployments"
+	capiMachineSetGVR        = "cluster.x-k8s.io/v1beta1/machinesets"
+	capiMachineGVR           = "cluster.x-k8s.io/v1beta1/machines"
+)
+
+// CAPICluster represents a Cluster API cluster viewer.
+type CAPICluster struct {
+	ResourceViewer
+}
+
+// NewCAPICluster returns a new viewer.
+func NewCAPICluster(gvr client.GVR) ResourceViewer {
+	c := CAPICluster{
+		ResourceViewer: NewOwnerExtender(NewBrowser(gvr)),
+	}
+	c.GetTable().SetEnterFn(c.showDeployments)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *CAPICluster) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftM: ui.NewKeyAction("Machines", c.machinesCmd, true),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Phase", c.GetTable().SortColCmd("PHASE", true), false),
+	})
+}
+
+func (c *CAPICluster) showDeployments(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	showCAPIChildren(app, capiMachineDeploymentGVR, path, render.CAPIClusterLabel)
+}
+
+func (c *CAPICluster) machinesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showCAPIChildren(c.App(), capiMachineGVR, path, render.CAPIClusterLabel)
+
+	return nil
+}
+
+// MachineDeployment represents a Cluster API machine deployment viewer.
+type MachineDeployment struct {
+	ResourceViewer
+}
+
+// NewMachineDeployment returns a new viewer.
+func NewMachineDeployment(gvr client.GVR) ResourceViewer {
+	d := MachineDeployment{
+		ResourceViewer: NewOwnerExtender(NewBrowser(gvr)),
+	}
+	d.GetTable().SetEnterFn(d.showMachineSets)
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+func (d *MachineDeployment) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyShiftM, ui.NewKeyAction("Machines", d.machinesCmd, true))
+}
+
+func (d *MachineDeployment) showMachineSets(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	showCAPIChildren(app, capiMachineSetGVR, path, render.CAPIDeploymentLabel)
+}
+
+func (d *MachineDeployment) machinesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := d.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showCAPIChildren(d.App(), capiMachineGVR, path, render.CAPIDeploymentLabel)
+
+	return nil
+}
+
+// MachineSet represents a Cluster API machine set viewer.
+type MachineSet struct {
+	ResourceViewer
+}
+
+// NewMachineSet returns a new viewer.
+func NewMachineSet(gvr client.GVR) ResourceViewer {
+	s := MachineSet{
+		ResourceViewer: NewOwnerExtender(NewBrowser(gvr)),
+	}
+	s.GetTable().SetEnterFn(s.showMachines)
+
+	return &s
+}
+
+func (*MachineSet) showMachines(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	showCAPIChildren(app, capiMachineGVR, path, render.CAPISetLabel)
+}
+
+// Machine represents a Cluster API machine viewer.
+type Machine struct {
+	ResourceViewer
+}
+
+// NewMachine returns a new viewer.
+func NewMachine(gvr client.GVR) ResourceViewer {
+	m := Machine{
+		ResourceViewer: NewOwnerExtender(NewBrowser(gvr)),
+	}
+	m.GetTable().SetEnterFn(m.showNode)
+	m.AddBindKeysFn(m.bindKeys)
+
+	return &m
+}
+
+func (m *Machine) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftN: ui.NewKeyAction("Sort Node", m.GetTable().SortColCmd("NODE", true), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Phase", m.GetTable().SortColCmd("PHASE", true), false),
+	})
+}
+
+// showNode jumps to the node a machine provisioned. The node must live in
+// the current cluster, ie a self-hosted management cluster.
+func (*Machine) showNode(app *App, _ ui.Tabular, gvr client.GVR, path string) {
+	o, err := app.factory.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		app.Flash().Errf("expecting unstructured but got %T", o)
+		return
+	}
+	node := render.MachineNode(u)
+	if node == "" {
+		app.Flash().Warnf("Machine %s has no node yet", path)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+	defer cancel()
+	if _, err := dao.FetchNode(ctx, app.factory, node); err != nil {
+		app.Flash().Warnf("Node %s not found in this cluster. Switch to the workload cluster context", node)
+		return
+	}
+	app.gotoResource("v1/nodes", node, false)
+}
+
+// showCAPIChildren lists the resources labeled as belonging to the given parent.
+func showCAPIChildren(app *App, gvr, path, label string) {
+	ns, n := client.Namespaced(path)
+	app.gotoResource(fmt.Sprintf("%s %s %s=%s", gvr, ns, label, n), "", false)
+}
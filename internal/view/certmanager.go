@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// Certificate represents a cert-manager certificate viewer.
+type Certificate struct {
+	ResourceViewer
+}
+
+// NewCertificate returns a new viewer.
+func NewCertificate(gvr client.GVR) ResourceViewer {
+	c := Certificate{
+		ResourceViewer: NewOwnerExtender(NewBrowser(gvr)),
+	}
+	c.GetTable().SetEnterFn(showCertManagerChildren)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *Certificate) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyT:      ui.NewKeyAction("Trace", c.traceCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd(statusCol, true), false),
+		ui.KeyShiftE: ui.NewKeyAction("Sort Expires", c.GetTable().SortColCmd("EXPIRES", true), false),
+	})
+	if c.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyR, ui.NewKeyActionWithOpts("Renew", c.renewCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+			Verbs:     []string{client.UpdateVerb},
+		}))
+}
+
+func (c *Certificate) traceCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return showCertManagerTrace(c, evt)
+}
+
+func (c *Certificate) renewCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Force renew certificate %s?", path)
+	dialog.ShowConfirm(c.App().Styles.Dialog(), c.App().Content.Pages, "Confirm Renew", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), c.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := dao.RenewCertificate(ctx, c.App().factory, path); err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		c.App().Flash().Infof("Renewal triggered for certificate %s", path)
+	}, func() {})
+
+	return nil
+}
+
+// CertificateRequest represents a cert-manager certificate request viewer.
+type CertificateRequest struct {
+	ResourceViewer
+}
+
+// NewCertificateRequest returns a new viewer.
+func NewCertificateRequest(gvr client.GVR) ResourceViewer {
+	c := CertificateRequest{
+		ResourceViewer: NewOwnerExtender(NewBrowser(gvr)),
+	}
+	c.GetTable().SetEnterFn(showCertManagerChildren)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *CertificateRequest) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyT:      ui.NewKeyAction("Trace", c.traceCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd(statusCol, true), false),
+	})
+}
+
+func (c *CertificateRequest) traceCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return showCertManagerTrace(c, evt)
+}
+
+// Order represents a cert-manager ACME order viewer.
+type Order struct {
+	ResourceViewer
+}
+
+// NewOrder returns a new viewer.
+func NewOrder(gvr client.GVR) ResourceViewer {
+	o := Order{
+		ResourceViewer: NewOwnerExtender(NewBrowser(gvr)),
+	}
+	o.GetTable().SetEnterFn(showCertManagerChildren)
+	o.AddBindKeysFn(o.bindKeys)
+
+	return &o
+}
+
+func (o *Order) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyT:      ui.NewKeyAction("Trace", o.traceCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort State", o.GetTable().SortColCmd("STATE", true), false),
+	})
+}
+
+func (o *Order) traceCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return showCertManagerTrace(o, evt)
+}
+
+// Challenge represents a cert-manager ACME challenge viewer.
+type Challenge struct {
+	ResourceViewer
+}
+
+// NewChallenge returns a new viewer.
+func NewChallenge(gvr client.GVR) ResourceViewer {
+	c := Challenge{
+		ResourceViewer: NewOwnerExtender(NewBrowser(gvr)),
+	}
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *Challenge) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftS: ui.NewKeyAction("Sort State", c.GetTable().SortColCmd("STATE", true), false),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Type", c.GetTable().SortColCmd("TYPE", true), false),
+	})
+}
+
+// Helpers...
+
+// showCertManagerChildren lists the resources spawned by a cert-manager
+// resource ie certificate -> requests -> orders -> challenges.
+func showCertManagerChildren(app *App, _ ui.Tabular, gvr client.GVR, path string) {
+	u, err := fetchUnstructured(app, gvr, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	cgvr, ok := dao.CertManagerChildGVR(u.GetKind())
+	if !ok {
+		return
+	}
+	var v ResourceViewer
+	switch cgvr {
+	case dao.CertificateRequestGVR:
+		v = NewCertificateRequest(cgvr)
+	case dao.OrderGVR:
+		v = NewOrder(cgvr)
+	default:
+		v = NewChallenge(cgvr)
+	}
+	v.SetContextFn(ownerCtx(path, string(u.GetUID())))
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func showCertManagerTrace(v ResourceViewer, evt *tcell.EventKey) *tcell.EventKey {
+	path := v.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	gvr := v.GVR()
+	showReport(v.App(), "Trace", path, func(ctx context.Context, f dao.Factory) (string, error) {
+		return dao.CertManagerTrace(ctx, f, gvr, path)
+	})
+
+	return nil
+}
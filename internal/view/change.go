@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Change presents recently changed resources.
+type Change struct {
+	ResourceViewer
+}
+
+// NewChange returns a new viewer.
+func NewChange(gvr client.GVR) ResourceViewer {
+	c := Change{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetSortCol("WHEN", true)
+	c.GetTable().SetEnterFn(c.gotoResource)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *Change) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftK: ui.NewKeyAction("Sort GVR", c.GetTable().SortColCmd("GVR", true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Change", c.GetTable().SortColCmd("CHANGE", true), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort Manager", c.GetTable().SortColCmd("MANAGER", true), false),
+		ui.KeyShiftW: ui.NewKeyAction("Sort When", c.GetTable().SortColCmd("WHEN", true), false),
+	})
+}
+
+func (c *Change) gotoResource(app *App, _ ui.Tabular, _ client.GVR, id string) {
+	gvr, path, ok := render.ParseChangeID(id)
+	if !ok {
+		app.Flash().Errf("Invalid selection %q", id)
+		return
+	}
+	app.gotoResource(gvr, path, false)
+}
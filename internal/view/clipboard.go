@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+const (
+	clipboardAuto   = "auto"
+	clipboardSystem = "system"
+	clipboardOSC52  = "osc52"
+
+	// osc52MaxLen caps the escape payload since most terminals drop larger ones.
+	osc52MaxLen = 100_000
+)
+
+var (
+	clipboardMode = clipboardAuto
+
+	// clipboardTerm tracks the terminal osc52 sequences are sent to.
+	clipboardTerm io.Writer
+)
+
+func setClipboardMode(m string) {
+	switch m {
+	case clipboardSystem, clipboardOSC52:
+		clipboardMode = m
+	default:
+		clipboardMode = clipboardAuto
+	}
+}
+
+// clipboardWrite copies text to the system clipboard. In auto mode, it falls
+// back to an OSC52 terminal sequence over ssh or when no system clipboard is
+// available so copies still reach the local terminal.
+func clipboardWrite(text string) error {
+	switch clipboardMode {
+	case clipboardSystem:
+		return clipboard.WriteAll(text)
+	case clipboardOSC52:
+		return osc52Write(clipboardTerm, text)
+	}
+
+	if os.Getenv("SSH_TTY") == "" && os.Getenv("SSH_CONNECTION") == "" {
+		if err := clipboard.WriteAll(text); err == nil {
+			return nil
+		}
+	}
+
+	return osc52Write(clipboardTerm, text)
+}
+
+func osc52Write(w io.Writer, text string) error {
+	if w == nil {
+		return errors.New("no terminal available to copy to")
+	}
+	seq, err := osc52Seq(text, os.Getenv("TMUX") != "")
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, seq)
+
+	return err
+}
+
+// osc52Seq builds a set clipboard escape sequence. Under tmux, the sequence
+// must be wrapped in a passthrough envelope to reach the outer terminal.
+func osc52Seq(text string, tmux bool) (string, error) {
+	b64 := base64.StdEncoding.EncodeToString([]byte(text))
+	if len(b64) > osc52MaxLen {
+		return "", fmt.Errorf("content too large to copy via terminal (%d bytes)", len(text))
+	}
+	seq := "\x1b]52;c;" + b64 + "\a"
+	if tmux {
+		seq = "\x1bPtmux;\x1b" + seq + "\x1b\\"
+	}
+
+	return seq, nil
+}
+
+// tableTSV renders the visible table columns as tab separated values.
+func tableTSV(t *ui.Table) string {
+	data := t.GetFilteredData()
+	h := data.Header()
+	idx := make([]int, 0, len(h))
+	hh := make([]string, 0, len(h))
+	for i, c := range h {
+		if c.Hide || (c.Wide && !t.IsWide()) {
+			continue
+		}
+		idx, hh = append(idx, i), append(hh, c.Name)
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Join(hh, "\t") + "\n")
+	data.RowsRange(func(_ int, re model1.RowEvent) bool {
+		ff := make([]string, 0, len(idx))
+		for _, i := range idx {
+			var f string
+			if i < len(re.Row.Fields) {
+				f = re.Row.Fields[i]
+			}
+			ff = append(ff, strings.NewReplacer("\t", " ", "\n", " ").Replace(f))
+		}
+		b.WriteString(strings.Join(ff, "\t") + "\n")
+		return true
+	})
+
+	return b.String()
+}
+
+// visibleLines returns the lines in view given a scroll offset and height.
+func visibleLines(ll []string, row, height int) []string {
+	if row < 0 {
+		row = 0
+	}
+	if row >= len(ll) || height <= 0 {
+		return nil
+	}
+
+	return ll[row:min(row+height, len(ll))]
+}
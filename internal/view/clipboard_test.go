@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOSC52Seq(t *testing.T) {
+	uu := map[string]struct {
+		text string
+		tmux bool
+		e    string
+	}{
+		"plain": {
+			text: "fred",
+			e:    "\x1b]52;c;ZnJlZA==\a",
+		},
+		"tmux": {
+			text: "fred",
+			tmux: true,
+			e:    "\x1bPtmux;\x1b\x1b]52;c;ZnJlZA==\a\x1b\\",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			seq, err := osc52Seq(u.text, u.tmux)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, seq)
+		})
+	}
+}
+
+func TestOSC52SeqTooLarge(t *testing.T) {
+	_, err := osc52Seq(strings.Repeat("x", osc52MaxLen), false)
+
+	assert.Error(t, err)
+}
+
+func TestOSC52Write(t *testing.T) {
+	t.Setenv("TMUX", "")
+	var b bytes.Buffer
+
+	assert.NoError(t, osc52Write(&b, "blee"))
+	assert.Equal(t, "\x1b]52;c;YmxlZQ==\a", b.String())
+}
+
+func TestOSC52WriteNoTerminal(t *testing.T) {
+	assert.Error(t, osc52Write(nil, "blee"))
+}
+
+func TestVisibleLines(t *testing.T) {
+	ll := []string{"l1", "l2", "l3", "l4"}
+	uu := map[string]struct {
+		row, height int
+		e           []string
+	}{
+		"top": {
+			height: 2,
+			e:      []string{"l1", "l2"},
+		},
+		"tail": {
+			row:    2,
+			height: 5,
+			e:      []string{"l3", "l4"},
+		},
+		"out": {
+			row:    4,
+			height: 5,
+		},
+		"empty": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, visibleLines(ll, u.row, u.height))
+		})
+	}
+}
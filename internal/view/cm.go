@@ -29,7 +29,57 @@ func NewConfigMap(gvr client.GVR) ResourceViewer {
 }
 
 func (s *ConfigMap) bindKeys(aa *ui.KeyActions) {
-	aa.Add(ui.KeyU, ui.NewKeyAction("UsedBy", s.refCmd, true))
+	aa.Bulk(ui.KeyMap{
+		ui.KeyU: ui.NewKeyAction("UsedBy", s.refCmd, true),
+		ui.KeyI: ui.NewKeyAction("Keys", s.keysCmd, true),
+	})
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Bulk(ui.KeyMap{
+		ui.KeyE: ui.NewKeyActionWithOpts("Edit", s.editCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+				Verbs:     []string{client.PatchVerb, client.UpdateVerb},
+				AnyVerbs:  true,
+			}),
+		ui.KeyH: ui.NewKeyActionWithOpts("History", s.historyCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+				Verbs:     []string{client.PatchVerb, client.UpdateVerb},
+				AnyVerbs:  true,
+			}),
+		ui.KeyR: ui.NewKeyActionWithOpts("Restart Consumers", s.restartCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+				Verbs:     client.GetAccess,
+			}),
+	})
+}
+
+func (s *ConfigMap) keysCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showDataKeys(s.App(), dao.CmGVR, path)
+
+	return nil
+}
+
+func (s *ConfigMap) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return editData(s, dao.CmGVR)
+}
+
+func (s *ConfigMap) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return showDataHistory(s, dao.CmGVR)
+}
+
+func (s *ConfigMap) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return restartConsumers(evt, s.App(), s.GetTable(), dao.CmGVR)
 }
 
 func (s *ConfigMap) refCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -120,6 +120,109 @@ func (c *Interpreter) IsDirCmd() bool {
 	return ok
 }
 
+// IsDiscoveryCmd returns true if discovery cmd is detected.
+func (c *Interpreter) IsDiscoveryCmd() bool {
+	_, ok := discoveryCmd[c.cmd]
+	return ok
+}
+
+// IsLoginCmd returns true if login cmd is detected.
+func (c *Interpreter) IsLoginCmd() bool {
+	_, ok := loginCmd[c.cmd]
+	return ok
+}
+
+// IsStatsCmd returns true if stats cmd is detected.
+func (c *Interpreter) IsStatsCmd() bool {
+	_, ok := statsCmd[c.cmd]
+	return ok
+}
+
+// IsAlarmsCmd returns true if alarms cmd is detected.
+func (c *Interpreter) IsAlarmsCmd() bool {
+	_, ok := alarmsCmd[c.cmd]
+	return ok
+}
+
+// IsNetDiagCmd returns true if network diagnostics cmd is detected.
+func (c *Interpreter) IsNetDiagCmd() bool {
+	_, ok := netDiagCmd[c.cmd]
+	return ok
+}
+
+// IsStorageDiagCmd returns true if storage diagnostics cmd is detected.
+func (c *Interpreter) IsStorageDiagCmd() bool {
+	_, ok := storageDiagCmd[c.cmd]
+	return ok
+}
+
+// IsControlPlaneCmd returns true if control plane cmd is detected.
+func (c *Interpreter) IsControlPlaneCmd() bool {
+	_, ok := controlPlaneCmd[c.cmd]
+	return ok
+}
+
+// IsCtxDiffCmd returns true if cross context diff cmd is detected.
+func (c *Interpreter) IsCtxDiffCmd() bool {
+	_, ok := ctxDiffCmd[c.cmd]
+	return ok
+}
+
+// IsMultiCmd returns true if multi resources cmd is detected.
+func (c *Interpreter) IsMultiCmd() bool {
+	_, ok := multiCmd[c.cmd]
+	return ok
+}
+
+// IsReportCmd returns true if report cmd is detected.
+func (c *Interpreter) IsReportCmd() bool {
+	_, ok := reportCmd[c.cmd]
+	return ok
+}
+
+// IsRedactCmd returns true if redact cmd is detected.
+func (c *Interpreter) IsRedactCmd() bool {
+	_, ok := redactCmd[c.cmd]
+	return ok
+}
+
+// IsTourCmd returns true if tour cmd is detected.
+func (c *Interpreter) IsTourCmd() bool {
+	_, ok := tourCmd[c.cmd]
+	return ok
+}
+
+// IsLowBandwidthCmd returns true if low bandwidth toggle cmd is detected.
+func (c *Interpreter) IsLowBandwidthCmd() bool {
+	_, ok := lowBandwidthCmd[c.cmd]
+	return ok
+}
+
+// IsGVRCmd returns true if gvr cmd is detected.
+func (c *Interpreter) IsGVRCmd() bool {
+	_, ok := gvrCmd[c.cmd]
+	return ok
+}
+
+// IsFindCmd returns true if find cmd is detected.
+func (c *Interpreter) IsFindCmd() bool {
+	_, ok := findCmd[c.cmd]
+	return ok
+}
+
+// IsAPICmd returns true if api cmd is detected.
+func (c *Interpreter) IsAPICmd() bool {
+	_, ok := apiCmd[c.cmd]
+	return ok
+}
+
+// IsSubresourceCmd returns true if a subresource cmd ie pods/status is detected.
+func (c *Interpreter) IsSubresourceCmd() bool {
+	_, _, ok := c.SubresourceArgs()
+
+	return ok
+}
+
 // IsRBACCmd returns true if rbac cmd is detected.
 func (c *Interpreter) IsRBACCmd() bool {
 	return c.cmd == canCmd
@@ -159,6 +262,120 @@ func (c *Interpreter) CowArg() (string, bool) {
 	return m, ok && m != ""
 }
 
+// DiscoveryArg returns the discovery action if any.
+func (c *Interpreter) DiscoveryArg() (string, bool) {
+	if !c.IsDiscoveryCmd() {
+		return "", false
+	}
+	a, ok := c.args[nsKey]
+
+	return a, ok && a != ""
+}
+
+// ReportArg returns the report format if any.
+func (c *Interpreter) ReportArg() (string, bool) {
+	if !c.IsReportCmd() {
+		return "", false
+	}
+	f, ok := c.args[nsKey]
+
+	return f, ok && f != ""
+}
+
+// GVRArg returns the alias to inspect.
+func (c *Interpreter) GVRArg() (string, bool) {
+	if !c.IsGVRCmd() {
+		return "", false
+	}
+	a, ok := c.args[nsKey]
+
+	return a, ok && a != ""
+}
+
+// FindArg returns the search query.
+func (c *Interpreter) FindArg() (string, bool) {
+	if !c.IsFindCmd() {
+		return "", false
+	}
+	ff := strings.Fields(c.line)
+
+	return strings.Join(ff[1:], " "), len(ff) > 1
+}
+
+// APIArg returns the API path to browse or get if any.
+func (c *Interpreter) APIArg() (string, bool) {
+	if !c.IsAPICmd() {
+		return "", false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) < 2 {
+		return "", false
+	}
+
+	return "/" + strings.TrimLeft(ff[1], "/"), true
+}
+
+// CtxDiffArgs returns the resource, its path and the contexts to diff. When
+// a single context is given the active context is diffed against it.
+func (c *Interpreter) CtxDiffArgs() (string, string, []string, bool) {
+	if !c.IsCtxDiffCmd() {
+		return "", "", nil, false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) < 4 || len(ff) > 5 {
+		return "", "", nil, false
+	}
+
+	return ff[1], ff[2], ff[3:], true
+}
+
+// MultiArgs returns the resources to stack and the namespace to view them in.
+// Resources are comma or space separated and the namespace is set via -n.
+func (c *Interpreter) MultiArgs() ([]string, string, bool) {
+	if !c.IsMultiCmd() {
+		return nil, "", false
+	}
+	var (
+		rr []string
+		ns string
+	)
+	ff := strings.Fields(c.line)
+	for i := 1; i < len(ff); i++ {
+		switch f := ff[i]; {
+		case f == "-n":
+			if i++; i < len(ff) {
+				ns = ff[i]
+			}
+		case strings.Index(f, "-n") == 0:
+			ns = f[2:]
+		case strings.Index(f, filterFlag) == 0:
+			// Filters are picked up via FilterArg.
+		default:
+			for _, r := range strings.Split(f, ",") {
+				if r != "" {
+					rr = append(rr, strings.ToLower(r))
+				}
+			}
+		}
+	}
+
+	return rr, strings.ToLower(ns), len(rr) > 0
+}
+
+// SubresourceArgs returns the resource and the subresource to view.
+func (c *Interpreter) SubresourceArgs() (string, string, bool) {
+	i := strings.LastIndex(c.cmd, "/")
+	if i <= 0 {
+		return "", "", false
+	}
+	res, sub := c.cmd[:i], c.cmd[i+1:]
+	if _, ok := subresourceCmd[sub]; !ok {
+		return "", "", false
+	}
+
+	return res, sub, true
+}
+
 // RBACArgs returns the subject and topic is any.
 func (c *Interpreter) RBACArgs() (string, string, bool) {
 	if !c.IsRBACCmd() {
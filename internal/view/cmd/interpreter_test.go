@@ -468,3 +468,546 @@ func TestCowCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestDiscoveryCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd    string
+		ok     bool
+		action string
+	}{
+		"empty": {},
+		"plain": {
+			cmd: "discovery",
+			ok:  true,
+		},
+		"alias": {
+			cmd:    "disco refresh",
+			ok:     true,
+			action: "refresh",
+		},
+		"toast": {
+			cmd: "discover",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			assert.Equal(t, u.ok, p.IsDiscoveryCmd())
+			a, _ := p.DiscoveryArg()
+			assert.Equal(t, u.action, a)
+		})
+	}
+}
+
+func TestLoginCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+	}{
+		"plain": {
+			cmd: "login",
+			ok:  true,
+		},
+		"toast": {
+			cmd: "logon",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, cmd.NewInterpreter(u.cmd).IsLoginCmd())
+		})
+	}
+}
+
+func TestStatsCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+	}{
+		"plain": {
+			cmd: "stats",
+			ok:  true,
+		},
+		"toast": {
+			cmd: "stat",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, cmd.NewInterpreter(u.cmd).IsStatsCmd())
+		})
+	}
+}
+
+func TestNetDiagCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+	}{
+		"plain": {
+			cmd: "netdiag",
+			ok:  true,
+		},
+		"alias": {
+			cmd: "dns",
+			ok:  true,
+		},
+		"toast": {
+			cmd: "net",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, cmd.NewInterpreter(u.cmd).IsNetDiagCmd())
+		})
+	}
+}
+
+func TestControlPlaneCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+	}{
+		"plain": {
+			cmd: "controlplane",
+			ok:  true,
+		},
+		"alias": {
+			cmd: "cplane",
+			ok:  true,
+		},
+		"toast": {
+			cmd: "cp",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, cmd.NewInterpreter(u.cmd).IsControlPlaneCmd())
+		})
+	}
+}
+
+func TestStorageDiagCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+	}{
+		"plain": {
+			cmd: "storagediag",
+			ok:  true,
+		},
+		"alias": {
+			cmd: "csidiag",
+			ok:  true,
+		},
+		"toast": {
+			cmd: "storage",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, cmd.NewInterpreter(u.cmd).IsStorageDiagCmd())
+		})
+	}
+}
+
+func TestCtxDiffCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd       string
+		ok        bool
+		res, path string
+		ctxs      []string
+	}{
+		"active": {
+			cmd:  "ctxdiff deploy default/nginx prod",
+			ok:   true,
+			res:  "deploy",
+			path: "default/nginx",
+			ctxs: []string{"prod"},
+		},
+		"both": {
+			cmd:  "xdiff cm kube-system/coredns staging prod",
+			ok:   true,
+			res:  "cm",
+			path: "kube-system/coredns",
+			ctxs: []string{"staging", "prod"},
+		},
+		"no-ctx": {
+			cmd: "ctxdiff deploy default/nginx",
+		},
+		"toast": {
+			cmd: "diff deploy default/nginx prod",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			res, path, ctxs, ok := cmd.NewInterpreter(u.cmd).CtxDiffArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.res, res)
+			assert.Equal(t, u.path, path)
+			assert.Equal(t, u.ctxs, ctxs)
+		})
+	}
+}
+
+func TestMultiCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+		rr  []string
+		ns  string
+	}{
+		"plain": {
+			cmd: "multi pods,events,deploy",
+			ok:  true,
+			rr:  []string{"pods", "events", "deploy"},
+		},
+		"ns": {
+			cmd: "multi pods,Events -n fred",
+			ok:  true,
+			rr:  []string{"pods", "events"},
+			ns:  "fred",
+		},
+		"spaces": {
+			cmd: "multi po svc -nfred /blee",
+			ok:  true,
+			rr:  []string{"po", "svc"},
+			ns:  "fred",
+		},
+		"no-res": {
+			cmd: "multi -n fred",
+			ns:  "fred",
+		},
+		"toast": {
+			cmd: "pods,events",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr, ns, ok := cmd.NewInterpreter(u.cmd).MultiArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.rr, rr)
+			assert.Equal(t, u.ns, ns)
+		})
+	}
+}
+
+func TestAlarmsCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+	}{
+		"plain": {
+			cmd: "alarms",
+			ok:  true,
+		},
+		"singular": {
+			cmd: "alarm",
+			ok:  true,
+		},
+		"toast": {
+			cmd: "alerts",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, cmd.NewInterpreter(u.cmd).IsAlarmsCmd())
+		})
+	}
+}
+
+func TestReportCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd    string
+		ok     bool
+		format string
+	}{
+		"empty": {},
+		"plain": {
+			cmd: "report",
+			ok:  true,
+		},
+		"html": {
+			cmd:    "report HTML",
+			ok:     true,
+			format: "html",
+		},
+		"toast": {
+			cmd: "reports",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			assert.Equal(t, u.ok, p.IsReportCmd())
+			f, _ := p.ReportArg()
+			assert.Equal(t, u.format, f)
+		})
+	}
+}
+
+func TestRedactCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+	}{
+		"empty": {},
+		"plain": {
+			cmd: "redact",
+			ok:  true,
+		},
+		"caps": {
+			cmd: "REDACT",
+			ok:  true,
+		},
+		"toast": {
+			cmd: "redacted",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, cmd.NewInterpreter(u.cmd).IsRedactCmd())
+		})
+	}
+}
+
+func TestLowBandwidthCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+	}{
+		"empty": {},
+		"short": {
+			cmd: "lowbw",
+			ok:  true,
+		},
+		"long": {
+			cmd: "lowbandwidth",
+			ok:  true,
+		},
+		"toast": {
+			cmd: "bw",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, cmd.NewInterpreter(u.cmd).IsLowBandwidthCmd())
+		})
+	}
+}
+
+func TestGVRCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd   string
+		ok    bool
+		alias string
+	}{
+		"empty": {},
+		"no-arg": {
+			cmd: "gvr",
+		},
+		"alias": {
+			cmd:   "gvr cfg",
+			ok:    true,
+			alias: "cfg",
+		},
+		"toast": {
+			cmd: "gvrs cfg",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a, ok := cmd.NewInterpreter(u.cmd).GVRArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.alias, a)
+		})
+	}
+}
+
+func TestFindCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+		q   string
+	}{
+		"empty": {},
+		"no-query": {
+			cmd: "find",
+		},
+		"query": {
+			cmd: "find name~fred label app=blee ns=zorg",
+			ok:  true,
+			q:   "name~fred label app=blee ns=zorg",
+		},
+		"alias": {
+			cmd: "search  fred",
+			ok:  true,
+			q:   "fred",
+		},
+		"toast": {
+			cmd: "finds fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q, ok := cmd.NewInterpreter(u.cmd).FindArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.q, q)
+		})
+	}
+}
+
+func TestAPICmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd  string
+		ok   bool
+		path string
+	}{
+		"empty": {},
+		"root": {
+			cmd: "api",
+		},
+		"path": {
+			cmd:  "api /apis/metrics.k8s.io/v1beta1",
+			ok:   true,
+			path: "/apis/metrics.k8s.io/v1beta1",
+		},
+		"relative": {
+			cmd:  "api healthz?verbose",
+			ok:   true,
+			path: "/healthz?verbose",
+		},
+		"toast": {
+			cmd: "apis /api",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, ok := cmd.NewInterpreter(u.cmd).APIArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.path, p)
+		})
+	}
+}
+
+func TestSubresourceCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd      string
+		ok       bool
+		res, sub string
+		ns       string
+	}{
+		"empty": {},
+		"plain": {
+			cmd: "pods",
+		},
+		"status": {
+			cmd: "pods/status",
+			ok:  true,
+			res: "pods",
+			sub: "status",
+		},
+		"scale-ns": {
+			cmd: "deploy/scale fred",
+			ok:  true,
+			res: "deploy",
+			sub: "scale",
+			ns:  "fred",
+		},
+		"gvr": {
+			cmd: "apps/v1/deployments/scale",
+			ok:  true,
+			res: "apps/v1/deployments",
+			sub: "scale",
+		},
+		"kind": {
+			cmd: "pod/v1",
+		},
+		"no-res": {
+			cmd: "/status",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			res, sub, ok := p.SubresourceArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.res, res)
+			assert.Equal(t, u.sub, sub)
+			ns, _ := p.NSArg()
+			assert.Equal(t, u.ns, ns)
+		})
+	}
+}
+
+func TestTourCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+	}{
+		"empty": {},
+		"plain": {
+			cmd: "tour",
+			ok:  true,
+		},
+		"toast": {
+			cmd: "tours",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, cmd.NewInterpreter(u.cmd).IsTourCmd())
+		})
+	}
+}
+
+func TestSpecs(t *testing.T) {
+	ss := cmd.Specs()
+	assert.Equal(t, 22, len(ss))
+
+	mm := make(map[string]cmd.Spec, len(ss))
+	for _, s := range ss {
+		assert.NotEmpty(t, s.Aliases)
+		assert.IsIncreasing(t, s.Aliases)
+		mm[s.Description] = s
+	}
+	assert.Equal(t, []string{"context", "contexts", "ctx"}, mm["Switch Context"].Aliases)
+	assert.False(t, mm["Switch Context"].Args)
+	assert.Equal(t, []string{"ctxdiff", "xdiff"}, mm["Diff Resource Across Contexts"].Aliases)
+	assert.True(t, mm["Diff Resource Across Contexts"].Args)
+	assert.True(t, cmd.NewInterpreter(mm["Quit"].Aliases[0]).IsBailCmd())
+}
@@ -3,7 +3,10 @@
 
 package cmd
 
-import "regexp"
+import (
+	"regexp"
+	"sort"
+)
 
 const (
 	cowCmd      = "cow"
@@ -50,4 +53,122 @@ var (
 		"xr":   {},
 		"xray": {},
 	}
+	discoveryCmd = map[string]struct{}{
+		"disco":     {},
+		"discovery": {},
+	}
+	loginCmd = map[string]struct{}{
+		"login": {},
+	}
+	statsCmd = map[string]struct{}{
+		"stats": {},
+	}
+	alarmsCmd = map[string]struct{}{
+		"alarms": {},
+		"alarm":  {},
+	}
+	reportCmd = map[string]struct{}{
+		"report": {},
+	}
+	redactCmd = map[string]struct{}{
+		"redact": {},
+	}
+	tourCmd = map[string]struct{}{
+		"tour": {},
+	}
+	gvrCmd = map[string]struct{}{
+		"gvr": {},
+	}
+	findCmd = map[string]struct{}{
+		"find":   {},
+		"search": {},
+	}
+	apiCmd = map[string]struct{}{
+		"api": {},
+	}
+	netDiagCmd = map[string]struct{}{
+		"netdiag": {},
+		"dns":     {},
+	}
+	controlPlaneCmd = map[string]struct{}{
+		"controlplane": {},
+		"cplane":       {},
+	}
+	storageDiagCmd = map[string]struct{}{
+		"storagediag": {},
+		"csidiag":     {},
+	}
+	ctxDiffCmd = map[string]struct{}{
+		"ctxdiff": {},
+		"xdiff":   {},
+	}
+	multiCmd = map[string]struct{}{
+		"multi": {},
+	}
+	lowBandwidthCmd = map[string]struct{}{
+		"lowbw":        {},
+		"lowbandwidth": {},
+	}
+	subresourceCmd = map[string]struct{}{
+		"status": {},
+		"scale":  {},
+	}
 )
+
+// Spec describes a special command.
+type Spec struct {
+	// Aliases lists the command names in lexical order.
+	Aliases []string
+
+	// Description describes the command.
+	Description string
+
+	// Args indicates the command requires arguments.
+	Args bool
+}
+
+type cmdSpec struct {
+	aliases map[string]struct{}
+	desc    string
+	args    bool
+}
+
+var cmdSpecs = []cmdSpec{
+	{aliases: aliasCmd, desc: "Aliases"},
+	{aliases: alarmsCmd, desc: "Alarms"},
+	{aliases: apiCmd, desc: "Browse API Paths"},
+	{aliases: map[string]struct{}{canCmd: {}}, desc: "RBAC Access For Subject", args: true},
+	{aliases: controlPlaneCmd, desc: "Control Plane Health"},
+	{aliases: contextCmd, desc: "Switch Context"},
+	{aliases: ctxDiffCmd, desc: "Diff Resource Across Contexts", args: true},
+	{aliases: dirCmd, desc: "Browse Manifests Directory", args: true},
+	{aliases: discoveryCmd, desc: "API Discovery"},
+	{aliases: findCmd, desc: "Search Resources", args: true},
+	{aliases: gvrCmd, desc: "Inspect Alias", args: true},
+	{aliases: loginCmd, desc: "Login"},
+	{aliases: lowBandwidthCmd, desc: "Toggle Low Bandwidth Mode"},
+	{aliases: multiCmd, desc: "Stack Resource Views", args: true},
+	{aliases: netDiagCmd, desc: "Network Diagnostics"},
+	{aliases: bailCmd, desc: "Quit"},
+	{aliases: redactCmd, desc: "Toggle Redaction"},
+	{aliases: reportCmd, desc: "Cluster Report"},
+	{aliases: statsCmd, desc: "Cluster Stats"},
+	{aliases: storageDiagCmd, desc: "Storage Diagnostics"},
+	{aliases: tourCmd, desc: "Guided Tour"},
+	{aliases: xrayCmd, desc: "XRay Resource", args: true},
+}
+
+// Specs returns the special commands.
+func Specs() []Spec {
+	ss := make([]Spec, 0, len(cmdSpecs))
+	for _, c := range cmdSpecs {
+		aa := make([]string, 0, len(c.aliases))
+		for a := range c.aliases {
+			aa = append(aa, a)
+		}
+		sort.Strings(aa)
+		ss = append(ss, Spec{Aliases: aa, Description: c.desc, Args: c.args})
+	}
+
+	return ss
+}
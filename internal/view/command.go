@@ -4,18 +4,23 @@
 package view
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"runtime/debug"
+	"slices"
 	"strings"
 	"sync"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/redact"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/view/cmd"
 	"github.com/rs/zerolog/log"
+	"sigs.k8s.io/yaml"
 )
 
 var (
@@ -45,11 +50,15 @@ func (c *Command) AliasesFor(s string) []string {
 // Init initializes the command.
 func (c *Command) Init(path string) error {
 	c.alias = dao.NewAlias(c.app.factory)
+	dao.MetaAccess.UseDiscoveryCache(c.app.Config.ContextDiscoveryPath())
+	dao.MetaAccess.SetRecoverFn(c.discoveryRecovered)
+	c.probeScope()
 	if _, err := c.alias.Ensure(path); err != nil {
 		log.Error().Err(err).Msgf("Alias ensure failed!")
 		return err
 	}
 	customViewers = loadCustomViewers()
+	c.checkDiscovery()
 
 	return nil
 }
@@ -61,10 +70,212 @@ func (c *Command) Reset(path string, clear bool) error {
 
 	if clear {
 		c.alias.Clear()
+		dao.MetaAccess.UseDiscoveryCache(c.app.Config.ContextDiscoveryPath())
+		c.probeScope()
 	}
 	if _, err := c.alias.Ensure(path); err != nil {
 		return err
 	}
+	if clear {
+		c.checkDiscovery()
+	}
+
+	return nil
+}
+
+// probeScope restricts resources and namespaces to the ones the user can
+// access when cluster wide namespace listing is denied.
+func (c *Command) probeScope() {
+	dao.NSScope.Reset()
+	if !c.app.Config.K9s.NamespaceScoped {
+		ok, err := c.app.Conn().CanI(client.ClusterScope, "v1/namespaces", "", client.ListAccess)
+		if ok || err != nil {
+			return
+		}
+	}
+
+	nss := []string{c.app.Config.ActiveNamespace()}
+	for _, ns := range c.app.Config.FavNamespaces() {
+		if !slices.Contains(nss, ns) {
+			nss = append(nss, ns)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.app.Conn().Config().CallTimeout())
+	defer cancel()
+	if err := dao.NSScope.Probe(ctx, c.app.factory, nss); err != nil {
+		log.Warn().Err(err).Msgf("Namespace scope probe failed")
+		return
+	}
+	c.app.Flash().Infof("Namespace scoped mode on %s", strings.Join(dao.NSScope.Namespaces(), ","))
+}
+
+// checkDiscovery warns when running off a stale discovery cache or when
+// some api groups could not be discovered.
+func (c *Command) checkDiscovery() {
+	st := dao.MetaAccess.DiscoveryStatus()
+	if len(st.FailedGroups) > 0 {
+		c.app.Flash().Warnf("Discovery failed for %d api groups. Use `discovery` for details", len(st.FailedGroups))
+		return
+	}
+	if st.Stale {
+		c.app.Flash().Warnf("Discovery cache is %s old. Use `discovery refresh` to update it", st.Age)
+	}
+}
+
+// discoveryRecovered reloads aliases once failed api groups come back.
+func (c *Command) discoveryRecovered(gvs []string) {
+	c.mx.Lock()
+	c.alias.Clear()
+	_, err := c.alias.Ensure(c.app.Config.ContextAliasesPath())
+	c.mx.Unlock()
+	if err != nil {
+		log.Warn().Err(err).Msgf("Alias reload failed")
+		return
+	}
+	c.app.QueueUpdateDraw(func() {
+		c.app.Flash().Infof("Discovery recovered api groups %s", strings.Join(gvs, ","))
+	})
+}
+
+func (c *Command) discoveryCmd(p *cmd.Interpreter) error {
+	a, ok := p.DiscoveryArg()
+	if !ok {
+		raw, err := yaml.Marshal(dao.MetaAccess.DiscoveryStatus())
+		if err != nil {
+			return err
+		}
+		details := NewDetails(c.app, "Discovery", c.app.Config.ActiveContextName(), contentYAML, true).Update(string(raw))
+		return c.app.inject(details, false)
+	}
+	if a != "refresh" {
+		return fmt.Errorf("invalid discovery action %q. Use `discovery [refresh]`", a)
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if err := dao.MetaAccess.RefreshResources(c.app.factory); err != nil {
+		return err
+	}
+	c.alias.Clear()
+	if _, err := c.alias.Ensure(c.app.Config.ContextAliasesPath()); err != nil {
+		return err
+	}
+	c.app.Flash().Infof("Discovered %d resources", dao.MetaAccess.DiscoveryStatus().Resources)
+	c.checkDiscovery()
+
+	return nil
+}
+
+func (c *Command) statsCmd() error {
+	raw, err := yaml.Marshal(dao.NewK9sStats(c.app.factory.Stats(), c.app.factory.CacheStats(), client.Stats.Snapshot()))
+	if err != nil {
+		return err
+	}
+	details := NewDetails(c.app, "Stats", c.app.Config.ActiveContextName(), contentYAML, true).Update(string(raw))
+
+	return c.app.inject(details, false)
+}
+
+func (c *Command) alarmsCmd() error {
+	details := NewDetails(c.app, "Alarms", c.app.Config.ActiveContextName(), contentTXT, true).Update(c.app.alarms.Dump())
+
+	return c.app.inject(details, false)
+}
+
+func (c *Command) diagCmd(title string, run reportFunc) {
+	c.app.Flash().Infof("Running %s checks...", strings.ToLower(title))
+	showReport(c.app, title, c.app.Config.ActiveContextName(), run)
+}
+
+func (c *Command) ctxDiffCmd(p *cmd.Interpreter) error {
+	res, path, ctxs, ok := p.CtxDiffArgs()
+	if !ok {
+		return errors.New("invalid command. Use `ctxdiff resource [ns/]name [context] context`")
+	}
+	gvr, _, ok := c.alias.AsGVR(res)
+	if !ok {
+		return fmt.Errorf("unknown resource %q", res)
+	}
+	if !strings.Contains(path, "/") {
+		if meta, err := dao.MetaAccess.MetaFor(gvr); err == nil && !meta.Namespaced {
+			path = client.FQN(client.ClusterScope, path)
+		} else {
+			ns := client.CleanseNamespace(c.app.Config.ActiveNamespace())
+			if client.IsAllNamespaces(ns) {
+				return fmt.Errorf("namespace required. Use `ctxdiff %s ns/%s ...`", res, path)
+			}
+			path = client.FQN(ns, path)
+		}
+	}
+	if len(ctxs) == 1 {
+		ctxs = append([]string{c.app.Config.ActiveContextName()}, ctxs...)
+	}
+
+	x := c.app.Config.K9s.Export
+	c.app.Flash().Infof("Diffing %s across %s and %s...", path, ctxs[0], ctxs[1])
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), c.app.Conn().Config().CallTimeout())
+		defer cancel()
+		dd, err := dao.ContextDiff(ctx, c.app.Conn().Config(), gvr, path, ctxs[0], ctxs[1], dao.NewExportRules(x.Strip, x.Keep))
+		c.app.QueueUpdateDraw(func() {
+			if err != nil {
+				c.app.Flash().Err(err)
+				return
+			}
+			subject := path + " " + ctxs[0] + " ↔ " + ctxs[1]
+			details := NewDetails(c.app, "Diff", subject, contentDiff, true).Update(strings.Join(dd, "\n"))
+			if err := c.app.inject(details, false); err != nil {
+				c.app.Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+func (c *Command) multiCmd(p *cmd.Interpreter) error {
+	rr, ns, ok := p.MultiArgs()
+	if !ok {
+		return errors.New("invalid command. Use `multi res1,res2,... [-n ns]`")
+	}
+	gvrs := make([]client.GVR, 0, len(rr))
+	for _, r := range rr {
+		gvr, _, ok := c.alias.AsGVR(r)
+		if !ok {
+			return fmt.Errorf("unknown resource %q", r)
+		}
+		gvrs = append(gvrs, gvr)
+	}
+	if ns == "" {
+		ns = c.app.Config.ActiveNamespace()
+	}
+	if err := c.app.Config.SetActiveNamespace(client.CleanseNamespace(ns)); err != nil {
+		return err
+	}
+	if err := c.app.switchNS(ns); err != nil {
+		return err
+	}
+	v := NewMulti(c.app, gvrs, ns)
+	if err := c.exec(p, client.NewGVR("multi"), v, true); err != nil {
+		return err
+	}
+	if f, ok := p.FilterArg(); ok {
+		v.SetFilter(f)
+	}
+
+	return nil
+}
+
+func (c *Command) reportCmd(p *cmd.Interpreter) error {
+	format := model.ReportMarkdown
+	if f, ok := p.ReportArg(); ok {
+		format = f
+	}
+	if format != model.ReportMarkdown && format != model.ReportHTML {
+		return fmt.Errorf("unsupported report format %q (md|html)", format)
+	}
+
+	ShowReportSections(c.app, format)
 
 	return nil
 }
@@ -142,6 +353,10 @@ func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack bool) error {
 	if c.specialCmd(p) {
 		return nil
 	}
+	if gvrs := c.alias.Ambiguous(p.Cmd()); len(gvrs) > 0 {
+		c.pickResource(p, fqn, clearStack, gvrs)
+		return nil
+	}
 	gvr, v, err := c.viewMetaFor(p)
 	if err != nil {
 		return err
@@ -180,6 +395,10 @@ func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack bool) error {
 		}
 	}
 
+	if c.accessDenied(gvr) {
+		return nil
+	}
+
 	co := c.componentFor(gvr, fqn, v)
 	co.SetFilter("")
 	co.SetLabelFilter(nil)
@@ -196,6 +415,114 @@ func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack bool) error {
 	return c.exec(p, gvr, co, clearStack)
 }
 
+// pickResource asks which resource an ambiguous alias refers to.
+func (c *Command) pickResource(p *cmd.Interpreter, fqn string, clearStack bool, gvrs client.GVRs) {
+	opts := make([]string, 0, len(gvrs))
+	for _, gvr := range gvrs {
+		opts = append(opts, gvr.String())
+	}
+	dialog.ShowPicker(c.app.Styles.Dialog(), c.app.Content.Pages, "Resolve "+p.Cmd(), opts, func(i int) {
+		if i < 0 || i >= len(opts) {
+			return
+		}
+		line := opts[i]
+		if ff := strings.Fields(p.GetLine()); len(ff) > 1 {
+			line += " " + strings.Join(ff[1:], " ")
+		}
+		if err := c.run(cmd.NewInterpreter(line), fqn, clearStack); err != nil {
+			c.app.Flash().Err(err)
+		}
+	})
+}
+
+func (c *Command) findCmd(s string) error {
+	q, err := dao.ParseFindQuery(s)
+	if err != nil {
+		return err
+	}
+	for _, k := range q.Kinds {
+		gvr, _, ok := c.alias.AsGVR(k)
+		if !ok {
+			return fmt.Errorf("`%s` command not found", k)
+		}
+		q.GVRs = append(q.GVRs, gvr)
+	}
+
+	return showFind(c.app, q)
+}
+
+func (c *Command) gvrCmd(a string) error {
+	r, err := c.alias.Resolve(a)
+	if err != nil {
+		return err
+	}
+	raw, err := yaml.Marshal(r)
+	if err != nil {
+		return err
+	}
+	details := NewDetails(c.app, "Resolve", a, contentYAML, true).Update(string(raw))
+
+	return c.app.inject(details, false)
+}
+
+func (c *Command) apiCmd(p *cmd.Interpreter) error {
+	path, ok := p.APIArg()
+	if !ok {
+		return c.app.inject(newAPIPath(client.NewGVR("apipaths"), ""), false)
+	}
+	if _, ok := dao.GroupVersionPath(path); ok {
+		return c.app.inject(newAPIPath(client.NewGVR("apipaths"), path), false)
+	}
+
+	showAPIGet(c.app, "API", path)
+
+	return nil
+}
+
+func (c *Command) subresourceCmd(p *cmd.Interpreter) error {
+	res, sub, _ := p.SubresourceArgs()
+	gvr, _, ok := c.alias.AsGVR(res)
+	if !ok {
+		return fmt.Errorf("`%s` command not found", res)
+	}
+	meta, err := dao.MetaAccess.SubresourceFor(c.app.factory, gvr, sub)
+	if err != nil {
+		return err
+	}
+	if ns, ok := p.NSArg(); ok {
+		if err := c.app.switchNS(ns); err != nil {
+			return err
+		}
+	}
+
+	return showSubresource(c.app, client.NewGVR(gvr.String()+":"+sub), meta.Verbs)
+}
+
+// accessDenied checks the user can list and watch a resource and if not
+// explains the missing grants.
+func (c *Command) accessDenied(gvr client.GVR) bool {
+	meta, err := dao.MetaAccess.MetaFor(gvr)
+	if err != nil || !dao.IsK8sMeta(meta) || !c.app.ConOK() {
+		return false
+	}
+	ns := client.CleanseNamespace(c.app.Config.ActiveNamespace())
+	missing := dao.MissingVerbs(c.app.Conn(), ns, gvr, client.MonitorAccess)
+	if len(missing) == 0 {
+		return false
+	}
+	raw, err := dao.AccessDeniedReport(ns, gvr, meta.Namespaced, missing)
+	if err != nil {
+		log.Error().Err(err).Msgf("Access report failed for %q", gvr)
+		return false
+	}
+	details := NewDetails(c.app, "Access Denied", gvr.String(), contentYAML, true).Update(raw)
+	if err := c.app.inject(details, false); err != nil {
+		c.app.Flash().Err(err)
+	}
+
+	return true
+}
+
 func (c *Command) defaultCmd() error {
 	if c.app.Conn() == nil || !c.app.Conn().ConnectionOK() {
 		return c.run(cmd.NewInterpreter("context"), "", true)
@@ -234,6 +561,26 @@ func (c *Command) specialCmd(p *cmd.Interpreter) bool {
 		if err := c.xrayCmd(p); err != nil {
 			c.app.Flash().Err(err)
 		}
+	case p.IsFindCmd():
+		if q, ok := p.FindArg(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `find name~xxx [label sel] [ns=xxx] [kind=xxx]`")
+		} else if err := c.findCmd(q); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsGVRCmd():
+		if a, ok := p.GVRArg(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `gvr xxx`")
+		} else if err := c.gvrCmd(a); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsAPICmd():
+		if err := c.apiCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsSubresourceCmd():
+		if err := c.subresourceCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
 	case p.IsRBACCmd():
 		if cat, sub, ok := p.RBACArgs(); !ok {
 			c.app.Flash().Errf("Invalid command. Use `can [u|g|s]:xxx`")
@@ -244,6 +591,48 @@ func (c *Command) specialCmd(p *cmd.Interpreter) bool {
 		if err := c.contextCmd(p); err != nil {
 			c.app.Flash().Err(err)
 		}
+	case p.IsDiscoveryCmd():
+		if err := c.discoveryCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsLoginCmd():
+		if err := c.app.loginCmd(); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsStatsCmd():
+		if err := c.statsCmd(); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsAlarmsCmd():
+		if err := c.alarmsCmd(); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsNetDiagCmd():
+		c.diagCmd("Network Diagnostics", dao.NetDiagReport)
+	case p.IsControlPlaneCmd():
+		c.diagCmd("Control Plane", dao.ControlPlaneReport)
+	case p.IsStorageDiagCmd():
+		c.diagCmd("Storage Diagnostics", dao.StorageDiagReport)
+	case p.IsCtxDiffCmd():
+		if err := c.ctxDiffCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsMultiCmd():
+		if err := c.multiCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsReportCmd():
+		if err := c.reportCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsRedactCmd():
+		c.redactCmd()
+	case p.IsLowBandwidthCmd():
+		c.lowBandwidthCmd()
+	case p.IsTourCmd():
+		if err := c.app.tourCmd(); err != nil {
+			c.app.Flash().Err(err)
+		}
 	case p.IsDirCmd():
 		if a, ok := p.DirArg(); !ok {
 			c.app.Flash().Errf("Invalid command. Use `dir xxx`")
@@ -260,7 +649,17 @@ func (c *Command) specialCmd(p *cmd.Interpreter) bool {
 func (c *Command) viewMetaFor(p *cmd.Interpreter) (client.GVR, *MetaViewer, error) {
 	agvr, exp, ok := c.alias.AsGVR(p.Cmd())
 	if !ok {
-		return client.NoGVR, nil, fmt.Errorf("`%s` command not found", p.Cmd())
+		if _, isKind := dao.ParseKind(p.Cmd()); !isKind {
+			return client.NoGVR, nil, fmt.Errorf("`%s` command not found", p.Cmd())
+		}
+		gvr, err := dao.MetaAccess.ResolveKind(c.app.factory, p.Cmd())
+		if err != nil {
+			return client.NoGVR, nil, err
+		}
+		agvr = gvr
+	}
+	if !dao.NSScope.CanList(agvr) {
+		return client.NoGVR, nil, fmt.Errorf("`%s` is not accessible in namespace scoped mode", p.Cmd())
 	}
 	gvr := agvr
 	if exp != "" {
@@ -272,6 +671,17 @@ func (c *Command) viewMetaFor(p *cmd.Interpreter) (client.GVR, *MetaViewer, erro
 	}
 
 	v := MetaViewer{viewerFn: NewBrowser}
+	if meta, err := dao.MetaAccess.MetaFor(gvr); err == nil {
+		if dao.IsScalable(meta) {
+			v = MetaViewer{viewerFn: newScalableBrowser}
+		}
+		if dao.IsSuspendable(meta) {
+			v.viewerFn = suspendableViewer(v.viewerFn)
+		}
+		if !dao.IsK9sMeta(meta) {
+			v.viewerFn = ownedViewer(v.viewerFn)
+		}
+	}
 	if mv, ok := customViewers[gvr]; ok {
 		v = mv
 	}
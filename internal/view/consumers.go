@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// restartConsumers restarts the workloads using a given configmap or secret.
+func restartConsumers(evt *tcell.EventKey, a *App, t *Table, gvr client.GVR) *tcell.EventKey {
+	path := t.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	refs, err := dao.ScanConsumers(context.Background(), a.factory, gvr, path)
+	if err != nil {
+		a.Flash().Err(err)
+		return nil
+	}
+	rr, oo := dao.SplitRestartable(a.factory, refs)
+	if len(rr) == 0 {
+		a.Flash().Warnf("No restartable consumers found for %s::%s", gvr, path)
+		return nil
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Restart %d consumer(s) of %s?\n", len(rr), path)
+	for _, r := range rr {
+		fmt.Fprintf(&b, "\n%s %s", client.NewGVR(r.GVR).R(), r.FQN)
+	}
+	if len(oo) > 0 {
+		fmt.Fprintf(&b, "\n\n%d other consumer(s) must be recycled manually.", len(oo))
+	}
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Restart Consumers", b.String(), func() {
+		ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+		defer cancel()
+		n, err := dao.RestartConsumers(ctx, a.factory, rr)
+		if err != nil {
+			log.Error().Err(err).Msgf("Restart consumers failed for %s", path)
+			a.Flash().Err(err)
+			return
+		}
+		a.Flash().Infof("Restart in progress for %d consumer(s) of %s", n, path)
+	}, func() {})
+
+	return nil
+}
+
+// consumersHint reminds the user to restart the consumers of an updated
+// configmap or secret.
+func consumersHint(a *App, gvr client.GVR, path string) {
+	refs, err := dao.ScanConsumers(context.Background(), a.factory, gvr, path)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Consumers scan failed for %s", path)
+		return
+	}
+	if rr, _ := dao.SplitRestartable(a.factory, refs); len(rr) > 0 {
+		a.Flash().Warnf("%s updated! %d consumer(s) still run the previous version. Press <r> to restart them.", path, len(rr))
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -15,7 +16,9 @@ import (
 	"github.com/derailed/k9s/internal/port"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 )
 
@@ -76,6 +79,20 @@ func (c *Container) bindDangerousKeys(aa *ui.KeyActions) {
 				Visible:   true,
 				Dangerous: true,
 			}),
+		ui.KeyR: ui.NewKeyActionWithOpts(
+			"Restart",
+			c.restartCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyShiftO: ui.NewKeyActionWithOpts(
+			"Run Probe",
+			c.runProbeCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
 	})
 }
 
@@ -88,6 +105,7 @@ func (c *Container) bindKeys(aa *ui.KeyActions) {
 
 	aa.Bulk(ui.KeyMap{
 		ui.KeyF:      ui.NewKeyAction("Show PortForward", c.showPFCmd, true),
+		ui.KeyO:      ui.NewKeyAction("Probes", c.probesCmd, true),
 		ui.KeyShiftF: ui.NewKeyAction("PortForward", c.portFwdCmd, true),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", c.GetTable().SortColCmd("RESTARTS", false), false),
 	})
@@ -182,6 +200,56 @@ func (c *Container) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (c *Container) probesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if c.GetTable().GetSelectedItem() == "" {
+		return evt
+	}
+	showProbeHistory(c.App(), c.GetTable().Path)
+
+	return nil
+}
+
+func (c *Container) runProbeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	co := c.GetTable().GetSelectedItem()
+	if co == "" {
+		return evt
+	}
+	showProbeRun(c.App(), c.GetTable().Path, co)
+
+	return nil
+}
+
+func (c *Container) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
+	co := c.GetTable().GetSelectedItem()
+	if co == "" {
+		return evt
+	}
+
+	fqn := c.GetTable().Path
+	var dc dao.Container
+	dc.Init(c.App().factory, c.GVR())
+	if err := dc.Restartable(fqn, co); err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	msg := fmt.Sprintf("Restart container %s in pod %s?\n\nSends SIGTERM to the container main process. The container image must provide a kill command.", co, fqn)
+	dialog.ShowConfirm(c.App().Styles.Dialog(), c.App().Content.Pages, "Restart Container", msg, func() {
+		go func() {
+			out, err := runKu(c.App(), shellOpts{args: dao.ContainerKillArgs(fqn, co, "TERM")})
+			c.App().QueueUpdateDraw(func() {
+				if err := killResult(out, err); err != nil {
+					log.Error().Err(err).Msgf("Container restart failed")
+					c.App().Flash().Errf("Restart failed for container %s: %s", co, err)
+					return
+				}
+				c.App().Flash().Infof("Restart in progress for container %s", co)
+			})
+		}()
+	}, func() {})
+
+	return nil
+}
+
 func (c *Container) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := c.GetTable().GetSelectedItem()
 	if path == "" {
@@ -249,3 +317,19 @@ func (c *Container) listForwardable(path string) (port.ContainerPortSpecs, map[s
 
 	return port.FromContainerPorts(path, co.Ports), po.Annotations, true
 }
+
+// killResult surfaces a failed kill along with the exec output since kubectl
+// only reports an exit status.
+func killResult(out string, err error) error {
+	out = strings.TrimSpace(out)
+	switch {
+	case err != nil && out != "":
+		return fmt.Errorf("%w: %s", err, out)
+	case err != nil:
+		return err
+	case out != "":
+		return errors.New(out)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKillResult(t *testing.T) {
+	uu := map[string]struct {
+		out string
+		err error
+		e   string
+	}{
+		"ok": {},
+		"status": {
+			err: errors.New("exit status 1"),
+			e:   "exit status 1",
+		},
+		"stderr": {
+			out: "kill: executable file not found in $PATH\n",
+			err: errors.New("exit status 126"),
+			e:   "exit status 126: kill: executable file not found in $PATH",
+		},
+		"noStatus": {
+			out: "kill: (1) - Operation not permitted",
+			e:   "kill: (1) - Operation not permitted",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := killResult(u.out, u.err)
+			if u.e == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.e)
+		})
+	}
+}
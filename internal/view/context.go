@@ -122,6 +122,13 @@ func useContext(app *App, name string) error {
 	if !ok {
 		return errors.New("expecting a switchable resource")
 	}
+	spec, err := app.Config.K9s.ContextProxy(name)
+	if err != nil {
+		return err
+	}
+	if err := app.Conn().SetProxy(spec); err != nil {
+		return err
+	}
 	if err := switcher.Switch(name); err != nil {
 		log.Error().Err(err).Msgf("Context switch failed")
 		return err
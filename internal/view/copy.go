@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tcell/v2"
+)
+
+const copyToKey = "copyTo"
+
+func (b *Browser) copyToCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := b.GetSelectedItems()
+	if len(paths) == 0 {
+		return evt
+	}
+	cc, err := b.app.Conn().Config().ContextNames()
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	ctxs := make([]string, 0, len(cc))
+	for c := range cc {
+		ctxs = append(ctxs, c)
+	}
+	slices.Sort(ctxs)
+	b.showCopyTo(paths, ctxs)
+
+	return nil
+}
+
+func (b *Browser) showCopyTo(paths, ctxs []string) {
+	f := newStyledForm(b.app.Styles.Dialog())
+
+	ctx := b.app.Config.K9s.ActiveContextName()
+	sel := max(slices.Index(ctxs, ctx), 0)
+	f.AddDropDown("Context:", ctxs, sel, func(_ string, idx int) {
+		sel = idx
+	})
+	var ns, name string
+	if b.meta.Namespaced {
+		f.AddInputField("Namespace:", "", 40, nil, func(s string) {
+			ns = strings.TrimSpace(s)
+		})
+	}
+	if len(paths) == 1 {
+		f.AddInputField("Name:", "", 40, nil, func(s string) {
+			name = strings.TrimSpace(s)
+		})
+	}
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(b.app, copyToKey)
+	})
+	f.AddButton("OK", func() {
+		dismissModalForm(b.app, copyToKey)
+		if len(ctxs) == 0 {
+			b.app.Flash().Err(fmt.Errorf("no contexts found"))
+			return
+		}
+		b.copyTo(paths, ctxs[sel], ns, name)
+	})
+
+	msg := fmt.Sprintf("Copy %s to another namespace or context?", paths[0])
+	if len(paths) > 1 {
+		msg = fmt.Sprintf("Copy %d %s to another namespace or context?", len(paths), b.GVR().R())
+	}
+	msg += "\nBlank values keep the originals. The manifest is opened in your editor before applying."
+	showModalForm(b.app, copyToKey, "<Copy To>", msg, f)
+}
+
+func (b *Browser) copyTo(paths []string, ctx, ns, name string) {
+	x := b.app.Config.K9s.Export
+	raw, err := dao.CopyManifest(b.app.factory, b.GVR(), paths, dao.NewExportRules(x.Strip, x.Keep), ns, name)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+
+	subject := paths[0]
+	if len(paths) > 1 {
+		subject = b.GVR().R()
+	}
+	// The target context overrides the active one passed to kubectl.
+	editAndRun(b, "Copy", "Copied", client.FQN(ctx, subject), raw, "--context", ctx, "apply")
+}
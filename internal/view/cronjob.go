@@ -58,13 +58,13 @@ func (c *CronJob) showJobs(app *App, model ui.Tabular, gvr client.GVR, path stri
 	}
 
 	v := NewJob(client.NewGVR("batch/v1/jobs"))
-	v.SetContextFn(jobCtx(path, string(cj.UID)))
+	v.SetContextFn(ownerCtx(path, string(cj.UID)))
 	if err := app.inject(v, false); err != nil {
 		app.Flash().Err(err)
 	}
 }
 
-func jobCtx(path, uid string) ContextFunc {
+func ownerCtx(path, uid string) ContextFunc {
 	return func(ctx context.Context) context.Context {
 		ctx = context.WithValue(ctx, internal.KeyPath, path)
 		return context.WithValue(ctx, internal.KeyUID, uid)
@@ -0,0 +1,253 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/yaml"
+)
+
+const dataHistoryKey = "data-history"
+
+// editData edits a configmap or secret and confirms its data changes
+// before applying them.
+func editData(v ResourceViewer, gvr client.GVR) *tcell.EventKey {
+	path := v.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	a := v.App()
+	before, err := fetchData(a, gvr, path)
+	if err != nil {
+		a.Flash().Err(err)
+		return nil
+	}
+	after, err := editManifest(v, path, before)
+	if err != nil {
+		a.Flash().Err(err)
+		return nil
+	}
+	if after == nil {
+		a.Flash().Info("Edit canceled. No changes detected")
+		return nil
+	}
+
+	diff, err := dataDiff(gvr, before, after)
+	if err != nil {
+		a.Flash().Err(err)
+		return nil
+	}
+	msg := fmt.Sprintf("Apply changes to %s?\n\n%s", path, diff)
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Apply Changes", msg, func() {
+		applyData(a, gvr, path, before, after)
+	}, func() {})
+
+	return nil
+}
+
+// showDataHistory pops a dialog to restore a stashed data payload.
+func showDataHistory(v ResourceViewer, gvr client.GVR) *tcell.EventKey {
+	path := v.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	a := v.App()
+	stash, err := dataStash(a)
+	if err != nil {
+		a.Flash().Err(err)
+		return nil
+	}
+	if !stash.Enabled(gvr) {
+		a.Flash().Warn("Secrets stashing is disabled. Set k9s.stashSecrets to enable it")
+		return nil
+	}
+	vv, err := stash.Versions(gvr, path)
+	if err != nil {
+		a.Flash().Err(err)
+		return nil
+	}
+	if len(vv) == 0 {
+		a.Flash().Warnf("No stashed versions found for %s", path)
+		return nil
+	}
+
+	f := newStyledForm(a.Styles.Dialog())
+
+	opts, sel := make([]string, 0, len(vv)), 0
+	for _, ver := range vv {
+		opts = append(opts, fmt.Sprintf("%s (%s ago)", ver.Taken.Local().Format(time.DateTime), duration.HumanDuration(time.Since(ver.Taken))))
+	}
+	f.AddDropDown("Version:", opts, sel, func(_ string, idx int) {
+		sel = idx
+	})
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(a, dataHistoryKey)
+	})
+	f.AddButton("Restore", func() {
+		dismissModalForm(a, dataHistoryKey)
+		restoreData(a, gvr, path, stash, vv[sel])
+	})
+
+	showModalForm(a, dataHistoryKey, "<History>", fmt.Sprintf("Restore %s data from a previous version?", path), f)
+
+	return nil
+}
+
+func restoreData(a *App, gvr client.GVR, path string, stash *dao.DataStash, ver dao.DataVersion) {
+	snap, err := stash.Load(ver)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	before, err := fetchData(a, gvr, path)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	after := before.DeepCopy()
+	if err := snap.Apply(after); err != nil {
+		a.Flash().Err(err)
+		return
+	}
+
+	diff, err := dataDiff(gvr, before, after)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	msg := fmt.Sprintf("Restore %s data from %s?\n\n%s", path, ver.Taken.Local().Format(time.DateTime), diff)
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Restore", msg, func() {
+		applyData(a, gvr, path, before, after)
+	}, func() {})
+}
+
+// applyData stashes the current payload and updates the resource.
+func applyData(a *App, gvr client.GVR, path string, before, after *unstructured.Unstructured) {
+	stash, err := dataStash(a)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	if stash.Enabled(gvr) {
+		if err := stash.Save(gvr, path, dao.SnapshotData(before)); err != nil {
+			a.Flash().Errf("Unable to stash %s: %s", path, err)
+			return
+		}
+	}
+
+	var g dao.Generic
+	g.Init(a.factory, gvr)
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+	defer cancel()
+	if err := g.Update(ctx, after); err != nil {
+		log.Error().Err(err).Msgf("Update failed for %s", path)
+		a.Flash().Err(err)
+		return
+	}
+	a.Flash().Infof("%s updated!", path)
+	consumersHint(a, gvr, path)
+}
+
+// editManifest lets the user edit a resource manifest. It returns nil when
+// the manifest was left untouched.
+func editManifest(v ResourceViewer, path string, o *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	raw, err := dao.ToYAML(o, false)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.CreateTemp("", "k9s-edit-*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(raw); err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	v.Stop()
+	defer v.Start()
+	if !edit(v.App(), shellOpts{clear: true, args: []string{f.Name()}}) {
+		return nil, errors.New("failed to launch editor")
+	}
+	bb, err := os.ReadFile(f.Name())
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(bb, []byte(raw)) {
+		return nil, nil
+	}
+
+	var m map[string]interface{}
+	if err := yaml.Unmarshal(bb, &m); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	u := unstructured.Unstructured{Object: m}
+	if u.GetNamespace() != o.GetNamespace() || u.GetName() != o.GetName() {
+		return nil, fmt.Errorf("renaming %s is not supported", path)
+	}
+
+	return &u, nil
+}
+
+func dataDiff(gvr client.GVR, before, after *unstructured.Unstructured) (string, error) {
+	bv, err := dao.DataValues(before)
+	if err != nil {
+		return "", err
+	}
+	av, err := dao.DataValues(after)
+	if err != nil {
+		return "", err
+	}
+	cc := dao.DiffData(bv, av)
+	if len(cc) == 0 {
+		return "No data changes.", nil
+	}
+
+	return dao.FormatDataDiff(cc, gvr == dao.SecGVR), nil
+}
+
+func fetchData(a *App, gvr client.GVR, path string) (*unstructured.Unstructured, error) {
+	var g dao.Generic
+	g.Init(a.factory, gvr)
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+	defer cancel()
+	o, err := g.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return u, nil
+}
+
+func dataStash(a *App) (*dao.DataStash, error) {
+	dir, err := a.Config.ContextStashDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return dao.NewDataStash(dir, dao.DefaultStashSize, a.Config.K9s.StashSecrets), nil
+}
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/redact"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	dataKeyTitle  = "Keys"
+	dataKeyDialog = "data-key"
+	exportFileMod = 0600
+)
+
+// DataKey presents the keys of a configmap or secret.
+type DataKey struct {
+	ResourceViewer
+
+	owner client.GVR
+	path  string
+}
+
+// NewDataKey returns a new viewer.
+func NewDataKey(gvr client.GVR) ResourceViewer {
+	d := DataKey{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.GetTable().SetSortCol("KEY", true)
+	d.GetTable().SetEnterFn(d.inspect)
+	d.SetContextFn(d.keyContext)
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+// showDataKeys opens the keys view of a configmap or secret.
+func showDataKeys(app *App, gvr client.GVR, path string) {
+	v := NewDataKey(client.NewGVR("datakeys"))
+	d := v.(*DataKey)
+	d.owner, d.path = gvr, path
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// Name returns the component name.
+func (d *DataKey) Name() string { return dataKeyTitle }
+
+func (d *DataKey) keyContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, d.path)
+	return context.WithValue(ctx, internal.KeyGVR, d.owner)
+}
+
+func (d *DataKey) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlD, tcell.KeyCtrlZ, ui.KeyD, ui.KeyE, ui.KeyY)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyX:      ui.NewKeyAction("Hex Dump", d.hexCmd, true),
+		ui.KeyS:      ui.NewKeyAction("Save To File", d.exportCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Key", d.GetTable().SortColCmd("KEY", true), false),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Type", d.GetTable().SortColCmd("TYPE", true), false),
+		ui.KeyShiftZ: ui.NewKeyAction("Sort Size", d.GetTable().SortColCmd("SIZE", false), false),
+	})
+	if d.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyI, ui.NewKeyActionWithOpts("Import From File", d.importCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		},
+	))
+}
+
+func (d *DataKey) selectedKey() (render.DataKeyRes, bool) {
+	sel := d.GetTable().GetSelectedItem()
+	if sel == "" {
+		return render.DataKeyRes{}, false
+	}
+	var acc dao.DataKey
+	acc.Init(d.App().factory, d.GVR())
+	o, err := acc.Get(d.keyContext(context.Background()), sel)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return render.DataKeyRes{}, false
+	}
+
+	return o.(render.DataKeyRes), true
+}
+
+func (d *DataKey) inspect(app *App, _ ui.Tabular, _ client.GVR, _ string) {
+	k, ok := d.selectedKey()
+	if !ok {
+		return
+	}
+	if k.Binary {
+		d.showHex(k)
+		return
+	}
+	v := redact.Data(d.owner.String(), k.Value)
+	details := NewDetails(app, "Inspect", d.path+":"+k.Key, contentTXT, true).Update(tview.Escape(string(v)))
+	if err := app.inject(details, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (d *DataKey) hexCmd(evt *tcell.EventKey) *tcell.EventKey {
+	k, ok := d.selectedKey()
+	if !ok {
+		return evt
+	}
+	d.showHex(k)
+
+	return nil
+}
+
+func (d *DataKey) showHex(k render.DataKeyRes) {
+	v := redact.Data(d.owner.String(), k.Value)
+	details := NewDetails(d.App(), "Hex Dump", d.path+":"+k.Key, contentTXT, true).Update(tview.Escape(hex.Dump(v)))
+	if err := d.App().inject(details, false); err != nil {
+		d.App().Flash().Err(err)
+	}
+}
+
+func (d *DataKey) exportCmd(evt *tcell.EventKey) *tcell.EventKey {
+	k, ok := d.selectedKey()
+	if !ok {
+		return evt
+	}
+
+	ns, n := client.Namespaced(d.path)
+	file := filepath.Join(
+		d.App().Config.K9s.ContextScreenDumpDir(),
+		data.SanitizeFileName(strings.Join([]string{ns, n, k.Key}, "-")),
+	)
+	d.showFileDialog("<Save To File>", fmt.Sprintf("Save key %q (%d bytes)", k.Key, len(k.Value)), k.Key, false, file, func(_, path string) {
+		if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+			d.App().Flash().Err(err)
+			return
+		}
+		if err := os.WriteFile(path, k.Value, exportFileMod); err != nil {
+			d.App().Flash().Err(err)
+			return
+		}
+		d.App().Flash().Infof("Key %s saved to %s", k.Key, path)
+	})
+
+	return nil
+}
+
+func (d *DataKey) importCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := d.GetTable().GetSelectedItem()
+	d.showFileDialog("<Import From File>", "Import file content into key", key, true, "", func(key, path string) {
+		if key == "" {
+			d.App().Flash().Errf("A key name is required")
+			return
+		}
+		bb, err := os.ReadFile(path)
+		if err != nil {
+			d.App().Flash().Err(err)
+			return
+		}
+		d.importKey(key, bb)
+	})
+
+	return nil
+}
+
+func (d *DataKey) importKey(key string, bb []byte) {
+	a := d.App()
+	before, err := fetchData(a, d.owner, d.path)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	after := before.DeepCopy()
+	if err := dao.SetDataKeyValue(after, key, bb); err != nil {
+		a.Flash().Err(err)
+		return
+	}
+
+	diff, err := dataDiff(d.owner, before, after)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	msg := fmt.Sprintf("Import %d bytes (%s) into %s?\n\n%s", len(bb), dao.DetectDataType(bb), d.path, diff)
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Import", msg, func() {
+		applyData(a, d.owner, d.path, before, after)
+	}, func() {})
+}
+
+type fileFunc func(key, path string)
+
+func (d *DataKey) showFileDialog(title, msg, key string, editKey bool, path string, okFn fileFunc) {
+	f := newStyledForm(d.App().Styles.Dialog())
+
+	if editKey {
+		f.AddInputField("Key:", key, 0, nil, func(v string) {
+			key = strings.TrimSpace(v)
+		})
+	}
+	f.AddInputField("Path:", path, 0, nil, func(v string) {
+		path = strings.TrimSpace(v)
+	})
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(d.App(), dataKeyDialog)
+	})
+	f.AddButton("OK", func() {
+		dismissModalForm(d.App(), dataKeyDialog)
+		if path == "" {
+			d.App().Flash().Errf("A file path is required")
+			return
+		}
+		okFn(key, path)
+	})
+
+	showModalForm(d.App(), dataKeyDialog, title, msg, f)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/redact"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
@@ -21,6 +22,8 @@ const (
 	detailsTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
 	contentTXT      = "text"
 	contentYAML     = "yaml"
+	contentJSON     = "json"
+	contentDiff     = "diff"
 )
 
 // Details represents a generic text viewer.
@@ -98,6 +101,10 @@ func (d *Details) TextChanged(lines []string) {
 	switch d.contentType {
 	case contentYAML:
 		d.text.SetText(colorizeYAML(d.app.Styles.Views().Yaml, strings.Join(lines, "\n")))
+	case contentJSON:
+		d.text.SetText(tview.Escape(strings.Join(lines, "\n")))
+	case contentDiff:
+		d.text.SetText(colorizeDiff(lines))
 	default:
 		d.text.SetText(strings.Join(lines, "\n"))
 	}
@@ -137,6 +144,7 @@ func (d *Details) bindKeys() {
 		tcell.KeyEscape: ui.NewKeyAction("Back", d.resetCmd, false),
 		tcell.KeyCtrlS:  ui.NewKeyAction("Save", d.saveCmd, false),
 		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(d.app.Flash(), d.text), true),
+		ui.KeyPipe:      ui.NewKeyAction("Pipe", d.pipeCmd, true),
 		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", d.toggleFullScreenCmd, true),
 		ui.KeyN:         ui.NewKeyAction("Next Match", d.nextCmd, true),
 		ui.KeyShiftN:    ui.NewKeyAction("Prev Match", d.prevCmd, true),
@@ -149,6 +157,15 @@ func (d *Details) bindKeys() {
 	}
 }
 
+func (d *Details) pipeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if d.InCmdMode() {
+		return evt
+	}
+	showPipe(d.app, d.subject, sanitizeEsc(d.text.GetText(true)))
+
+	return nil
+}
+
 func (d *Details) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 	if a, ok := d.actions.Get(ui.AsKey(evt)); ok {
 		return a.Action(evt)
@@ -167,7 +184,7 @@ func (d *Details) StylesChanged(s *config.Styles) {
 
 // Update updates the view content.
 func (d *Details) Update(buff string) *Details {
-	d.model.SetText(buff)
+	d.model.SetText(redact.Manifest(buff))
 
 	return d
 }
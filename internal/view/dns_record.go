@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// DNSRecord presents the records external-dns publishes for ingresses and
+// services.
+type DNSRecord struct {
+	ResourceViewer
+
+	lookups bool
+}
+
+// NewDNSRecord returns a new viewer.
+func NewDNSRecord(gvr client.GVR) ResourceViewer {
+	d := DNSRecord{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.GetTable().SetSortCol("HOSTNAME", true)
+	d.GetTable().SetEnterFn(d.showSource)
+	d.SetContextFn(d.dnsContext)
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+func (d *DNSRecord) dnsContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyDNSLookup, d.lookups)
+}
+
+func (d *DNSRecord) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyL:      ui.NewKeyAction("Toggle Lookups", d.toggleLookupsCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", d.GetTable().SortColCmd(statusCol, true), false),
+		ui.KeyShiftH: ui.NewKeyAction("Sort Hostname", d.GetTable().SortColCmd("HOSTNAME", true), false),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", d.GetTable().SortColCmd("KIND", true), false),
+	})
+}
+
+func (d *DNSRecord) toggleLookupsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	d.lookups = !d.lookups
+	if d.lookups {
+		d.App().Flash().Info("DNS lookups enabled")
+	} else {
+		d.App().Flash().Info("DNS lookups disabled")
+	}
+	d.Start()
+
+	return nil
+}
+
+// showSource jumps to the ingress or service publishing a record.
+func (*DNSRecord) showSource(app *App, _ ui.Tabular, _ client.GVR, id string) {
+	gvr, path, ok := render.ParseResourceID(id)
+	if !ok {
+		app.Flash().Errf("Invalid selection %q", id)
+		return
+	}
+	path, _, _ = strings.Cut(path, "|")
+	app.gotoResource(gvr, path, false)
+}
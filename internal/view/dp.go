@@ -28,7 +28,7 @@ func NewDeploy(gvr client.GVR) ResourceViewer {
 			NewRestartExtender(
 				NewScaleExtender(
 					NewImageExtender(
-						NewLogsExtender(NewBrowser(gvr), d.logOptions),
+						NewLogsExtender(NewTimelineExtender(NewBrowser(gvr)), d.logOptions),
 					),
 				),
 			),
@@ -24,7 +24,7 @@ func NewDaemonSet(gvr client.GVR) ResourceViewer {
 		NewVulnerabilityExtender(
 			NewRestartExtender(
 				NewImageExtender(
-					NewLogsExtender(NewBrowser(gvr), d.logOptions),
+					NewLogsExtender(NewTimelineExtender(NewBrowser(gvr)), d.logOptions),
 				),
 			),
 		),
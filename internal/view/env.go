@@ -21,7 +21,7 @@ type Env map[string]string
 // |                               (g2)(group 3)       (g5)( group 6   )
 // |                            (    group 1    ) (       group 4        )
 // |                           (                 group 0                  )
-var envRX = regexp.MustCompile(`(\$(!?)([\w\-]+))|(\$\{(!?)([\w\-%/: ]+)})`)
+var envRX = regexp.MustCompile(`(\$(!?)([\w\-]+))|(\$\{(!?)([\w\-%/:. ]+)})`)
 
 // keyFromSubmatch extracts the name and inverse flag of a match.
 func keyFromSubmatch(m []string) (key string, inverse bool) {
@@ -34,9 +34,10 @@ import (
 )
 
 const (
-	shellCheck   = `command -v bash >/dev/null && exec bash || exec sh`
-	bannerFmt    = "<<K9s-Shell>> Pod: %s | Container: %s \n"
-	outputPrefix = "[output]"
+	shellCheck        = `command -v bash >/dev/null && exec bash || exec sh`
+	windowsShellCheck = `where ` + powerShell + ` >NUL 2>&1 && ` + powerShell + ` || cmd`
+	bannerFmt         = "<<K9s-Shell>> Pod: %s | Container: %s \n"
+	outputPrefix      = "[output]"
 )
 
 var editorEnvVars = []string{"KUBE_EDITOR", "K9S_EDITOR", "EDITOR"}
@@ -47,6 +48,7 @@ type shellOpts struct {
 	binary            string
 	banner            string
 	args              []string
+	env               []string
 }
 
 func (s shellOpts) String() string {
@@ -78,7 +80,7 @@ func runK(a *App, opts shellOpts) error {
 	if len(args) > 0 {
 		opts.args = append(args, opts.args[1:]...)
 	}
-	opts.binary = bin
+	opts.binary, opts.env = bin, proxyEnv(a)
 
 	suspended, errChan, stChan := run(a, opts)
 	if !suspended {
@@ -180,6 +182,9 @@ func execute(opts shellOpts, statusChan chan<- string) error {
 
 	cmds := make([]*exec.Cmd, 0, 1)
 	cmd := exec.CommandContext(ctx, opts.binary, opts.args...)
+	if len(opts.env) > 0 {
+		cmd.Env = append(os.Environ(), opts.env...)
+	}
 	log.Debug().Msgf("RUNNING> %s", opts)
 	cmds = append(cmds, cmd)
 
@@ -227,11 +232,21 @@ func runKu(a *App, opts shellOpts) (string, error) {
 	if len(args) > 0 {
 		opts.args = append(args, opts.args...)
 	}
-	opts.binary, opts.background = bin, false
+	opts.binary, opts.background, opts.env = bin, false, proxyEnv(a)
 
 	return oneShoot(opts)
 }
 
+// proxyEnv routes kubectl through the context proxy or ssh bastion if any.
+func proxyEnv(a *App) []string {
+	u := a.Conn().Config().ProxyURL()
+	if u == nil {
+		return nil
+	}
+
+	return []string{"HTTPS_PROXY=" + u.String(), "HTTP_PROXY=" + u.String()}
+}
+
 func oneShoot(opts shellOpts) (string, error) {
 	if opts.clear {
 		clearScreen()
@@ -239,6 +254,9 @@ func oneShoot(opts shellOpts) (string, error) {
 
 	log.Debug().Msgf("Running command> %s %s", opts.binary, strings.Join(opts.args, " "))
 	cmd := exec.Command(opts.binary, opts.args...)
+	if len(opts.env) > 0 {
+		cmd.Env = append(os.Environ(), opts.env...)
+	}
 
 	var err error
 	buff := bytes.NewBufferString("")
@@ -314,10 +332,7 @@ func sshIn(a *App, fqn, co string) error {
 		args = append(args, cfg.Command...)
 		args = append(args, cfg.Args...)
 	} else {
-		if os == windowsOS {
-			args = append(args, "--", powerShell)
-		}
-		args = append(args, "sh", "-c", shellCheck)
+		args = append(args, shellCommand(os)...)
 	}
 	log.Debug().Msgf("ARGS %#v", args)
 
@@ -359,7 +374,7 @@ func nukeK9sShell(a *App) error {
 func launchShellPod(ctx context.Context, a *App, node string) error {
 	var (
 		spo  = a.Config.K9s.ShellPod
-		spec = k9sShellPod(node, spo)
+		spec = k9sShellPod(node, nodeOS(ctx, a.factory, node), spo)
 	)
 
 	dial, err := a.Conn().Dial()
@@ -406,14 +421,26 @@ func k9sShellPodName() string {
 	return fmt.Sprintf("%s-%d", k9sShell, os.Getpid())
 }
 
-func k9sShellPod(node string, cfg config.ShellPod) *v1.Pod {
+// nodeOS returns a node operating system or blank if it can't be detected.
+func nodeOS(ctx context.Context, f dao.Factory, node string) string {
+	no, err := dao.FetchNode(ctx, f, node)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Node os detect failed for %q", node)
+		return ""
+	}
+	os, _ := osFromSelector(no.Labels)
+
+	return os
+}
+
+func k9sShellPod(node, os string, cfg config.ShellPod) *v1.Pod {
 	var grace int64
 	var priv bool = true
 
 	log.Debug().Msgf("Shell Config %#v", cfg)
 	c := v1.Container{
 		Name:            k9sShell,
-		Image:           cfg.Image,
+		Image:           cfg.ImageFor(os),
 		ImagePullPolicy: cfg.ImagePullPolicy,
 		VolumeMounts: []v1.VolumeMount{
 			{
@@ -436,7 +463,7 @@ func k9sShellPod(node string, cfg config.ShellPod) *v1.Pod {
 		c.Args = cfg.Args
 	}
 
-	return &v1.Pod{
+	po := v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      k9sShellPodName(),
 			Namespace: cfg.Namespace,
@@ -467,6 +494,32 @@ func k9sShellPod(node string, cfg config.ShellPod) *v1.Pod {
 			},
 		},
 	}
+	if os == windowsOS {
+		asWindowsShellPod(&po, cfg)
+	}
+
+	return &po
+}
+
+// asWindowsShellPod turns a shell pod into a Windows HostProcess pod since
+// privileged containers and host mounts are not supported on Windows nodes.
+func asWindowsShellPod(po *v1.Pod, cfg config.ShellPod) {
+	hostProcess, user := true, `NT AUTHORITY\SYSTEM`
+	po.Spec.OS = &v1.PodOS{Name: v1.Windows}
+	po.Spec.HostPID = false
+	po.Spec.Volumes = nil
+	po.Spec.SecurityContext = &v1.PodSecurityContext{
+		WindowsOptions: &v1.WindowsSecurityContextOptions{
+			HostProcess:   &hostProcess,
+			RunAsUserName: &user,
+		},
+	}
+	c := &po.Spec.Containers[0]
+	c.VolumeMounts = nil
+	c.SecurityContext = nil
+	if len(cfg.Command) == 0 {
+		c.Command = []string{"cmd", "/c", "ping -t localhost >NUL"}
+	}
 }
 
 func asResource(r config.Limits) v1.ResourceRequirements {
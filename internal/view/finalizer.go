@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// Finalizer presents resources stuck in a terminating state.
+type Finalizer struct {
+	ResourceViewer
+}
+
+// NewFinalizer returns a new viewer.
+func NewFinalizer(gvr client.GVR) ResourceViewer {
+	f := Finalizer{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	f.GetTable().SetBorderFocusColor(tcell.ColorIndianRed)
+	f.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorIndianRed).Attributes(tcell.AttrNone))
+	f.GetTable().SetSortCol("TERMINATING", false)
+	f.GetTable().SetEnterFn(f.describe)
+	f.AddBindKeysFn(f.bindKeys)
+
+	return &f
+}
+
+func (f *Finalizer) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftK: ui.NewKeyAction("Sort GVR", f.GetTable().SortColCmd("GVR", true), false),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Terminating", f.GetTable().SortColCmd("TERMINATING", false), false),
+	})
+	if f.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyR, ui.NewKeyActionWithOpts("Remove Finalizer", f.removeCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		},
+	))
+}
+
+func (f *Finalizer) describe(app *App, model ui.Tabular, _ client.GVR, id string) {
+	gvr, path, ok := render.ParseResourceID(id)
+	if !ok {
+		app.Flash().Errf("Invalid selection %q", id)
+		return
+	}
+	describeResource(app, model, client.NewGVR(gvr), path)
+}
+
+func (f *Finalizer) removeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := f.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+
+	var fin dao.Finalizer
+	fin.Init(f.App().factory, f.GVR())
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), f.App().Conn().Config().CallTimeout())
+		defer cancel()
+		ff, err := fin.Finalizers(ctx, id)
+		f.App().QueueUpdateDraw(func() {
+			if err != nil {
+				f.App().Flash().Err(err)
+				return
+			}
+			if len(ff) == 0 {
+				f.App().Flash().Infof("No pending finalizers on %s", id)
+				return
+			}
+			dialog.ShowPicker(f.App().Styles.Dialog(), f.App().Content.Pages, "Remove Finalizer", ff, func(i int) {
+				if i < 0 || i >= len(ff) {
+					return
+				}
+				f.confirmRemove(&fin, id, ff[i])
+			})
+		})
+	}()
+
+	return nil
+}
+
+func (f *Finalizer) confirmRemove(fin *dao.Finalizer, id, finalizer string) {
+	_, path, _ := render.ParseResourceID(id)
+	_, n := client.Namespaced(path)
+	msg := fmt.Sprintf(
+		"Removing finalizer %q from %s skips the controller cleanup it guards and may leak external resources!\nType the resource name to confirm.",
+		finalizer,
+		path,
+	)
+	dialog.ShowConfirmAck(f.App().App, f.App().Content.Pages, n, true, "Remove Finalizer", msg, func() {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), f.App().Conn().Config().CallTimeout())
+			defer cancel()
+			err := fin.RemoveFinalizer(ctx, id, finalizer)
+			f.App().QueueUpdateDraw(func() {
+				if err != nil {
+					log.Error().Err(err).Msgf("Finalizer removal failed for %s", id)
+					f.App().Flash().Err(err)
+					return
+				}
+				f.App().Flash().Infof("Finalizer %q removed from %s", finalizer, path)
+				f.Refresh()
+			})
+		}()
+	}, func() {})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showFinalizers(app *App, ns string) {
+	v := NewFinalizer(client.NewGVR("finalizers"))
+	if err := app.Config.SetActiveNamespace(ns); err != nil {
+		log.Error().Err(err).Msg("Config NS set failed!")
+	}
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Find presents resources matching a search across kinds.
+type Find struct {
+	ResourceViewer
+}
+
+// NewFind returns a new viewer.
+func NewFind(gvr client.GVR) ResourceViewer {
+	f := Find{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	f.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	f.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	f.GetTable().SetSortCol("KIND", true)
+	f.GetTable().SetEnterFn(f.gotoResource)
+	f.AddBindKeysFn(f.bindKeys)
+
+	return &f
+}
+
+func (f *Find) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyD:      ui.NewKeyAction("Describe", f.describeCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", f.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", f.GetTable().SortColCmd(nameCol, true), false),
+	})
+}
+
+func (f *Find) gotoResource(app *App, _ ui.Tabular, _ client.GVR, id string) {
+	gvr, path, ok := render.ParseResourceID(id)
+	if !ok {
+		app.Flash().Errf("Invalid selection %q", id)
+		return
+	}
+	app.gotoResource(gvr, path, false)
+}
+
+func (f *Find) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	gvr, path, ok := render.ParseResourceID(f.GetTable().GetSelectedItem())
+	if !ok {
+		return evt
+	}
+	describeResource(f.App(), f.GetTable().GetModel(), client.NewGVR(gvr), path)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// showFind lists the query matches. A query namespace only scopes the
+// search and leaves the active namespace alone.
+func showFind(app *App, q dao.FindQuery) error {
+	if q.Namespace != "" && !dao.NSScope.CanAccessNamespace(client.CleanseNamespace(q.Namespace)) {
+		return fmt.Errorf("namespace %q is not accessible in namespace scoped mode", q.Namespace)
+	}
+	v := NewFind(client.NewGVR("finds"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyQuery, q)
+	})
+
+	return app.inject(v, false)
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Fleet presents a multi clusters health viewer.
+type Fleet struct {
+	ResourceViewer
+}
+
+// NewFleet returns a new viewer.
+func NewFleet(gvr client.GVR) ResourceViewer {
+	f := Fleet{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	f.GetTable().SetEnterFn(f.useCtx)
+	f.GetTable().SetSortCol("CONTEXT", true)
+	f.SetContextFn(f.fleetContext)
+	f.AddBindKeysFn(f.bindKeys)
+
+	return &f
+}
+
+func (f *Fleet) fleetContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyFleet, f.App().Config.K9s.Fleet)
+}
+
+func (f *Fleet) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", f.GetTable().SortColCmd("STATUS", true), false),
+		ui.KeyShiftF: ui.NewKeyAction("Sort Failing", f.GetTable().SortColCmd("FAILING", false), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort Latency", f.GetTable().SortColCmd("LATENCY", false), false),
+	})
+}
+
+// useCtx drills into a cluster by switching to its context.
+func (f *Fleet) useCtx(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	if err := useContext(app, path); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -5,375 +5,241 @@ package view
 
 import (
 	"context"
-	"fmt"
 	"sort"
-	"strconv"
+	"strings"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
-	"github.com/derailed/k9s/internal/config"
-	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
 	"github.com/derailed/tcell/v2"
-	"github.com/derailed/tview"
 )
 
+const helpTitle = "Help"
+
+// Help sections.
 const (
-	helpTitle    = "Help"
-	helpTitleFmt = " [aqua::b]%s "
+	helpResource   = "RESOURCE"
+	helpGeneral    = "GENERAL"
+	helpNavigation = "NAVIGATION"
+	helpHotKey     = "HOTKEY"
+	helpPlugin     = "PLUGIN"
+	helpCommand    = "COMMAND"
+	helpAlias      = "ALIAS"
 )
 
-// HelpFunc processes menu hints.
-type HelpFunc func() model.MenuHints
-
-// Help presents a help viewer.
+var helpKeys = []render.HelpRes{
+	{Section: helpGeneral, Key: "<:cmd>", Description: "Command mode"},
+	{Section: helpGeneral, Key: "</term>", Description: "Filter mode"},
+	{Section: helpGeneral, Key: "</-f term>", Description: "Fuzzy filter mode"},
+	{Section: helpNavigation, Key: "<g>", Description: "Goto Top"},
+	{Section: helpNavigation, Key: "<shift-g>", Description: "Goto Bottom"},
+	{Section: helpNavigation, Key: "<ctrl-b>", Description: "Page Up"},
+	{Section: helpNavigation, Key: "<ctrl-f>", Description: "Page Down"},
+	{Section: helpNavigation, Key: "<h>", Description: "Left"},
+	{Section: helpNavigation, Key: "<l>", Description: "Right"},
+	{Section: helpNavigation, Key: "<k>", Description: "Up"},
+	{Section: helpNavigation, Key: "<j>", Description: "Down"},
+}
+
+// Help presents a searchable index of key bindings, commands and aliases.
 type Help struct {
-	*Table
+	ResourceViewer
 
-	styles                   *config.Styles
-	hints                    HelpFunc
-	maxKey, maxDesc, maxRows int
+	viewer  Viewer
+	entries []render.HelpRes
+	runs    map[string]func()
+	keys    map[tcell.Key]struct{}
 }
 
 // NewHelp returns a new help viewer.
 func NewHelp(app *App) *Help {
-	return &Help{
-		Table: NewTable(client.NewGVR("help")),
-		hints: app.Content.Top().Hints,
+	h := Help{
+		ResourceViewer: NewBrowser(client.NewGVR("help")),
+		runs:           make(map[string]func()),
+		keys:           make(map[tcell.Key]struct{}),
 	}
-}
+	if v, ok := app.Content.Top().(Viewer); ok {
+		h.viewer = v
+	}
+	h.GetTable().SetBorderFocusColor(tcell.ColorAqua)
+	h.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorAqua).Attributes(tcell.AttrNone))
+	h.AddBindKeysFn(h.bindKeys)
+	h.SetContextFn(h.helpContext)
 
-func (h *Help) SetFilter(string)                 {}
-func (h *Help) SetLabelFilter(map[string]string) {}
+	return &h
+}
 
-// Init initializes the component.
+// Init initializes the view.
 func (h *Help) Init(ctx context.Context) error {
-	if err := h.Table.Init(ctx); err != nil {
+	if err := h.ResourceViewer.Init(ctx); err != nil {
 		return err
 	}
-	h.SetSelectable(false, false)
-	h.resetTitle()
-	h.SetBorder(true)
-	h.SetBorderPadding(0, 0, 1, 1)
-	h.bindKeys()
+	h.GetTable().GetModel().SetNamespace(client.NotNamespaced)
 	h.build()
-	h.app.Styles.AddListener(h)
-	h.StylesChanged(h.app.Styles)
 
 	return nil
 }
 
-// InCmdMode checks if prompt is active.
-func (*Help) InCmdMode() bool {
-	return false
-}
-
-// StylesChanged notifies skin changed.
-func (h *Help) StylesChanged(s *config.Styles) {
-	h.styles = s
-	h.SetBackgroundColor(s.BgColor())
-	h.updateStyle()
+func (h *Help) helpContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyHelp, h.entries)
 }
 
-func (h *Help) bindKeys() {
-	h.Actions().Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlS, ui.KeySlash)
-	h.Actions().Bulk(ui.KeyMap{
-		tcell.KeyEscape: ui.NewKeyAction("Back", h.app.PrevCmd, true),
-		ui.KeyHelp:      ui.NewKeyAction("Back", h.app.PrevCmd, false),
-		tcell.KeyEnter:  ui.NewKeyAction("Back", h.app.PrevCmd, false),
+func (h *Help) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlD, tcell.KeyCtrlK)
+	aa.Bulk(ui.KeyMap{
+		tcell.KeyEnter: ui.NewKeyAction("Run", h.runCmd, true),
+		ui.KeyShiftS:   ui.NewKeyAction("Sort Section", h.GetTable().SortColCmd("SECTION", true), false),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort Key", h.GetTable().SortColCmd("KEY", true), false),
+		ui.KeyShiftD:   ui.NewKeyAction("Sort Description", h.GetTable().SortColCmd("DESCRIPTION", true), false),
 	})
 }
 
-func (h *Help) computeMaxes(hh model.MenuHints) {
-	h.maxKey, h.maxDesc = 0, 0
-	for _, hint := range hh {
-		if len(hint.Mnemonic) > h.maxKey {
-			h.maxKey = len(hint.Mnemonic)
-		}
-		if len(hint.Description) > h.maxDesc {
-			h.maxDesc = len(hint.Description)
-		}
+// runCmd executes the selected entry against the view help was opened from.
+func (h *Help) runCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if h.GetTable().CmdBuff().IsActive() {
+		return h.GetTable().activateCmd(evt)
 	}
-	h.maxKey += 2
-}
 
-func (h *Help) computeExtraMaxes(ee map[string]string) {
-	h.maxDesc = 0
-	for k := range ee {
-		if len(k) > h.maxDesc {
-			h.maxDesc = len(k)
-		}
+	run, ok := h.runs[h.GetTable().GetSelectedItem()]
+	if !ok {
+		h.App().Flash().Info(i18n.T("Nothing to run for this entry"))
+		return nil
 	}
+	h.App().PrevCmd(evt)
+	run()
+
+	return nil
 }
 
 func (h *Help) build() {
-	h.Clear()
-
-	sections := []string{"RESOURCE", "GENERAL", "NAVIGATION"}
-	h.maxRows = len(h.showGeneral())
-	ff := []HelpFunc{
-		h.hints,
-		h.showGeneral,
-		h.showNav,
+	if h.viewer != nil {
+		h.addActions(h.viewer.Actions())
+		h.addExtras(h.viewer.ExtraHints())
 	}
-
-	var col int
-	extras := h.app.Content.Top().ExtraHints()
-	for i, section := range sections {
-		hh := ff[i]()
-		sort.Sort(hh)
-		h.computeMaxes(hh)
-		if extras != nil {
-			h.computeExtraMaxes(extras)
-		}
-		h.addSection(col, section, hh)
-		if i == 0 && extras != nil {
-			h.addExtras(extras, col, len(hh))
-		}
-		col += 2
-	}
-	if hh, err := h.showHotKeys(); err == nil {
-		h.computeMaxes(hh)
-		h.addSection(col, "HOTKEYS", hh)
+	h.addActions(h.App().GetActions())
+	for _, e := range helpKeys {
+		h.add(e, nil)
 	}
+	h.addCommands()
+	h.addAliases()
 }
 
-func (h *Help) addExtras(extras map[string]string, col, size int) {
-	kk := make([]string, 0, len(extras))
-	for k := range extras {
-		kk = append(kk, k)
-	}
-	sort.StringSlice(kk).Sort()
-	row := size + 1
-	for _, k := range kk {
-		h.SetCell(row, col, padCell(extras[k], h.maxKey))
-		h.SetCell(row, col+1, padCell(k, h.maxDesc))
-		row++
+func (h *Help) add(e render.HelpRes, run func()) {
+	e.Section, e.Description = i18n.T(e.Section), i18n.T(e.Description)
+	h.entries = append(h.entries, e)
+	if run != nil {
+		h.runs[e.ID()] = run
 	}
 }
 
-func (h *Help) showNav() model.MenuHints {
-	return model.MenuHints{
-		{
-			Mnemonic:    "g",
-			Description: "Goto Top",
-		},
-		{
-			Mnemonic:    "Shift-g",
-			Description: "Goto Bottom",
-		},
-		{
-			Mnemonic:    "Ctrl-b",
-			Description: "Page Up",
-		},
-		{
-			Mnemonic:    "Ctrl-f",
-			Description: "Page Down",
-		},
-		{
-			Mnemonic:    "h",
-			Description: "Left",
-		},
-		{
-			Mnemonic:    "l",
-			Description: "Right",
-		},
-		{
-			Mnemonic:    "k",
-			Description: "Up",
-		},
-		{
-			Mnemonic:    "j",
-			Description: "Down",
-		},
-	}
-}
+// addActions indexes the given bindings. Keys already indexed are skipped
+// as the view bindings shadow the application ones.
+func (h *Help) addActions(aa *ui.KeyActions) {
+	kk := make([]int, 0, aa.Len())
+	aa.Range(func(k tcell.Key, _ ui.KeyAction) {
+		kk = append(kk, int(k))
+	})
+	sort.Ints(kk)
 
-func (h *Help) showHotKeys() (model.MenuHints, error) {
-	hh := config.NewHotKeys()
-	if err := hh.Load(h.App().Config.ContextHotkeysPath()); err != nil {
-		return nil, fmt.Errorf("no hotkey configuration found")
-	}
-	kk := make(sort.StringSlice, 0, len(hh.HotKey))
-	for k := range hh.HotKey {
-		kk = append(kk, k)
-	}
-	kk.Sort()
-	mm := make(model.MenuHints, 0, len(hh.HotKey))
 	for _, k := range kk {
-		mm = append(mm, model.MenuHint{
-			Mnemonic:    hh.HotKey[k].ShortCut,
-			Description: hh.HotKey[k].Description,
-		})
+		key := tcell.Key(k)
+		if _, ok := h.keys[key]; ok {
+			continue
+		}
+		a, ok := aa.Get(key)
+		if !ok || a.Description == "" {
+			continue
+		}
+		h.keys[key] = struct{}{}
+		name, ok := tcell.KeyNames[key]
+		if !ok {
+			continue
+		}
+		h.add(render.HelpRes{
+			Section:     actionSection(a.Opts),
+			Key:         ui.ToMnemonic(name),
+			Description: a.Description,
+		}, h.keyRunner(key, a))
 	}
-
-	return mm, nil
 }
 
-func (h *Help) showGeneral() model.MenuHints {
-	return model.MenuHints{
-		{
-			Mnemonic:    "?",
-			Description: "Help",
-		},
-		{
-			Mnemonic:    "Ctrl-a",
-			Description: "Aliases",
-		},
-		{
-			Mnemonic:    ":cmd",
-			Description: "Command mode",
-		},
-		{
-			Mnemonic:    "/term",
-			Description: "Filter mode",
-		},
-		{
-			Mnemonic:    "esc",
-			Description: "Back/Clear",
-		},
-		{
-			Mnemonic:    "tab",
-			Description: "Field Next",
-		},
-		{
-			Mnemonic:    "backtab",
-			Description: "Field Previous",
-		},
-		{
-			Mnemonic:    "Ctrl-r",
-			Description: "Reload",
-		},
-		{
-			Mnemonic:    "Ctrl-u",
-			Description: "Command Clear",
-		},
-		{
-			Mnemonic:    "Ctrl-e",
-			Description: "Toggle Header",
-		},
-		{
-			Mnemonic:    "Ctrl-g",
-			Description: "Toggle Crumbs",
-		},
-		{
-			Mnemonic:    ":q",
-			Description: "Quit",
-		},
-		{
-			Mnemonic:    "space",
-			Description: "Mark",
-		},
-		{
-			Mnemonic:    "Ctrl-space",
-			Description: "Mark Range",
-		},
-		{
-			Mnemonic:    "Ctrl-\\",
-			Description: "Mark Clear",
-		},
-		{
-			Mnemonic:    "Ctrl-s",
-			Description: "Save",
-		},
+func (h *Help) addExtras(ee map[string]string) {
+	for desc, key := range ee {
+		h.add(render.HelpRes{
+			Section:     helpResource,
+			Key:         key,
+			Description: desc,
+		}, nil)
 	}
 }
 
-func (h *Help) resetTitle() {
-	h.SetTitle(fmt.Sprintf(helpTitleFmt, helpTitle))
-}
-
-func (h *Help) addSpacer(c int) {
-	cell := tview.NewTableCell(render.Pad("", h.maxKey))
-	cell.SetExpansion(1)
-	h.SetCell(0, c, cell)
-}
-
-func (h *Help) addSection(c int, title string, hh model.MenuHints) {
-	if len(hh) > h.maxRows {
-		h.maxRows = len(hh)
+func (h *Help) keyRunner(key tcell.Key, a ui.KeyAction) func() {
+	return func() {
+		a.Action(asEvent(key))
 	}
-	row := 0
-	h.SetCell(row, c, h.titleCell(title))
-	h.addSpacer(c + 1)
-	row++
+}
 
-	for _, hint := range hh {
-		col := c
-		h.SetCell(row, col, padCellWithRef(ui.ToMnemonic(hint.Mnemonic), h.maxKey, hint.Mnemonic))
-		col++
-		h.SetCell(row, col, padCell(hint.Description, h.maxDesc))
-		row++
+func (h *Help) addCommands() {
+	for _, c := range cmd.Specs() {
+		c := c
+		h.add(render.HelpRes{
+			Section:     helpCommand,
+			Key:         ":" + strings.Join(c.Aliases, ","),
+			Description: c.Description,
+		}, func() {
+			if c.Args {
+				h.App().ResetPrompt(h.App().CmdBuff())
+				h.App().CmdBuff().SetText(c.Aliases[0]+" ", "")
+				return
+			}
+			h.App().gotoResource(c.Aliases[0], "", true)
+		})
 	}
+}
 
-	if len(hh) >= h.maxRows {
+func (h *Help) addAliases() {
+	if h.App().command == nil {
 		return
 	}
-
-	for i := h.maxRows - len(hh); i > 0; i-- {
-		col := c
-		h.SetCell(row, col, padCell("", h.maxKey))
-		col++
-		h.SetCell(row, col, padCell("", h.maxDesc))
-		row++
-	}
-}
-
-func (h *Help) updateStyle() {
-	var (
-		style   = tcell.StyleDefault.Background(h.styles.K9s.Help.BgColor.Color())
-		key     = style.Foreground(h.styles.K9s.Help.KeyColor.Color()).Bold(true)
-		numKey  = style.Foreground(h.app.Styles.K9s.Help.NumKeyColor.Color()).Bold(true)
-		info    = style.Foreground(h.app.Styles.K9s.Help.FgColor.Color())
-		heading = style.Foreground(h.app.Styles.K9s.Help.SectionColor.Color())
-	)
-	for col := 0; col < h.GetColumnCount(); col++ {
-		for row := 0; row < h.GetRowCount(); row++ {
-			c := h.GetCell(row, col)
-			if c == nil {
-				continue
-			}
-			switch {
-			case row == 0:
-				c.SetStyle(heading)
-			case col%2 != 0:
-				c.SetStyle(info)
-			default:
-				if _, err := strconv.Atoi(extractRef(c)); err == nil {
-					c.SetStyle(numKey)
-					continue
-				}
-				c.SetStyle(key)
-			}
-		}
+	for gvr, aa := range h.App().command.alias.ShortNames() {
+		sort.StringSlice(aa).Sort()
+		res, _ := client.NewGVR(gvr).RG()
+		name := aa[0]
+		h.add(render.HelpRes{
+			Section:     helpAlias,
+			Key:         ":" + strings.Join(aa, ","),
+			Description: res,
+		}, func() {
+			h.App().gotoResource(name, "", true)
+		})
 	}
 }
 
 // ----------------------------------------------------------------------------
 // Helpers...
 
-func extractRef(c *tview.TableCell) string {
-	if ref, ok := c.GetReference().(string); ok {
-		return ref
+func actionSection(o ui.ActionOpts) string {
+	switch {
+	case o.HotKey:
+		return helpHotKey
+	case o.Plugin:
+		return helpPlugin
+	case o.Shared:
+		return helpGeneral
+	default:
+		return helpResource
 	}
-
-	return c.Text
-}
-
-func (h *Help) titleCell(title string) *tview.TableCell {
-	c := tview.NewTableCell(title)
-	c.SetTextColor(h.Styles().K9s.Help.SectionColor.Color())
-	c.SetAttributes(tcell.AttrBold)
-	c.SetExpansion(1)
-	c.SetAlign(tview.AlignLeft)
-
-	return c
 }
 
-func padCellWithRef(s string, width int, ref interface{}) *tview.TableCell {
-	return padCell(s, width).SetReference(ref)
-}
+// asEvent converts a key binding back to the event that triggers it.
+func asEvent(k tcell.Key) *tcell.EventKey {
+	if k > tcell.KeyUS && k < tcell.KeyDEL {
+		return tcell.NewEventKey(tcell.KeyRune, rune(k), tcell.ModNone)
+	}
 
-func padCell(s string, width int) *tview.TableCell {
-	return tview.NewTableCell(render.Pad(s, width))
+	return tcell.NewEventKey(k, 0, tcell.ModNone)
 }
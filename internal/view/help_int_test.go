@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/mock"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHelpEntries(t *testing.T) {
+	app := NewApp(mock.NewMockConfig())
+	ctx := context.WithValue(context.Background(), internal.KeyApp, app)
+	po := NewPod(client.NewGVR("v1/pods"))
+	assert.NoError(t, po.Init(ctx))
+	app.Content.Push(po)
+
+	h := NewHelp(app)
+	assert.NoError(t, h.Init(ctx))
+
+	ss := make(map[string]int)
+	for _, e := range h.entries {
+		ss[e.Section]++
+	}
+	assert.Equal(t, len(cmd.Specs()), ss[helpCommand])
+	assert.Equal(t, 8, ss[helpNavigation])
+	assert.Contains(t, h.entries, render.HelpRes{Section: helpResource, Key: "<a>", Description: "Attach"})
+	assert.Contains(t, h.entries, render.HelpRes{Section: helpCommand, Key: ":a,alias", Description: "Aliases"})
+	assert.Contains(t, h.runs, ":a,alias Aliases COMMAND")
+}
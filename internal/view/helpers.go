@@ -10,7 +10,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
@@ -20,6 +19,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/k9s/internal/watch"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 	"github.com/rs/zerolog/log"
@@ -45,10 +45,6 @@ func aliasesFor(m v1.APIResource, aa []string) map[string]struct{} {
 	return rr
 }
 
-func clipboardWrite(text string) error {
-	return clipboard.WriteAll(text)
-}
-
 func sanitizeEsc(s string) string {
 	return strings.ReplaceAll(s, "[]", "]")
 }
@@ -127,7 +123,9 @@ func describeResource(app *App, m ui.Tabular, gvr client.GVR, path string) {
 	v := NewLiveView(app, "Describe", model.NewDescribe(gvr, path))
 	if err := app.inject(v, false); err != nil {
 		app.Flash().Err(err)
+		return
 	}
+	hintActions(app, v, gvr, path)
 }
 
 func toLabelsStr(labels map[string]string) string {
@@ -278,3 +276,67 @@ func linesWithRegions(lines []string, matches fuzzy.Matches) []string {
 	}
 	return ll
 }
+
+// popCanceler cancels a background task once its view is popped.
+type popCanceler struct {
+	c      model.Component
+	cancel context.CancelFunc
+}
+
+// StackPushed notifies a new component was pushed.
+func (*popCanceler) StackPushed(model.Component) {}
+
+// StackPopped cancels the task when its view is popped.
+func (p *popCanceler) StackPopped(o, _ model.Component) {
+	if o == p.c {
+		p.cancel()
+	}
+}
+
+// StackTop notifies the top component.
+func (*popCanceler) StackTop(model.Component) {}
+
+// cancelOnPop cancels a background task once its view is popped off the
+// content stack. The returned func unregisters the listener and must be
+// called on the ui goroutine.
+func cancelOnPop(app *App, c model.Component, cancel context.CancelFunc) func() {
+	l := &popCanceler{c: c, cancel: cancel}
+	app.Content.Stack.AddListener(l)
+
+	return func() {
+		app.Content.Stack.RemoveListener(l)
+	}
+}
+
+// canList checks the user may list a resource. Resources too large to be
+// cached are listed straight from the api server so the budget is not fatal.
+func canList(f *watch.Factory, ns, gvr string) error {
+	_, err := f.CanForResource(ns, gvr, client.ListAccess)
+	if errors.Is(err, watch.ErrCacheBudget) {
+		return nil
+	}
+
+	return err
+}
+
+// reportFunc generates a yaml report.
+type reportFunc func(context.Context, dao.Factory) (string, error)
+
+// showReport generates a report off the ui goroutine and displays it.
+func showReport(app *App, title, path string, fn reportFunc) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		defer cancel()
+		report, err := fn(ctx, app.factory)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			details := NewDetails(app, title, path, contentYAML, true).Update(report)
+			if err := app.inject(details, false); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+	}()
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	lockPage      = "lock"
+	idleCheck     = 5 * time.Second
+	passphraseLen = 30
+)
+
+// touch records user activity.
+func (a *App) touch() {
+	atomic.StoreInt64(&a.lastActive, time.Now().UnixNano())
+}
+
+func (a *App) isLocked() bool {
+	return atomic.LoadInt32(&a.locked) == 1
+}
+
+// idleWatcher locks the session once the user has been idle for too long.
+func (a *App) idleWatcher(ctx context.Context) {
+	timeout := a.Config.K9s.IdleLock.GetTimeout()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(idleCheck):
+			if a.isLocked() {
+				continue
+			}
+			if time.Since(time.Unix(0, atomic.LoadInt64(&a.lastActive))) >= timeout {
+				a.QueueUpdateDraw(a.lock)
+			}
+		}
+	}
+}
+
+// lock hides the screen content and pauses the active view until the user
+// resumes the session. The idle lock is suspended while a tour is running.
+func (a *App) lock() {
+	if a.touring {
+		a.touch()
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&a.locked, 0, 1) {
+		return
+	}
+	if c := a.Content.Top(); c != nil {
+		c.Stop()
+	}
+
+	msg := fmt.Sprintf("Session locked after %s of inactivity.", a.Config.K9s.IdleLock.GetTimeout())
+	var p tview.Primitive
+	if a.Config.K9s.IdleLock.Passphrase == "" {
+		p = a.keyLock(msg)
+	} else {
+		p = a.passphraseLock(msg)
+	}
+	a.Main.AddPage(lockPage, p, true, false)
+	a.Main.SwitchToPage(lockPage)
+	a.SetFocus(p)
+}
+
+func (a *App) unlock() {
+	if !atomic.CompareAndSwapInt32(&a.locked, 1, 0) {
+		return
+	}
+	a.touch()
+	a.Main.SwitchToPage("main")
+	a.Main.RemovePage(lockPage)
+	if c := a.Content.Top(); c != nil {
+		c.Start()
+		a.SetFocus(c)
+	}
+}
+
+func (a *App) keyLock(msg string) tview.Primitive {
+	styles := a.Styles.Dialog()
+	v := tview.NewTextView()
+	v.SetTextAlign(tview.AlignCenter)
+	v.SetBackgroundColor(styles.BgColor.Color())
+	v.SetTextColor(styles.FgColor.Color())
+	v.SetText("\n\n\n" + msg + "\n\nPress any key to resume...")
+	v.SetInputCapture(func(*tcell.EventKey) *tcell.EventKey {
+		a.unlock()
+		return nil
+	})
+
+	return v
+}
+
+func (a *App) passphraseLock(msg string) tview.Primitive {
+	styles := a.Styles.Dialog()
+	f := newStyledForm(styles)
+
+	var pass string
+	f.AddPasswordField("Passphrase:", "", passphraseLen, '*', func(s string) {
+		pass = s
+	})
+	var modal *tview.ModalForm
+	f.AddButton("Unlock", func() {
+		if !a.Config.K9s.IdleLock.CheckPassphrase(pass) {
+			modal.SetText(msg + "\nInvalid passphrase!")
+			return
+		}
+		a.unlock()
+	})
+	modal = newModalForm(styles, "<Locked>", msg, f)
+
+	return modal
+}
@@ -5,9 +5,14 @@ package view
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"os/exec"
 	"strings"
+	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
@@ -16,7 +21,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
-const imageKey = "setImage"
+const (
+	imageKey         = "setImage"
+	archCheckTimeout = 30 * time.Second
+)
 
 type imageFormSpec struct {
 	name, dockerImage, newDockerImage string
@@ -25,7 +33,7 @@ type imageFormSpec struct {
 
 func (m *imageFormSpec) modified() bool {
 	newDockerImage := strings.TrimSpace(m.newDockerImage)
-	return newDockerImage != "" && m.dockerImage != newDockerImage
+	return newDockerImage != "" && m.dockerImage != bumpImage(m.dockerImage, newDockerImage)
 }
 
 func (m *imageFormSpec) imageSpec() dao.ImageSpec {
@@ -35,7 +43,7 @@ func (m *imageFormSpec) imageSpec() dao.ImageSpec {
 	}
 
 	if m.modified() {
-		ret.DockerImage = strings.TrimSpace(m.newDockerImage)
+		ret.DockerImage = bumpImage(m.dockerImage, strings.TrimSpace(m.newDockerImage))
 	} else {
 		ret.DockerImage = m.dockerImage
 	}
@@ -57,10 +65,104 @@ func NewImageExtender(r ResourceViewer) ResourceViewer {
 }
 
 func (s *ImageExtender) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyShiftH, ui.NewKeyAction("Arch Check", s.archCheckCmd, true))
+	aa.Add(ui.KeyShiftW, ui.NewKeyAction("Provenance", s.provenanceCmd, true))
 	if s.App().Config.K9s.IsReadOnly() {
 		return
 	}
-	aa.Add(ui.KeyI, ui.NewKeyAction("Set Image", s.setImageCmd, false))
+	aa.Add(ui.KeyI, ui.NewKeyActionWithOpts("Set Image", s.setImageCmd,
+		ui.ActionOpts{Verbs: client.PatchAccess}))
+}
+
+func (s *ImageExtender) archCheckCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	spec, err := s.getPodSpec(path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+
+	s.App().Flash().Infof("Checking %s images platforms...", path)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), archCheckTimeout)
+		defer cancel()
+		report, err := dao.ImageArchReport(ctx, s.App().factory, spec)
+		s.App().QueueUpdateDraw(func() {
+			if err != nil {
+				s.App().Flash().Err(err)
+				return
+			}
+			s.App().Flash().Clear()
+			details := NewDetails(s.App(), "Arch Check", path, contentYAML, true).Update(report)
+			if err := s.App().inject(details, false); err != nil {
+				s.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+func (s *ImageExtender) provenanceCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	cfg := s.App().Config.K9s.Provenance
+	opts := dao.ProvenanceOpts{
+		Fetch:      dao.RegistryDigest,
+		Signatures: cfg.Signatures,
+	}
+	if cfg.Verify != "" {
+		opts.Verify = func(ctx context.Context, ref string) error {
+			out, err := verifyImage(ctx, cfg.VerifyArgs(ref), ref)
+			if err == nil || out == "" {
+				return err
+			}
+			if i := strings.LastIndexByte(out, '\n'); i >= 0 {
+				out = out[i+1:]
+			}
+			return fmt.Errorf("%w: %s", err, strings.TrimSpace(out))
+		}
+	}
+
+	s.App().Flash().Infof("Checking %s images provenance...", path)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), archCheckTimeout)
+		defer cancel()
+		report, err := dao.ImageProvenanceReport(ctx, s.App().factory, s.GVR(), path, opts)
+		s.App().QueueUpdateDraw(func() {
+			if err != nil {
+				s.App().Flash().Err(err)
+				return
+			}
+			s.App().Flash().Clear()
+			details := NewDetails(s.App(), "Provenance", path, contentYAML, true).Update(report)
+			if err := s.App().inject(details, false); err != nil {
+				s.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+// verifyImage runs an image verify command without a shell. The digest
+// reference is also made available to wrapper scripts as $IMAGE.
+func verifyImage(ctx context.Context, args []string, ref string) (string, error) {
+	if len(args) == 0 {
+		return "", errors.New("no verify command configured")
+	}
+	log.Debug().Msgf("Verifying image> %v", args)
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Env = append(os.Environ(), "IMAGE="+ref)
+	out, err := cmd.CombinedOutput()
+
+	return strings.TrimRight(string(out), "\n"), err
 }
 
 func (s *ImageExtender) setImageCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -84,7 +186,7 @@ func (s *ImageExtender) showImageDialog(path string) error {
 		return err
 	}
 	confirm := tview.NewModalForm("<Set image>", form)
-	confirm.SetText(fmt.Sprintf("Set image %s %s", s.GVR(), path))
+	confirm.SetText(fmt.Sprintf("Set image %s %s\nUse :tag or @digest to only swap the tag or digest", s.GVR(), path))
 	confirm.SetDoneFunc(func(int, string) {
 		s.dismissDialog()
 	})
@@ -113,6 +215,12 @@ func (s *ImageExtender) makeSetImageForm(sel string) (*tview.Form, error) {
 			ctn.newDockerImage = changed
 		})
 	}
+	var watch bool
+	if s.GVR().String() != "v1/pods" {
+		f.AddCheckbox("Watch Rollout:", watch, func(_ string, b bool) {
+			watch = b
+		})
+	}
 
 	f.AddButton("OK", func() {
 		defer s.dismissDialog()
@@ -130,6 +238,9 @@ func (s *ImageExtender) makeSetImageForm(sel string) (*tview.Form, error) {
 			return
 		}
 		s.App().Flash().Infof("Resource %s:%s image updated successfully", s.GVR(), sel)
+		if watch && len(imageSpecsModified) > 0 {
+			s.watchRollout(sel)
+		}
 	})
 	f.AddButton("Cancel", func() {
 		s.dismissDialog()
@@ -138,6 +249,38 @@ func (s *ImageExtender) makeSetImageForm(sel string) (*tview.Form, error) {
 	return f, nil
 }
 
+// watchRollout tracks a workload rollout once its images are updated.
+func (s *ImageExtender) watchRollout(path string) {
+	d := NewDetails(s.App(), "Rollout", path, contentYAML, true)
+	if err := s.App().inject(d, false); err != nil {
+		s.App().Flash().Err(err)
+		return
+	}
+	update := func(status string) {
+		s.App().QueueUpdateDraw(func() {
+			d.Update(path + ": " + status)
+		})
+	}
+	d.Update(path + ": pending")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := cancelOnPop(s.App(), d, cancel)
+	go func() {
+		defer cancel()
+		err := waitRollout(ctx, s.App(), s.GVR(), path, defaultWaveOpts().timeout, update)
+		s.App().QueueUpdateDraw(func() {
+			done()
+			switch {
+			case errors.Is(err, context.Canceled):
+			case err != nil:
+				d.Update(path + ": failed -- " + err.Error())
+			default:
+				s.App().Flash().Infof("Rollout completed for %s", path)
+			}
+		})
+	}()
+}
+
 func (s *ImageExtender) dismissDialog() {
 	s.App().Content.RemovePage(imageKey)
 }
@@ -179,3 +322,19 @@ func (s *ImageExtender) setImages(ctx context.Context, path string, imageSpecs d
 
 	return resourceWPodSpec.SetImages(ctx, path, imageSpecs)
 }
+
+// bumpImage swaps an image tag or digest when the new image is given as
+// :tag or @digest. Otherwise the new image is returned as is.
+func bumpImage(image, ref string) string {
+	if !strings.HasPrefix(ref, ":") && !strings.HasPrefix(ref, "@") {
+		return ref
+	}
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+
+	return image + ref
+}
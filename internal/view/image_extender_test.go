@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBumpImage(t *testing.T) {
+	uu := map[string]struct {
+		image, ref, e string
+	}{
+		"full": {
+			image: "nginx:1.25",
+			ref:   "redis:7",
+			e:     "redis:7",
+		},
+		"tag": {
+			image: "nginx:1.25",
+			ref:   ":1.26",
+			e:     "nginx:1.26",
+		},
+		"no-tag": {
+			image: "nginx",
+			ref:   ":1.26",
+			e:     "nginx:1.26",
+		},
+		"registry-port": {
+			image: "localhost:5000/fred/nginx:1.25",
+			ref:   ":1.26",
+			e:     "localhost:5000/fred/nginx:1.26",
+		},
+		"registry-port-no-tag": {
+			image: "localhost:5000/fred/nginx",
+			ref:   ":1.26",
+			e:     "localhost:5000/fred/nginx:1.26",
+		},
+		"digest": {
+			image: "nginx:1.25@sha256:aaa",
+			ref:   "@sha256:bbb",
+			e:     "nginx@sha256:bbb",
+		},
+		"digest-to-tag": {
+			image: "ghcr.io/fred/nginx@sha256:aaa",
+			ref:   ":1.26",
+			e:     "ghcr.io/fred/nginx:1.26",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, bumpImage(u.image, u.ref))
+		})
+	}
+}
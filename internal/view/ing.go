@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog/log"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Ingress represents an ingress viewer.
+type Ingress struct {
+	ResourceViewer
+
+	bench *perf.Benchmark
+}
+
+// NewIngress returns a new viewer.
+func NewIngress(gvr client.GVR) ResourceViewer {
+	i := Ingress{
+		ResourceViewer: NewOwnerExtender(NewBrowser(gvr)),
+	}
+	i.AddBindKeysFn(i.bindKeys)
+
+	return &i
+}
+
+func (i *Ingress) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyB, ui.NewKeyAction("Bench Run/Stop", i.toggleBenchCmd, true))
+}
+
+func (i *Ingress) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if i.bench != nil {
+		i.App().Status(model.FlashErr, "Benchmark Canceled!")
+		i.bench.Cancel()
+		i.App().ClearStatus(true)
+		return nil
+	}
+
+	path := i.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	cust, err := config.NewBench(i.App().BenchFile)
+	if err != nil {
+		log.Debug().Msgf("No bench config file found %s", i.App().BenchFile)
+	}
+	cfg, ok := cust.Benchmarks.Ingresses[path]
+	if !ok {
+		cfg = config.DefaultBenchSpec()
+		cfg.C, cfg.N = cust.Benchmarks.Defaults.C, cust.Benchmarks.Defaults.N
+	}
+	cfg.Name = path
+
+	ing, err := fetchIngress(i.App().factory, i.GVR(), path)
+	if err != nil {
+		i.App().Flash().Err(err)
+		return nil
+	}
+	u := dao.IngressURL(ing)
+	if cfg.HTTP.Host != "" {
+		u = benchURL(cfg.HTTP.Host, "", cfg.HTTP.Path)
+	}
+	ShowBench(i, path, u, cfg, i.runBenchmark)
+
+	return nil
+}
+
+func (i *Ingress) runBenchmark(base string, cfg config.BenchConfig) error {
+	ct, err := i.App().Config.K9s.ActiveContext()
+	if err != nil {
+		return err
+	}
+	if i.bench, err = perf.NewBenchmark(base, i.App().version, cfg); err != nil {
+		return err
+	}
+	i.bench.SetExporter(perf.NewExporter(i.App().Config.K9s.Exporter))
+
+	i.App().Status(model.FlashWarn, "Benchmark in progress...")
+	go i.bench.Run(ct.ClusterName, i.App().Config.K9s.ActiveContextName(), i.benchDone)
+
+	return nil
+}
+
+func (i *Ingress) benchDone() {
+	i.App().QueueUpdate(func() {
+		if i.bench.Canceled() {
+			i.App().Status(model.FlashInfo, "Benchmark canceled")
+		} else {
+			i.App().Status(model.FlashInfo, "Benchmark Completed!")
+			i.bench.Cancel()
+		}
+		i.bench = nil
+		go clearStatus(i.App())
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func fetchIngress(f dao.Factory, gvr client.GVR, path string) (*netv1.Ingress, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var ing netv1.Ingress
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &ing)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ing, nil
+}
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog/log"
+)
+
+const cloneKey = "clone"
+
+// Inventory presents all resources living in a namespace.
+type Inventory struct {
+	ResourceViewer
+}
+
+// NewInventory returns a new viewer.
+func NewInventory(gvr client.GVR) ResourceViewer {
+	i := Inventory{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	i.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	i.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	i.GetTable().SetSortCol("GVR", true)
+	i.GetTable().SetEnterFn(i.describe)
+	i.AddBindKeysFn(i.bindKeys)
+
+	return &i
+}
+
+func (i *Inventory) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyR:      ui.NewKeyAction("Report", i.reportCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Sort GVR", i.GetTable().SortColCmd("GVR", true), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Owner", i.GetTable().SortColCmd("OWNER", true), false),
+	})
+}
+
+func (i *Inventory) describe(app *App, model ui.Tabular, _ client.GVR, id string) {
+	gvr, path, ok := render.ParseResourceID(id)
+	if !ok {
+		app.Flash().Errf("Invalid selection %q", id)
+		return
+	}
+	describeResource(app, model, client.NewGVR(gvr), path)
+}
+
+func (i *Inventory) reportCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ns := i.GetTable().GetModel().GetNamespace()
+	if !client.IsNamespaced(ns) {
+		i.App().Flash().Warn("Report requires a namespace")
+		return nil
+	}
+	var inv dao.Inventory
+	inv.Init(i.App().factory, i.GVR())
+	go func() {
+		report := inv.Report(ns)
+		i.App().QueueUpdateDraw(func() {
+			details := NewDetails(i.App(), "Report", ns, contentYAML, true).Update(report)
+			if err := i.App().inject(details, false); err != nil {
+				i.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showInventory(app *App, ns string) {
+	v := NewInventory(client.NewGVR("inventory"))
+	if err := app.Config.SetActiveNamespace(ns); err != nil {
+		log.Error().Err(err).Msg("Config NS set failed!")
+	}
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func emptyNamespace(app *App, ns string) {
+	var inv dao.Inventory
+	inv.Init(app.factory, client.NewGVR("inventory"))
+	go func() {
+		preview, count := inv.EmptyPreview(ns)
+		app.QueueUpdateDraw(func() {
+			if count == 0 {
+				app.Flash().Infof("Nothing to delete in namespace %s", ns)
+				return
+			}
+			confirmEmpty(app, &inv, ns, preview)
+		})
+	}()
+}
+
+func confirmEmpty(app *App, inv *dao.Inventory, ns, preview string) {
+	msg := fmt.Sprintf(
+		"The following resources will be DELETED!\n\n%s\nType the namespace name to confirm.",
+		preview,
+	)
+	dialog.ShowConfirmAck(app.App, app.Content.Pages, ns, true, "Empty Namespace", msg, func() {
+		go func() {
+			n, err := inv.Empty(context.Background(), ns)
+			app.QueueUpdateDraw(func() {
+				if err != nil {
+					log.Error().Err(err).Msgf("Empty namespace %s failed", ns)
+					app.Flash().Err(err)
+					return
+				}
+				app.Flash().Infof("Deleted %d resources in namespace %s", n, ns)
+			})
+		}()
+	}, func() {})
+}
+
+func showClone(app *App, ns string) {
+	f := newStyledForm(app.Styles.Dialog())
+
+	opts := dao.CloneOptions{
+		Target: ns + "-clone",
+		GVRs:   dao.DefaultCloneGVRs,
+	}
+	f.AddInputField("Target Namespace:", opts.Target, 40, nil, func(s string) {
+		opts.Target = strings.TrimSpace(s)
+	})
+	f.AddInputField("Kinds (GVRs):", strings.Join(opts.GVRs, ","), 40, nil, func(s string) {
+		opts.GVRs = splitGVRs(s)
+	})
+	f.AddInputField("Rename From:", "", 40, nil, func(s string) {
+		opts.From = s
+	})
+	f.AddInputField("Rename To:", "", 40, nil, func(s string) {
+		opts.To = s
+	})
+
+	f.AddButton("OK", func() {
+		dismissModalForm(app, cloneKey)
+		var inv dao.Inventory
+		inv.Init(app.factory, client.NewGVR("inventory"))
+		app.Flash().Infof("Cloning namespace %s to %s...", ns, opts.Target)
+		go func() {
+			n, err := inv.Clone(context.Background(), ns, opts)
+			app.QueueUpdateDraw(func() {
+				if err != nil {
+					log.Error().Err(err).Msgf("Clone namespace %s failed", ns)
+					app.Flash().Errf("Cloned %d resources with errors: %s", n, err)
+					return
+				}
+				app.Flash().Infof("Cloned %d resources from %s to %s", n, ns, opts.Target)
+			})
+		}()
+	})
+	f.AddButton("Cancel", func() {
+		dismissModalForm(app, cloneKey)
+	})
+
+	showModalForm(app, cloneKey, "<Clone>", "Clone namespace "+ns+"\nOwned resources are skipped and recreated by their controllers.", f)
+}
+
+func splitGVRs(s string) []string {
+	gg := make([]string, 0, 10)
+	for _, g := range strings.Split(s, ",") {
+		if g = strings.TrimSpace(g); g != "" {
+			gg = append(gg, g)
+		}
+	}
+
+	return gg
+}
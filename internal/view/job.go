@@ -9,6 +9,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -31,10 +32,31 @@ func NewJob(gvr client.GVR) ResourceViewer {
 	)
 	j.GetTable().SetEnterFn(j.showPods)
 	j.GetTable().SetSortCol("AGE", true)
+	j.AddBindKeysFn(j.bindKeys)
 
 	return &j
 }
 
+func (j *Job) bindKeys(aa *ui.KeyActions) {
+	if j.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyShiftP, ui.NewKeyActionWithOpts("Purge", j.purgeCmd, ui.ActionOpts{
+		Visible:   true,
+		Dangerous: true,
+		Verbs:     []string{client.ListVerb, client.DeleteVerb},
+	}))
+}
+
+func (j *Job) purgeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ShowReap(j, dao.ReapOptions{
+		Namespace: client.CleanseNamespace(j.GetTable().GetModel().GetNamespace()),
+		Jobs:      true,
+	})
+
+	return nil
+}
+
 func (*Job) showPods(app *App, model ui.Tabular, gvr client.GVR, path string) {
 	o, err := app.factory.Get(gvr.String(), path, true, labels.Everything())
 	if err != nil {
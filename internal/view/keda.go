@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ScaledObject represents a KEDA scaled object viewer.
+type ScaledObject struct {
+	ResourceViewer
+}
+
+// NewScaledObject returns a new viewer.
+func NewScaledObject(gvr client.GVR) ResourceViewer {
+	s := ScaledObject{
+		ResourceViewer: NewOwnerExtender(NewBrowser(gvr)),
+	}
+	s.GetTable().SetEnterFn(s.showTarget)
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *ScaledObject) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyT:      ui.NewKeyAction("Triggers", s.triggersCmd, true),
+		ui.KeyShiftA: ui.NewKeyAction("Sort Active", s.GetTable().SortColCmd("ACTIVE", true), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Paused", s.GetTable().SortColCmd("PAUSED", true), false),
+	})
+	bindKEDAPause(s, aa)
+}
+
+func (s *ScaledObject) triggersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return showKEDATriggers(s, evt)
+}
+
+// showTarget jumps to the workload a scaled object scales.
+func (*ScaledObject) showTarget(app *App, _ ui.Tabular, gvr client.GVR, path string) {
+	u, err := fetchUnstructured(app, gvr, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	kind, n := render.KEDAScaleTarget(u)
+	api, _, _ := unstructured.NestedString(u.Object, "spec", "scaleTargetRef", "apiVersion")
+	if api == "" {
+		api = "apps/v1"
+	}
+	gv, err := schema.ParseGroupVersion(api)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	tgvr, _, ok := dao.MetaAccess.GVK2GVR(gv, kind)
+	if !ok {
+		app.Flash().Warnf("Unable to resolve scale target %s/%s", kind, n)
+		return
+	}
+	app.gotoResource(tgvr.String(), client.FQN(u.GetNamespace(), n), false)
+}
+
+// ScaledJob represents a KEDA scaled job viewer.
+type ScaledJob struct {
+	ResourceViewer
+}
+
+// NewScaledJob returns a new viewer.
+func NewScaledJob(gvr client.GVR) ResourceViewer {
+	s := ScaledJob{
+		ResourceViewer: NewOwnerExtender(NewBrowser(gvr)),
+	}
+	s.GetTable().SetEnterFn(s.showJobs)
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *ScaledJob) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyT:      ui.NewKeyAction("Triggers", s.triggersCmd, true),
+		ui.KeyShiftA: ui.NewKeyAction("Sort Active", s.GetTable().SortColCmd("ACTIVE", true), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Paused", s.GetTable().SortColCmd("PAUSED", true), false),
+	})
+	bindKEDAPause(s, aa)
+}
+
+func (s *ScaledJob) triggersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return showKEDATriggers(s, evt)
+}
+
+// showJobs lists the jobs spawned by a scaled job.
+func (*ScaledJob) showJobs(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	ns, n := client.Namespaced(path)
+	app.gotoResource(fmt.Sprintf("%s %s %s=%s", dao.JobGVR, ns, render.KEDAScaledJobLabel, n), "", false)
+}
+
+// Helpers...
+
+func bindKEDAPause(v ResourceViewer, aa *ui.KeyActions) {
+	if v.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyP, ui.NewKeyActionWithOpts("Pause/Resume", func(evt *tcell.EventKey) *tcell.EventKey {
+		return toggleKEDAPause(v, evt)
+	}, ui.ActionOpts{
+		Visible:   true,
+		Dangerous: true,
+		Verbs:     client.PatchAccess,
+	}))
+}
+
+func toggleKEDAPause(v ResourceViewer, evt *tcell.EventKey) *tcell.EventKey {
+	path := v.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	app := v.App()
+	msg := fmt.Sprintf("Pause/Resume autoscaling for %s?", path)
+	dialog.ShowConfirm(app.Styles.Dialog(), app.Content.Pages, "Confirm Pause/Resume", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		defer cancel()
+		paused, err := dao.ToggleKEDAPause(ctx, app.factory, v.GVR(), path)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		if paused {
+			app.Flash().Infof("Autoscaling paused for %s", path)
+			return
+		}
+		app.Flash().Infof("Autoscaling resumed for %s", path)
+	}, func() {})
+
+	return nil
+}
+
+func showKEDATriggers(v ResourceViewer, evt *tcell.EventKey) *tcell.EventKey {
+	path := v.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	gvr := v.GVR()
+	showReport(v.App(), "Triggers", path, func(ctx context.Context, f dao.Factory) (string, error) {
+		return dao.KEDAReport(ctx, f, gvr, path)
+	})
+
+	return nil
+}
@@ -10,8 +10,11 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/redact"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
@@ -31,6 +34,7 @@ type LiveView struct {
 	title                     string
 	model                     model.ResourceViewer
 	text                      *tview.TextView
+	banner                    *tview.TextView
 	actions                   *ui.KeyActions
 	app                       *App
 	cmdBuff                   *model.FishBuff
@@ -38,6 +42,8 @@ type LiveView struct {
 	cancel                    context.CancelFunc
 	fullScreen                bool
 	managedField              bool
+	clean                     bool
+	expand                    bool
 	autoRefresh               bool
 }
 
@@ -46,6 +52,7 @@ func NewLiveView(app *App, title string, m model.ResourceViewer) *LiveView {
 	v := LiveView{
 		Flex:          tview.NewFlex(),
 		text:          tview.NewTextView(),
+		banner:        tview.NewTextView(),
 		app:           app,
 		title:         title,
 		actions:       ui.NewKeyActions(),
@@ -55,6 +62,7 @@ func NewLiveView(app *App, title string, m model.ResourceViewer) *LiveView {
 		model:         m,
 		autoRefresh:   app.Config.K9s.LiveViewAutoRefresh,
 	}
+	v.SetDirection(tview.FlexRow)
 	v.AddItem(v.text, 0, 1, true)
 
 	return &v
@@ -71,6 +79,7 @@ func (v *LiveView) Init(_ context.Context) error {
 	v.text.SetScrollable(true).SetWrap(true).SetRegions(true)
 	v.text.SetDynamicColors(true)
 	v.text.SetHighlightColor(tcell.ColorOrange)
+	v.banner.SetDynamicColors(true)
 	v.SetTitleColor(tcell.ColorAqua)
 	v.SetInputCapture(v.keyboard)
 	v.SetBorderPadding(0, 0, 1, 1)
@@ -106,7 +115,15 @@ func (v *LiveView) ResourceFailed(err error) {
 
 // ResourceChanged notifies when the filter changes.
 func (v *LiveView) ResourceChanged(lines []string, matches fuzzy.Matches) {
+	var (
+		size      int
+		truncated bool
+	)
+	if m, ok := v.model.(model.LargeResourceViewer); ok {
+		size, truncated = m.Size(), m.Truncated()
+	}
 	v.app.QueueUpdateDraw(func() {
+		v.updateBanner(size, truncated)
 		v.text.SetTextAlign(tview.AlignLeft)
 		v.currentRegion, v.maxRegions = 0, len(matches)
 
@@ -115,7 +132,13 @@ func (v *LiveView) ResourceChanged(lines []string, matches fuzzy.Matches) {
 		}
 
 		lines = linesWithRegions(lines, matches)
-		v.text.SetText(colorizeYAML(v.app.Styles.Views().Yaml, strings.Join(lines, "\n")))
+		text := redact.Manifest(strings.Join(lines, "\n"))
+		if len(text) > model.LargeObjectSize {
+			text = enableRegion(tview.Escape(text))
+		} else {
+			text = colorizeYAML(v.app.Styles.Views().Yaml, text)
+		}
+		v.text.SetText(text)
 		v.text.Highlight()
 		if v.currentRegion < v.maxRegions {
 			v.text.Highlight("search_" + strconv.Itoa(v.currentRegion))
@@ -144,6 +167,7 @@ func (v *LiveView) bindKeys() {
 		tcell.KeyEscape: ui.NewKeyAction("Back", v.resetCmd, false),
 		tcell.KeyCtrlS:  ui.NewKeyAction("Save", v.saveCmd, false),
 		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(v.app.Flash(), v.text), true),
+		ui.KeyPipe:      ui.NewKeyAction("Pipe", v.pipeCmd, true),
 		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", v.toggleFullScreenCmd, true),
 		ui.KeyR:         ui.NewKeyAction("Toggle Auto-Refresh", v.toggleRefreshCmd, true),
 		ui.KeyN:         ui.NewKeyAction("Next Match", v.nextCmd, true),
@@ -158,11 +182,27 @@ func (v *LiveView) bindKeys() {
 	if v.title == yamlAction {
 		v.actions.Add(ui.KeyM, ui.NewKeyAction("Toggle ManagedFields", v.toggleManagedCmd, true))
 	}
+	if _, ok := v.model.(model.CleanResourceViewer); ok && v.title == yamlAction {
+		v.actions.Add(ui.KeyShiftC, ui.NewKeyAction("Toggle Clean", v.toggleCleanCmd, true))
+		v.actions.Add(ui.KeyShiftT, ui.NewKeyAction("Save Template", v.saveTemplateCmd, true))
+	}
+	if _, ok := v.model.(model.LargeResourceViewer); ok {
+		v.actions.Add(ui.KeyZ, ui.NewKeyAction("Toggle Expand", v.toggleExpandCmd, true))
+	}
 	if v.model != nil && v.model.GVR().IsDecodable() {
 		v.actions.Add(ui.KeyX, ui.NewKeyAction("Toggle Decode", v.toggleEncodedDecodedCmd, true))
 	}
 }
 
+func (v *LiveView) pipeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+	showPipe(v.app, v.model.GetPath(), sanitizeEsc(v.text.GetText(true)))
+
+	return nil
+}
+
 func (v *LiveView) toggleEncodedDecodedCmd(evt *tcell.EventKey) *tcell.EventKey {
 	m, ok := v.model.(model.EncDecResourceViewer)
 
@@ -271,11 +311,100 @@ func (v *LiveView) toggleManagedCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	v.managedField = !v.managedField
-	v.model.SetOptions(v.defaultCtx(), map[string]bool{model.ManagedFieldsOpts: v.managedField})
+	v.model.SetOptions(v.defaultCtx(), v.options())
+
+	return nil
+}
+
+func (v *LiveView) toggleCleanCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+
+	v.clean = !v.clean
+	if m, ok := v.model.(model.CleanResourceViewer); ok {
+		m.SetExportRules(v.exportRules())
+	}
+	v.model.SetOptions(v.defaultCtx(), v.options())
+
+	return nil
+}
+
+func (v *LiveView) saveTemplateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+	if m, ok := v.model.(model.LargeResourceViewer); ok && m.Truncated() {
+		v.app.Flash().Warn("Expand the manifest before saving it as a template")
+		return nil
+	}
+
+	raw, err := v.exportRules().CleanYAML(sanitizeEsc(v.text.GetText(true)))
+	if err == nil {
+		raw, err = dao.ExportTemplate(raw)
+	}
+	if err != nil {
+		v.app.Flash().Err(err)
+		return nil
+	}
+	_, n := client.Namespaced(v.model.GetPath())
+	name := v.model.GVR().R() + "-" + n
+	if _, err := saveTemplate(config.AppTemplatesDir, name, raw); err != nil {
+		v.app.Flash().Err(err)
+		return nil
+	}
+	v.app.Flash().Infof("Template %q saved successfully!", name)
 
 	return nil
 }
 
+func (v *LiveView) exportRules() dao.ExportRules {
+	x := v.app.Config.K9s.Export
+
+	return dao.NewExportRules(x.Strip, x.Keep)
+}
+
+func (v *LiveView) toggleExpandCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+
+	v.expand = !v.expand
+	v.model.SetOptions(v.defaultCtx(), v.options())
+
+	return nil
+}
+
+func (v *LiveView) options() model.ViewerToggleOpts {
+	return model.ViewerToggleOpts{
+		model.ManagedFieldsOpts: v.managedField,
+		model.CleanOpts:         v.clean,
+		model.ExpandOpts:        v.expand,
+	}
+}
+
+// updateBanner warns about large resources and how to toggle truncation.
+func (v *LiveView) updateBanner(size int, truncated bool) {
+	v.RemoveItem(v.banner)
+	if size <= model.LargeObjectSize {
+		return
+	}
+
+	msg := "values truncated, press <z> to expand"
+	if !truncated {
+		msg = "showing full content, press <z> to truncate"
+	}
+	v.banner.SetText(fmt.Sprintf("[%s::b]Large object (%s) -- %s",
+		v.app.Styles.Frame().Status.ErrorColor.String(),
+		model.HumanSize(size),
+		tview.Escape(msg),
+	))
+	v.banner.SetBackgroundColor(v.app.Styles.BgColor())
+	v.Clear()
+	v.AddItem(v.banner, 1, 0, false)
+	v.AddItem(v.text, 0, 1, true)
+}
+
 func (v *LiveView) toggleFullScreenCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if v.app.InCmdMode() {
 		return evt
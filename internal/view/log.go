@@ -19,6 +19,7 @@ import (
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/redact"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
@@ -30,6 +31,7 @@ const (
 	logMessage          = "Waiting for logs...\n"
 	logFmt              = "([hilite:bg:]%s[-:bg:-])[[green:bg:b]%s[-:bg:-]] "
 	logCoFmt            = "([hilite:bg:]%s:[hilite:bg:b]%s[-:bg:-])[[green:bg:b]%s[-:bg:-]] "
+	logStepFmt          = "[[orange:bg:b]%d/%d %s[-:bg:-]] "
 	defaultFlushTimeout = 50 * time.Millisecond
 )
 
@@ -47,6 +49,10 @@ type Log struct {
 	mx                sync.Mutex
 	follow            bool
 	requestOneRefresh bool
+	stepGVR           client.GVR
+	stepPath          string
+	steps             []dao.LogStep
+	step              int
 }
 
 var _ model.Component = (*Log)(nil)
@@ -54,9 +60,12 @@ var _ model.Component = (*Log)(nil)
 // NewLog returns a new viewer.
 func NewLog(gvr client.GVR, opts *dao.LogOptions) *Log {
 	l := Log{
-		Flex:   tview.NewFlex(),
-		model:  model.NewLog(gvr, opts, defaultFlushTimeout),
-		follow: true,
+		Flex:     tview.NewFlex(),
+		model:    model.NewLog(gvr, opts, defaultFlushTimeout),
+		follow:   true,
+		stepGVR:  gvr,
+		stepPath: opts.Path,
+		step:     -1,
 	}
 
 	return &l
@@ -93,6 +102,9 @@ func (l *Log) Init(ctx context.Context) (err error) {
 
 	l.ansiWriter = tview.ANSIWriter(l.logs, l.app.Styles.Views().Log.FgColor.String(), l.app.Styles.Views().Log.BgColor.String())
 	l.AddItem(l.logs, 0, 1, true)
+	if l.app.factory != nil {
+		l.loadSteps()
+	}
 	l.bindKeys()
 
 	l.StylesChanged(l.app.Styles)
@@ -260,10 +272,72 @@ func (l *Log) bindKeys() {
 		ui.KeyW:         ui.NewKeyAction("Toggle Wrap", l.toggleTextWrapCmd, true),
 		tcell.KeyCtrlS:  ui.NewKeyAction("Save", l.SaveCmd, true),
 		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(l.app.Flash(), l.logs.TextView), true),
+		ui.KeyV:         ui.NewKeyAction("Copy Visible", l.cpVisibleCmd, true),
+		ui.KeyPipe:      ui.NewKeyAction("Pipe", l.pipeCmd, true),
 	})
 	if l.model.HasDefaultContainer() {
 		l.logs.Actions().Add(ui.KeyA, ui.NewKeyAction("Toggle AllContainers", l.toggleAllContainers, true))
 	}
+	if len(l.steps) > 1 {
+		l.logs.Actions().Bulk(ui.KeyMap{
+			ui.KeyLeftBracket:  ui.NewKeyAction("Prev Step", l.stepCmd(-1), true),
+			ui.KeyRightBracket: ui.NewKeyAction("Next Step", l.stepCmd(1), true),
+		})
+	}
+}
+
+// loadSteps fetches the containers or job attempts the logs can step through.
+func (l *Log) loadSteps() {
+	ss, err := dao.LogSteps(l.app.factory, l.stepGVR, l.stepPath)
+	if err != nil {
+		log.Debug().Err(err).Msgf("No log steps for %s", l.stepPath)
+		return
+	}
+	l.steps = ss
+	if l.step >= 0 {
+		return
+	}
+	for i, s := range ss {
+		if s.GVR == l.model.GVR() && s.Path == l.model.GetPath() && s.Container != "" && s.Container == l.model.GetContainer() {
+			l.step = i
+		}
+	}
+}
+
+// stepCmd tails the logs of the next or previous container or job attempt.
+func (l *Log) stepCmd(direction int) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if l.app.InCmdMode() {
+			return evt
+		}
+		// Refresh the steps so their status annotations stay current.
+		l.loadSteps()
+		if len(l.steps) == 0 {
+			return nil
+		}
+		if l.step < 0 && direction < 0 {
+			l.step = len(l.steps) - 1
+		} else {
+			l.step = (l.step + direction + len(l.steps)) % len(l.steps)
+		}
+		s := l.steps[l.step]
+		l.logs.Clear()
+		l.model.Retarget(l.getContext(), s.GVR, s.Path, s.Container)
+		l.requestOneRefresh = true
+		l.updateTitle()
+		l.app.Flash().Infof("Step %d/%d %s", l.step+1, len(l.steps), s.Status)
+
+		return nil
+	}
+}
+
+func (l *Log) pipeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.logs.cmdBuff.IsActive() {
+		return evt
+	}
+	showPipe(l.app, l.model.GetPath(), l.logs.GetText(true))
+
+	return nil
 }
 
 func (l *Log) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -321,6 +395,10 @@ func (l *Log) updateTitle() {
 		title += ui.SkinTitle(fmt.Sprintf(logCoFmt, path, co, since), l.app.Styles.Frame())
 	}
 
+	if l.step >= 0 && l.step < len(l.steps) {
+		title += ui.SkinTitle(fmt.Sprintf(logStepFmt, l.step+1, len(l.steps), l.steps[l.step].Status), l.app.Styles.Frame())
+	}
+
 	buff := l.logs.cmdBuff.GetText()
 	if buff != "" {
 		title += ui.SkinTitle(fmt.Sprintf(ui.SearchFmt, buff), l.app.Styles.Frame())
@@ -354,6 +432,10 @@ func (l *Log) Flush(lines [][]byte) {
 		if l.cancelUpdates {
 			break
 		}
+		if redact.IsEnabled() {
+			_, _ = l.ansiWriter.Write([]byte(redact.Text(string(lines[i]))))
+			continue
+		}
 		_, _ = l.ansiWriter.Write(lines[i])
 	}
 	if l.follow {
@@ -449,6 +531,20 @@ func (l *Log) clearCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// cpVisibleCmd copies the log lines currently on screen.
+func (l *Log) cpVisibleCmd(*tcell.EventKey) *tcell.EventKey {
+	row, _ := l.logs.GetScrollOffset()
+	_, _, _, h := l.logs.GetInnerRect()
+	lines := visibleLines(strings.Split(sanitizeEsc(l.logs.GetText(true)), "\n"), row, h)
+	if err := clipboardWrite(strings.Join(lines, "\n")); err != nil {
+		l.app.Flash().Err(err)
+		return nil
+	}
+	l.app.Flash().Infof("%d log line(s) copied to clipboard...", len(lines))
+
+	return nil
+}
+
 func (l *Log) markCmd(*tcell.EventKey) *tcell.EventKey {
 	_, _, w, _ := l.GetRect()
 	fmt.Fprintf(l.ansiWriter, "\n[%s:-:b]%s[-:-:-]", l.app.Styles.Views().Log.FgColor.String(), strings.Repeat("─", w-4))
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	loginTimeout    = 5 * time.Minute
+	minLoginBackOff = 30 * time.Second
+	maxLoginBackOff = 10 * time.Minute
+)
+
+// promptLogin offers to run the context login command when the cluster
+// can't be reached. Declined or failed logins back off before prompting again.
+func (a *App) promptLogin() {
+	if time.Now().UnixNano() < atomic.LoadInt64(&a.loginAfter) {
+		return
+	}
+	l := a.contextLogin()
+	if l.IsEmpty() || !atomic.CompareAndSwapInt32(&a.loggingIn, 0, 1) {
+		return
+	}
+
+	msg := fmt.Sprintf("Context %q is unreachable. Credentials may have expired.\n\nRun `%s`?", a.Config.ActiveContextName(), l)
+	a.QueueUpdateDraw(func() {
+		dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Authentication Required", msg, func() {
+			a.runLogin(l)
+		}, func() {
+			a.backOffLogin()
+			atomic.StoreInt32(&a.loggingIn, 0)
+		})
+	})
+}
+
+// backOffLogin doubles the delay before the next login prompt.
+func (a *App) backOffLogin() {
+	d := 2 * time.Duration(atomic.LoadInt64(&a.loginBackOff))
+	if d < minLoginBackOff {
+		d = minLoginBackOff
+	}
+	if d > maxLoginBackOff {
+		d = maxLoginBackOff
+	}
+	atomic.StoreInt64(&a.loginBackOff, int64(d))
+	atomic.StoreInt64(&a.loginAfter, time.Now().Add(d).UnixNano())
+}
+
+func (a *App) resetLoginBackOff() {
+	atomic.StoreInt64(&a.loginBackOff, 0)
+	atomic.StoreInt64(&a.loginAfter, 0)
+}
+
+func (a *App) loginCmd() error {
+	l := a.contextLogin()
+	if l.IsEmpty() {
+		return fmt.Errorf("no login command configured for context %q", a.Config.ActiveContextName())
+	}
+	if !atomic.CompareAndSwapInt32(&a.loggingIn, 0, 1) {
+		return fmt.Errorf("login already in progress")
+	}
+	a.runLogin(l)
+
+	return nil
+}
+
+// contextLogin returns the active context login command. OpenShift clusters
+// default to an interactive oc login against the context api server.
+func (a *App) contextLogin() *data.Login {
+	if l := a.Config.Login(); !l.IsEmpty() || !dao.IsOpenShift() {
+		return l
+	}
+	if _, err := exec.LookPath(dao.OCBinary); err != nil {
+		return nil
+	}
+	cfg, err := a.Conn().Config().RESTConfig()
+	if err != nil {
+		log.Warn().Err(err).Msg("Unable to resolve OpenShift api server")
+		return nil
+	}
+
+	return dao.OCLogin(cfg.Host)
+}
+
+func (a *App) runLogin(l *data.Login) {
+	if l.Interactive {
+		suspended, errChan, _ := run(a, shellOpts{clear: true, binary: l.Command, args: l.Args})
+		if !suspended {
+			go a.loginDone(errors.New("unable to run login command"))
+			return
+		}
+		var errs error
+		for e := range errChan {
+			errs = errors.Join(errs, e)
+		}
+		go a.loginDone(errs)
+		return
+	}
+
+	details := NewDetails(a, "Login", a.Config.ActiveContextName(), contentTXT, false).Update(l.String() + "\n")
+	if err := a.inject(details, false); err != nil {
+		go a.loginDone(err)
+		return
+	}
+	go func() {
+		a.loginDone(a.streamLogin(details, l))
+	}()
+}
+
+func (a *App) streamLogin(details *Details, l *data.Login) error {
+	ctx, cancel := context.WithTimeout(context.Background(), loginTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, l.Command, l.Args...)
+	r, w := io.Pipe()
+	cmd.Stdout, cmd.Stderr = w, w
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	var (
+		buff strings.Builder
+		mx   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	buff.WriteString(l.String() + "\n")
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			mx.Lock()
+			buff.WriteString(scanner.Text() + "\n")
+			out := buff.String()
+			mx.Unlock()
+			a.QueueUpdateDraw(func() {
+				details.Update(out)
+			})
+		}
+	}()
+	err := cmd.Wait()
+	_ = w.Close()
+	wg.Wait()
+
+	return err
+}
+
+func (a *App) loginDone(err error) {
+	defer atomic.StoreInt32(&a.loggingIn, 0)
+
+	if err != nil {
+		a.backOffLogin()
+		log.Error().Err(err).Msgf("Login failed")
+		a.QueueUpdateDraw(func() {
+			a.Flash().Errf("Login failed: %s", err)
+		})
+		return
+	}
+	if !a.Conn().CheckConnectivity() {
+		a.backOffLogin()
+		a.QueueUpdateDraw(func() {
+			a.Flash().Warnf("Still unable to reach context %q", a.Config.ActiveContextName())
+		})
+		return
+	}
+	a.resetLoginBackOff()
+	a.QueueUpdateDraw(func() {
+		a.Flash().Infof("Logged in to context %q", a.Config.ActiveContextName())
+	})
+}
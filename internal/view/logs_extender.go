@@ -4,12 +4,16 @@
 package view
 
 import (
+	"context"
+
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // LogsExtender adds log actions to a given viewer.
@@ -36,6 +40,40 @@ func (l *LogsExtender) bindKeys(aa *ui.KeyActions) {
 		ui.KeyL: ui.NewKeyAction("Logs", l.logsCmd(false), true),
 		ui.KeyP: ui.NewKeyAction("Logs Previous", l.logsCmd(true), true),
 	})
+	if dao.IsReplicated(l.GVR()) {
+		aa.Add(tcell.KeyCtrlL, ui.NewKeyAction("Leader Logs", l.leaderLogsCmd, true))
+	}
+}
+
+// leaderLogsCmd tails the logs of the replica holding the workload lease.
+func (l *LogsExtender) leaderLogsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := l.GetTable().GetSelectedItem()
+	if !isResourcePath(path) {
+		return evt
+	}
+	o, err := l.App().factory.Get(l.GVR().String(), path, true, labels.Everything())
+	if err != nil {
+		l.App().Flash().Err(err)
+		return nil
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		l.App().Flash().Errf("expecting unstructured but got %T", o)
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), l.App().Conn().Config().CallTimeout())
+	defer cancel()
+	leader, err := dao.LeaderFor(ctx, l.App().factory, u)
+	if err != nil {
+		l.App().Flash().Err(err)
+		return nil
+	}
+	l.App().Flash().Infof("Leader %s holds lease %s", leader.Pod, leader.Lease)
+	if err := l.App().inject(NewLog(client.NewGVR("v1/pods"), l.buildLogOpts(leader.Pod, "", false)), false); err != nil {
+		l.App().Flash().Err(err)
+	}
+
+	return nil
 }
 
 func (l *LogsExtender) logsCmd(prev bool) func(evt *tcell.EventKey) *tcell.EventKey {
@@ -60,13 +98,13 @@ func isResourcePath(p string) bool {
 
 func (l *LogsExtender) showLogs(path string, prev bool) {
 	ns, _ := client.Namespaced(path)
-	_, err := l.App().factory.CanForResource(ns, "v1/pods", client.ListAccess)
-	if err != nil {
+	if err := canList(l.App().factory, ns, "v1/pods"); err != nil {
 		l.App().Flash().Err(err)
 		return
 	}
 	opts := l.buildLogOpts(path, "", prev)
 	if l.optionsFn != nil {
+		var err error
 		if opts, err = l.optionsFn(prev); err != nil {
 			l.App().Flash().Err(err)
 			return
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+)
+
+// newStyledForm returns a dialog form using the skin colors.
+func newStyledForm(styles config.Dialog) *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color()).
+		SetFieldBackgroundColor(styles.BgColor.Color())
+
+	return f
+}
+
+// newModalForm wraps a fully built form in a skinned modal.
+func newModalForm(styles config.Dialog, title, msg string, f *tview.Form) *tview.ModalForm {
+	for i := 0; i < f.GetFormItemCount(); i++ {
+		if dd, ok := f.GetFormItem(i).(*tview.DropDown); ok {
+			dd.SetListStyles(
+				styles.FgColor.Color(), styles.BgColor.Color(),
+				styles.ButtonFocusFgColor.Color(), styles.ButtonFocusBgColor.Color(),
+			)
+		}
+	}
+	for i := 0; i < f.GetButtonCount(); i++ {
+		b := f.GetButton(i)
+		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+	modal := tview.NewModalForm(title, f)
+	modal.SetText(msg)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetBackgroundColor(styles.BgColor.Color())
+
+	return modal
+}
+
+// showModalForm displays a form dialog and focuses it.
+func showModalForm(a *App, key, title, msg string, f *tview.Form) *tview.ModalForm {
+	modal := newModalForm(a.Styles.Dialog(), title, msg, f)
+	modal.SetDoneFunc(func(int, string) {
+		dismissModalForm(a, key)
+	})
+	pages := a.Content.Pages
+	pages.AddPage(key, modal, false, true)
+	pages.ShowPage(key)
+	a.SetFocus(pages.GetPrimitive(key))
+
+	return modal
+}
+
+// dismissModalForm closes a form dialog and restores the focus.
+func dismissModalForm(a *App, key string) {
+	p := a.Content.Pages
+	p.RemovePage(key)
+	a.SetFocus(p.CurrentPage().Item)
+}
@@ -0,0 +1,284 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const multiTitle = "Multi"
+
+var _ model.Component = (*Multi)(nil)
+
+// Multi represents a stack of resource tables sharing a namespace and a filter.
+type Multi struct {
+	*tview.Flex
+
+	app      *App
+	gvrs     []client.GVR
+	ns       string
+	panes    []*multiPane
+	focus    int
+	cmdBuff  *model.FishBuff
+	cancelFn context.CancelFunc
+}
+
+// multiPane represents a resource table within a multi view.
+type multiPane struct {
+	*Table
+}
+
+// NewMulti returns a new multi resources view.
+func NewMulti(app *App, gvrs []client.GVR, ns string) *Multi {
+	return &Multi{
+		Flex:    tview.NewFlex(),
+		app:     app,
+		gvrs:    gvrs,
+		ns:      client.CleanseNamespace(ns),
+		cmdBuff: model.NewFishBuff('/', model.FilterBuffer),
+	}
+}
+
+// Init initializes the view.
+func (m *Multi) Init(ctx context.Context) error {
+	m.SetDirection(tview.FlexRow)
+	for i, gvr := range m.gvrs {
+		meta, err := dao.MetaAccess.MetaFor(gvr)
+		if err != nil {
+			return err
+		}
+		p := multiPane{Table: NewTable(gvr)}
+		if err := p.Init(ctx); err != nil {
+			return err
+		}
+		colorerFn := model1.DefaultColorer
+		if r, ok := model.Registry[gvr.String()]; ok && r.Renderer != nil {
+			colorerFn = r.Renderer.ColorerFunc()
+		}
+		p.SetColorerFn(colorerFn)
+		ns := m.ns
+		if !meta.Namespaced {
+			ns = client.ClusterScope
+		}
+		p.GetModel().SetNamespace(ns)
+		m.bindKeys(p.Actions())
+		m.panes = append(m.panes, &p)
+		m.AddItem(p, 0, 1, i == 0)
+	}
+	m.cmdBuff.AddListener(m)
+
+	return nil
+}
+
+func (m *Multi) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		tcell.KeyEscape:  ui.NewSharedKeyAction("Filter Reset", m.resetCmd, false),
+		tcell.KeyEnter:   ui.NewKeyAction("Goto", m.gotoCmd, true),
+		tcell.KeyTab:     ui.NewKeyAction("Next", m.nextFocusCmd(1), true),
+		tcell.KeyBacktab: ui.NewKeyAction("Prev", m.nextFocusCmd(-1), true),
+		ui.KeyD:          ui.NewKeyAction("Describe", m.describeCmd, true),
+		ui.KeySlash:      ui.NewSharedKeyAction("Filter Mode", m.activateCmd, false),
+	})
+}
+
+// Name returns the component name.
+func (m *Multi) Name() string { return multiTitle }
+
+// InCmdMode checks if prompt is active.
+func (m *Multi) InCmdMode() bool {
+	return m.cmdBuff.InCmdMode()
+}
+
+// SetFilter sets the filter shared by all tables.
+func (m *Multi) SetFilter(s string) {
+	m.cmdBuff.SetText(s, "")
+}
+
+// SetLabelFilter sets the label filter shared by all tables.
+func (m *Multi) SetLabelFilter(labels map[string]string) {
+	m.cmdBuff.SetText("-l "+toLabelsStr(labels), "")
+}
+
+// Focus delegates focus to the active table.
+func (m *Multi) Focus(delegate func(p tview.Primitive)) {
+	if len(m.panes) == 0 {
+		m.Flex.Focus(delegate)
+		return
+	}
+	delegate(m.panes[m.focus])
+}
+
+// Hints returns the view hints.
+func (m *Multi) Hints() model.MenuHints {
+	if len(m.panes) == 0 {
+		return nil
+	}
+
+	return m.panes[m.focus].Hints()
+}
+
+// ExtraHints returns additional hints.
+func (m *Multi) ExtraHints() map[string]string {
+	return nil
+}
+
+// Start initializes the tables watch loops.
+func (m *Multi) Start() {
+	m.Stop()
+
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, m.app.factory)
+	ctx = context.WithValue(ctx, internal.KeyNamespace, m.ns)
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, m.app.Conn().HasMetrics())
+	ctx, m.cancelFn = context.WithCancel(ctx)
+	for _, p := range m.panes {
+		p.Table.Start()
+		p.GetModel().AddListener(p)
+		pctx := context.WithValue(ctx, internal.KeyGVR, p.GVR())
+		p.SetContext(pctx)
+		if err := p.GetModel().Watch(pctx); err != nil {
+			m.app.Flash().Errf("Watcher failed for %s -- %s", p.GVR(), err)
+		}
+	}
+}
+
+// Stop terminates the tables watch loops.
+func (m *Multi) Stop() {
+	if m.cancelFn != nil {
+		m.cancelFn()
+		m.cancelFn = nil
+	}
+	for _, p := range m.panes {
+		p.GetModel().RemoveListener(p)
+		p.Table.Stop()
+	}
+}
+
+// BufferChanged indicates the buffer was changed.
+func (m *Multi) BufferChanged(text, _ string) {
+	m.filter(text)
+}
+
+// BufferCompleted indicates input was accepted.
+func (m *Multi) BufferCompleted(text, _ string) {
+	m.filter(text)
+}
+
+// BufferActive indicates the buff activity changed.
+func (m *Multi) BufferActive(state bool, k model.BufferKind) {
+	m.app.BufferActive(state, k)
+	if !state && len(m.panes) > 0 {
+		m.app.SetFocus(m.panes[m.focus])
+	}
+}
+
+// filter applies a filter or a label selector to all tables.
+func (m *Multi) filter(q string) {
+	var labels string
+	if internal.IsLabelSelector(q) {
+		labels = ui.TrimLabelSelector(q)
+	}
+	for _, p := range m.panes {
+		refresh := p.GetModel().GetLabelFilter() != labels
+		p.GetModel().SetLabelFilter(labels)
+		p.CmdBuff().SetText(q, "")
+		if !refresh || p.GetContext() == nil {
+			continue
+		}
+		go func(p *multiPane) {
+			if err := p.GetModel().Refresh(p.GetContext()); err != nil {
+				log.Error().Err(err).Msgf("Refresh failed for %s", p.GVR())
+			}
+		}(p)
+	}
+}
+
+func (m *Multi) activateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if m.app.InCmdMode() {
+		return evt
+	}
+	m.app.ResetPrompt(m.cmdBuff)
+
+	return nil
+}
+
+func (m *Multi) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !m.cmdBuff.InCmdMode() && m.cmdBuff.Empty() {
+		return m.app.PrevCmd(evt)
+	}
+	m.cmdBuff.Reset()
+
+	return nil
+}
+
+func (m *Multi) nextFocusCmd(direction int) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if len(m.panes) == 0 {
+			return nil
+		}
+		m.focus = (m.focus + direction + len(m.panes)) % len(m.panes)
+		m.app.SetFocus(m.panes[m.focus])
+
+		return nil
+	}
+}
+
+func (m *Multi) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p := m.panes[m.focus]
+	path := p.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	describeResource(m.app, p.GetModel(), p.GVR(), path)
+
+	return nil
+}
+
+func (m *Multi) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p := m.panes[m.focus]
+	cmd := p.GVR().String()
+	if ns := p.GetModel().GetNamespace(); !client.IsClusterScoped(ns) {
+		if client.IsAllNamespace(ns) {
+			ns = client.NamespaceAll
+		}
+		cmd += " " + ns
+	}
+	if q := m.cmdBuff.GetText(); q != "" && !internal.IsLabelSelector(q) && !strings.Contains(q, " ") {
+		cmd += " /" + q
+	}
+	m.app.gotoResource(cmd, "", false)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Model Protocol...
+
+// TableDataChanged notifies view new data is available.
+func (p *multiPane) TableDataChanged(data *model1.TableData) {
+	if !p.app.ConOK() || !p.app.IsRunning() {
+		return
+	}
+	cdata := p.Update(data, p.app.Conn().HasMetrics())
+	p.app.QueueUpdateDraw(func() {
+		p.UpdateUI(cdata, data)
+	})
+}
+
+// TableLoadFailed notifies view something went south.
+func (p *multiPane) TableLoadFailed(err error) {
+	p.app.QueueUpdateDraw(func() {
+		p.app.Flash().Errf("%s -- %s", p.GVR().R(), err)
+	})
+}
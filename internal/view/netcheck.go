@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	netCheckKey      = "netcheck"
+	netCheckDeadline = 2 * time.Minute
+)
+
+// showNetCheck pops a connectivity test dialog for a given service.
+func showNetCheck(a *App, path string) {
+	var svc dao.Service
+	svc.Init(a.factory, client.NewGVR("v1/services"))
+	o, err := svc.GetInstance(path)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	chk, from := dao.NewNetCheck(o), ""
+
+	f := newStyledForm(a.Styles.Dialog())
+
+	f.AddInputField("Host:", chk.Host, 0, nil, func(v string) {
+		chk.Host = strings.TrimSpace(v)
+	})
+	f.AddInputField("Port:", strconv.Itoa(int(chk.Port)), 6, nil, func(v string) {
+		p, err := strconv.Atoi(v)
+		if err != nil || p < 0 || p > 65535 {
+			a.Flash().Errf("Invalid port %q", v)
+			return
+		}
+		a.Flash().Clear()
+		chk.Port = int32(p)
+	})
+	f.AddCheckbox("HTTP:", chk.HTTP, func(_ string, v bool) {
+		chk.HTTP = v
+	})
+	f.AddCheckbox("TLS:", chk.TLS, func(_ string, v bool) {
+		chk.TLS = v
+	})
+	f.AddInputField("Path:", chk.Path, 0, nil, func(v string) {
+		chk.Path = v
+	})
+	f.AddInputField("Timeout:", chk.Timeout.String(), 0, nil, func(v string) {
+		d, err := asDurOpt(v)
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		a.Flash().Clear()
+		chk.Timeout = d
+	})
+	f.AddInputField("From Pod:", from, 0, nil, func(v string) {
+		from = strings.TrimSpace(v)
+	})
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(a, netCheckKey)
+	})
+	f.AddButton("OK", func() {
+		dismissModalForm(a, netCheckKey)
+		if chk.Host == "" {
+			a.Flash().Errf("A host is required")
+			return
+		}
+		if err := chk.Validate(); err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		ns, _ := client.Namespaced(path)
+		go runNetCheck(a, ns, from, chk)
+	})
+
+	showModalForm(a, netCheckKey, "<Connectivity Test>", fmt.Sprintf("Probe %s from inside the cluster. Leave From Pod blank to use a throwaway pod.", path), f)
+}
+
+// runNetCheck runs a probe either from an existing pod or from a
+// short-lived one and reports the outcome.
+func runNetCheck(a *App, ns, from string, chk dao.NetCheck) {
+	a.QueueUpdateDraw(func() {
+		a.Flash().Infof("Connectivity test in progress for %s...", chk.Host)
+	})
+
+	var (
+		out string
+		err error
+	)
+	if from != "" {
+		pns, po := client.Namespaced(from)
+		if pns == "" {
+			pns = ns
+		}
+		out, err = runKu(a, shellOpts{args: append([]string{"exec", "-n", pns, po, "--"}, chk.Command()...)})
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), netCheckDeadline)
+		defer cancel()
+		out, err = dao.RunNetCheck(ctx, a.factory, ns, a.Config.K9s.ShellPod, chk)
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("Connectivity test failed for %s", chk.Host)
+	}
+
+	a.QueueUpdateDraw(func() {
+		if err != nil && out == "" {
+			a.Flash().Err(err)
+			return
+		}
+		a.Flash().Clear()
+		msg := strings.Join(dao.NetCheckSummary(out), "\n")
+		if msg == "" {
+			msg = "No probe results"
+		}
+		if err != nil {
+			msg += "\n\n" + err.Error()
+		}
+		dialog.ShowInfo(a.Styles.Dialog(), a.Content.Pages, "Connectivity Test", fmt.Sprintf("%s:%d\n\n%s", chk.Host, chk.Port, msg))
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
@@ -18,6 +19,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	defaultDrainTimeout = 5 * time.Second
+	windowsDrainTimeout = 30 * time.Second
+)
+
 // Node represents a node view.
 type Node struct {
 	ResourceViewer
@@ -65,6 +71,24 @@ func (n *Node) bindDangerousKeys(aa *ui.KeyActions) {
 				Dangerous: true,
 			},
 		),
+		ui.KeyT: ui.NewKeyActionWithOpts(
+			"Taints",
+			n.taintCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+				Verbs:     client.PatchAccess,
+			},
+		),
+		ui.KeyShiftU: ui.NewKeyActionWithOpts(
+			"Roll Maintenance",
+			n.rollCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+				Verbs:     client.PatchAccess,
+			},
+		),
 	})
 	ct, err := n.App().Config.K9s.ActiveContext()
 	if err != nil {
@@ -87,13 +111,82 @@ func (n *Node) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(cpuCol, false), false),
 		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false),
 		ui.KeyShiftO: ui.NewKeyAction("Sort Pods", n.GetTable().SortColCmd("PODS", false), false),
+		ui.KeyShiftP: ui.NewKeyAction("Pods By Namespace", n.podsCmd, true),
 	})
 }
 
+// podsCmd shows the node pods grouped by namespace along with their usage.
+func (n *Node) podsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	n.App().Flash().Infof("Gathering node %s pods...", path)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+		defer cancel()
+		report, err := dao.NodePods(ctx, n.App().factory, path, n.App().Conn().HasMetrics())
+		n.App().QueueUpdateDraw(func() {
+			if err != nil {
+				n.App().Flash().Err(err)
+				return
+			}
+			details := NewDetails(n.App(), "Node Pods", path, contentYAML, true).Update(report)
+			if err := n.App().inject(details, false); err != nil {
+				n.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
 func (n *Node) showPods(a *App, _ ui.Tabular, _ client.GVR, path string) {
 	showPods(a, n.GetTable().GetSelectedItem(), client.BlankNamespace, "spec.nodeName="+path)
 }
 
+func (n *Node) taintCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ShowTaints(n, path)
+
+	return nil
+}
+
+// rollCmd rolls maintenance across the marked nodes or the filtered ones
+// so a node pool can be selected via a label filter.
+func (n *Node) rollCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !n.GetTable().HasMarks() && n.GetTable().CmdBuff().Empty() {
+		n.App().Flash().Warn("Mark nodes or filter the node view to select the nodes to roll")
+		return nil
+	}
+	nodes := n.GetTable().GetSelectedItems()
+	if !n.GetTable().HasMarks() {
+		nodes = make([]string, 0, n.GetTable().GetFilteredData().RowCount())
+		n.GetTable().GetFilteredData().RowsRange(func(_ int, re model1.RowEvent) bool {
+			nodes = append(nodes, re.Row.ID)
+			return true
+		})
+	}
+	if len(nodes) == 0 {
+		return evt
+	}
+	ShowRoll(n, nodes, dao.RollOptions{
+		Drain: dao.DrainOptions{
+			GracePeriodSeconds:  -1,
+			Timeout:             defaultDrainTimeout,
+			IgnoreAllDaemonSets: true,
+		},
+		Concurrency: 1,
+		Gate:        defaultRollGate,
+	})
+
+	return nil
+}
+
 func (n *Node) drainCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sels := n.GetTable().GetSelectedItems()
 	if len(sels) == 0 {
@@ -102,7 +195,16 @@ func (n *Node) drainCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	opts := dao.DrainOptions{
 		GracePeriodSeconds: -1,
-		Timeout:            5 * time.Second,
+		Timeout:            defaultDrainTimeout,
+	}
+	// Windows containers are slower to tear down so allow for a longer drain.
+	ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+	defer cancel()
+	for _, sel := range sels {
+		if nodeOS(ctx, n.App().factory, sel) == windowsOS {
+			opts.Timeout = windowsDrainTimeout
+			break
+		}
 	}
 	ShowDrain(n, sels, opts, drainNode)
 
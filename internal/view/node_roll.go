@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+)
+
+const (
+	rollKey         = "roll"
+	defaultRollGate = 5 * time.Minute
+	maxRollListed   = 10
+)
+
+// ShowRoll pops a dialog to cordon, drain and uncordon nodes in sequence.
+func ShowRoll(v ResourceViewer, nodes []string, opts dao.RollOptions) {
+	f := newStyledForm(v.App().Styles.Dialog())
+
+	f.AddInputField("Concurrency:", strconv.Itoa(opts.Concurrency), 0, nil, func(s string) {
+		a, err := asIntOpt(s)
+		if err != nil || a <= 0 {
+			v.App().Flash().Errf("concurrency must be a positive integer: %q", s)
+			return
+		}
+		v.App().Flash().Clear()
+		opts.Concurrency = a
+	})
+	f.AddInputField("Health Gate:", opts.Gate.String(), 0, nil, func(s string) {
+		a, err := asDurOpt(s)
+		if err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		v.App().Flash().Clear()
+		opts.Gate = a
+	})
+	f.AddInputField("GracePeriod:", strconv.Itoa(opts.Drain.GracePeriodSeconds), 0, nil, func(s string) {
+		a, err := asIntOpt(s)
+		if err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		v.App().Flash().Clear()
+		opts.Drain.GracePeriodSeconds = a
+	})
+	f.AddInputField("Timeout:", opts.Drain.Timeout.String(), 0, nil, func(s string) {
+		a, err := asDurOpt(s)
+		if err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		v.App().Flash().Clear()
+		opts.Drain.Timeout = a
+	})
+	f.AddCheckbox("Ignore DaemonSets:", opts.Drain.IgnoreAllDaemonSets, func(_ string, b bool) {
+		opts.Drain.IgnoreAllDaemonSets = b
+	})
+	f.AddCheckbox("Delete Local Data:", opts.Drain.DeleteEmptyDirData, func(_ string, b bool) {
+		opts.Drain.DeleteEmptyDirData = b
+	})
+	f.AddCheckbox("Force:", opts.Drain.Force, func(_ string, b bool) {
+		opts.Drain.Force = b
+	})
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(v.App(), rollKey)
+	})
+	f.AddButton("OK", func() {
+		dismissModalForm(v.App(), rollKey)
+		rollNodes(v, nodes, opts)
+	})
+
+	showModalForm(v.App(), rollKey, "<Roll Maintenance>", rollMsg(nodes), f)
+}
+
+// rollMsg lists the nodes about to be rolled.
+func rollMsg(nodes []string) string {
+	if len(nodes) == 1 {
+		return fmt.Sprintf("Cordon, drain and uncordon %s?", nodes[0])
+	}
+	nn := nodes
+	if len(nn) > maxRollListed {
+		nn = nn[:maxRollListed]
+	}
+	msg := fmt.Sprintf("Cordon, drain and uncordon (%d) nodes: %s", len(nodes), strings.Join(nn, ", "))
+	if len(nodes) > len(nn) {
+		msg += fmt.Sprintf(" and %d more", len(nodes)-len(nn))
+	}
+
+	return msg + "?"
+}
+
+func rollNodes(v ResourceViewer, nodes []string, opts dao.RollOptions) {
+	res, err := dao.AccessorFor(v.App().factory, v.GVR())
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+	m, ok := res.(dao.NodeMaintainer)
+	if !ok {
+		v.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", v.GVR()))
+		return
+	}
+
+	d := NewDetails(v.App(), "Roll Progress", "nodes", contentYAML, true)
+	if err := v.App().inject(d, false); err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := cancelOnPop(v.App(), d, cancel)
+	v.App().Flash().Info("Rolling nodes. Leave the progress view to cancel.")
+	go func() {
+		defer cancel()
+		err := dao.RollNodes(ctx, m, dao.NodeGate(v.App().factory), nodes, opts, d.GetWriter())
+		v.App().QueueUpdateDraw(func() {
+			done()
+			switch {
+			case errors.Is(err, context.Canceled):
+				v.App().Flash().Warn("Roll canceled")
+			case err != nil:
+				v.App().Flash().Err(err)
+			default:
+				v.App().Flash().Infof("Rolled %d node(s)", len(nodes))
+			}
+		})
+	}()
+}
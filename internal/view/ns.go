@@ -4,8 +4,12 @@
 package view
 
 import (
+	"context"
+
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 )
@@ -32,11 +36,46 @@ func NewNamespace(gvr client.GVR) ResourceViewer {
 	return &n
 }
 
+// Init initializes the view.
+func (n *Namespace) Init(ctx context.Context) error {
+	if err := n.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	if dao.IsOpenShift() {
+		n.GetTable().Alias = "projects"
+	}
+
+	return nil
+}
+
 func (n *Namespace) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyU:      ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyF:      ui.NewKeyAction("Finalizers", n.finalizersCmd, true),
+		ui.KeyT:      ui.NewKeyAction("Diagnose Terminating", n.diagnoseCmd, true),
+		ui.KeyI:      ui.NewKeyAction("Inventory", n.inventoryCmd, true),
+		ui.KeyP:      ui.NewKeyAction("Pod Security", n.podSecurityCmd, true),
+		ui.KeyShiftH: ui.NewKeyAction("Hierarchy", n.hierarchyCmd, true),
+		ui.KeyShiftT: ui.NewKeyAction("VCluster", n.vclusterCmd, true),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", n.GetTable().SortColCmd(statusCol, true), false),
 	})
+	if n.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Bulk(ui.KeyMap{
+		ui.KeyO: ui.NewKeyActionWithOpts("Clone", n.cloneCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+				Verbs:     []string{client.CreateVerb},
+			}),
+		ui.KeyX: ui.NewKeyActionWithOpts("Empty", n.emptyCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+				Verbs:     client.GetAccess,
+			}),
+	})
 }
 
 func (n *Namespace) switchNs(app *App, _ ui.Tabular, _ client.GVR, path string) {
@@ -54,6 +93,118 @@ func (n *Namespace) useNsCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (n *Namespace) finalizersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	_, ns := client.Namespaced(path)
+	showFinalizers(n.App(), ns)
+
+	return nil
+}
+
+func (n *Namespace) inventoryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if ns, ok := n.selectedNamespace(); ok {
+		showInventory(n.App(), ns)
+	}
+
+	return nil
+}
+
+func (n *Namespace) podSecurityCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ns, ok := n.selectedNamespace()
+	if !ok {
+		return nil
+	}
+	raw, err := dao.NamespacePSS(n.App().factory, ns)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(n.App(), "Pod Security", ns, contentYAML, true).Update(raw)
+	if err := n.App().inject(details, false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (n *Namespace) hierarchyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	showNamespaceTree(n.App())
+
+	return nil
+}
+
+func (n *Namespace) vclusterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ns, ok := n.selectedNamespace()
+	if !ok {
+		return nil
+	}
+	fqn, err := vclusterFor(n.App(), ns)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	launchVCluster(n.App(), fqn)
+
+	return nil
+}
+
+func (n *Namespace) cloneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if ns, ok := n.selectedNamespace(); ok {
+		showClone(n.App(), ns)
+	}
+
+	return nil
+}
+
+func (n *Namespace) emptyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if ns, ok := n.selectedNamespace(); ok {
+		emptyNamespace(n.App(), ns)
+	}
+
+	return nil
+}
+
+func (n *Namespace) selectedNamespace() (string, bool) {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" || path == client.NamespaceAll {
+		return "", false
+	}
+	_, ns := client.Namespaced(path)
+
+	return ns, true
+}
+
+func (n *Namespace) diagnoseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ns, ok := n.selectedNamespace()
+	if !ok {
+		return nil
+	}
+
+	var fin dao.Finalizer
+	fin.Init(n.App().factory, client.NewGVR("finalizers"))
+	n.App().Flash().Infof("Diagnosing namespace %s...", ns)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+		defer cancel()
+		raw, err := fin.DiagnoseNamespace(ctx, ns)
+		n.App().QueueUpdateDraw(func() {
+			if err != nil {
+				n.App().Flash().Err(err)
+				return
+			}
+			details := NewDetails(n.App(), "Terminating", ns, contentYAML, true).Update(raw)
+			if err := n.App().inject(details, false); err != nil {
+				n.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
 func (n *Namespace) useNamespace(fqn string) {
 	_, ns := client.Namespaced(fqn)
 	if client.CleanseNamespace(n.App().Config.ActiveNamespace()) == ns {
@@ -79,7 +230,7 @@ func (n *Namespace) decorate(td *model1.TableData) {
 			Kind: model1.EventUnchanged,
 			Row: model1.Row{
 				ID:     client.NamespaceAll,
-				Fields: model1.Fields{client.NamespaceAll, "Active", "", "", ""},
+				Fields: model1.Fields{client.NamespaceAll, "Active", "", "", "", "", ""},
 			},
 		},
 		)
@@ -89,7 +240,8 @@ func (n *Namespace) decorate(td *model1.TableData) {
 	for _, ns := range n.App().Config.FavNamespaces() {
 		favs[ns] = struct{}{}
 	}
-	ans := n.App().Config.ActiveNamespace()
+	ans, ad := n.App().Config.ActiveNamespace(), n.App().anomalies
+	idx, anomalies := td.Header().IndexOf(render.AnomalyCol, true)
 	td.RowsRange(func(i int, re model1.RowEvent) bool {
 		_, n := client.Namespaced(re.Row.ID)
 		if _, ok := favs[n]; ok {
@@ -98,6 +250,9 @@ func (n *Namespace) decorate(td *model1.TableData) {
 		if ans == re.Row.ID {
 			re.Row.Fields[0] += defaultNSIndicator
 		}
+		if ad != nil && anomalies {
+			re.Row.Fields[idx] = ad.Anomaly(n)
+		}
 		re.Kind = model1.EventUnchanged
 		td.SetRow(i, re)
 		return true
@@ -0,0 +1,363 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	fileEnvKey       = "FILE"
+	labelEnvKey      = "LABEL:"
+	annotationEnvKey = "ANNOTATION:"
+)
+
+func openerActions(b *Browser, aa *ui.KeyActions) error {
+	aa.Range(func(k tcell.Key, a ui.KeyAction) {
+		if a.Opts.Opener {
+			aa.Delete(k)
+		}
+	})
+
+	oo := config.NewOpeners()
+	if err := oo.Load(b.app.Config.ContextOpenersPath()); err != nil {
+		return err
+	}
+
+	var (
+		errs    error
+		aliases = b.Aliases()
+		links   []config.Opener
+	)
+	for k, o := range oo.Openers {
+		if !inScope(o.Scopes, aliases) {
+			continue
+		}
+		if o.Description == "" {
+			o.Description = k
+		}
+		if o.URL != "" {
+			links = append(links, o)
+		}
+		if o.ShortCut == "" {
+			continue
+		}
+		key, err := asKey(o.ShortCut)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if _, ok := aa.Get(key); ok {
+			if !o.Override {
+				errs = errors.Join(errs, fmt.Errorf("duplicate opener key found for %q in %q", o.ShortCut, k))
+				continue
+			}
+			log.Debug().Msgf("Action %q has been overridden by opener in %q", o.ShortCut, k)
+		}
+		aa.Add(key, ui.NewKeyActionWithOpts(
+			o.Description,
+			openerAction(b, o),
+			ui.ActionOpts{
+				Visible: true,
+				Opener:  true,
+			},
+		))
+	}
+	// Resources may carry links in their annotations hints.
+	if len(links) > 0 || !dao.IsK9sMeta(b.meta) {
+		if _, ok := aa.Get(tcell.KeyCtrlO); !ok {
+			slices.SortFunc(links, func(a, b config.Opener) int {
+				return strings.Compare(a.Description, b.Description)
+			})
+			aa.Add(tcell.KeyCtrlO, ui.NewKeyActionWithOpts(
+				"Links",
+				linksCmd(b, links),
+				ui.ActionOpts{
+					Visible: true,
+					Opener:  true,
+				},
+			))
+		}
+	}
+
+	return errs
+}
+
+// linksCmd lists the url openers and annotations links available for the
+// selected resource.
+func linksCmd(b *Browser, links []config.Opener) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := b.GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		gvr := b.GVR()
+		go func() {
+			uu := hintLinks(b.app, gvr, path)
+			b.app.QueueUpdateDraw(func() {
+				showLinks(b, links, uu, path, evt)
+			})
+		}()
+
+		return nil
+	}
+}
+
+func showLinks(b *Browser, links []config.Opener, uu []dao.Hint, path string, evt *tcell.EventKey) {
+	if len(links) == 0 && len(uu) == 0 {
+		b.app.Flash().Warnf("No links found for %s", path)
+		return
+	}
+	dd := make([]string, 0, len(links)+len(uu))
+	for _, l := range links {
+		dd = append(dd, l.Description)
+	}
+	for _, u := range uu {
+		dd = append(dd, u.Label)
+	}
+	dialog.ShowPicker(b.app.Styles.Dialog(), b.app.Content.Pages, "Links", dd, func(i int) {
+		switch {
+		case i >= 0 && i < len(links):
+			openerAction(b, links[i])(evt)
+		case i >= len(links) && i < len(dd):
+			u, _ := uu[i-len(links)].URL()
+			openURL(b.app, u)
+		}
+	})
+}
+
+func openerAction(b *Browser, o config.Opener) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := b.GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		if b.EnvFn() == nil {
+			return nil
+		}
+		env := b.EnvFn()()
+
+		if o.URL != "" {
+			if err := b.objectEnv(env, path); err != nil {
+				b.app.Flash().Err(err)
+				return nil
+			}
+			u, err := urlEnv(env).Substitute(o.URL)
+			if err != nil {
+				b.app.Flash().Err(err)
+				return nil
+			}
+			openURL(b.app, u)
+			return nil
+		}
+
+		if needsFile(o.Args) {
+			raw, err := model.NewYAML(b.GVR(), path).ToYAML(b.defaultContext(), b.GVR(), path, false)
+			if err != nil {
+				b.app.Flash().Err(err)
+				return nil
+			}
+			file, err := dumpOpenFile(raw)
+			if err != nil {
+				b.app.Flash().Err(err)
+				return nil
+			}
+			b.app.openFiles.add(file)
+			env[fileEnvKey] = file
+		}
+		args := make([]string, 0, len(o.Args))
+		for _, a := range o.Args {
+			arg, err := env.Substitute(a)
+			if err != nil {
+				b.app.Flash().Err(err)
+				return nil
+			}
+			args = append(args, arg)
+		}
+		openWith(b.app, os.ExpandEnv(o.Command), args...)
+
+		return nil
+	}
+}
+
+// hintLinks returns a resource annotations hints holding a link.
+func hintLinks(app *App, gvr client.GVR, path string) []dao.Hint {
+	if app.factory == nil {
+		return nil
+	}
+	hh, err := dao.ResourceHints(app.factory, gvr, path)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Hints lookup failed for %s", path)
+		return nil
+	}
+	uu := make([]dao.Hint, 0, len(hh))
+	for _, h := range hh {
+		if _, ok := h.URL(); ok {
+			uu = append(uu, h)
+		}
+	}
+
+	return uu
+}
+
+// hintActions binds number keys opening a resource annotations links once
+// the resource hints are known.
+func hintActions(app *App, v *LiveView, gvr client.GVR, path string) {
+	go func() {
+		hh := hintLinks(app, gvr, path)
+		if len(hh) == 0 {
+			return
+		}
+		app.QueueUpdateDraw(func() {
+			for i, h := range hh {
+				key, ok := ui.NumKeys[i+1]
+				if !ok {
+					break
+				}
+				u, _ := h.URL()
+				v.Actions().Add(key, ui.NewKeyAction("Open "+h.Label, func(*tcell.EventKey) *tcell.EventKey {
+					openURL(app, u)
+					return nil
+				}, true))
+			}
+			if app.Content.Top() == model.Component(v) {
+				app.Menu().HydrateMenu(v.Hints())
+			}
+		})
+	}()
+}
+
+// objectEnv adds the resource labels and annotations to the env so they can
+// be referenced as ${LABEL:app} or ${ANNOTATION:team.io/owner}.
+func (b *Browser) objectEnv(env Env, path string) error {
+	o, err := b.app.factory.Get(b.GVR().String(), path, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return err
+	}
+	addObjectEnv(env, m.GetLabels(), m.GetAnnotations())
+
+	return nil
+}
+
+func addObjectEnv(env Env, ll, aa map[string]string) {
+	for k, v := range ll {
+		env[labelEnvKey+strings.ToUpper(k)] = v
+	}
+	for k, v := range aa {
+		env[annotationEnvKey+strings.ToUpper(k)] = v
+	}
+}
+
+// urlEnv escapes env values so they may be embedded in urls.
+func urlEnv(env Env) Env {
+	ee := make(Env, len(env))
+	for k, v := range env {
+		ee[k] = url.QueryEscape(v)
+	}
+
+	return ee
+}
+
+func needsFile(args []string) bool {
+	for _, a := range args {
+		if strings.Contains(a, "$"+fileEnvKey) || strings.Contains(a, "${"+fileEnvKey+"}") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dumpOpenFile saves a manifest for an external tool.
+func dumpOpenFile(raw string) (string, error) {
+	f, err := os.CreateTemp("", "k9s-open-*.yaml")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := f.WriteString(raw); err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+
+	return f.Name(), nil
+}
+
+// openURL launches the system browser on a given url.
+func openURL(app *App, u string) {
+	bin := browseLinux
+	if runtime.GOOS == "darwin" {
+		bin = browseOSX
+	}
+	openWith(app, bin, u)
+}
+
+// openFiles tracks the manifests dumped for external tools. Launchers such as
+// open or xdg-open hand the file off and exit right away so the files are
+// only removed once k9s exits.
+type openFiles struct {
+	ff []string
+	mx sync.Mutex
+}
+
+func (o *openFiles) add(f string) {
+	o.mx.Lock()
+	defer o.mx.Unlock()
+
+	o.ff = append(o.ff, f)
+}
+
+// clear removes all tracked files.
+func (o *openFiles) clear() {
+	o.mx.Lock()
+	defer o.mx.Unlock()
+
+	for _, f := range o.ff {
+		if err := os.Remove(f); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Warn().Err(err).Msgf("Unable to remove opener file %q", f)
+		}
+	}
+	o.ff = nil
+}
+
+func openWith(app *App, bin string, args ...string) {
+	ok, errChan, _ := run(app, shellOpts{
+		background: true,
+		binary:     bin,
+		args:       args,
+	})
+	if !ok {
+		app.Flash().Errf("unable to run %q", bin)
+		return
+	}
+	var errs error
+	for e := range errChan {
+		errs = errors.Join(errs, e)
+	}
+	if errs != nil {
+		app.Flash().Err(errs)
+	}
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNeedsFile(t *testing.T) {
+	uu := map[string]struct {
+		args []string
+		e    bool
+	}{
+		"none": {},
+		"plain": {
+			args: []string{"-n", "$FILE"},
+			e:    true,
+		},
+		"braces": {
+			args: []string{"--file=${FILE}"},
+			e:    true,
+		},
+		"other": {
+			args: []string{"$NAME", "$NAMESPACE"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, needsFile(u.args))
+		})
+	}
+}
+
+func TestURLEnv(t *testing.T) {
+	env := urlEnv(Env{"NAME": "fred", "FILTER": "app=blee zorg"})
+
+	s, err := env.Substitute("https://grafana/d/pods?var-pod=$NAME&q=${FILTER}")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://grafana/d/pods?var-pod=fred&q=app%3Dblee+zorg", s)
+}
+
+func TestAddObjectEnv(t *testing.T) {
+	env := Env{"NAMESPACE": "ns1", "NAME": "fred"}
+	addObjectEnv(env, map[string]string{"app.kubernetes.io/name": "blee"}, map[string]string{"team": "zorg"})
+
+	s, err := urlEnv(env).Substitute("https://grafana/d/k8s?var-ns=$NAMESPACE&var-app=${LABEL:app.kubernetes.io/name}&team=${ANNOTATION:team}")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://grafana/d/k8s?var-ns=ns1&var-app=blee&team=zorg", s)
+}
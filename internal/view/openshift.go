@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Project represents an OpenShift project viewer.
+type Project struct {
+	ResourceViewer
+}
+
+// NewProject returns a new viewer.
+func NewProject(gvr client.GVR) ResourceViewer {
+	p := Project{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetEnterFn(p.switchProject)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *Project) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyU:      ui.NewKeyAction("Use", p.useCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(statusCol, true), false),
+	})
+}
+
+func (p *Project) switchProject(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	if !p.useProject(path) {
+		return
+	}
+	app.gotoResource("pods", "", false)
+}
+
+func (p *Project) useCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if p.useProject(path) {
+		p.App().Flash().Infof("Using project %q", path)
+	}
+
+	return nil
+}
+
+func (p *Project) useProject(fqn string) bool {
+	_, ns := client.Namespaced(fqn)
+	if err := p.App().switchNS(ns); err != nil {
+		p.App().Flash().Err(err)
+		return false
+	}
+	if err := p.App().Config.SetActiveNamespace(ns); err != nil {
+		p.App().Flash().Err(err)
+		return false
+	}
+
+	return true
+}
+
+// DeploymentConfig represents an OpenShift deployment config viewer.
+type DeploymentConfig struct {
+	ResourceViewer
+}
+
+// NewDeploymentConfig returns a new viewer.
+func NewDeploymentConfig(gvr client.GVR) ResourceViewer {
+	d := DeploymentConfig{
+		ResourceViewer: NewScaleExtender(NewOwnerExtender(NewBrowser(gvr))),
+	}
+	d.GetTable().SetEnterFn(d.showPods)
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+func (d *DeploymentConfig) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", d.GetTable().SortColCmd(readyCol, true), false),
+		ui.KeyShiftU: ui.NewKeyAction("Sort UpToDate", d.GetTable().SortColCmd(uptodateCol, true), false),
+	})
+}
+
+func (*DeploymentConfig) showPods(app *App, _ ui.Tabular, gvr client.GVR, path string) {
+	u, err := fetchUnstructured(app, gvr, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	sel, _, _ := unstructured.NestedStringMap(u.Object, "spec", "selector")
+	if len(sel) == 0 {
+		sel = map[string]string{render.DeploymentConfigLabel: u.GetName()}
+	}
+	showPods(app, path, labels.SelectorFromSet(sel).String(), "")
+}
+
+// Route represents an OpenShift route viewer.
+type Route struct {
+	ResourceViewer
+}
+
+// NewRoute returns a new viewer.
+func NewRoute(gvr client.GVR) ResourceViewer {
+	r := Route{
+		ResourceViewer: NewOwnerExtender(NewBrowser(gvr)),
+	}
+	r.GetTable().SetEnterFn(r.showService)
+	r.AddBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+func (r *Route) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyShiftA, ui.NewKeyAction("Sort Admitted", r.GetTable().SortColCmd("ADMITTED", true), false))
+}
+
+// showService jumps to the service backing a route.
+func (*Route) showService(app *App, _ ui.Tabular, gvr client.GVR, path string) {
+	u, err := fetchUnstructured(app, gvr, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	svc, _, _ := unstructured.NestedString(u.Object, "spec", "to", "name")
+	if svc == "" {
+		app.Flash().Warnf("Route %s has no backing service", path)
+		return
+	}
+	app.gotoResource(dao.SvcGVR.String(), client.FQN(u.GetNamespace(), svc), false)
+}
+
+func fetchUnstructured(app *App, gvr client.GVR, path string) (*unstructured.Unstructured, error) {
+	o, err := app.factory.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return u, nil
+}
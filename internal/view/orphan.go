@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// Orphan presents resources no longer in use.
+type Orphan struct {
+	ResourceViewer
+}
+
+// NewOrphan returns a new viewer.
+func NewOrphan(gvr client.GVR) ResourceViewer {
+	o := Orphan{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	o.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	o.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	o.GetTable().SetSortCol("GVR", true)
+	o.GetTable().SetEnterFn(o.describe)
+	o.AddBindKeysFn(o.bindKeys)
+
+	return &o
+}
+
+func (o *Orphan) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		tcell.KeyCtrlD: ui.NewKeyAction("Cleanup", o.cleanupCmd, true),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort GVR", o.GetTable().SortColCmd("GVR", true), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Reason", o.GetTable().SortColCmd("REASON", true), false),
+	})
+}
+
+func (o *Orphan) describe(app *App, model ui.Tabular, _ client.GVR, id string) {
+	gvr, path, ok := render.ParseResourceID(id)
+	if !ok {
+		app.Flash().Errf("Invalid selection %q", id)
+		return
+	}
+	describeResource(app, model, client.NewGVR(gvr), path)
+}
+
+// cleanupCmd deletes the marked orphans or the selected one.
+func (o *Orphan) cleanupCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ids := o.GetTable().GetSelectedItems()
+	if len(ids) == 0 {
+		return evt
+	}
+
+	var orph dao.Orphan
+	orph.Init(o.App().factory, o.GVR())
+	msg := fmt.Sprintf("The following resources will be DELETED!\n\n%s", orph.CleanupPreview(ids))
+	dialog.ShowConfirm(o.App().Styles.Dialog(), o.App().Content.Pages, "Cleanup", msg, func() {
+		n, err := orph.Cleanup(context.Background(), ids)
+		if err != nil {
+			log.Error().Err(err).Msg("Orphans cleanup failed")
+			o.App().Flash().Errf("Deleted %d orphans with errors: %s", n, err)
+		} else {
+			o.App().Flash().Infof("Deleted %d orphans", n)
+		}
+		o.GetTable().ClearMarks()
+		o.GetTable().Refresh()
+	}, func() {})
+
+	return nil
+}
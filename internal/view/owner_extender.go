@@ -3,6 +3,7 @@ package view
 import (
 	"context"
 	"fmt"
+
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/rs/zerolog/log"
 
@@ -11,7 +12,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -20,7 +20,13 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 )
 
-// OwnerExtender adds owner actions to a given viewer.
+func ownedViewer(f func(client.GVR) ResourceViewer) func(client.GVR) ResourceViewer {
+	return func(gvr client.GVR) ResourceViewer {
+		return NewOwnerExtender(f(gvr))
+	}
+}
+
+// OwnerExtender adds owner and children navigation to a given viewer.
 type OwnerExtender struct {
 	ResourceViewer
 }
@@ -35,6 +41,12 @@ func NewOwnerExtender(r ResourceViewer) ResourceViewer {
 
 func (v *OwnerExtender) bindKeys(aa *ui.KeyActions) {
 	aa.Add(ui.KeyShiftJ, ui.NewKeyAction("Jump Owner", v.ownerCmd, true))
+	switch {
+	case dao.SelectsPods(v.GVR()):
+		aa.Add(ui.KeyShiftK, ui.NewKeyAction("Matched Pods", v.childrenCmd, true))
+	case v.GVR() != dao.PodGVR:
+		aa.Add(ui.KeyShiftK, ui.NewKeyAction("Jump Children", v.childrenCmd, true))
+	}
 }
 
 func (v *OwnerExtender) ownerCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -50,20 +62,32 @@ func (v *OwnerExtender) ownerCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
-func (v *OwnerExtender) findOwnerFor(path string) error {
-	res, err := dao.AccessorFor(v.App().factory, v.GVR())
-	if err != nil {
-		return err
+func (v *OwnerExtender) childrenCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := v.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
 	}
 
-	o, err := res.Get(v.defaultCtx(), path)
+	u, err := v.getObject(path)
 	if err != nil {
-		return err
+		v.App().Flash().Warnf("Unable to jump children: %s", err)
+		return nil
 	}
+	sel, err := dao.PodSelector(v.App().factory, u)
+	if err != nil {
+		log.Warn().Msgf("Unable to jump to the children of resource %q: %s", path, err)
+		v.App().Flash().Warnf("Unable to jump children: %s", err)
+		return nil
+	}
+	showPodsFromSelector(v.App(), path, sel)
 
-	u, ok := v.asUnstructuredObject(o)
-	if !ok {
-		return errors.Errorf("unsupported object type: %t", o)
+	return nil
+}
+
+func (v *OwnerExtender) findOwnerFor(path string) error {
+	u, err := v.getObject(path)
+	if err != nil {
+		return err
 	}
 
 	ns, _ := client.Namespaced(path)
@@ -87,25 +111,32 @@ func (v *OwnerExtender) findOwnerFor(path string) error {
 	return errors.Errorf("no owner found")
 }
 
-func (v *OwnerExtender) jumpOwner(ns string, owner metav1.OwnerReference) error {
-	gv, err := schema.ParseGroupVersion(owner.APIVersion)
+func (v *OwnerExtender) getObject(path string) (*unstructured.Unstructured, error) {
+	res, err := dao.AccessorFor(v.App().factory, v.GVR())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	gvr, namespaced, found := dao.MetaAccess.GVK2GVR(gv, owner.Kind)
-	if !found {
-		return errors.Errorf("unsupported GVK: %s/%s", owner.APIVersion, owner.Kind)
+	o, err := res.Get(v.defaultCtx(), path)
+	if err != nil {
+		return nil, err
 	}
 
-	var ownerFQN string
-	if namespaced {
-		ownerFQN = client.FQN(ns, owner.Name)
-	} else {
-		ownerFQN = owner.Name
+	u, ok := v.asUnstructuredObject(o)
+	if !ok {
+		return nil, errors.Errorf("unsupported object type: %T", o)
+	}
+
+	return u, nil
+}
+
+func (v *OwnerExtender) jumpOwner(ns string, owner metav1.OwnerReference) error {
+	gvr, path, err := dao.OwnerPath(ns, owner)
+	if err != nil {
+		return err
 	}
+	v.App().gotoResource(gvr.String(), path, false)
 
-	v.App().gotoResource(gvr.String(), ownerFQN, false)
 	return nil
 }
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/perf"
@@ -103,13 +104,19 @@ func (p *PortForward) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
 		col = 4
 	}
 	base := ui.TrimCell(p.GetTable().SelectTable, r, col)
+	ShowBench(p, path, base, cfg, p.startBenchmark)
+
+	return nil
+}
+
+func (p *PortForward) startBenchmark(base string, cfg config.BenchConfig) error {
 	var err error
 	p.bench, err = perf.NewBenchmark(base, p.App().version, cfg)
 	if err != nil {
-		p.App().Flash().Errf("Bench failed %v", err)
 		p.App().ClearStatus(false)
-		return nil
+		return err
 	}
+	p.bench.SetExporter(perf.NewExporter(p.App().Config.K9s.Exporter))
 
 	p.App().Status(model.FlashWarn, "Benchmark in progress...")
 	go func() {
@@ -17,6 +17,7 @@ type Picker struct {
 	*tview.List
 
 	actions ui.KeyActions
+	title   string
 }
 
 // NewPicker returns a new picker.
@@ -24,6 +25,7 @@ func NewPicker() *Picker {
 	return &Picker{
 		List:    tview.NewList(),
 		actions: *ui.NewKeyActions(),
+		title:   "Containers Picker",
 	}
 }
 
@@ -45,7 +47,7 @@ func (p *Picker) Init(ctx context.Context) error {
 	p.ShowSecondaryText(false)
 	p.SetShortcutColor(pickerView.ShortcutColor.Color())
 	p.SetSelectedBackgroundColor(pickerView.FocusColor.Color())
-	p.SetTitle(" [aqua::b]Containers Picker ")
+	p.SetTitle(" [aqua::b]" + p.title + " ")
 
 	p.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
 		if a, ok := p.actions.Get(evt.Key()); ok {
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const pipeKey = "pipe"
+
+// lastPipeCmd tracks the last command a buffer was piped to.
+var lastPipeCmd string
+
+// showPipe prompts for an external command to pipe a text buffer through.
+func showPipe(a *App, subject, in string) {
+	f := newStyledForm(a.Styles.Dialog())
+
+	presets := a.Config.K9s.Pipe.Commands
+	command := lastPipeCmd
+	if command == "" && len(presets) > 0 {
+		command = presets[0]
+	}
+	if len(presets) > 0 {
+		f.AddDropDown("Preset:", presets, -1, func(opt string, _ int) {
+			if in, ok := f.GetFormItemByLabel("Command:").(*tview.InputField); ok {
+				in.SetText(opt)
+			}
+		})
+	}
+	f.AddInputField("Command:", command, 50, nil, func(s string) {
+		command = strings.TrimSpace(s)
+	})
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(a, pipeKey)
+	})
+	f.AddButton("OK", func() {
+		dismissModalForm(a, pipeKey)
+		if command == "" {
+			return
+		}
+		lastPipeCmd = command
+		runPipe(a, subject, command, in)
+	})
+
+	showModalForm(a, pipeKey, "<Pipe>", "Pipe "+subject+" to a command ie yq .spec or grep -i error", f)
+}
+
+func runPipe(a *App, subject, command, in string) {
+	a.Flash().Infof("Piping to %s...", command)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), a.Config.K9s.Pipe.GetTimeout())
+		defer cancel()
+		out, err := pipeText(ctx, command, in)
+		a.QueueUpdateDraw(func() {
+			if err != nil && out == "" {
+				a.Flash().Err(err)
+				return
+			}
+			if err != nil {
+				a.Flash().Warnf("%s: %s", command, err)
+			}
+			details := NewDetails(a, "Pipe", subject+" | "+command, contentTXT, true).
+				Update(tview.TranslateANSI(tview.Escape(out)))
+			if err := a.inject(details, false); err != nil {
+				a.Flash().Err(err)
+			}
+		})
+	}()
+}
+
+// pipeText runs a shell command using the given text as its standard input
+// and returns the command combined output.
+func pipeText(ctx context.Context, command, in string) (string, error) {
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == windowsOS {
+		shell, flag = "cmd", "/C"
+	}
+	log.Debug().Msgf("Piping to> %s", command)
+	cmd := exec.CommandContext(ctx, shell, flag, command)
+
+	var buff bytes.Buffer
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = strings.NewReader(in), &buff, &buff
+	err := cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = errors.New("command timed out")
+	}
+
+	return strings.TrimRight(buff.String(), "\n"), err
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipeText(t *testing.T) {
+	if runtime.GOOS == windowsOS {
+		t.Skip("requires a posix shell")
+	}
+
+	uu := map[string]struct {
+		cmd, in, e string
+		err        bool
+	}{
+		"grep": {
+			cmd: "grep -i blee",
+			in:  "fred\nBlee\nzorg\n",
+			e:   "Blee",
+		},
+		"pipeline": {
+			cmd: "tr a-z A-Z | head -1",
+			in:  "fred\nblee\n",
+			e:   "FRED",
+		},
+		"fail": {
+			cmd: "echo toast >&2; exit 1",
+			e:   "toast",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			out, err := pipeText(ctx, u.cmd, u.in)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, out)
+		})
+	}
+}
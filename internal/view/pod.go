@@ -17,6 +17,7 @@ import (
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/trace"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
@@ -49,7 +50,7 @@ type Pod struct {
 // NewPod returns a new viewer.
 func NewPod(gvr client.GVR) ResourceViewer {
 	var p Pod
-	p.ResourceViewer = NewPortForwardExtender(
+	p.ResourceViewer = NewProxyExtender(NewPortForwardExtender(
 		NewOwnerExtender(
 			NewVulnerabilityExtender(
 				NewImageExtender(
@@ -57,7 +58,7 @@ func NewPod(gvr client.GVR) ResourceViewer {
 				),
 			),
 		),
-	)
+	))
 	p.AddBindKeysFn(p.bindKeys)
 	p.GetTable().SetEnterFn(p.showContainers)
 	p.GetTable().SetDecorateFn(p.portForwardIndicator)
@@ -119,9 +120,27 @@ func (p *Pod) bindDangerousKeys(aa *ui.KeyActions) {
 				Visible:   true,
 				Dangerous: true,
 			}),
+		ui.KeyShiftP: ui.NewKeyActionWithOpts(
+			"Purge",
+			p.purgeCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+				Verbs:     []string{client.ListVerb, client.DeleteVerb},
+			}),
 	})
 }
 
+func (p *Pod) purgeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ShowReap(p, dao.ReapOptions{
+		Namespace: client.CleanseNamespace(p.GetTable().GetModel().GetNamespace()),
+		Succeeded: true,
+		Evicted:   true,
+	})
+
+	return nil
+}
+
 func (p *Pod) bindKeys(aa *ui.KeyActions) {
 	if !p.App().Config.K9s.IsReadOnly() {
 		p.bindDangerousKeys(aa)
@@ -129,12 +148,20 @@ func (p *Pod) bindKeys(aa *ui.KeyActions) {
 
 	aa.Bulk(ui.KeyMap{
 		ui.KeyO:      ui.NewKeyAction("Show Node", p.showNode, true),
+		ui.KeyShiftB: ui.NewKeyAction("Node Neighbors", p.showNeighbors, true),
+		ui.KeyShiftL: ui.NewKeyAction("Tolerations", p.tolerationsCmd, true),
+		ui.KeyM:      ui.NewKeyAction("Mesh", p.meshCmd, true),
+		ui.KeyShiftY: ui.NewKeyAction("Envoy Dump", p.envoyCmd, true),
+		ui.KeyShiftE: ui.NewKeyAction("Preemption", p.preemptionCmd, true),
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(readyCol, true), false),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd("RESTARTS", false), false),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(statusCol, true), false),
 		ui.KeyShiftI: ui.NewKeyAction("Sort IP", p.GetTable().SortColCmd("IP", true), false),
 		ui.KeyShiftO: ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd("NODE", true), false),
 	})
+	if p.App().Config.K9s.Tracing.IsEnabled() {
+		aa.Add(ui.KeyU, ui.NewKeyAction("Traces", p.tracesCmd, true))
+	}
 	aa.Merge(resourceSorters(p.GetTable()))
 }
 
@@ -190,6 +217,154 @@ func (p *Pod) showNode(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// showNeighbors shows all the pods sharing the selected pod node.
+func (p *Pod) showNeighbors(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	pod, err := fetchPod(p.App().factory, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	if pod.Spec.NodeName == "" {
+		p.App().Flash().Err(errors.New("no node assigned"))
+		return nil
+	}
+	showPods(p.App(), pod.Spec.NodeName, client.BlankNamespace, "spec.nodeName="+pod.Spec.NodeName)
+
+	return nil
+}
+
+// tolerationsCmd shows the node taints each pod toleration matches.
+func (p *Pod) tolerationsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	pod, err := fetchPod(p.App().factory, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.App().Conn().Config().CallTimeout())
+	defer cancel()
+	report, err := dao.PodTolerations(ctx, p.App().factory, pod)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(p.App(), "Tolerations", path, contentYAML, true).Update(report)
+	if err := p.App().inject(details, false); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *Pod) meshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	report, err := dao.MeshReport(p.App().factory, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(p.App(), "Mesh", path, contentYAML, true).Update(report)
+	if err := p.App().inject(details, false); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *Pod) envoyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	p.App().Flash().Infof("Fetching envoy admin dump for %s...", path)
+	go func() {
+		dump, err := dao.FetchEnvoyDump(p.App().factory, path)
+		p.App().QueueUpdateDraw(func() {
+			if err != nil {
+				p.App().Flash().Err(err)
+				return
+			}
+			if err := showEnvoyDump(p.App(), path, dump); err != nil {
+				p.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+func (p *Pod) tracesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	po, err := fetchPod(p.App().factory, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	cfg := p.App().Config.K9s.Tracing
+	f, err := trace.NewFinder(cfg)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+
+	svc := trace.ServiceName(po.Labels, po.GenerateName, po.Name)
+	p.App().Flash().Infof("Looking up traces for service %q...", svc)
+	go func() {
+		tt, err := f.Find(context.Background(), svc)
+		p.App().QueueUpdateDraw(func() {
+			if err != nil {
+				p.App().Flash().Err(err)
+				return
+			}
+			details := NewDetails(p.App(), "Traces", svc, contentTXT, true).Update(trace.Render(cfg, svc, tt))
+			if err := p.App().inject(details, false); err != nil {
+				p.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+func (p *Pod) preemptionCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	po, err := fetchPod(p.App().factory, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.App().Conn().Config().CallTimeout())
+	defer cancel()
+	report, err := dao.PreemptionReport(ctx, p.App().factory, po)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(p.App(), "Preemption", path, contentYAML, true).Update(report)
+	if err := p.App().inject(details, false); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 	selections := p.GetTable().GetSelectedItems()
 	if len(selections) == 0 {
@@ -382,6 +557,20 @@ func containerShellin(a *App, comp model.Component, path, co string) error {
 	return a.inject(picker, false)
 }
 
+func showEnvoyDump(a *App, path string, dump dao.EnvoyDump) error {
+	picker := NewPicker()
+	picker.title = "Envoy Panes"
+	picker.populate(dump.Panes())
+	picker.SetSelectedFunc(func(_ int, pane, _ string, _ rune) {
+		details := NewDetails(a, "Envoy "+pane, path, contentYAML, true).Update(dump[pane])
+		if err := a.inject(details, false); err != nil {
+			a.Flash().Err(err)
+		}
+	})
+
+	return a.inject(picker, false)
+}
+
 func resumeShellIn(a *App, c model.Component, path, co string) {
 	c.Stop()
 	defer c.Start()
@@ -447,10 +636,19 @@ func attachIn(a *App, path, co string) {
 
 func computeShellArgs(path, co string, kcfg *string, os string) []string {
 	args := buildShellArgs("exec", path, co, kcfg)
+	args = append(args, "--")
+
+	return append(args, shellCommand(os)...)
+}
+
+// shellCommand returns the default shell command for a given os. Windows
+// containers fall back to cmd when powershell is not available.
+func shellCommand(os string) []string {
 	if os == windowsOS {
-		return append(args, "--", powerShell)
+		return []string{"cmd", "/c", windowsShellCheck}
 	}
-	return append(args, "--", "sh", "-c", shellCheck)
+
+	return []string{"sh", "-c", shellCheck}
 }
 
 func buildShellArgs(cmd, path, co string, kcfg *string) []string {
@@ -529,6 +727,9 @@ func getPodOS(f dao.Factory, fqn string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if po.Spec.OS != nil && po.Spec.OS.Name != "" {
+		return string(po.Spec.OS.Name), nil
+	}
 	if podOS, ok := osFromSelector(po.Spec.NodeSelector); ok {
 		return podOS, nil
 	}
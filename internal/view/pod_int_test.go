@@ -7,7 +7,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 )
 
 func TestComputeShellArgs(t *testing.T) {
@@ -51,7 +53,7 @@ func TestComputeShellArgs(t *testing.T) {
 			"c1",
 			windowsOS,
 			&empty,
-			"exec -it -n fred blee -c c1 -- powershell",
+			"exec -it -n fred blee -c c1 -- cmd /c " + windowsShellCheck,
 		},
 	}
 
@@ -64,6 +66,25 @@ func TestComputeShellArgs(t *testing.T) {
 	}
 }
 
+func TestK9sShellPod(t *testing.T) {
+	cfg := config.NewShellPod()
+
+	po := k9sShellPod("n1", "linux", cfg)
+	assert.Equal(t, cfg.Image, po.Spec.Containers[0].Image)
+	assert.True(t, po.Spec.HostPID)
+	assert.True(t, *po.Spec.Containers[0].SecurityContext.Privileged)
+	assert.Nil(t, po.Spec.OS)
+
+	po = k9sShellPod("n1", windowsOS, cfg)
+	assert.Equal(t, cfg.ImageFor(windowsOS), po.Spec.Containers[0].Image)
+	assert.Equal(t, v1.Windows, po.Spec.OS.Name)
+	assert.False(t, po.Spec.HostPID)
+	assert.True(t, po.Spec.HostNetwork)
+	assert.True(t, *po.Spec.SecurityContext.WindowsOptions.HostProcess)
+	assert.Nil(t, po.Spec.Containers[0].SecurityContext)
+	assert.Empty(t, po.Spec.Volumes)
+}
+
 // func TestComputeShellArgs(t *testing.T) {
 // 	config, empty := "coolConfig", ""
 // 	uu := map[string]struct {
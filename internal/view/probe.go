@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+const probeRunKey = "probe-run"
+
+// showProbeHistory displays a pod probes status and recent failures.
+func showProbeHistory(a *App, fqn string) {
+	po, err := fetchPod(a.factory, fqn)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+	defer cancel()
+	report, err := dao.ProbeHistory(ctx, a.factory, po)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	details := NewDetails(a, "Probes", fqn, contentYAML, true).Update(report)
+	if err := a.inject(details, false); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+// showProbeRun pops a dialog to execute one of a container probes on demand.
+func showProbeRun(a *App, fqn, co string) {
+	po, err := fetchPod(a.factory, fqn)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	kinds := dao.ContainerProbeKinds(po, co)
+	if len(kinds) == 0 {
+		a.Flash().Warnf("No probes defined on container %s", co)
+		return
+	}
+
+	f := newStyledForm(a.Styles.Dialog())
+
+	sel := 0
+	f.AddDropDown("Probe:", kinds, sel, func(_ string, idx int) {
+		sel = idx
+	})
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(a, probeRunKey)
+	})
+	f.AddButton("Run", func() {
+		dismissModalForm(a, probeRunKey)
+		run, err := dao.NewProbeRun(po, co, kinds[sel])
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		go runProbe(a, fqn, run)
+	})
+
+	msg := fmt.Sprintf("Run a probe on container %s now? Network probes are issued from a throwaway pod in namespace %s, not from the kubelet.", co, po.Namespace)
+	showModalForm(a, probeRunKey, "<Run Probe>", msg, f)
+}
+
+// runProbe executes a probe either in the container or from a throwaway pod
+// and reports whether it currently passes.
+func runProbe(a *App, fqn string, run dao.ProbeRun) {
+	a.QueueUpdateDraw(func() {
+		a.Flash().Infof("Running %s probe on container %s...", strings.ToLower(run.Probe), run.Container)
+	})
+
+	var (
+		out, verdict string
+		err          error
+	)
+	if run.Exec != nil {
+		out, err = runKu(a, shellOpts{args: run.ExecArgs(fqn)})
+		verdict = "Exec: " + dao.NetCheckOK
+		if err != nil {
+			verdict = "Exec: " + dao.NetCheckFailed
+		}
+	} else {
+		ns, _ := client.Namespaced(fqn)
+		ctx, cancel := context.WithTimeout(context.Background(), netCheckDeadline)
+		defer cancel()
+		out, err = dao.RunNetCheck(ctx, a.factory, ns, a.Config.K9s.ShellPod, run.Check)
+		verdict = strings.Join(dao.NetCheckSummary(out), "\n")
+		out = ""
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("%s probe run failed for %s:%s", run.Probe, fqn, run.Container)
+	}
+
+	a.QueueUpdateDraw(func() {
+		if err != nil && verdict == "" {
+			a.Flash().Err(err)
+			return
+		}
+		a.Flash().Clear()
+		msg := fmt.Sprintf("%s probe on %s\n\n%s", run.Probe, run.Container, verdict)
+		if out != "" {
+			msg += "\n\n" + out
+		}
+		if err != nil {
+			msg += "\n\n" + err.Error()
+		}
+		dialog.ShowInfo(a.Styles.Dialog(), a.Content.Pages, "Probe Result", msg)
+	})
+}
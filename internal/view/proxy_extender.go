@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const proxyKey = "proxy"
+
+// ProxyExtender queries pods or services http endpoints via the API server proxy.
+type ProxyExtender struct {
+	ResourceViewer
+}
+
+// NewProxyExtender returns a new extender.
+func NewProxyExtender(r ResourceViewer) ResourceViewer {
+	p := ProxyExtender{ResourceViewer: r}
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *ProxyExtender) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyX, ui.NewKeyAction("Proxy", p.proxyCmd, true))
+}
+
+func (p *ProxyExtender) proxyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ns, n := client.Namespaced(path)
+	sub := client.NewGVR(p.GVR().String() + ":proxy")
+	if ok, err := p.App().Conn().CanI(ns, sub.String(), n, client.GetAccess); !ok || err != nil {
+		p.App().Flash().Errf("current user can't proxy to %s", path)
+		return nil
+	}
+	o, err := p.App().factory.Get(p.GVR().String(), path, true, labels.Everything())
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		p.App().Flash().Errf("expecting unstructured but got %T", o)
+		return nil
+	}
+	p.showProxyDialog(path, dao.ProxyPorts(u))
+
+	return nil
+}
+
+func (p *ProxyExtender) showProxyDialog(path string, ports []string) {
+	f := newStyledForm(p.App().Styles.Dialog())
+
+	var port, sub string
+	if len(ports) > 0 {
+		port = ports[0]
+	}
+	f.AddInputField("Port:", port, 0, nil, func(v string) {
+		port = strings.TrimSpace(v)
+	})
+	f.AddInputField("Path:", "/", 0, nil, func(v string) {
+		sub = strings.TrimSpace(v)
+	})
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(p.App(), proxyKey)
+	})
+	f.AddButton("OK", func() {
+		dismissModalForm(p.App(), proxyKey)
+		ns, n := client.Namespaced(path)
+		url := dao.ProxyPath(p.GVR().R(), ns, n, port, sub)
+		showAPIGet(p.App(), "Proxy", url)
+	})
+
+	msg := fmt.Sprintf("Query %s via the API server proxy", path)
+	if len(ports) > 1 {
+		msg += "\n\nPorts: " + strings.Join(ports, ",")
+	}
+	showModalForm(p.App(), proxyKey, "<Proxy>", msg, f)
+}
@@ -18,9 +18,11 @@ import (
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/health"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/tchart"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/rs/zerolog/log"
 )
 
 // Graphable represents a graphic component.
@@ -127,12 +129,11 @@ func (p *Pulse) StylesChanged(s *config.Styles) {
 	for _, c := range p.charts {
 		c.SetFocusColorNames(s.Table().BgColor.String(), s.Table().CursorBgColor.String())
 		if c.IsDial() {
-			c.SetBackgroundColor(s.Charts().DialBgColor.Color())
 			c.SetSeriesColors(s.Charts().DefaultDialColors.Colors()...)
 		} else {
-			c.SetBackgroundColor(s.Charts().ChartBgColor.Color())
 			c.SetSeriesColors(s.Charts().DefaultChartColors.Colors()...)
 		}
+		p.setChartBg(c, s)
 		if ss, ok := s.Charts().ResourceColors[c.ID()]; ok {
 			c.SetSeriesColors(ss.Colors()...)
 		}
@@ -199,6 +200,51 @@ func (p *Pulse) PulseChanged(c *health.Check) {
 		))
 	}
 	v.Add(tchart.Metric{S1: c.Tally(health.S1), S2: c.Tally(health.S2)})
+	p.highlight(c.GVR, v)
+	p.export(c)
+}
+
+// export publishes the pulse tallies when a metrics exporter is configured.
+func (p *Pulse) export(c *health.Check) {
+	e := perf.NewExporter(p.app.Config.K9s.Exporter)
+	if e == nil {
+		return
+	}
+	s1, s2 := "ok", "failed"
+	if c.GVR == "cpu" || c.GVR == "mem" {
+		s1, s2 = "used", "capacity"
+	}
+	ll := []perf.Label{
+		{Name: "context", Value: p.app.Config.ActiveContextName()},
+		{Name: "namespace", Value: p.model.GetNamespace()},
+		{Name: "resource", Value: client.NewGVR(c.GVR).R()},
+	}
+	mm := []perf.Metric{
+		{Name: s1, Value: float64(c.Tally(health.S1))},
+		{Name: s2, Value: float64(c.Tally(health.S2))},
+	}
+	go func() {
+		if err := e.Export(context.Background(), "pulse", ll, mm); err != nil {
+			log.Warn().Err(err).Msgf("Pulse export failed")
+		}
+	}()
+}
+
+// highlight flags charts whose app pulse alarms are tripped.
+func (p *Pulse) highlight(gvr string, g Graphable) {
+	if a := p.app.pulseAlarms; a != nil && a.IsTripped(gvr) {
+		g.SetBackgroundColor(p.app.Styles.Frame().Status.ErrorColor.Color())
+		return
+	}
+	p.setChartBg(g, p.app.Styles)
+}
+
+func (p *Pulse) setChartBg(g Graphable, s *config.Styles) {
+	if g.IsDial() {
+		g.SetBackgroundColor(s.Charts().DialBgColor.Color())
+		return
+	}
+	g.SetBackgroundColor(s.Charts().ChartBgColor.Color())
 }
 
 // PulseFailed notifies the load failed.
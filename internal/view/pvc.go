@@ -33,8 +33,23 @@ func (p *PersistentVolumeClaim) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftO: ui.NewKeyAction("Sort StorageClass", p.GetTable().SortColCmd("STORAGECLASS", true), false),
 		ui.KeyShiftC: ui.NewKeyAction("Sort Capacity", p.GetTable().SortColCmd("CAPACITY", true), false),
 	})
+	if p.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyA, ui.NewKeyActionWithOpts("Create", p.createCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+			Verbs:     []string{client.CreateVerb},
+		}))
 }
 
 func (p *PersistentVolumeClaim) refCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return scanRefs(evt, p.App(), p.GetTable(), dao.PvcGVR)
 }
+
+func (p *PersistentVolumeClaim) createCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ShowCreatePVC(p)
+
+	return nil
+}
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	pvcDialogKey    = "pvc-create"
+	pvcFieldLen     = 40
+	pvcDefaultSize  = "1Gi"
+	pvcBindPoll     = 2 * time.Second
+	pvcBindDeadline = 30 * time.Second
+)
+
+// ShowCreatePVC pops a dialog to create a persistent volume claim once the
+// cluster storage classes are known.
+func ShowCreatePVC(v ResourceViewer) {
+	go func() {
+		scs, err := dao.FetchStorageClasses(v.App().factory)
+		if err != nil {
+			log.Warn().Err(err).Msg("Storage classes lookup failed")
+		}
+		v.App().QueueUpdateDraw(func() {
+			showCreatePVC(v, scs)
+		})
+	}()
+}
+
+func showCreatePVC(v ResourceViewer, scs []dao.StorageClassInfo) {
+	spec := dao.PVCSpec{
+		Size:        pvcDefaultSize,
+		AccessModes: []string{dao.PVCAccessModes[0]},
+		VolumeMode:  dao.PVCVolumeModes[0],
+	}
+	if ns := v.App().Config.ActiveNamespace(); client.IsNamespaced(ns) {
+		spec.Namespace = ns
+	}
+
+	f := newStyledForm(v.App().Styles.Dialog())
+
+	f.AddInputField("Namespace:", spec.Namespace, pvcFieldLen, nil, func(s string) {
+		spec.Namespace = strings.TrimSpace(s)
+	})
+	f.AddInputField("Name:", "", pvcFieldLen, nil, func(s string) {
+		spec.Name = strings.TrimSpace(s)
+	})
+	if len(scs) > 0 {
+		opts := make([]string, 0, len(scs))
+		for _, sc := range scs {
+			opts = append(opts, storageClassLabel(sc))
+		}
+		spec.StorageClass = scs[0].Name
+		f.AddDropDown("Storage Class:", opts, 0, func(_ string, idx int) {
+			if idx >= 0 && idx < len(scs) {
+				spec.StorageClass = scs[idx].Name
+			}
+		})
+	} else {
+		f.AddInputField("Storage Class:", "", pvcFieldLen, nil, func(s string) {
+			spec.StorageClass = strings.TrimSpace(s)
+		})
+		f.GetFormItemByLabel("Storage Class:").(*tview.InputField).SetPlaceholder("Blank uses the cluster default")
+	}
+	f.AddInputField("Size:", spec.Size, pvcFieldLen, nil, func(s string) {
+		spec.Size = strings.TrimSpace(s)
+	})
+	for i, m := range dao.PVCAccessModes {
+		m := m
+		f.AddCheckbox(m+":", i == 0, func(_ string, b bool) {
+			spec.AccessModes = toggleMode(spec.AccessModes, m, b)
+		})
+	}
+	f.AddDropDown("Volume Mode:", dao.PVCVolumeModes, 0, func(opt string, _ int) {
+		spec.VolumeMode = opt
+	})
+
+	f.AddButton("OK", func() {
+		if _, err := spec.Build(); err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		dismissModalForm(v.App(), pvcDialogKey)
+		createPVC(v.App(), spec, waitsForConsumer(scs, spec.StorageClass))
+	})
+	f.AddButton("Cancel", func() {
+		dismissModalForm(v.App(), pvcDialogKey)
+	})
+
+	showModalForm(v.App(), pvcDialogKey, "<Create PVC>", pvcDialogMessage(scs), f)
+}
+
+func createPVC(app *App, spec dao.PVCSpec, waitForConsumer bool) {
+	app.Flash().Infof("Creating claim %s...", spec.Name)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		defer cancel()
+		pvc, err := dao.CreatePVC(ctx, app.factory, spec)
+		if err != nil {
+			app.QueueUpdateDraw(func() {
+				app.Flash().Err(err)
+			})
+			return
+		}
+		path := client.FQN(pvc.Namespace, pvc.Name)
+		app.QueueUpdateDraw(func() {
+			app.Flash().Infof("Claim %s created. Waiting on binding...", path)
+		})
+		followPVCBinding(app, path, waitForConsumer)
+	}()
+}
+
+// followPVCBinding reports on a new claim binding progress until it settles
+// or the deadline is reached.
+func followPVCBinding(app *App, path string, waitForConsumer bool) {
+	deadline := time.Now().Add(pvcBindDeadline)
+	for {
+		<-time.After(pvcBindPoll)
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		st, err := dao.PVCBinding(ctx, app.factory, path, waitForConsumer)
+		cancel()
+		expired := time.Now().After(deadline)
+		app.QueueUpdateDraw(func() {
+			switch {
+			case err != nil:
+				app.Flash().Err(err)
+			case st.Bound || !st.Settled && !expired:
+				app.Flash().Info(st.Message)
+			default:
+				app.Flash().Warn(st.Message)
+			}
+		})
+		if err != nil || st.Settled || expired {
+			return
+		}
+	}
+}
+
+func storageClassLabel(sc dao.StorageClassInfo) string {
+	if sc.Default {
+		return sc.Name + " (default)"
+	}
+
+	return sc.Name
+}
+
+func waitsForConsumer(scs []dao.StorageClassInfo, name string) bool {
+	for _, sc := range scs {
+		if sc.Name == name {
+			return sc.WaitForConsumer
+		}
+	}
+
+	return false
+}
+
+func toggleMode(mm []string, m string, on bool) []string {
+	out := make([]string, 0, len(mm)+1)
+	for _, x := range mm {
+		if x != m {
+			out = append(out, x)
+		}
+	}
+	if on {
+		out = append(out, m)
+	}
+
+	return out
+}
+
+func pvcDialogMessage(scs []dao.StorageClassInfo) string {
+	if len(scs) == 0 {
+		return "No storage classes found. Claims bind to pre-provisioned volumes or the cluster default"
+	}
+	ss := make([]string, 0, len(scs))
+	for _, sc := range scs {
+		s := sc.Name + " -> " + sc.Provisioner
+		if sc.WaitForConsumer {
+			s += " (binds on first consumer)"
+		}
+		ss = append(ss, s)
+	}
+
+	return fmt.Sprintf("Storage classes:\n%s", strings.Join(ss, "\n"))
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func quickActions(b *Browser, aa *ui.KeyActions) error {
+	aa.Range(func(k tcell.Key, a ui.KeyAction) {
+		if a.Opts.QuickAction {
+			aa.Delete(k)
+		}
+	})
+	if b.app.Config.K9s.IsReadOnly() {
+		return nil
+	}
+
+	qq := config.NewActions()
+	if err := qq.Load(b.app.Config.ContextActionsPath()); err != nil {
+		return err
+	}
+
+	var (
+		errs    error
+		aliases = b.Aliases()
+	)
+	for k, q := range qq.Actions {
+		if !inScope(q.Scopes, aliases) {
+			continue
+		}
+		key, err := asKey(q.ShortCut)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if _, ok := aa.Get(key); ok {
+			if !q.Override {
+				errs = errors.Join(errs, fmt.Errorf("duplicate quick action key found for %q in %q", q.ShortCut, k))
+				continue
+			}
+			log.Debug().Msgf("Action %q has been overridden by quick action in %q", q.ShortCut, k)
+		}
+		desc := q.Description
+		if desc == "" {
+			desc = k
+		}
+		aa.Add(key, ui.NewKeyActionWithOpts(
+			desc,
+			quickAction(b, desc, q),
+			ui.ActionOpts{
+				Visible:     true,
+				QuickAction: true,
+				Dangerous:   true,
+			},
+		))
+	}
+
+	return errs
+}
+
+func quickAction(b *Browser, desc string, q config.Action) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := b.GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+
+		pt, err := dao.ToPatchType(q.GetPatchType())
+		if err != nil {
+			b.app.Flash().Err(err)
+			return nil
+		}
+		patch := []byte(q.Patch)
+		if q.Toggle != "" {
+			if patch, err = b.togglePatch(path, q.Toggle); err != nil {
+				b.app.Flash().Err(err)
+				return nil
+			}
+		}
+
+		apply := func() {
+			ctx, cancel := context.WithTimeout(context.Background(), b.app.Conn().Config().CallTimeout())
+			defer cancel()
+			if err := dao.PatchResource(ctx, b.app.factory, b.GVR(), path, pt, patch, q.Subresource); err != nil {
+				b.app.Flash().Errf("%s failed: %s", desc, err)
+				return
+			}
+			b.app.Flash().Infof("%s applied to %s", desc, path)
+			b.refresh()
+		}
+		if !q.Confirm {
+			apply()
+			return nil
+		}
+		msg := fmt.Sprintf("Apply %q to %s?\n\n%s", desc, path, patch)
+		dialog.ShowConfirm(b.app.Styles.Dialog(), b.app.Content.Pages, "Confirm "+desc, msg, apply, func() {})
+
+		return nil
+	}
+}
+
+func (b *Browser) togglePatch(path, field string) ([]byte, error) {
+	o, err := b.app.factory.Get(b.GVR().String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	patch, _, err := dao.TogglePatch(u, field)
+
+	return patch, err
+}
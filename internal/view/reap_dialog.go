@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	reapKey        = "reap"
+	defaultReapAge = "1h"
+)
+
+// ShowReap pops a dialog to delete finished pods and jobs in bulk.
+func ShowReap(view ResourceViewer, opts dao.ReapOptions) {
+	f := newStyledForm(view.App().Styles.Dialog())
+
+	age := defaultReapAge
+	opts.OlderThan, _ = dao.ParseAge(age)
+	f.AddInputField("Older Than:", age, 0, nil, func(v string) {
+		d, err := dao.ParseAge(v)
+		if err != nil {
+			view.App().Flash().Err(err)
+			return
+		}
+		view.App().Flash().Clear()
+		opts.OlderThan = d
+	})
+	f.AddCheckbox("Succeeded Pods:", opts.Succeeded, func(_ string, v bool) {
+		opts.Succeeded = v
+	})
+	f.AddCheckbox("Evicted Pods:", opts.Evicted, func(_ string, v bool) {
+		opts.Evicted = v
+	})
+	f.AddCheckbox("Completed Jobs:", opts.Jobs, func(_ string, v bool) {
+		opts.Jobs = v
+	})
+	ns := opts.Namespace
+	f.AddCheckbox("All Namespaces:", ns == client.BlankNamespace, func(_ string, v bool) {
+		if v {
+			opts.Namespace = client.BlankNamespace
+		} else {
+			opts.Namespace = ns
+		}
+	})
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(view.App(), reapKey)
+	})
+	f.AddButton("OK", func() {
+		dismissModalForm(view.App(), reapKey)
+		previewReap(view, opts)
+	})
+
+	showModalForm(view.App(), reapKey, "<Purge>", "Delete finished pods and jobs in "+nsLabel(ns)+"?", f)
+}
+
+// previewReap scans for purge candidates off the ui goroutine and asks for
+// confirmation once the scan completes.
+func previewReap(view ResourceViewer, opts dao.ReapOptions) {
+	var r dao.Reaper
+	r.Init(view.App().factory, client.NewGVR("v1/pods"))
+
+	view.App().Flash().Infof("Scanning %s for resources to purge...", nsLabel(opts.Namespace))
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), view.App().Conn().Config().CallTimeout())
+		defer cancel()
+		rr, err := r.Candidates(ctx, opts)
+		view.App().QueueUpdateDraw(func() {
+			if err != nil {
+				view.App().Flash().Err(err)
+				return
+			}
+			if len(rr) == 0 {
+				view.App().Flash().Infof("Nothing to purge in %s", nsLabel(opts.Namespace))
+				return
+			}
+			view.App().Flash().Clear()
+			confirmReap(view, &r, rr, opts)
+		})
+	}()
+}
+
+func confirmReap(view ResourceViewer, r *dao.Reaper, rr []dao.Reapable, opts dao.ReapOptions) {
+	msg := fmt.Sprintf("The following resources will be DELETED!\n\n%s", r.Preview(rr))
+	dialog.ShowConfirm(view.App().Styles.Dialog(), view.App().Content.Pages, "Purge", msg, func() {
+		go func() {
+			n, err := r.Reap(context.Background(), rr)
+			view.App().QueueUpdateDraw(func() {
+				if err != nil {
+					log.Error().Err(err).Msg("Purge failed")
+					view.App().Flash().Errf("Purged %d resources with errors: %s", n, err)
+					return
+				}
+				view.App().Flash().Infof("Purged %d resources in %s", n, nsLabel(opts.Namespace))
+			})
+		}()
+	}, func() {})
+}
+
+func nsLabel(ns string) string {
+	if client.IsAllNamespaces(ns) {
+		return "all namespaces"
+	}
+
+	return "namespace " + ns
+}
@@ -15,7 +15,12 @@ func loadCustomViewers() MetaViewers {
 	rbacViewers(m)
 	batchViewers(m)
 	crdViewers(m)
+	admissionViewers(m)
 	helmViewers(m)
+	capiViewers(m)
+	openshiftViewers(m)
+	kedaViewers(m)
+	certManagerViewers(m)
 
 	return m
 }
@@ -26,6 +31,57 @@ func helmViewers(vv MetaViewers) {
 	}
 }
 
+func capiViewers(vv MetaViewers) {
+	vv[client.NewGVR("cluster.x-k8s.io/v1beta1/clusters")] = MetaViewer{
+		viewerFn: NewCAPICluster,
+	}
+	vv[client.NewGVR(capiMachineDeploymentGVR)] = MetaViewer{
+		viewerFn: NewMachineDeployment,
+	}
+	vv[client.NewGVR(capiMachineSetGVR)] = MetaViewer{
+		viewerFn: NewMachineSet,
+	}
+	vv[client.NewGVR(capiMachineGVR)] = MetaViewer{
+		viewerFn: NewMachine,
+	}
+}
+
+func openshiftViewers(vv MetaViewers) {
+	vv[client.NewGVR("project.openshift.io/v1/projects")] = MetaViewer{
+		viewerFn: NewProject,
+	}
+	vv[client.NewGVR("apps.openshift.io/v1/deploymentconfigs")] = MetaViewer{
+		viewerFn: NewDeploymentConfig,
+	}
+	vv[client.NewGVR("route.openshift.io/v1/routes")] = MetaViewer{
+		viewerFn: NewRoute,
+	}
+}
+
+func kedaViewers(vv MetaViewers) {
+	vv[client.NewGVR("keda.sh/v1alpha1/scaledobjects")] = MetaViewer{
+		viewerFn: NewScaledObject,
+	}
+	vv[client.NewGVR("keda.sh/v1alpha1/scaledjobs")] = MetaViewer{
+		viewerFn: NewScaledJob,
+	}
+}
+
+func certManagerViewers(vv MetaViewers) {
+	vv[client.NewGVR("cert-manager.io/v1/certificates")] = MetaViewer{
+		viewerFn: NewCertificate,
+	}
+	vv[client.NewGVR("cert-manager.io/v1/certificaterequests")] = MetaViewer{
+		viewerFn: NewCertificateRequest,
+	}
+	vv[client.NewGVR("acme.cert-manager.io/v1/orders")] = MetaViewer{
+		viewerFn: NewOrder,
+	}
+	vv[client.NewGVR("acme.cert-manager.io/v1/challenges")] = MetaViewer{
+		viewerFn: NewChallenge,
+	}
+}
+
 func coreViewers(vv MetaViewers) {
 	vv[client.NewGVR("v1/namespaces")] = MetaViewer{
 		viewerFn: NewNamespace,
@@ -57,6 +113,9 @@ func coreViewers(vv MetaViewers) {
 	vv[client.NewGVR("v1/persistentvolumeclaims")] = MetaViewer{
 		viewerFn: NewPersistentVolumeClaim,
 	}
+	vv[client.NewGVR("networking.k8s.io/v1/ingresses")] = MetaViewer{
+		viewerFn: NewIngress,
+	}
 }
 
 func miscViewers(vv MetaViewers) {
@@ -66,6 +125,15 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("contexts")] = MetaViewer{
 		viewerFn: NewContext,
 	}
+	vv[client.NewGVR("fleet")] = MetaViewer{
+		viewerFn: NewFleet,
+	}
+	vv[client.NewGVR("tlsexpiry")] = MetaViewer{
+		viewerFn: NewTLSExpiry,
+	}
+	vv[client.NewGVR("dnsrecords")] = MetaViewer{
+		viewerFn: NewDNSRecord,
+	}
 	vv[client.NewGVR("containers")] = MetaViewer{
 		viewerFn: NewContainer,
 	}
@@ -90,6 +158,33 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("pulses")] = MetaViewer{
 		viewerFn: NewPulse,
 	}
+	vv[client.NewGVR("finalizers")] = MetaViewer{
+		viewerFn: NewFinalizer,
+	}
+	vv[client.NewGVR("changes")] = MetaViewer{
+		viewerFn: NewChange,
+	}
+	vv[client.NewGVR("finds")] = MetaViewer{
+		viewerFn: NewFind,
+	}
+	vv[client.NewGVR("orphans")] = MetaViewer{
+		viewerFn: NewOrphan,
+	}
+	vv[client.NewGVR("subresources")] = MetaViewer{
+		viewerFn: NewSubresource,
+	}
+	vv[client.NewGVR("apipaths")] = MetaViewer{
+		viewerFn: NewAPIPath,
+	}
+	vv[client.NewGVR("inventory")] = MetaViewer{
+		viewerFn: NewInventory,
+	}
+	vv[client.NewGVR("templates")] = MetaViewer{
+		viewerFn: NewTemplate,
+	}
+	vv[client.NewGVR("datakeys")] = MetaViewer{
+		viewerFn: NewDataKey,
+	}
 	// !!BOZO!! Popeye
 	// vv[client.NewGVR("popeye")] = MetaViewer{
 	// 	viewerFn: NewPopeye,
@@ -153,6 +248,17 @@ func batchViewers(vv MetaViewers) {
 	}
 }
 
+func admissionViewers(vv MetaViewers) {
+	for _, v := range []string{"v1", "v1beta1"} {
+		vv[client.NewGVR("admissionregistration.k8s.io/"+v+"/validatingadmissionpolicies")] = MetaViewer{
+			viewerFn: NewValidatingAdmissionPolicy,
+		}
+		vv[client.NewGVR("admissionregistration.k8s.io/"+v+"/validatingadmissionpolicybindings")] = MetaViewer{
+			viewerFn: NewValidatingAdmissionPolicyBinding,
+		}
+	}
+}
+
 func crdViewers(vv MetaViewers) {
 	vv[client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions")] = MetaViewer{
 		viewerFn: NewCRD,
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/vul"
+)
+
+const reportKey = "report"
+
+// ShowReportSections pops a dialog to pick the sections of a cluster report.
+func ShowReportSections(app *App, format string) {
+	f := newStyledForm(app.Styles.Dialog())
+
+	picked := make([]bool, len(model.ReportSections))
+	for i, s := range model.ReportSections {
+		i := i
+		picked[i] = true
+		f.AddCheckbox(s.Title+":", true, func(_ string, v bool) {
+			picked[i] = v
+		})
+	}
+	scans := vul.ImgScanner != nil
+	if scans {
+		f.AddCheckbox(model.ReportScansTitle+":", true, func(_ string, v bool) {
+			scans = v
+		})
+	}
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(app, reportKey)
+	})
+	f.AddButton("OK", func() {
+		dismissModalForm(app, reportKey)
+		ss := make([]model.ReportSection, 0, len(picked))
+		for i, ok := range picked {
+			if ok {
+				ss = append(ss, model.ReportSections[i])
+			}
+		}
+		if len(ss) == 0 && !scans {
+			app.Flash().Warn("No report sections selected")
+			return
+		}
+		generateReport(app, format, ss, scans)
+	})
+
+	showModalForm(app, reportKey, "<Report>", "Pick the report sections", f)
+}
+
+func generateReport(app *App, format string, ss []model.ReportSection, scans bool) {
+	app.Flash().Info("Generating report...")
+	go func() {
+		r := model.NewReport(app.Config.ActiveContextName(), app.Config.ActiveNamespace())
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		defer cancel()
+		r.Build(context.WithValue(ctx, internal.KeyFactory, app.factory), ss, scans)
+
+		out, err := r.Render(format)
+		if err != nil {
+			app.QueueUpdateDraw(func() { app.Flash().Err(err) })
+			return
+		}
+		path, err := saveReport(app.Config.K9s.ContextScreenDumpDir(), format, out)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			details := NewDetails(app, "Report", path, contentTXT, true).Update(out)
+			if err := app.inject(details, false); err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			app.Flash().Infof("Report saved to %s", path)
+		})
+	}()
+}
+
+func saveReport(dir, format, report string) (string, error) {
+	if err := data.EnsureFullPath(dir, data.DefaultDirMod); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("report-%d.%s", time.Now().UnixNano(), format))
+
+	return path, os.WriteFile(path, []byte(report), 0600)
+}
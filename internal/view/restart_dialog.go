@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"time"
+)
+
+const restartKey = "restart"
+
+type waveOpts struct {
+	delay   time.Duration
+	timeout time.Duration
+	wait    bool
+}
+
+func defaultWaveOpts() waveOpts {
+	return waveOpts{
+		timeout: 5 * time.Minute,
+		wait:    true,
+	}
+}
+
+// restartWavesFunc represents a bulk restart callback function.
+type restartWavesFunc func(paths []string, opts waveOpts)
+
+// showRestartWaves pops a bulk restart dialog.
+func showRestartWaves(view ResourceViewer, paths []string, opts waveOpts, okFn restartWavesFunc) {
+	f := newStyledForm(view.App().Styles.Dialog())
+
+	f.AddInputField("Wave Delay:", opts.delay.String(), 0, nil, func(v string) {
+		a, err := asDurOpt(v)
+		if err != nil {
+			view.App().Flash().Err(err)
+			return
+		}
+		view.App().Flash().Clear()
+		opts.delay = a
+	})
+	f.AddCheckbox("Wait For Rollout:", opts.wait, func(_ string, v bool) {
+		opts.wait = v
+	})
+	f.AddInputField("Rollout Timeout:", opts.timeout.String(), 0, nil, func(v string) {
+		a, err := asDurOpt(v)
+		if err != nil {
+			view.App().Flash().Err(err)
+			return
+		}
+		view.App().Flash().Clear()
+		opts.timeout = a
+	})
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(view.App(), restartKey)
+	})
+	f.AddButton("OK", func() {
+		dismissModalForm(view.App(), restartKey)
+		okFn(paths, opts)
+	})
+
+	showModalForm(view.App(), restartKey, "<Restart>", fmt.Sprintf("Restart %d %s one at a time?", len(paths), view.GVR().R()), f)
+}
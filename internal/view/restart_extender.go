@@ -8,13 +8,18 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
+const rolloutPollInterval = 2 * time.Second
+
 // RestartExtender represents a restartable resource.
 type RestartExtender struct {
 	ResourceViewer
@@ -37,6 +42,7 @@ func (r *RestartExtender) bindKeys(aa *ui.KeyActions) {
 		ui.ActionOpts{
 			Visible:   true,
 			Dangerous: true,
+			Verbs:     client.PatchAccess,
 		},
 	))
 }
@@ -49,10 +55,11 @@ func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	r.Stop()
 	defer r.Start()
-	msg := fmt.Sprintf("Restart %s %s?", singularize(r.GVR().R()), paths[0])
 	if len(paths) > 1 {
-		msg = fmt.Sprintf("Restart %d %s?", len(paths), r.GVR().R())
+		showRestartWaves(r, paths, defaultWaveOpts(), r.restartWaves)
+		return nil
 	}
+	msg := fmt.Sprintf("Restart %s %s?", singularize(r.GVR().R()), paths[0])
 	dialog.ShowConfirm(r.App().Styles.Dialog(), r.App().Content.Pages, "Confirm Restart", msg, func() {
 		ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
 		defer cancel()
@@ -81,6 +88,118 @@ func (r *RestartExtender) restartRollout(ctx context.Context, path string) error
 	return s.Restart(ctx, path)
 }
 
+// restartWaves restarts workloads one at a time, optionally waiting for each
+// rollout to complete and pausing between waves.
+func (r *RestartExtender) restartWaves(paths []string, opts waveOpts) {
+	d := NewDetails(r.App(), "Restart Progress", r.GVR().R(), contentYAML, true)
+	if err := r.App().inject(d, false); err != nil {
+		r.App().Flash().Err(err)
+		return
+	}
+
+	lines := make([]string, len(paths))
+	for i, path := range paths {
+		lines[i] = path + ": pending"
+	}
+	d.Update(strings.Join(lines, "\n"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := cancelOnPop(r.App(), d, cancel)
+	r.App().Flash().Info("Restarting. Leave the progress view to cancel.")
+
+	go func() {
+		defer cancel()
+		// lines is only touched by this goroutine from here on.
+		update := func(i int, status string) {
+			lines[i] = fmt.Sprintf("%s: %s", paths[i], status)
+			buff := strings.Join(lines, "\n")
+			r.App().QueueUpdateDraw(func() {
+				d.Update(buff)
+			})
+		}
+		var failed int
+		for i, path := range paths {
+			if i > 0 && opts.delay > 0 {
+				update(i, "waiting "+opts.delay.String())
+				select {
+				case <-ctx.Done():
+				case <-time.After(opts.delay):
+				}
+			}
+			if ctx.Err() != nil {
+				update(i, "canceled")
+				continue
+			}
+			update(i, "restarting")
+			cctx, ccancel := context.WithTimeout(ctx, r.App().Conn().Config().CallTimeout())
+			err := r.restartRollout(cctx, path)
+			ccancel()
+			if err != nil {
+				failed++
+				update(i, "failed -- "+err.Error())
+				continue
+			}
+			if !opts.wait {
+				update(i, "restarted")
+				continue
+			}
+			if err := waitRollout(ctx, r.App(), r.GVR(), path, opts.timeout, func(s string) { update(i, s) }); err != nil {
+				failed++
+				update(i, "failed -- "+err.Error())
+			}
+		}
+		canceled := ctx.Err() != nil
+		r.App().QueueUpdateDraw(func() {
+			done()
+			if canceled {
+				r.App().Flash().Warn("Restart canceled")
+				return
+			}
+			if failed > 0 {
+				r.App().Flash().Errf("Restart completed with %d failure(s)", failed)
+				return
+			}
+			r.App().Flash().Infof("Restarted %d %s", len(paths), r.GVR().R())
+		})
+	}()
+}
+
+// waitRollout polls a workload until its rollout completes, times out or the
+// context is canceled.
+func waitRollout(ctx context.Context, a *App, gvr client.GVR, path string, timeout time.Duration, status func(string)) error {
+	var g dao.Generic
+	g.Init(a.factory, gvr)
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(rolloutPollInterval):
+		}
+		cctx, cancel := context.WithTimeout(ctx, a.Conn().Config().CallTimeout())
+		o, err := g.Get(cctx, path)
+		cancel()
+		if err != nil {
+			return err
+		}
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		msg, done, err := dao.RolloutStatus(u)
+		if err != nil {
+			return err
+		}
+		status(msg)
+		if done {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("rollout timed out after %s", timeout)
+}
+
 // Helpers...
 
 func singularize(s string) string {
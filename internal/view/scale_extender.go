@@ -5,19 +5,24 @@ package view
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
-	"github.com/derailed/k9s/internal/config"
-
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 	"github.com/rs/zerolog/log"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 )
 
+func newScalableBrowser(gvr client.GVR) ResourceViewer {
+	return NewScaleExtender(NewBrowser(gvr))
+}
+
 // ScaleExtender adds scaling extensions.
 type ScaleExtender struct {
 	ResourceViewer
@@ -39,6 +44,7 @@ func (s *ScaleExtender) bindKeys(aa *ui.KeyActions) {
 		ui.ActionOpts{
 			Visible:   true,
 			Dangerous: true,
+			Verbs:     client.PatchAccess,
 		},
 	))
 }
@@ -57,22 +63,54 @@ func (s *ScaleExtender) scaleCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (s *ScaleExtender) showScaleDialog(paths []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+	defer cancel()
+
+	hpas := make(map[string]*autoscalingv2.HorizontalPodAutoscaler, len(paths))
+	for _, p := range paths {
+		hpa, err := dao.HPAFor(ctx, s.App().factory, s.GVR(), p)
+		if err != nil {
+			log.Warn().Err(err).Msgf("HPA lookup failed for %s", p)
+			continue
+		}
+		if hpa != nil {
+			hpas[p] = hpa
+		}
+	}
+	if hpa, ok := hpas[paths[0]]; ok && len(paths) == 1 {
+		s.showHPADialog(paths[0], hpa)
+		return
+	}
+
 	form, err := s.makeScaleForm(paths)
 	if err != nil {
 		s.App().Flash().Err(err)
 		return
 	}
-	confirm := tview.NewModalForm("<Scale>", form)
-	msg := fmt.Sprintf("Scale %s %s?", singularize(s.GVR().R()), paths[0])
+	showModalForm(s.App(), scaleDialogKey, "<Scale>", s.scaleMessage(ctx, paths, hpas), form)
+}
+
+func (s *ScaleExtender) scaleMessage(ctx context.Context, paths []string, hpas map[string]*autoscalingv2.HorizontalPodAutoscaler) string {
 	if len(paths) > 1 {
-		msg = fmt.Sprintf("Scale [%d] %s?", len(paths), s.GVR().R())
+		msg := fmt.Sprintf("Scale [%d] %s?", len(paths), s.GVR().R())
+		if len(hpas) > 0 {
+			msg += fmt.Sprintf("\n\nWARNING! %d of them are managed by an HPA and will be rescaled by it.", len(hpas))
+		}
+		return msg
 	}
-	confirm.SetText(msg)
-	confirm.SetDoneFunc(func(int, string) {
-		s.dismissDialog()
-	})
-	s.App().Content.AddPage(scaleDialogKey, confirm, false, false)
-	s.App().Content.ShowPage(scaleDialogKey)
+
+	msg := fmt.Sprintf("Scale %s %s?", singularize(s.GVR().R()), paths[0])
+	ns, n := client.Namespaced(paths[0])
+	hh, err := dao.ScaleHistory(ctx, s.App().factory, ns, n)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Scale history failed for %s", paths[0])
+		return msg
+	}
+	if len(hh) > 0 {
+		msg += "\n\nRecent activity:\n" + strings.Join(hh, "\n")
+	}
+
+	return msg
 }
 
 func (s *ScaleExtender) valueOf(col string) (string, error) {
@@ -83,21 +121,38 @@ func (s *ScaleExtender) valueOf(col string) (string, error) {
 	return s.GetTable().GetSelectedCell(colIdx), nil
 }
 
+// replicasFor returns the desired replicas from the view or the scale
+// subresource when the view does not track readiness.
+func (s *ScaleExtender) replicasFor(path string) (string, error) {
+	if replicas, err := s.valueOf("READY"); err == nil {
+		tokens := strings.Split(replicas, "/")
+		if len(tokens) < 2 {
+			return "", fmt.Errorf("unable to locate replicas from %s", replicas)
+		}
+		return strings.TrimRight(tokens[1], ui.DeltaSign), nil
+	}
+
+	var g dao.Generic
+	g.Init(s.App().factory, s.GVR())
+	ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+	defer cancel()
+	r, err := g.Replicas(ctx, path)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.Itoa(int(r)), nil
+}
+
 func (s *ScaleExtender) makeScaleForm(sels []string) (*tview.Form, error) {
-	styles := s.App().Styles.Dialog()
-	f := s.makeStyledForm(styles)
+	f := newStyledForm(s.App().Styles.Dialog())
 
 	factor := "0"
 	if len(sels) == 1 {
-		replicas, err := s.valueOf("READY")
-		if err != nil {
+		var err error
+		if factor, err = s.replicasFor(sels[0]); err != nil {
 			return nil, err
 		}
-		tokens := strings.Split(replicas, "/")
-		if len(tokens) < 2 {
-			return nil, fmt.Errorf("unable to locate replicas from %s", replicas)
-		}
-		factor = strings.TrimRight(tokens[1], ui.DeltaSign)
 	}
 	f.AddInputField("Replicas:", factor, 4, func(textToCheck string, lastChar rune) bool {
 		_, err := strconv.Atoi(textToCheck)
@@ -107,54 +162,103 @@ func (s *ScaleExtender) makeScaleForm(sels []string) (*tview.Form, error) {
 	})
 
 	f.AddButton("OK", func() {
-		defer s.dismissDialog()
+		defer dismissModalForm(s.App(), scaleDialogKey)
 		count, err := strconv.Atoi(factor)
 		if err != nil {
 			s.App().Flash().Err(err)
 			return
 		}
-		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
-		defer cancel()
-		for _, sel := range sels {
-			if err := s.scale(ctx, sel, count); err != nil {
-				log.Error().Err(err).Msgf("DP %s scaling failed", sel)
-				s.App().Flash().Err(err)
-				return
-			}
-		}
-		if len(sels) == 1 {
-			s.App().Flash().Infof("[%d] %s scaled successfully", len(sels), singularize(s.GVR().R()))
-		} else {
-			s.App().Flash().Infof("%s %s scaled successfully", s.GVR().R(), sels[0])
-		}
+		s.scaleAll(sels, count)
 	})
 	f.AddButton("Cancel", func() {
-		s.dismissDialog()
+		dismissModalForm(s.App(), scaleDialogKey)
 	})
-	for i := 0; i < 2; i++ {
-		if b := f.GetButton(i); b != nil {
-			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
-			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
-		}
-	}
 
 	return f, nil
 }
 
-func (s *ScaleExtender) dismissDialog() {
-	s.App().Content.RemovePage(scaleDialogKey)
+func (s *ScaleExtender) scaleAll(sels []string, count int) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+	defer cancel()
+
+	var errs error
+	for _, sel := range sels {
+		if err := s.scale(ctx, sel, count); err != nil {
+			log.Error().Err(err).Msgf("%s scaling failed", sel)
+			errs = errors.Join(errs, err)
+		}
+	}
+	if errs != nil {
+		s.App().Flash().Err(errs)
+		return
+	}
+	if len(sels) == 1 {
+		s.App().Flash().Infof("%s %s scaled successfully", singularize(s.GVR().R()), sels[0])
+	} else {
+		s.App().Flash().Infof("[%d] %s scaled successfully", len(sels), s.GVR().R())
+	}
 }
 
-func (s *ScaleExtender) makeStyledForm(styles config.Dialog) *tview.Form {
-	f := tview.NewForm()
-	f.SetItemPadding(0)
-	f.SetButtonsAlign(tview.AlignCenter).
-		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
-		SetButtonTextColor(styles.ButtonBgColor.Color()).
-		SetLabelColor(styles.LabelFgColor.Color()).
-		SetFieldTextColor(styles.FieldFgColor.Color())
+// showHPADialog offers to tune the autoscaler bounds since the HPA owns the
+// replicas count.
+func (s *ScaleExtender) showHPADialog(path string, hpa *autoscalingv2.HorizontalPodAutoscaler) {
+	f := newStyledForm(s.App().Styles.Dialog())
+
+	var minR int32 = 1
+	if hpa.Spec.MinReplicas != nil {
+		minR = *hpa.Spec.MinReplicas
+	}
+	lower, upper := strconv.Itoa(int(minR)), strconv.Itoa(int(hpa.Spec.MaxReplicas))
+	accept := func(textToCheck string, lastChar rune) bool {
+		_, err := strconv.Atoi(textToCheck)
+		return err == nil
+	}
+	f.AddInputField("Min Replicas:", lower, 4, accept, func(changed string) {
+		lower = changed
+	})
+	f.AddInputField("Max Replicas:", upper, 4, accept, func(changed string) {
+		upper = changed
+	})
 
-	return f
+	f.AddButton("Update HPA", func() {
+		defer dismissModalForm(s.App(), scaleDialogKey)
+		lo, err1 := strconv.Atoi(lower)
+		hi, err2 := strconv.Atoi(upper)
+		if err := errors.Join(err1, err2); err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+		defer cancel()
+		fqn := client.FQN(hpa.Namespace, hpa.Name)
+		if err := dao.UpdateHPABounds(ctx, s.App().factory, fqn, int32(lo), int32(hi)); err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.App().Flash().Infof("HPA %s bounds set to [%d, %d]", fqn, lo, hi)
+	})
+	f.AddButton("Scale Anyway", func() {
+		dismissModalForm(s.App(), scaleDialogKey)
+		form, err := s.makeScaleForm([]string{path})
+		if err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		msg := fmt.Sprintf("Scale %s %s?\nThe HPA will likely override this value!", singularize(s.GVR().R()), path)
+		showModalForm(s.App(), scaleDialogKey, "<Scale>", msg, form)
+	})
+	f.AddButton("Cancel", func() {
+		dismissModalForm(s.App(), scaleDialogKey)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+	defer cancel()
+	ns, n := client.Namespaced(path)
+	msg := fmt.Sprintf("%s is managed by HPA %s [%d, %d]. Edit the HPA bounds instead?", path, hpa.Name, minR, hpa.Spec.MaxReplicas)
+	if hh, err := dao.ScaleHistory(ctx, s.App().factory, ns, n, hpa.Name); err == nil && len(hh) > 0 {
+		msg += "\n\nRecent activity:\n" + strings.Join(hh, "\n")
+	}
+	showModalForm(s.App(), scaleDialogKey, "<Scale>", msg, f)
 }
 
 func (s *ScaleExtender) scale(ctx context.Context, path string, replicas int) error {
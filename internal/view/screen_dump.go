@@ -5,18 +5,27 @@ package view
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/rs/zerolog/log"
 )
 
+const dumpInputKey = "dumpInput"
+
 // ScreenDump presents a directory listing viewer.
 type ScreenDump struct {
 	ResourceViewer
+
+	dir string
 }
 
 // NewScreenDump returns a new viewer.
@@ -30,12 +39,30 @@ func NewScreenDump(gvr client.GVR) ResourceViewer {
 	s.GetTable().SelectRow(1, 0, true)
 	s.GetTable().SetEnterFn(s.edit)
 	s.SetContextFn(s.dirContext)
+	s.AddBindKeysFn(s.bindKeys)
 
 	return &s
 }
 
+func (s *ScreenDump) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyV: ui.NewKeyAction("Preview", s.previewCmd, true),
+		ui.KeyF: ui.NewKeyAction("Find", s.findCmd, true),
+		ui.KeyX: ui.NewKeyAction("Diff Marked", s.diffCmd, true),
+		ui.KeyT: ui.NewKeyAction("Tag Incident", s.tagCmd, true),
+	})
+}
+
+func (s *ScreenDump) dumpDir() string {
+	if s.dir != "" {
+		return s.dir
+	}
+
+	return s.App().Config.K9s.ContextScreenDumpDir()
+}
+
 func (s *ScreenDump) dirContext(ctx context.Context) context.Context {
-	dir := s.App().Config.K9s.ContextScreenDumpDir()
+	dir := s.dumpDir()
 	if err := data.EnsureFullPath(dir, data.DefaultDirMod); err != nil {
 		s.App().Flash().Err(err)
 		return ctx
@@ -44,12 +71,132 @@ func (s *ScreenDump) dirContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, internal.KeyDir, dir)
 }
 
-func (s *ScreenDump) edit(app *App, _ ui.Tabular, _ client.GVR, path string) {
+func (s *ScreenDump) edit(app *App, _ ui.Tabular, gvr client.GVR, path string) {
 	log.Debug().Msgf("ScreenDump selection is %q", path)
 
+	if fi, err := os.Stat(path); err == nil && fi.IsDir() {
+		v := NewScreenDump(gvr)
+		v.(*ScreenDump).dir = path
+		if err := app.inject(v, false); err != nil {
+			app.Flash().Err(err)
+		}
+		return
+	}
+
 	s.Stop()
 	defer s.Start()
 	if !edit(app, shellOpts{clear: true, args: []string{path}}) {
 		app.Flash().Errf("Failed to launch editor")
 	}
 }
+
+func (s *ScreenDump) previewCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+
+	details := NewDetails(s.App(), "Preview", filepath.Base(path), contentTXT, true).Update(string(bb))
+	if err := s.App().inject(details, false); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (s *ScreenDump) findCmd(evt *tcell.EventKey) *tcell.EventKey {
+	s.showInput("<Find>", "Search all dumps for:", "Text:", func(term string) {
+		mm, err := dao.SearchDumps(s.dumpDir(), term)
+		if err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		if len(mm) == 0 {
+			s.App().Flash().Infof("No dumps matching %q", term)
+			return
+		}
+
+		var b strings.Builder
+		for _, m := range mm {
+			rel, err := filepath.Rel(s.dumpDir(), m.Path)
+			if err != nil {
+				rel = m.Path
+			}
+			fmt.Fprintf(&b, "%s:%d: %s\n", rel, m.Line, m.Text)
+		}
+		details := NewDetails(s.App(), "Find", term, contentTXT, true).Update(b.String())
+		if err := s.App().inject(details, false); err != nil {
+			s.App().Flash().Err(err)
+		}
+	})
+
+	return nil
+}
+
+func (s *ScreenDump) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := s.GetTable().GetSelectedItems()
+	if len(sels) != 2 {
+		s.App().Flash().Warn("Mark exactly two dumps to diff")
+		return nil
+	}
+	dd, err := dao.DiffDumps(sels[0], sels[1])
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+
+	subject := filepath.Base(sels[0]) + " ↔ " + filepath.Base(sels[1])
+	details := NewDetails(s.App(), "Diff", subject, contentTXT, true).Update(strings.Join(dd, "\n"))
+	if err := s.App().inject(details, false); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (s *ScreenDump) tagCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := s.GetTable().GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
+	}
+	msg := fmt.Sprintf("Move %d dump(s) to incident folder:", len(sels))
+	s.showInput("<Tag Incident>", msg, "Incident:", func(tag string) {
+		dir, err := dao.TagDumps(s.dumpDir(), tag, sels)
+		if err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.GetTable().ClearMarks()
+		s.App().Flash().Infof("Moved %d dump(s) to %s", len(sels), dir)
+		s.Refresh()
+	})
+
+	return nil
+}
+
+func (s *ScreenDump) showInput(title, msg, label string, okFn func(string)) {
+	f := newStyledForm(s.App().Styles.Dialog())
+
+	var text string
+	f.AddInputField(label, "", 30, nil, func(v string) {
+		text = strings.TrimSpace(v)
+	})
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(s.App(), dumpInputKey)
+	})
+	f.AddButton("OK", func() {
+		dismissModalForm(s.App(), dumpInputKey)
+		if text == "" {
+			return
+		}
+		okFn(text)
+	})
+
+	showModalForm(s.App(), dumpInputKey, title, msg, f)
+}
@@ -6,6 +6,7 @@ package view
 import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/redact"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -29,10 +30,61 @@ func NewSecret(gvr client.GVR) ResourceViewer {
 }
 
 func (s *Secret) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyU, ui.NewKeyAction("UsedBy", s.refCmd, true))
+	if s.App().Config.K9s.IsSupportMode() {
+		return
+	}
 	aa.Bulk(ui.KeyMap{
 		ui.KeyX: ui.NewKeyAction("Decode", s.decodeCmd, true),
-		ui.KeyU: ui.NewKeyAction("UsedBy", s.refCmd, true),
+		ui.KeyI: ui.NewKeyAction("Keys", s.keysCmd, true),
 	})
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Bulk(ui.KeyMap{
+		ui.KeyE: ui.NewKeyActionWithOpts("Edit", s.editCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+				Verbs:     []string{client.PatchVerb, client.UpdateVerb},
+				AnyVerbs:  true,
+			}),
+		ui.KeyH: ui.NewKeyActionWithOpts("History", s.historyCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+				Verbs:     []string{client.PatchVerb, client.UpdateVerb},
+				AnyVerbs:  true,
+			}),
+		ui.KeyR: ui.NewKeyActionWithOpts("Restart Consumers", s.restartCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+				Verbs:     client.GetAccess,
+			}),
+	})
+}
+
+func (s *Secret) keysCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showDataKeys(s.App(), dao.SecGVR, path)
+
+	return nil
+}
+
+func (s *Secret) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return editData(s, dao.SecGVR)
+}
+
+func (s *Secret) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return showDataHistory(s, dao.SecGVR)
+}
+
+func (s *Secret) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return restartConsumers(evt, s.App(), s.GetTable(), dao.SecGVR)
 }
 
 func (s *Secret) refCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -57,6 +109,12 @@ func (s *Secret) decodeCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
+	if redact.IsEnabled() {
+		for k := range d {
+			d[k] = redact.Masked
+		}
+	}
+
 	raw, err := yaml.Marshal(d)
 	if err != nil {
 		s.App().Flash().Errf("Error decoding secret %s", err)
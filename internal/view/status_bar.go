@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const statusBarSep = " [gray::]│[-::] "
+
+// StatusBar represents a bar of configurable status widgets.
+type StatusBar struct {
+	*tview.TextView
+
+	app      *App
+	values   []string
+	rx       int64
+	rxAt     time.Time
+	ctx      context.Context
+	cancelFn context.CancelFunc
+}
+
+// NewStatusBar returns a new status bar.
+func NewStatusBar(app *App) *StatusBar {
+	s := StatusBar{
+		TextView: tview.NewTextView(),
+		app:      app,
+	}
+	s.SetDynamicColors(true)
+	s.SetTextAlign(tview.AlignLeft)
+	s.SetBorderPadding(0, 0, 1, 1)
+
+	return &s
+}
+
+// StylesChanged notifies the skin changed.
+func (s *StatusBar) StylesChanged(styles *config.Styles) {
+	s.SetBackgroundColor(styles.BgColor())
+	s.SetTextColor(styles.FgColor())
+}
+
+// Init tracks the context the widgets are watched under.
+func (s *StatusBar) Init(ctx context.Context) {
+	s.ctx = ctx
+	s.app.Styles.AddListener(s)
+	s.StylesChanged(s.app.Styles)
+}
+
+// Watch refreshes each widget on its own interval until stopped. Must be
+// called on the ui thread.
+func (s *StatusBar) Watch(ww []config.Widget) {
+	s.Stop()
+	var ctx context.Context
+	ctx, s.cancelFn = context.WithCancel(s.ctx)
+
+	s.values = make([]string, len(ww))
+	for i, w := range ww {
+		go s.watch(ctx, i, w)
+	}
+}
+
+// Stop stops refreshing the widgets. Must be called on the ui thread.
+func (s *StatusBar) Stop() {
+	if s.cancelFn != nil {
+		s.cancelFn()
+		s.cancelFn = nil
+	}
+}
+
+func (s *StatusBar) watch(ctx context.Context, i int, w config.Widget) {
+	for {
+		var out string
+		if w.Kind == config.WidgetCommand {
+			out = s.command(ctx, w)
+		}
+		s.app.QueueUpdateDraw(func() {
+			if ctx.Err() != nil {
+				return
+			}
+			if w.Kind != config.WidgetCommand {
+				out = s.builtin(w)
+			}
+			s.set(i, w.GetLabel(), out)
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.GetInterval()):
+		}
+	}
+}
+
+// set updates a widget value. Must be called on the ui thread.
+func (s *StatusBar) set(i int, label, value string) {
+	if value == "" {
+		value = render.NAValue
+	}
+	s.values[i] = fmt.Sprintf("[orange::b]%s:[-::-] %s", tview.Escape(label), value)
+	s.SetText(strings.Join(s.values, statusBarSep))
+}
+
+// builtin returns a widget value. Must be called on the ui thread.
+func (s *StatusBar) builtin(w config.Widget) string {
+	if s.app.Conn() == nil {
+		return ""
+	}
+	switch w.Kind {
+	case config.WidgetCluster:
+		cluster, err := s.app.Conn().Config().CurrentClusterName()
+		if err != nil {
+			return s.app.Config.ActiveContextName()
+		}
+		return s.app.Config.ActiveContextName() + "/" + cluster
+	case config.WidgetLatency:
+		snap := client.Stats.Snapshot()
+		return fmt.Sprintf("%s avg %s max", snap.Avg, snap.Max)
+	case config.WidgetPortForwards:
+		if s.app.factory == nil {
+			return ""
+		}
+		return fmt.Sprintf("%d", len(s.app.factory.Forwarders()))
+	case config.WidgetAlerts:
+		n := len(s.app.alarms.List())
+		if n > 0 {
+			return fmt.Sprintf("[orangered::b]%d[-::-]", n)
+		}
+		return "0"
+	case config.WidgetUser:
+		cfg := s.app.Conn().Config()
+		user, _ := cfg.CurrentUserName()
+		if as, err := cfg.ImpersonateUser(); err == nil && as != "" {
+			user += " as " + as
+		}
+		return tview.Escape(user)
+	case config.WidgetGitBranch:
+		d, ok := s.app.Content.Top().(*Dir)
+		if !ok {
+			return ""
+		}
+		return tview.Escape(gitBranch(d.path))
+	case config.WidgetBandwidth:
+		return s.bandwidth()
+	default:
+		log.Warn().Msgf("Unknown status bar widget %q", w.Kind)
+		return ""
+	}
+}
+
+// bandwidth returns the download rate since the last sample and the total
+// received from the api server. Must be called on the ui thread.
+func (s *StatusBar) bandwidth() string {
+	rx, now := client.Stats.Received(), time.Now()
+	var rate int64
+	if !s.rxAt.IsZero() {
+		if d := now.Sub(s.rxAt).Seconds(); d > 0 {
+			rate = int64(float64(rx-s.rx) / d)
+		}
+	}
+	s.rx, s.rxAt = rx, now
+
+	return fmt.Sprintf("%s/s %s", toByteSize(rate), toByteSize(rx))
+}
+
+func (s *StatusBar) command(ctx context.Context, w config.Widget) string {
+	ctx, cancel := context.WithTimeout(ctx, w.GetInterval())
+	defer cancel()
+	out, err := pipeText(ctx, w.Command, "")
+	if err != nil {
+		log.Warn().Err(err).Msgf("Status bar command %q failed", w.Command)
+		return "[orangered::]" + render.NAValue + "[-::]"
+	}
+	if i := strings.IndexByte(out, '\n'); i >= 0 {
+		out = out[:i]
+	}
+
+	return tview.Escape(strings.TrimSpace(out))
+}
+
+// gitBranch returns the git branch of the repository holding a given path
+// or the short commit when detached.
+func gitBranch(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	for dir := filepath.Clean(path); ; dir = filepath.Dir(dir) {
+		git := filepath.Join(dir, ".git")
+		if fi, err := os.Stat(git); err == nil {
+			if !fi.IsDir() {
+				bb, err := os.ReadFile(git)
+				if err != nil {
+					return ""
+				}
+				git = strings.TrimSpace(strings.TrimPrefix(string(bb), "gitdir:"))
+				if !filepath.IsAbs(git) {
+					git = filepath.Join(dir, git)
+				}
+			}
+			bb, err := os.ReadFile(filepath.Join(git, "HEAD"))
+			if err != nil {
+				return ""
+			}
+			head := strings.TrimSpace(string(bb))
+			if ref, ok := strings.CutPrefix(head, "ref: refs/heads/"); ok {
+				return ref
+			}
+			if len(head) > 7 {
+				return head[:7]
+			}
+			return head
+		}
+		if dir == filepath.Dir(dir) {
+			return ""
+		}
+	}
+}
+
+// toByteSize returns a human readable byte size.
+func toByteSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitBranch(t *testing.T) {
+	uu := map[string]struct {
+		head, e string
+	}{
+		"branch": {
+			head: "ref: refs/heads/fred\n",
+			e:    "fred",
+		},
+		"nested": {
+			head: "ref: refs/heads/feat/blee\n",
+			e:    "feat/blee",
+		},
+		"detached": {
+			head: "0123456789abcdef\n",
+			e:    "0123456",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dir := t.TempDir()
+			assert.NoError(t, os.MkdirAll(filepath.Join(dir, ".git"), 0700))
+			assert.NoError(t, os.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte(u.head), 0600))
+			sub := filepath.Join(dir, "a", "b")
+			assert.NoError(t, os.MkdirAll(sub, 0700))
+
+			assert.Equal(t, u.e, gitBranch(sub))
+		})
+	}
+}
+
+func TestGitBranchWorktree(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "gitdir"), 0700))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "gitdir", "HEAD"), []byte("ref: refs/heads/fred\n"), 0600))
+	wt := filepath.Join(dir, "wt")
+	assert.NoError(t, os.MkdirAll(wt, 0700))
+	assert.NoError(t, os.WriteFile(filepath.Join(wt, ".git"), []byte("gitdir: ../gitdir\n"), 0600))
+
+	assert.Equal(t, "fred", gitBranch(wt))
+}
+
+func TestToByteSize(t *testing.T) {
+	uu := map[string]struct {
+		n int64
+		e string
+	}{
+		"zero":  {e: "0B"},
+		"bytes": {n: 1023, e: "1023B"},
+		"kib":   {n: 1536, e: "1.5KiB"},
+		"mib":   {n: 3 * 1024 * 1024, e: "3.0MiB"},
+		"gib":   {n: 1 << 30, e: "1.0GiB"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, toByteSize(u.n))
+		})
+	}
+}
@@ -4,12 +4,25 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strconv"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	partitionDialogKey = "partition"
+	ordinalDialogKey   = "ordinal"
+	describeOrdinal    = "Describe"
+	logsOrdinal        = "Logs"
 )
 
 // StatefulSet represents a statefulset viewer.
@@ -25,7 +38,7 @@ func NewStatefulSet(gvr client.GVR) ResourceViewer {
 			NewRestartExtender(
 				NewScaleExtender(
 					NewImageExtender(
-						NewLogsExtender(NewBrowser(gvr), s.logOptions),
+						NewLogsExtender(NewTimelineExtender(NewBrowser(gvr)), s.logOptions),
 					),
 				),
 			),
@@ -52,6 +65,164 @@ func (s *StatefulSet) logOptions(prev bool) (*dao.LogOptions, error) {
 
 func (s *StatefulSet) bindKeys(aa *ui.KeyActions) {
 	aa.Add(ui.KeyShiftR, ui.NewKeyAction("Sort Ready", s.GetTable().SortColCmd(readyCol, true), false))
+	aa.Add(ui.KeyO, ui.NewKeyAction("Ordinal", s.ordinalCmd, true))
+	aa.Add(ui.KeyShiftT, ui.NewKeyAction("VCluster", s.vclusterCmd, true))
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyShiftP, ui.NewKeyActionWithOpts("Partition", s.partitionCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+			Verbs:     client.PatchAccess,
+		},
+	))
+}
+
+func (s *StatefulSet) partitionCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	sts, err := s.getInstance(path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+
+	s.Stop()
+	defer s.Start()
+	var replicas int32 = 1
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	partition := "0"
+	if ru := sts.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+		partition = strconv.Itoa(int(*ru.Partition))
+	}
+
+	f := newStyledForm(s.App().Styles.Dialog())
+	f.AddInputField("Partition:", partition, 4, func(textToCheck string, lastChar rune) bool {
+		_, err := strconv.Atoi(textToCheck)
+		return err == nil
+	}, func(changed string) {
+		partition = changed
+	})
+	f.AddButton("OK", func() {
+		defer dismissModalForm(s.App(), partitionDialogKey)
+		p, err := strconv.Atoi(partition)
+		if err != nil || p < 0 {
+			s.App().Flash().Errf("invalid partition %q", partition)
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var res dao.StatefulSet
+		res.Init(s.App().factory, s.GVR())
+		if err := res.SetPartition(ctx, path, int32(p)); err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.App().Flash().Infof("StatefulSet %s partition set to %d", path, p)
+	})
+	f.AddButton("Cancel", func() {
+		dismissModalForm(s.App(), partitionDialogKey)
+	})
+
+	msg := fmt.Sprintf("Set %s rolling update partition?\n\nOnly pods with an ordinal >= partition get updated. 0 updates all %d pods, %d pauses the rollout.", path, replicas, replicas)
+	if sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		msg += "\n\nWARNING! The update strategy will switch from OnDelete to RollingUpdate."
+	}
+	showModalForm(s.App(), partitionDialogKey, "<Partition>", msg, f)
+
+	return nil
+}
+
+// vclusterCmd opens a nested session into a vcluster control plane.
+func (s *StatefulSet) vclusterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	sts, err := s.getInstance(path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	if !dao.IsVCluster(sts.Labels) {
+		s.App().Flash().Errf("StatefulSet %s is not a vcluster", path)
+		return nil
+	}
+	launchVCluster(s.App(), client.FQN(sts.Namespace, dao.VClusterName(sts.Name, sts.Labels)))
+
+	return nil
+}
+
+// ordinalCmd inspects a StatefulSet pod given its ordinal.
+func (s *StatefulSet) ordinalCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	sts, err := s.getInstance(path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+
+	ordinal, action := "0", describeOrdinal
+	f := newStyledForm(s.App().Styles.Dialog())
+	f.AddInputField("Ordinal:", ordinal, 4, func(textToCheck string, lastChar rune) bool {
+		_, err := strconv.Atoi(textToCheck)
+		return err == nil
+	}, func(changed string) {
+		ordinal = changed
+	})
+	actions := []string{describeOrdinal, yamlAction, logsOrdinal}
+	f.AddDropDown("Action:", actions, 0, func(opt string, _ int) {
+		action = opt
+	})
+	f.AddButton("OK", func() {
+		dismissModalForm(s.App(), ordinalDialogKey)
+		o, err := strconv.Atoi(ordinal)
+		if err != nil || o < 0 {
+			s.App().Flash().Errf("invalid ordinal %q", ordinal)
+			return
+		}
+		s.inspectOrdinal(sts, client.FQN(sts.Namespace, fmt.Sprintf("%s-%d", sts.Name, o)), action)
+	})
+	f.AddButton("Cancel", func() {
+		dismissModalForm(s.App(), ordinalDialogKey)
+	})
+
+	var replicas int32 = 1
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	showModalForm(s.App(), ordinalDialogKey, "<Ordinal>", fmt.Sprintf("Inspect %s pod by ordinal [0, %d]", path, replicas-1), f)
+
+	return nil
+}
+
+func (s *StatefulSet) inspectOrdinal(sts *appsv1.StatefulSet, fqn, action string) {
+	podGVR := client.NewGVR("v1/pods")
+	if _, err := s.App().factory.Get(podGVR.String(), fqn, true, labels.Everything()); err != nil {
+		s.App().Flash().Err(err)
+		return
+	}
+
+	var v model.Component
+	switch action {
+	case yamlAction:
+		v = NewLiveView(s.App(), yamlAction, model.NewYAML(podGVR, fqn))
+	case logsOrdinal:
+		v = NewLog(podGVR, podLogOptions(s.App(), fqn, false, sts.ObjectMeta, sts.Spec.Template.Spec))
+	default:
+		v = NewLiveView(s.App(), describeOrdinal, model.NewDescribe(podGVR, fqn))
+	}
+	if err := s.App().inject(v, false); err != nil {
+		s.App().Flash().Err(err)
+	}
 }
 
 func (s *StatefulSet) showPods(app *App, _ ui.Tabular, _ client.GVR, path string) {
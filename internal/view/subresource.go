@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// Subresource presents resources through their status or scale subresource.
+type Subresource struct {
+	ResourceViewer
+
+	sub   client.GVR
+	verbs []string
+}
+
+// NewSubresource returns a new viewer.
+func NewSubresource(gvr client.GVR) ResourceViewer {
+	return newSubresource(gvr)
+}
+
+func newSubresource(gvr client.GVR) *Subresource {
+	s := Subresource{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	s.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	s.GetTable().SetEnterFn(s.viewSubresource)
+	s.AddBindKeysFn(s.bindKeys)
+	s.SetContextFn(s.subresourceContext)
+
+	return &s
+}
+
+func (s *Subresource) title() string {
+	return cases.Title(language.Und, cases.NoLower).String(s.sub.SubResource())
+}
+
+func (s *Subresource) subresourceContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeySubresource, s.sub)
+}
+
+func (s *Subresource) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyY:      ui.NewKeyAction(yamlAction, s.yamlCmd, true),
+		ui.KeyD:      ui.NewKeyAction("Describe", s.describeCmd, true),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Observed", s.GetTable().SortColCmd("OBSERVED", false), false),
+	})
+	if s.canEdit() {
+		aa.Add(ui.KeyE, ui.NewKeyActionWithOpts("Edit "+s.title(), s.editCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}))
+	}
+}
+
+// canEdit checks the subresource supports updates and the user may patch it.
+func (s *Subresource) canEdit() bool {
+	if s.App().Config.K9s.IsReadOnly() || s.sub.SubResource() == "" || !s.App().ConOK() {
+		return false
+	}
+	if !client.HasVerbs(s.verbs, client.PatchAccess) {
+		return false
+	}
+	ns := client.CleanseNamespace(s.GetTable().GetModel().GetNamespace())
+	ok, err := s.App().factory.Client().CanI(ns, s.sub.String(), "", client.PatchAccess)
+
+	return ok && err == nil
+}
+
+func (s *Subresource) viewSubresource(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	var acc dao.Subresource
+	acc.Init(app.factory, s.GVR())
+	o, err := acc.Get(s.subresourceContext(context.Background()), path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	raw, err := dao.ToYAML(o, false)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	details := NewDetails(app, s.title(), path, contentYAML, true).Update(raw)
+	if err := app.inject(details, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (s *Subresource) yamlCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	s.viewSubresource(s.App(), s.GetTable(), s.GVR(), path)
+
+	return nil
+}
+
+func (s *Subresource) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	describeResource(s.App(), s.GetTable().GetModel(), dao.ParentGVR(s.sub), path)
+
+	return nil
+}
+
+func (s *Subresource) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	s.Stop()
+	defer s.Start()
+	if err := editRes(s.App(), s.sub, path); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showSubresource(app *App, gvr client.GVR, verbs []string) error {
+	v := newSubresource(client.NewGVR("subresources"))
+	v.sub, v.verbs = gvr, verbs
+
+	return app.inject(v, false)
+}
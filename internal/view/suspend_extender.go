@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func suspendableViewer(f func(client.GVR) ResourceViewer) func(client.GVR) ResourceViewer {
+	return func(gvr client.GVR) ResourceViewer {
+		return NewSuspendExtender(f(gvr))
+	}
+}
+
+// SuspendExtender adds suspend/resume extensions.
+type SuspendExtender struct {
+	ResourceViewer
+}
+
+// NewSuspendExtender returns a new extender.
+func NewSuspendExtender(r ResourceViewer) ResourceViewer {
+	s := SuspendExtender{ResourceViewer: r}
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *SuspendExtender) bindKeys(aa *ui.KeyActions) {
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyZ, ui.NewKeyActionWithOpts("Suspend/Resume", s.toggleSuspendCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+			Verbs:     client.PatchAccess,
+		},
+	))
+}
+
+func (s *SuspendExtender) toggleSuspendCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	o, err := s.App().factory.Get(s.GVR().String(), path, true, labels.Everything())
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		s.App().Flash().Errf("expecting unstructured but got %T", o)
+		return nil
+	}
+	suspended, err := dao.IsSuspended(u)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	title, action := "Suspend", "suspended"
+	if suspended {
+		title, action = "Resume", "resumed"
+	}
+
+	msg := fmt.Sprintf("%s %s %s?", title, s.GVR().R(), path)
+	dialog.ShowConfirm(s.App().Styles.Dialog(), s.App().Content.Pages, title, msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := s.toggleSuspend(ctx, path); err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.App().Flash().Infof("%s %s %s", s.GVR().R(), path, action)
+	}, func() {})
+
+	return nil
+}
+
+func (s *SuspendExtender) toggleSuspend(ctx context.Context, path string) error {
+	res, err := dao.AccessorFor(s.App().factory, s.GVR())
+	if err != nil {
+		return err
+	}
+	suspender, ok := res.(dao.Suspendable)
+	if !ok {
+		return fmt.Errorf("expecting a suspendable resource for %q", s.GVR())
+	}
+
+	return suspender.ToggleSuspend(ctx, path)
+}
@@ -4,8 +4,8 @@
 package view
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"strings"
 	"time"
 
@@ -34,9 +34,9 @@ type Service struct {
 // NewService returns a new viewer.
 func NewService(gvr client.GVR) ResourceViewer {
 	s := Service{
-		ResourceViewer: NewPortForwardExtender(
+		ResourceViewer: NewProxyExtender(NewPortForwardExtender(
 			NewLogsExtender(NewBrowser(gvr), nil),
-		),
+		)),
 	}
 	s.AddBindKeysFn(s.bindKeys)
 	s.GetTable().SetEnterFn(s.showPods)
@@ -49,8 +49,41 @@ func NewService(gvr client.GVR) ResourceViewer {
 func (s *Service) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyB:      ui.NewKeyAction("Bench Run/Stop", s.toggleBenchCmd, true),
+		ui.KeyI:      ui.NewKeyAction("LB Diagnostics", s.lbDiagCmd, true),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Type", s.GetTable().SortColCmd("TYPE", true), false),
 	})
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyT, ui.NewKeyActionWithOpts("Connectivity Test", s.netCheckCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		},
+	))
+}
+
+func (s *Service) netCheckCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showNetCheck(s.App(), path)
+
+	return nil
+}
+
+func (s *Service) lbDiagCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	showReport(s.App(), "LB Diagnostics", path, func(ctx context.Context, f dao.Factory) (string, error) {
+		return dao.LBDiagReport(ctx, f, path)
+	})
+
+	return nil
 }
 
 func (s *Service) showPods(a *App, _ ui.Tabular, _ client.GVR, path string) {
@@ -117,8 +150,8 @@ func (s *Service) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	cfg, ok := cust.Benchmarks.Services[path]
 	if !ok {
-		s.App().Flash().Errf("No bench config found for service %s in %s", path, s.App().BenchFile)
-		return nil
+		cfg = config.DefaultBenchSpec()
+		cfg.C, cfg.N = cust.Benchmarks.Defaults.C, cust.Benchmarks.Defaults.N
 	}
 	cfg.Name = path
 	log.Debug().Msgf("Benchmark config %#v", cfg)
@@ -137,41 +170,27 @@ func (s *Service) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
 		s.App().Flash().Err(err)
 		return nil
 	}
-	if err := s.runBenchmark(port, cfg); err != nil {
-		s.App().Flash().Errf("Benchmark failed %v", err)
-		s.App().ClearStatus(false)
-		s.bench = nil
-	}
+	ShowBench(s, path, serviceBenchURL(svc, port, cfg), cfg, s.runBenchmark)
 
 	return nil
 }
 
-// BOZO!! Refactor used by forwards.
-func (s *Service) runBenchmark(port string, cfg config.BenchConfig) error {
-	if cfg.HTTP.Host == "" {
-		return fmt.Errorf("invalid benchmark host %q", cfg.HTTP.Host)
-	}
-
+func (s *Service) runBenchmark(base string, cfg config.BenchConfig) error {
 	var err error
-	base := cfg.HTTP.Host
-	if !strings.Contains(base, ":") {
-		base += ":" + port + cfg.HTTP.Path
-	} else {
-		base += cfg.HTTP.Path
-	}
-	if strings.Index(base, "http") != 0 {
-		base = "http://" + base
-	}
-
 	if s.bench, err = perf.NewBenchmark(base, s.App().version, cfg); err != nil {
+		s.App().ClearStatus(false)
+		s.bench = nil
 		return err
 	}
+	s.bench.SetExporter(perf.NewExporter(s.App().Config.K9s.Exporter))
 
 	s.App().Status(model.FlashWarn, "Benchmark in progress...")
 	log.Debug().Msg("Benchmark starting...")
 
 	ct, err := s.App().Config.K9s.ActiveContext()
 	if err != nil {
+		s.App().ClearStatus(false)
+		s.bench = nil
 		return err
 	}
 	name := s.App().Config.K9s.ActiveContextName()
@@ -205,6 +224,36 @@ func clearStatus(app *App) {
 	})
 }
 
+// serviceBenchURL returns the service benchmark url. The host defaults to
+// the load balancer ingress when not configured.
+func serviceBenchURL(svc *v1.Service, port string, cfg config.BenchConfig) string {
+	host := cfg.HTTP.Host
+	if host == "" {
+		for _, ing := range svc.Status.LoadBalancer.Ingress {
+			if host = ing.IP; host == "" {
+				host = ing.Hostname
+			}
+			break
+		}
+	}
+	if host == "" {
+		return ""
+	}
+
+	return benchURL(host, port, cfg.HTTP.Path)
+}
+
+func benchURL(host, port, path string) string {
+	if !strings.Contains(host, ":") && port != "" {
+		host += ":" + port
+	}
+	if !strings.HasPrefix(host, "http") {
+		host = "http://" + host
+	}
+
+	return host + path
+}
+
 func fetchService(f dao.Factory, path string) (*v1.Service, error) {
 	o, err := f.Get("v1/services", path, true, labels.Everything())
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/redact"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/rs/zerolog/log"
 )
@@ -67,8 +68,20 @@ func saveTable(dir, title, path string, data *model1.TableData) (string, error)
 	w := csv.NewWriter(out)
 	_ = w.Write(data.ColumnNames(true))
 
+	gvr, h := data.GVR().String(), data.Header()
 	data.RowsRange(func(_ int, re model1.RowEvent) bool {
-		_ = w.Write(re.Row.Fields)
+		if !redact.IsEnabled() {
+			_ = w.Write(re.Row.Fields)
+			return true
+		}
+		ff := make([]string, len(re.Row.Fields))
+		for i, f := range re.Row.Fields {
+			if i < len(h) {
+				f = redact.Field(gvr, h[i].Name, f)
+			}
+			ff[i] = f
+		}
+		_ = w.Write(ff)
 		return true
 	})
 	w.Flush()
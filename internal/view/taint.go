@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	taintDialogKey = "taint"
+	taintAdd       = "Add"
+	taintRemove    = "Remove"
+)
+
+// ShowTaints pops a dialog to add or remove a node taint.
+func ShowTaints(v ResourceViewer, path string) {
+	ctx, cancel := context.WithTimeout(context.Background(), v.App().Conn().Config().CallTimeout())
+	defer cancel()
+	no, err := dao.FetchNode(ctx, v.App().factory, path)
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+
+	f := newStyledForm(v.App().Styles.Dialog())
+
+	action, effect := taintAdd, dao.TaintEffects[0]
+	var key, value string
+	f.AddDropDown("Action:", []string{taintAdd, taintRemove}, 0, func(opt string, _ int) {
+		action = opt
+	})
+	f.AddInputField("Key:", "", 40, nil, func(s string) {
+		key = strings.TrimSpace(s)
+	})
+	f.AddInputField("Value:", "", 40, nil, func(s string) {
+		value = strings.TrimSpace(s)
+	})
+	f.AddDropDown("Effect:", dao.TaintEffects, 0, func(opt string, _ int) {
+		effect = opt
+	})
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(v.App(), taintDialogKey)
+	})
+	f.AddButton("OK", func() {
+		dismissModalForm(v.App(), taintDialogKey)
+		taint := v1.Taint{Key: key, Value: value, Effect: v1.TaintEffect(effect)}
+		if err := taintNode(v, path, taint, action == taintRemove); err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		verb := "added"
+		if action == taintRemove {
+			verb = "removed"
+		}
+		v.App().Flash().Infof("Node %s taint %s %s", path, taint.ToString(), verb)
+	})
+
+	showModalForm(v.App(), taintDialogKey, "<Taints>", taintsMessage(path, no.Spec.Taints), f)
+}
+
+func taintsMessage(path string, tt []v1.Taint) string {
+	if len(tt) == 0 {
+		return fmt.Sprintf("Node %s has no taints", path)
+	}
+	ss := make([]string, 0, len(tt))
+	for i := range tt {
+		ss = append(ss, tt[i].ToString())
+	}
+
+	return fmt.Sprintf("Node %s taints:\n%s", path, strings.Join(ss, "\n"))
+}
+
+func taintNode(v ResourceViewer, path string, t v1.Taint, remove bool) error {
+	res, err := dao.AccessorFor(v.App().factory, v.GVR())
+	if err != nil {
+		return err
+	}
+	no, ok := res.(*dao.Node)
+	if !ok {
+		return fmt.Errorf("expecting a node resource for %q", v.GVR())
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), v.App().Conn().Config().CallTimeout())
+	defer cancel()
+
+	return no.Taint(ctx, path, t, remove)
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const templateKey = "template"
+
+// Template presents resource creation templates.
+type Template struct {
+	ResourceViewer
+}
+
+// NewTemplate returns a new viewer.
+func NewTemplate(gvr client.GVR) ResourceViewer {
+	t := Template{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	t.GetTable().SetBorderFocusColor(tcell.ColorAquamarine)
+	t.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorAquamarine).Attributes(tcell.AttrNone))
+	t.GetTable().SetSortCol("NAME", true)
+	t.AddBindKeysFn(t.bindKeys)
+
+	return &t
+}
+
+func (t *Template) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlD, tcell.KeyCtrlZ, ui.KeyD, ui.KeyE)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyY: ui.NewKeyAction(yamlAction, t.viewCmd, true),
+	})
+	if t.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(tcell.KeyEnter, ui.NewKeyActionWithOpts("Create", t.createCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		},
+	))
+}
+
+func (t *Template) selectedTemplate() (config.ResourceTemplate, bool) {
+	sel := t.GetTable().GetSelectedItem()
+	if sel == "" {
+		return config.ResourceTemplate{}, false
+	}
+	var tpl dao.Template
+	tpl.Init(t.App().factory, t.GVR())
+	o, err := tpl.Get(context.Background(), sel)
+	if err != nil {
+		t.App().Flash().Err(err)
+		return config.ResourceTemplate{}, false
+	}
+
+	return o.(render.TemplateRes).Template, true
+}
+
+func (t *Template) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
+	tpl, ok := t.selectedTemplate()
+	if !ok {
+		return evt
+	}
+	details := NewDetails(t.App(), yamlAction, tpl.Name, contentYAML, true).Update(tpl.Body)
+	if err := t.App().inject(details, false); err != nil {
+		t.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (t *Template) createCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if t.GetTable().CmdBuff().IsActive() {
+		return t.GetTable().activateCmd(evt)
+	}
+	tpl, ok := t.selectedTemplate()
+	if !ok {
+		return evt
+	}
+	t.showVars(tpl)
+
+	return nil
+}
+
+func (t *Template) showVars(tpl config.ResourceTemplate) {
+	f := newStyledForm(t.App().Styles.Dialog())
+
+	vals := make(map[string]string)
+	for _, v := range tpl.Vars() {
+		name, def := v.Name, v.Default
+		if name == "NAMESPACE" && def == "" {
+			def = client.CleanseNamespace(t.App().Config.ActiveNamespace())
+			if def == client.BlankNamespace {
+				def = client.DefaultNamespace
+			}
+		}
+		vals[name] = def
+		f.AddInputField(name+":", def, 40, nil, func(s string) {
+			vals[name] = strings.TrimSpace(s)
+		})
+	}
+
+	f.AddButton("OK", func() {
+		raw, err := tpl.Render(vals)
+		if err != nil {
+			t.App().Flash().Err(err)
+			return
+		}
+		dismissModalForm(t.App(), templateKey)
+		t.create(tpl.Name, raw)
+	})
+	f.AddButton("Cancel", func() {
+		dismissModalForm(t.App(), templateKey)
+	})
+
+	showModalForm(t.App(), templateKey, "<Create "+tpl.Name+">", "Fill in the template variables.\nThe manifest is opened in your editor before creation.", f)
+}
+
+// create lets the user edit the rendered manifest prior to creating it.
+func (t *Template) create(name, raw string) {
+	editAndRun(t, "Create", "Created", name, raw, "create")
+}
+
+// editAndRun lets the user edit a manifest prior to running a kubectl
+// command against it.
+func editAndRun(v ResourceViewer, verb, title, name, raw string, args ...string) {
+	f, err := os.CreateTemp("", "k9s-"+data.SanitizeFileName(name)+"-*.yaml")
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(raw); err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+	if err := f.Close(); err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+
+	v.Stop()
+	defer v.Start()
+	if !edit(v.App(), shellOpts{clear: true, args: []string{f.Name()}}) {
+		v.App().Flash().Errf("Failed to launch editor")
+		return
+	}
+	bb, err := os.ReadFile(f.Name())
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+	if strings.TrimSpace(string(bb)) == "" {
+		v.App().Flash().Infof("%s canceled", verb)
+		return
+	}
+
+	res, err := runKu(v.App(), shellOpts{clear: false, args: append(args, "-f", f.Name())})
+	if err != nil {
+		res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
+	} else {
+		res = "message:\n" + fmtResults(res)
+	}
+	details := NewDetails(v.App(), title, name, contentYAML, true).Update(res)
+	if err := v.App().inject(details, false); err != nil {
+		v.App().Flash().Err(err)
+	}
+}
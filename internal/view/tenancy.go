@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/port"
+	"github.com/rs/zerolog/log"
+)
+
+// showNamespaceTree shows the namespaces hierarchy and the vclusters they host.
+func showNamespaceTree(a *App) {
+	a.Flash().Info("Building namespace tree...")
+	go func() {
+		tree, err := dao.NamespaceTree(a.factory)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				a.Flash().Err(err)
+				return
+			}
+			details := NewDetails(a, "Namespace Tree", a.Config.ActiveContextName(), contentTXT, true).Update(tree)
+			if err := a.inject(details, false); err != nil {
+				a.Flash().Err(err)
+			}
+		})
+	}()
+}
+
+// launchVCluster runs a nested k9s session against a vcluster api server
+// using the kubeconfig the vcluster stores on its host cluster. Api servers
+// only reachable locally are port forwarded to the vcluster service first.
+func launchVCluster(a *App, fqn string) {
+	a.Flash().Infof("Connecting to vcluster %s...", fqn)
+	go func() {
+		raw, err := dao.VClusterKubeconfig(a.factory, fqn)
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		server, local, err := dao.VClusterServer(raw)
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		var pf *dao.PortForwarder
+		if local {
+			if pf, raw, err = forwardVCluster(a, fqn, raw); err != nil {
+				a.Flash().Errf("VCluster %s port forward failed: %s", fqn, err)
+				return
+			}
+		}
+		path, err := writeVClusterConfig(fqn, raw)
+		if err != nil {
+			if pf != nil {
+				pf.Stop()
+			}
+			a.Flash().Err(err)
+			return
+		}
+		a.QueueUpdateDraw(func() {
+			defer func() {
+				if pf != nil {
+					pf.Stop()
+				}
+				if err := os.Remove(path); err != nil {
+					log.Warn().Err(err).Msgf("Unable to remove vcluster kubeconfig %q", path)
+				}
+			}()
+			runVCluster(a, fqn, server, path)
+		})
+	}()
+}
+
+func runVCluster(a *App, fqn, server, path string) {
+	bin, err := os.Executable()
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	args := []string{"--kubeconfig", path}
+	if a.Config.K9s.IsReadOnly() {
+		args = append(args, "--readonly")
+	}
+	opts := shellOpts{
+		clear:  true,
+		binary: bin,
+		banner: fmt.Sprintf("VCluster %s (%s)", fqn, server),
+		args:   args,
+	}
+	suspended, errChan, _ := run(a, opts)
+	if !suspended {
+		a.Flash().Errf("Unable to launch vcluster %s session", fqn)
+		return
+	}
+	for e := range errChan {
+		log.Error().Err(e).Msgf("VCluster %s session failed", fqn)
+	}
+}
+
+// forwardVCluster port forwards the vcluster service to a free local port
+// and points the kubeconfig at it.
+func forwardVCluster(a *App, fqn string, raw []byte) (*dao.PortForwarder, []byte, error) {
+	path, co, remote, err := dao.VClusterTunnel(a.factory, fqn)
+	if err != nil {
+		return nil, nil, err
+	}
+	local, err := freeLocalPort()
+	if err != nil {
+		return nil, nil, err
+	}
+	pf := dao.NewPortForwarder(a.factory)
+	fwd, err := pf.Start(path, port.NewPortTunnel("localhost", co, local, remote))
+	if err != nil {
+		return nil, nil, err
+	}
+	go func() {
+		if err := fwd.ForwardPorts(); err != nil {
+			log.Error().Err(err).Msgf("VCluster %s port forward failed", fqn)
+		}
+	}()
+	select {
+	case <-fwd.Ready:
+	case <-time.After(a.Conn().Config().CallTimeout()):
+		pf.Stop()
+		return nil, nil, fmt.Errorf("timed out waiting on %s", path)
+	}
+	bb, err := dao.VClusterKubeconfigServer(raw, "https://localhost:"+local)
+	if err != nil {
+		pf.Stop()
+		return nil, nil, err
+	}
+
+	return pf, bb, nil
+}
+
+func writeVClusterConfig(fqn string, raw []byte) (string, error) {
+	dir, err := config.UserTmpDir()
+	if err != nil {
+		return "", err
+	}
+	if err := data.EnsureFullPath(dir, data.DefaultDirMod); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, "vcluster-"+strings.ReplaceAll(fqn, "/", "-")+".yaml")
+
+	return path, os.WriteFile(path, raw, 0600)
+}
+
+func freeLocalPort() (string, error) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return "", err
+	}
+	defer l.Close()
+
+	return strconv.Itoa(l.Addr().(*net.TCPAddr).Port), nil
+}
+
+// vclusterFor returns the single vcluster running in a namespace.
+func vclusterFor(a *App, ns string) (string, error) {
+	vcs, err := dao.VClusters(a.factory, ns)
+	if err != nil {
+		return "", err
+	}
+	switch len(vcs) {
+	case 0:
+		return "", fmt.Errorf("no vcluster found in namespace %s", ns)
+	case 1:
+		return vcs[0], nil
+	default:
+		return "", fmt.Errorf("found %d vclusters in namespace %s. Pick one from the statefulsets view", len(vcs), ns)
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// TimelineExtender adds a pods restart timeline to workloads.
+type TimelineExtender struct {
+	ResourceViewer
+}
+
+// NewTimelineExtender returns a new extender.
+func NewTimelineExtender(v ResourceViewer) ResourceViewer {
+	t := TimelineExtender{ResourceViewer: v}
+	v.AddBindKeysFn(t.bindKeys)
+
+	return &t
+}
+
+func (t *TimelineExtender) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyT, ui.NewKeyAction("Timeline", t.timelineCmd, true))
+}
+
+func (t *TimelineExtender) timelineCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := t.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	details := NewDetails(t.App(), "Timeline", path, contentTXT, true)
+	window := func(d time.Duration) ui.ActionHandler {
+		return func(*tcell.EventKey) *tcell.EventKey {
+			t.refreshTimeline(details, path, d)
+			return nil
+		}
+	}
+	details.Actions().Bulk(ui.KeyMap{
+		ui.Key1: ui.NewKeyAction("Last 1h", window(time.Hour), true),
+		ui.Key2: ui.NewKeyAction("Last 6h", window(dao.DefaultTimelineWindow), true),
+		ui.Key3: ui.NewKeyAction("Last 24h", window(24*time.Hour), true),
+	})
+	if err := t.App().inject(details, false); err != nil {
+		t.App().Flash().Err(err)
+		return nil
+	}
+	t.refreshTimeline(details, path, dao.DefaultTimelineWindow)
+
+	return nil
+}
+
+func (t *TimelineExtender) refreshTimeline(details *Details, path string, window time.Duration) {
+	app, gvr := t.App(), t.GVR()
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		defer cancel()
+		report, err := dao.WorkloadTimeline(ctx, app.factory, gvr, path, window)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			details.Update(report)
+		})
+	}()
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// TLSExpiry presents a TLS certificates expiry watchboard.
+type TLSExpiry struct {
+	ResourceViewer
+}
+
+// NewTLSExpiry returns a new viewer.
+func NewTLSExpiry(gvr client.GVR) ResourceViewer {
+	t := TLSExpiry{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	t.GetTable().SetSortCol("EXPIRES", true)
+	t.GetTable().SetEnterFn(t.showSource)
+	t.AddBindKeysFn(t.bindKeys)
+
+	return &t
+}
+
+func (t *TLSExpiry) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyX:      ui.NewKeyAction("Secret", t.secretCmd, true),
+		ui.KeyShiftE: ui.NewKeyAction("Sort Expires", t.GetTable().SortColCmd("EXPIRES", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Renewal", t.GetTable().SortColCmd("RENEWAL", true), false),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", t.GetTable().SortColCmd("KIND", true), false),
+	})
+}
+
+// showSource jumps to the ingress or gateway serving a certificate.
+func (*TLSExpiry) showSource(app *App, _ ui.Tabular, _ client.GVR, id string) {
+	gvr, path, _, ok := parseTLSCertID(id)
+	if !ok {
+		app.Flash().Errf("Invalid selection %q", id)
+		return
+	}
+	app.gotoResource(gvr, path, false)
+}
+
+func (t *TLSExpiry) secretCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := t.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	_, path, sec, ok := parseTLSCertID(id)
+	if !ok {
+		t.App().Flash().Errf("Invalid selection %q", id)
+		return nil
+	}
+	if !strings.Contains(sec, "/") {
+		ns, _ := client.Namespaced(path)
+		sec = client.FQN(ns, sec)
+	}
+	t.App().gotoResource(dao.SecGVR.String(), sec, false)
+
+	return nil
+}
+
+// parseTLSCertID splits a certificate row id into the source gvr and path
+// and the secret name.
+func parseTLSCertID(id string) (string, string, string, bool) {
+	gvr, rest, ok := render.ParseResourceID(id)
+	if !ok {
+		return "", "", "", false
+	}
+	path, sec, ok := strings.Cut(rest, "|")
+
+	return gvr, path, sec, ok
+}
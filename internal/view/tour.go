@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	tourPage   = "tour"
+	tourWidth  = 80
+	tourHeight = 10
+)
+
+// tourGuide walks the user through a guided tour one step at a time.
+type tourGuide struct {
+	app  *App
+	tour *config.Tour
+	view *tview.TextView
+	step int
+}
+
+// firstRunTour starts the guided tour unless it was already completed.
+func (a *App) firstRunTour() {
+	if a.Config.K9s.UI.SkipTour {
+		return
+	}
+	t, err := config.LoadTour(config.AppTourFile)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Tour load failed")
+		return
+	}
+	if config.TourCompleted(config.AppTourStateFile, t.Name) {
+		return
+	}
+	a.startTour(t)
+}
+
+func (a *App) tourCmd() error {
+	t, err := config.LoadTour(config.AppTourFile)
+	if err != nil {
+		return err
+	}
+	a.startTour(t)
+
+	return nil
+}
+
+func (a *App) startTour(t *config.Tour) {
+	if a.touring || len(t.Steps) == 0 {
+		return
+	}
+
+	styles := a.Styles.Dialog()
+	v := tview.NewTextView()
+	v.SetWrap(true)
+	v.SetWordWrap(true)
+	v.SetBorder(true)
+	v.SetBorderPadding(0, 0, 1, 1)
+	v.SetTitleColor(tcell.ColorAqua)
+	v.SetBackgroundColor(styles.BgColor.Color())
+	v.SetTextColor(styles.FgColor.Color())
+
+	g := tourGuide{app: a, tour: t, view: v}
+	v.SetInputCapture(g.keyboard)
+
+	a.touring = true
+	a.Main.AddPage(tourPage, tourLayout(v), true, true)
+	g.show(0)
+}
+
+func (g *tourGuide) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	switch evt.Key() {
+	case tcell.KeyEnter, tcell.KeyRight:
+		if g.step == len(g.tour.Steps)-1 {
+			g.done()
+			return nil
+		}
+		g.show(g.step + 1)
+	case tcell.KeyLeft, tcell.KeyBackspace2:
+		if g.step > 0 {
+			g.show(g.step - 1)
+		}
+	case tcell.KeyEscape:
+		g.done()
+	}
+
+	return nil
+}
+
+// show navigates to the step view if any and displays its text.
+func (g *tourGuide) show(i int) {
+	g.step = i
+	s := g.tour.Steps[i]
+	if s.Command != "" {
+		g.app.gotoResource(s.Command, "", true)
+	}
+
+	g.view.SetTitle(fmt.Sprintf(" %s ", s.Title))
+	g.view.SetText(fmt.Sprintf("%s\n\n(%d/%d) <enter> next  <left> back  <esc> quit",
+		strings.TrimSpace(s.Text),
+		i+1,
+		len(g.tour.Steps),
+	))
+	g.app.SetFocus(g.view)
+}
+
+// done dismisses the tour and records it so it won't show on next launch.
+func (g *tourGuide) done() {
+	g.app.touring = false
+	g.app.Main.RemovePage(tourPage)
+	if c := g.app.Content.Top(); c != nil {
+		g.app.SetFocus(c)
+	}
+	if err := config.MarkTourCompleted(config.AppTourStateFile, g.tour.Name); err != nil {
+		log.Warn().Err(err).Msgf("Tour state save failed")
+	}
+}
+
+// tourLayout docks the tour box at the bottom center of the screen so
+// the view it describes remains visible.
+func tourLayout(p tview.Primitive) tview.Primitive {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(p, tourHeight, 0, true).
+			AddItem(nil, 2, 0, false), tourWidth, 0, true).
+		AddItem(nil, 0, 1, false)
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const policyEvalKey = "policyEval"
+
+// ValidatingAdmissionPolicy presents a CEL validating admission policy viewer.
+type ValidatingAdmissionPolicy struct {
+	ResourceViewer
+}
+
+// NewValidatingAdmissionPolicy returns a new viewer.
+func NewValidatingAdmissionPolicy(gvr client.GVR) ResourceViewer {
+	v := ValidatingAdmissionPolicy{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	v.AddBindKeysFn(v.bindKeys)
+
+	return &v
+}
+
+func (v *ValidatingAdmissionPolicy) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyT, ui.NewKeyAction("Evaluate", v.evalCmd, true))
+}
+
+func (v *ValidatingAdmissionPolicy) evalCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := v.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	o, err := v.App().factory.Get(v.GVR().String(), path, true, labels.Everything())
+	if err != nil {
+		v.App().Flash().Err(err)
+		return nil
+	}
+	var res string
+	if u, ok := o.(*unstructured.Unstructured); ok {
+		res, _, _ = strings.Cut(render.PolicyResources(u), ",")
+	}
+	if res == render.NAValue || res == "*" {
+		res = ""
+	}
+	v.showEvalDialog(path, res)
+
+	return nil
+}
+
+func (v *ValidatingAdmissionPolicy) showEvalDialog(path, res string) {
+	f := newStyledForm(v.App().Styles.Dialog())
+
+	var fqn string
+	f.AddInputField("Resource:", res, 0, nil, func(s string) {
+		res = strings.TrimSpace(s)
+	})
+	f.AddInputField("Name:", "", 0, nil, func(s string) {
+		fqn = strings.TrimSpace(s)
+	})
+
+	f.AddButton("Cancel", func() {
+		dismissModalForm(v.App(), policyEvalKey)
+	})
+	f.AddButton("OK", func() {
+		dismissModalForm(v.App(), policyEvalKey)
+		if err := v.evalPolicy(path, res, fqn); err != nil {
+			v.App().Flash().Err(err)
+		}
+	})
+
+	showModalForm(v.App(), policyEvalKey, "<Evaluate>", fmt.Sprintf("Evaluate %s against a resource ie deploy default/nginx", path), f)
+}
+
+func (v *ValidatingAdmissionPolicy) evalPolicy(path, res, fqn string) error {
+	if res == "" || fqn == "" {
+		return fmt.Errorf("a resource and a name are required")
+	}
+	gvr, _, ok := v.App().command.alias.AsGVR(res)
+	if !ok {
+		return fmt.Errorf("unknown resource %q", res)
+	}
+	if !strings.Contains(fqn, "/") {
+		if meta, err := dao.MetaAccess.MetaFor(gvr); err == nil && !meta.Namespaced {
+			fqn = client.FQN(client.ClusterScope, fqn)
+		} else {
+			fqn = client.FQN(client.CleanseNamespace(v.App().Config.ActiveNamespace()), fqn)
+		}
+	}
+	report, err := dao.EvalPolicyReport(v.App().factory, v.GVR(), path, gvr, fqn)
+	if err != nil {
+		return err
+	}
+	details := NewDetails(v.App(), "Evaluate", path, contentYAML, true).Update(report)
+
+	return v.App().inject(details, false)
+}
+
+// ValidatingAdmissionPolicyBinding presents a CEL policy binding viewer.
+type ValidatingAdmissionPolicyBinding struct {
+	ResourceViewer
+}
+
+// NewValidatingAdmissionPolicyBinding returns a new viewer.
+func NewValidatingAdmissionPolicyBinding(gvr client.GVR) ResourceViewer {
+	v := ValidatingAdmissionPolicyBinding{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	v.AddBindKeysFn(v.bindKeys)
+
+	return &v
+}
+
+func (v *ValidatingAdmissionPolicyBinding) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyP, ui.NewKeyAction("Params", v.paramsCmd, true))
+}
+
+func (v *ValidatingAdmissionPolicyBinding) paramsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := v.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	report, err := dao.PolicyParamsReport(v.App().factory, v.GVR(), path)
+	if err != nil {
+		v.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(v.App(), "Params", path, contentYAML, true).Update(report)
+	if err := v.App().inject(details, false); err != nil {
+		v.App().Flash().Err(err)
+	}
+
+	return nil
+}
@@ -279,8 +279,7 @@ func (x *Xray) showLogs(spec *xray.NodeSpec, prev bool) {
 	}
 
 	ns, _ := client.Namespaced(path)
-	_, err := x.app.factory.CanForResource(ns, "v1/pods", client.ListAccess)
-	if err != nil {
+	if err := canList(x.app.factory, ns, "v1/pods"); err != nil {
 		x.app.Flash().Err(err)
 		return
 	}
@@ -63,6 +63,23 @@ func enableRegion(str string) string {
 	return strings.ReplaceAll(strings.ReplaceAll(str, "<<<", "["), ">>>", "]")
 }
 
+// colorizeDiff highlights added and removed lines.
+func colorizeDiff(lines []string) string {
+	buff := make([]string, 0, len(lines))
+	for _, l := range lines {
+		l = tview.Escape(l)
+		switch {
+		case strings.HasPrefix(l, "+"):
+			l = "[green::]" + l + "[-::]"
+		case strings.HasPrefix(l, "-"):
+			l = "[red::]" + l + "[-::]"
+		}
+		buff = append(buff, l)
+	}
+
+	return strings.Join(buff, "\n")
+}
+
 func saveYAML(dir, name, raw string) (string, error) {
 	if err := ensureDir(dir); err != nil {
 		return "", err
@@ -87,3 +104,26 @@ func saveYAML(dir, name, raw string) (string, error) {
 
 	return fpath, nil
 }
+
+func saveTemplate(dir, name, raw string) (string, error) {
+	if err := ensureDir(dir); err != nil {
+		return "", err
+	}
+
+	fpath := filepath.Join(dir, data.SanitizeFileName(name)+".yaml")
+	mod := os.O_CREATE | os.O_WRONLY | os.O_EXCL
+	file, err := os.OpenFile(fpath, mod, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Error().Err(err).Msg("Closing template file")
+		}
+	}()
+	if _, err := file.Write([]byte(raw)); err != nil {
+		return "", err
+	}
+
+	return fpath, nil
+}
@@ -63,3 +63,9 @@ func TestYaml(t *testing.T) {
 		assert.Equal(t, u.e, colorizeYAML(s.Views().Yaml, u.s))
 	}
 }
+
+func TestColorizeDiff(t *testing.T) {
+	ll := []string{"  kind: ConfigMap", "-   a: b", "+   a: [c]"}
+
+	assert.Equal(t, "  kind: ConfigMap\n[red::]-   a: b[-::]\n[green::]+   a: [c[][-::]", colorizeDiff(ll))
+}
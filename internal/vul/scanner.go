@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -73,6 +75,34 @@ func (s *imageScanner) GetScan(img string) (*Scan, bool) {
 	return scan, ok
 }
 
+// Summary returns vulnerability counts per scanned image, sorted by image.
+// Columns are image, critical, high, medium, low and negligible.
+func (s *imageScanner) Summary() [][]string {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	kk := make([]string, 0, len(s.scans))
+	for k := range s.scans {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	rr := make([][]string, 0, len(kk))
+	for _, k := range kk {
+		t := s.scans[k].Tally
+		rr = append(rr, []string{
+			k,
+			strconv.Itoa(t[sevCritical]),
+			strconv.Itoa(t[sevHigh]),
+			strconv.Itoa(t[sevMedium]),
+			strconv.Itoa(t[sevLow]),
+			strconv.Itoa(t[sevNegligible]),
+		})
+	}
+
+	return rr
+}
+
 func (s *imageScanner) setScan(img string, sc *Scan) {
 	s.mx.Lock()
 	defer s.mx.Unlock()
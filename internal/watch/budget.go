@@ -0,0 +1,324 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/informers"
+)
+
+const (
+	evictInterval = 30 * time.Second
+
+	// directListTTL tracks how long an uncached listing is reused across
+	// refreshes before hitting the api server again.
+	directListTTL = 10 * time.Second
+)
+
+// ErrCacheBudget indicates a resource is too large to be cached.
+var ErrCacheBudget = errors.New("cache budget exceeded")
+
+// Budget tracks the informer caches memory budget. Zero values mean no limits.
+type Budget struct {
+	// MaxObjects caps the number of objects cached per resource. Larger
+	// resources are listed straight from the api server.
+	MaxObjects int
+
+	// EvictAfter drops caches that have not been accessed for that long.
+	EvictAfter time.Duration
+}
+
+// CacheStats tracks the informer caches budget and occupancy.
+type CacheStats struct {
+	Budget    Budget
+	Evictions int
+	Capped    []string
+}
+
+// directList tracks a listing served straight from the api server.
+type directList struct {
+	at time.Time
+	oo []runtime.Object
+}
+
+// watcher tracks a resource informer and when it was last accessed.
+type watcher struct {
+	informers.GenericInformer
+
+	ns, gvr  string
+	stopChan chan struct{}
+	started  bool
+	lastUsed atomic.Int64
+}
+
+func newWatcher(ns, gvr string, inf informers.GenericInformer) *watcher {
+	w := watcher{
+		GenericInformer: inf,
+		ns:              nsKey(ns),
+		gvr:             gvr,
+		stopChan:        make(chan struct{}),
+	}
+	w.touch()
+
+	return &w
+}
+
+func (w *watcher) start() {
+	if w.started {
+		return
+	}
+	w.started = true
+	go w.Informer().Run(w.stopChan)
+}
+
+func (w *watcher) stop() {
+	close(w.stopChan)
+}
+
+func (w *watcher) touch() {
+	w.lastUsed.Store(time.Now().UnixNano())
+}
+
+func (w *watcher) idle() time.Duration {
+	return time.Since(time.Unix(0, w.lastUsed.Load()))
+}
+
+func (w *watcher) size() int {
+	return len(w.Informer().GetStore().ListKeys())
+}
+
+// SetBudget sets the informer caches memory budget. Resources previously
+// deemed too large are reconsidered.
+func (f *Factory) SetBudget(b Budget) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.budget = b
+	for k := range f.capped {
+		delete(f.capped, k)
+	}
+	for k := range f.direct {
+		delete(f.direct, k)
+	}
+}
+
+// CacheStats returns the caches budget and the resources served uncached.
+func (f *Factory) CacheStats() CacheStats {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	cc := make([]string, 0, len(f.capped))
+	for k := range f.capped {
+		cc = append(cc, k)
+	}
+	sort.Strings(cc)
+
+	return CacheStats{
+		Budget:    f.budget,
+		Evictions: f.evictions,
+		Capped:    cc,
+	}
+}
+
+// checkCacheBudget checks a resource fits the budget prior to caching it.
+// The api server reports the remaining count when listing a single item so
+// large collections are caught without being fetched.
+func (f *Factory) checkCacheBudget(ns, gvr string) error {
+	key := watchKey(ns, gvr)
+	f.mx.RLock()
+	limit := f.budget.MaxObjects
+	_, capped := f.capped[key]
+	f.mx.RUnlock()
+	if capped {
+		return fmt.Errorf("%w for %q", ErrCacheBudget, key)
+	}
+	if limit == 0 {
+		return nil
+	}
+
+	ri, err := f.dynClient(ns, gvr)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), f.client.Config().CallTimeout())
+	defer cancel()
+	ll, err := ri.List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return nil
+	}
+	rc := ll.GetRemainingItemCount()
+	if rc == nil || int(*rc)+len(ll.Items) <= limit {
+		return nil
+	}
+
+	f.mx.Lock()
+	f.capped[key] = struct{}{}
+	f.mx.Unlock()
+	log.Warn().Msgf("Cache budget (%d) exceeded for %q. Listing from api server", limit, key)
+
+	return fmt.Errorf("%w for %q", ErrCacheBudget, key)
+}
+
+// listDirect lists a resource too large to be cached straight from the api
+// server. The listing is paged by the cache budget and reused for a short
+// while so refreshes do not page the whole collection each time.
+func (f *Factory) listDirect(ns, gvr string, sel labels.Selector) ([]runtime.Object, error) {
+	key := watchKey(ns, gvr) + "|" + sel.String()
+	f.mx.RLock()
+	limit := f.budget.MaxObjects
+	l, ok := f.direct[key]
+	f.mx.RUnlock()
+	if ok && time.Since(l.at) < directListTTL {
+		return l.oo, nil
+	}
+
+	oo, err := f.pageDirect(ns, gvr, sel, limit)
+	if err != nil {
+		return nil, err
+	}
+	f.mx.Lock()
+	f.direct[key] = directList{at: time.Now(), oo: oo}
+	f.mx.Unlock()
+
+	return oo, nil
+}
+
+func (f *Factory) pageDirect(ns, gvr string, sel labels.Selector, limit int) ([]runtime.Object, error) {
+	ri, err := f.dynClient(ns, gvr)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		oo   []runtime.Object
+		opts = metav1.ListOptions{
+			LabelSelector: sel.String(),
+			Limit:         int64(limit),
+		}
+	)
+	for {
+		ll, err := f.listPage(ri, opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range ll.Items {
+			oo = append(oo, &ll.Items[i])
+		}
+		if ll.GetContinue() == "" {
+			return oo, nil
+		}
+		opts.Continue = ll.GetContinue()
+	}
+}
+
+func (f *Factory) listPage(ri dynamic.ResourceInterface, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), f.client.Config().CallTimeout())
+	defer cancel()
+
+	return ri.List(ctx, opts)
+}
+
+// getDirect fetches a resource too large to be cached from the api server.
+func (f *Factory) getDirect(ns, gvr, n string) (runtime.Object, error) {
+	ri, err := f.dynClient(ns, gvr)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), f.client.Config().CallTimeout())
+	defer cancel()
+
+	return ri.Get(ctx, n, metav1.GetOptions{})
+}
+
+func (f *Factory) dynClient(ns, gvr string) (dynamic.ResourceInterface, error) {
+	dial, err := f.client.DynDial()
+	if err != nil {
+		return nil, err
+	}
+	if client.IsClusterScoped(ns) {
+		ns = client.BlankNamespace
+	}
+
+	return dial.Resource(toGVR(gvr)).Namespace(nsKey(ns)), nil
+}
+
+func (f *Factory) evictor(stop <-chan struct{}) {
+	t := time.NewTicker(evictInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+			f.evict()
+		}
+	}
+}
+
+// evict drops the caches not accessed recently or grown past the budget.
+func (f *Factory) evict() {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	for key, w := range f.watchers {
+		switch {
+		case f.budget.EvictAfter > 0 && w.idle() > f.budget.EvictAfter:
+			log.Debug().Msgf("Evicting idle cache %q", key)
+		case f.budget.MaxObjects > 0 && w.size() > f.budget.MaxObjects:
+			log.Warn().Msgf("Cache budget (%d) exceeded for %q. Listing from api server", f.budget.MaxObjects, key)
+			f.capped[key] = struct{}{}
+		default:
+			continue
+		}
+		f.drop(key)
+	}
+	for key, l := range f.direct {
+		if time.Since(l.at) >= directListTTL {
+			delete(f.direct, key)
+		}
+	}
+}
+
+// evictLRU drops the least recently accessed caches to make room for a new
+// one. Callers must hold the factory lock.
+func (f *Factory) evictLRU() {
+	for len(f.watchers) > 0 && len(f.watchers) >= f.maxWatches {
+		var (
+			lru  string
+			idle time.Duration = -1
+		)
+		for key, w := range f.watchers {
+			if d := w.idle(); d > idle {
+				lru, idle = key, d
+			}
+		}
+		log.Debug().Msgf("Watch budget (%d) reached. Evicting least recently used cache %q", f.maxWatches, lru)
+		f.drop(lru)
+	}
+}
+
+// drop stops a cache and discards its tracking. Callers must hold the
+// factory lock.
+func (f *Factory) drop(key string) {
+	if w, ok := f.watchers[key]; ok {
+		w.stop()
+	}
+	delete(f.watchers, key)
+	delete(f.stales, key)
+	f.changes.untrack(key)
+	f.evictions++
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	di "k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestFactoryEvict(t *testing.T) {
+	f := NewFactory(nil)
+	f.SetBudget(Budget{MaxObjects: 2, EvictAfter: time.Minute})
+
+	idle := makeWatcher("default", "v1/pods", 1)
+	idle.lastUsed.Store(time.Now().Add(-2 * time.Minute).UnixNano())
+	f.watchers[watchKey("default", "v1/pods")] = idle
+	f.watchers[watchKey("default", "v1/secrets")] = makeWatcher("default", "v1/secrets", 3)
+	f.watchers[watchKey("default", "v1/services")] = makeWatcher("default", "v1/services", 2)
+
+	f.evict()
+
+	assert.Len(t, f.watchers, 1)
+	assert.Contains(t, f.watchers, "default:v1/services")
+	st := f.CacheStats()
+	assert.Equal(t, 2, st.Evictions)
+	assert.Equal(t, []string{"default:v1/secrets"}, st.Capped)
+
+	err := f.checkCacheBudget("default", "v1/secrets")
+	assert.ErrorIs(t, err, ErrCacheBudget)
+
+	f.SetBudget(Budget{})
+	assert.Empty(t, f.CacheStats().Capped)
+}
+
+func TestFactoryEvictLRU(t *testing.T) {
+	f := NewFactory(nil)
+	f.SetMaxWatches(2)
+
+	old := makeWatcher("default", "v1/pods", 1)
+	old.lastUsed.Store(time.Now().Add(-2 * time.Minute).UnixNano())
+	f.watchers[watchKey("default", "v1/pods")] = old
+	f.watchers[watchKey("default", "v1/services")] = makeWatcher("default", "v1/services", 1)
+
+	f.evictLRU()
+
+	assert.Len(t, f.watchers, 1)
+	assert.Contains(t, f.watchers, "default:v1/services")
+	assert.Equal(t, 1, f.CacheStats().Evictions)
+}
+
+func TestFactoryStatsOccupancy(t *testing.T) {
+	f := NewFactory(nil)
+	f.SetBudget(Budget{MaxObjects: 4})
+	f.watchers[watchKey("", "v1/pods")] = makeWatcher("", "v1/pods", 1)
+
+	ss := f.Stats()
+
+	assert.Len(t, ss, 1)
+	assert.Equal(t, "all", ss[0].Namespace)
+	assert.Equal(t, 1, ss[0].Objects)
+	assert.Equal(t, "25%", ss[0].Occupancy)
+}
+
+func TestFactoryListDirectCached(t *testing.T) {
+	f := NewFactory(nil)
+	f.SetBudget(Budget{MaxObjects: 1})
+	sel := labels.Everything()
+	oo := []runtime.Object{&unstructured.Unstructured{}}
+	f.direct[watchKey("default", "v1/pods")+"|"+sel.String()] = directList{at: time.Now(), oo: oo}
+	f.direct[watchKey("default", "v1/secrets")+"|"+sel.String()] = directList{at: time.Now().Add(-2 * directListTTL)}
+
+	ll, err := f.listDirect("default", "v1/pods", sel)
+
+	assert.NoError(t, err)
+	assert.Equal(t, oo, ll)
+
+	f.evict()
+	assert.Len(t, f.direct, 1)
+}
+
+// Helpers...
+
+func makeWatcher(ns, gvr string, count int) *watcher {
+	inf := di.NewFilteredDynamicInformer(nil, toGVR(gvr), ns, 0, cache.Indexers{}, nil)
+	for i := 0; i < count; i++ {
+		var u unstructured.Unstructured
+		u.SetNamespace(ns)
+		u.SetName(fmt.Sprintf("o%d", i))
+		_ = inf.Informer().GetStore().Add(&u)
+	}
+
+	return newWatcher(ns, gvr, inf)
+}
@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MaxChanges tracks the number of recent changes kept around.
+const MaxChanges = 500
+
+// ChangeType represents a kind of resource change.
+type ChangeType string
+
+const (
+	// ChangeAdded tracks a resource creation.
+	ChangeAdded ChangeType = "ADDED"
+
+	// ChangeUpdated tracks a resource update.
+	ChangeUpdated ChangeType = "UPDATED"
+
+	// ChangeDeleted tracks a resource deletion.
+	ChangeDeleted ChangeType = "DELETED"
+)
+
+// metaFields tracks the metadata fields worth reporting on updates.
+var metaFields = []string{"labels", "annotations", "finalizers", "ownerReferences", "deletionTimestamp"}
+
+// Change represents a resource change observed by an informer.
+type Change struct {
+	Seq       int64
+	Time      time.Time
+	Type      ChangeType
+	GVR       string
+	Namespace string
+	Name      string
+	Manager   string
+	Operation string
+	Fields    []string
+}
+
+// ChangeLog tracks recent resource changes.
+type ChangeLog struct {
+	changes []Change
+	seq     int64
+	tracked map[string]struct{}
+	mx      sync.RWMutex
+}
+
+// NewChangeLog returns a new instance.
+func NewChangeLog() *ChangeLog {
+	return &ChangeLog{
+		changes: make([]Change, 0, MaxChanges),
+		tracked: make(map[string]struct{}),
+	}
+}
+
+// Record records a change and evicts the oldest ones past capacity.
+func (c *ChangeLog) Record(ch Change) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.seq++
+	ch.Seq = c.seq
+	if len(c.changes) >= MaxChanges {
+		c.changes = c.changes[1:]
+	}
+	c.changes = append(c.changes, ch)
+}
+
+// List returns the recorded changes, most recent first.
+func (c *ChangeLog) List() []Change {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	cc := make([]Change, len(c.changes))
+	copy(cc, c.changes)
+	sort.Slice(cc, func(i, j int) bool {
+		return cc[i].Seq > cc[j].Seq
+	})
+
+	return cc
+}
+
+// Clear clears out all changes and tracked watches.
+func (c *ChangeLog) Clear() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.changes = c.changes[:0]
+	for k := range c.tracked {
+		delete(c.tracked, k)
+	}
+}
+
+// track checks if a watch needs a change handler.
+func (c *ChangeLog) track(key string) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if _, ok := c.tracked[key]; ok {
+		return false
+	}
+	c.tracked[key] = struct{}{}
+
+	return true
+}
+
+// untrack releases a watch so its next informer gets a change handler.
+func (c *ChangeLog) untrack(key string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	delete(c.tracked, key)
+}
+
+func (c *ChangeLog) handler(gvr string) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(o interface{}, initial bool) {
+			if initial {
+				return
+			}
+			if u, ok := o.(*unstructured.Unstructured); ok {
+				c.Record(NewChange(ChangeAdded, gvr, u, nil))
+			}
+		},
+		UpdateFunc: func(o, n interface{}) {
+			ou, ok1 := o.(*unstructured.Unstructured)
+			nu, ok2 := n.(*unstructured.Unstructured)
+			if !ok1 || !ok2 || ou.GetResourceVersion() == nu.GetResourceVersion() {
+				return
+			}
+			c.Record(NewChange(ChangeUpdated, gvr, nu, ChangedFields(ou, nu)))
+		},
+		DeleteFunc: func(o interface{}) {
+			if d, ok := o.(cache.DeletedFinalStateUnknown); ok {
+				o = d.Obj
+			}
+			if u, ok := o.(*unstructured.Unstructured); ok {
+				c.Record(NewChange(ChangeDeleted, gvr, u, nil))
+			}
+		},
+	}
+}
+
+// NewChange returns a change for a given resource.
+func NewChange(t ChangeType, gvr string, u *unstructured.Unstructured, ff []string) Change {
+	ch := Change{
+		Time:      time.Now(),
+		Type:      t,
+		GVR:       gvr,
+		Namespace: u.GetNamespace(),
+		Name:      u.GetName(),
+		Fields:    ff,
+	}
+	if mf, ok := lastManager(u.GetManagedFields()); ok {
+		ch.Manager, ch.Operation = mf.Manager, string(mf.Operation)
+	}
+
+	return ch
+}
+
+// lastManager returns the most recent field manager.
+func lastManager(mm []metav1.ManagedFieldsEntry) (metav1.ManagedFieldsEntry, bool) {
+	var (
+		last metav1.ManagedFieldsEntry
+		ok   bool
+	)
+	for _, m := range mm {
+		if m.Time == nil {
+			continue
+		}
+		if !ok || last.Time.Before(m.Time) {
+			last, ok = m, true
+		}
+	}
+
+	return last, ok
+}
+
+// ChangedFields returns the top level fields that differ between two
+// revisions of a resource.
+func ChangedFields(o, n *unstructured.Unstructured) []string {
+	ff := make([]string, 0, 5)
+	for k, v := range n.Object {
+		if k == "metadata" {
+			continue
+		}
+		if !equality.Semantic.DeepEqual(o.Object[k], v) {
+			ff = append(ff, k)
+		}
+	}
+	for k := range o.Object {
+		if _, ok := n.Object[k]; !ok {
+			ff = append(ff, k)
+		}
+	}
+	om, _, _ := unstructured.NestedMap(o.Object, "metadata")
+	nm, _, _ := unstructured.NestedMap(n.Object, "metadata")
+	for _, k := range metaFields {
+		if !equality.Semantic.DeepEqual(om[k], nm[k]) {
+			ff = append(ff, "metadata."+k)
+		}
+	}
+	sort.Strings(ff)
+
+	return ff
+}
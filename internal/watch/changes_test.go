@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestChangeLogRecord(t *testing.T) {
+	c := watch.NewChangeLog()
+	for i := 0; i < watch.MaxChanges+10; i++ {
+		c.Record(watch.Change{Name: "fred"})
+	}
+
+	cc := c.List()
+	assert.Equal(t, watch.MaxChanges, len(cc))
+	assert.Equal(t, int64(watch.MaxChanges+10), cc[0].Seq)
+	assert.Equal(t, int64(11), cc[len(cc)-1].Seq)
+
+	c.Clear()
+	assert.Equal(t, 0, len(c.List()))
+}
+
+func TestChangedFields(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "fred",
+			"resourceVersion": "1",
+			"labels":          map[string]interface{}{"app": "fred"},
+		},
+		"spec":   map[string]interface{}{"replicas": int64(1)},
+		"status": map[string]interface{}{"ready": int64(1)},
+	}}
+	n := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "fred",
+			"resourceVersion": "2",
+			"labels":          map[string]interface{}{"app": "blee"},
+		},
+		"spec": map[string]interface{}{"replicas": int64(2)},
+		"data": map[string]interface{}{"a": "b"},
+	}}
+
+	assert.Equal(t, []string{"data", "metadata.labels", "spec", "status"}, watch.ChangedFields(&o, &n))
+}
+
+func TestChangeLastManager(t *testing.T) {
+	t1, t2 := metav1.NewTime(time.Now().Add(-time.Hour)), metav1.NewTime(time.Now())
+	u := unstructured.Unstructured{Object: map[string]interface{}{}}
+	u.SetName("fred")
+	u.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationUpdate, Time: &t2},
+		{Manager: "helm", Operation: metav1.ManagedFieldsOperationApply, Time: &t1},
+	})
+
+	ch := watch.NewChange(watch.ChangeUpdated, "v1/pods", &u, nil)
+	assert.Equal(t, "kubectl", ch.Manager)
+	assert.Equal(t, "Update", ch.Operation)
+	assert.Equal(t, "fred", ch.Name)
+}
@@ -4,7 +4,9 @@
 package watch
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -17,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	di "k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
@@ -26,19 +29,37 @@ const (
 
 // Factory tracks various resource informers.
 type Factory struct {
-	factories  map[string]di.DynamicSharedInformerFactory
+	watchers   map[string]*watcher
 	client     client.Connection
 	stopChan   chan struct{}
 	forwarders Forwarders
+	maxWatches int
+	budget     Budget
+	capped     map[string]struct{}
+	direct     map[string]directList
+	evictions  int
+	stales     map[string]staleWatch
+	changes    *ChangeLog
 	mx         sync.RWMutex
 }
 
+// staleWatch tracks a dropped watch and the last resource version synced
+// before the drop.
+type staleWatch struct {
+	since time.Time
+	rv    string
+}
+
 // NewFactory returns a new informers factory.
 func NewFactory(client client.Connection) *Factory {
 	return &Factory{
 		client:     client,
-		factories:  make(map[string]di.DynamicSharedInformerFactory),
+		watchers:   make(map[string]*watcher),
 		forwarders: NewForwarders(),
+		capped:     make(map[string]struct{}),
+		direct:     make(map[string]directList),
+		stales:     make(map[string]staleWatch),
+		changes:    NewChangeLog(),
 	}
 }
 
@@ -49,10 +70,11 @@ func (f *Factory) Start(ns string) {
 
 	log.Debug().Msgf("Factory START with ns `%q", ns)
 	f.stopChan = make(chan struct{})
-	for ns, fac := range f.factories {
-		log.Debug().Msgf("Starting factory in ns %q", ns)
-		fac.Start(f.stopChan)
+	for key, w := range f.watchers {
+		log.Debug().Msgf("Starting informer %q", key)
+		w.start()
 	}
+	go f.evictor(f.stopChan)
 }
 
 // Terminate terminates all watchers and forwards.
@@ -64,15 +86,26 @@ func (f *Factory) Terminate() {
 		close(f.stopChan)
 		f.stopChan = nil
 	}
-	for k := range f.factories {
-		delete(f.factories, k)
+	for k, w := range f.watchers {
+		w.stop()
+		delete(f.watchers, k)
+	}
+	for k := range f.capped {
+		delete(f.capped, k)
 	}
+	for k := range f.stales {
+		delete(f.stales, k)
+	}
+	f.changes.Clear()
 	f.forwarders.DeleteAll()
 }
 
 // List returns a resource collection.
 func (f *Factory) List(gvr, ns string, wait bool, labels labels.Selector) ([]runtime.Object, error) {
 	inf, err := f.CanForResource(ns, gvr, client.ListAccess)
+	if errors.Is(err, ErrCacheBudget) {
+		return f.listDirect(ns, gvr, labels)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +123,7 @@ func (f *Factory) List(gvr, ns string, wait bool, labels labels.Selector) ([]run
 		return oo, err
 	}
 
-	f.waitForCacheSync(ns)
+	waitForCacheSync(inf)
 	if client.IsClusterScoped(ns) {
 		return inf.Lister().List(labels)
 	}
@@ -100,6 +133,9 @@ func (f *Factory) List(gvr, ns string, wait bool, labels labels.Selector) ([]run
 // HasSynced checks if given informer is up to date.
 func (f *Factory) HasSynced(gvr, ns string) (bool, error) {
 	inf, err := f.CanForResource(ns, gvr, client.ListAccess)
+	if errors.Is(err, ErrCacheBudget) {
+		return true, nil
+	}
 	if err != nil {
 		return false, err
 	}
@@ -111,6 +147,9 @@ func (f *Factory) HasSynced(gvr, ns string) (bool, error) {
 func (f *Factory) Get(gvr, fqn string, wait bool, sel labels.Selector) (runtime.Object, error) {
 	ns, n := namespaced(fqn)
 	inf, err := f.CanForResource(ns, gvr, []string{client.GetVerb})
+	if errors.Is(err, ErrCacheBudget) {
+		return f.getDirect(ns, gvr, n)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -124,42 +163,81 @@ func (f *Factory) Get(gvr, fqn string, wait bool, sel labels.Selector) (runtime.
 		return o, err
 	}
 
-	f.waitForCacheSync(ns)
+	waitForCacheSync(inf)
 	if client.IsClusterScoped(ns) {
 		return inf.Lister().Get(n)
 	}
 	return inf.Lister().ByNamespace(ns).Get(n)
 }
 
-func (f *Factory) waitForCacheSync(ns string) {
-	if client.IsClusterWide(ns) {
-		ns = client.BlankNamespace
-	}
-
-	f.mx.RLock()
-	defer f.mx.RUnlock()
-	fac, ok := f.factories[ns]
-	if !ok {
-		return
-	}
-
+func waitForCacheSync(inf informers.GenericInformer) {
 	// Hang for a sec for the cache to refresh if still not done bail out!
 	c := make(chan struct{})
 	go func(c chan struct{}) {
 		<-time.After(defaultWaitTime)
 		close(c)
 	}(c)
-	_ = fac.WaitForCacheSync(c)
+	_ = cache.WaitForCacheSync(c, inf.Informer().HasSynced)
 }
 
-// WaitForCacheSync waits for all factories to update their cache.
+// WaitForCacheSync waits for all informers to update their cache.
 func (f *Factory) WaitForCacheSync() {
-	for ns, fac := range f.factories {
-		m := fac.WaitForCacheSync(f.stopChan)
-		for k, v := range m {
-			log.Debug().Msgf("CACHE `%q Loaded %t:%s", ns, v, k)
+	f.mx.RLock()
+	ww := make(map[string]*watcher, len(f.watchers))
+	for k, w := range f.watchers {
+		ww[k] = w
+	}
+	stop := f.stopChan
+	f.mx.RUnlock()
+
+	for k, w := range ww {
+		ok := cache.WaitForCacheSync(stop, w.Informer().HasSynced)
+		log.Debug().Msgf("CACHE `%q Loaded %t", k, ok)
+	}
+}
+
+// WatchStat tracks an informer state.
+type WatchStat struct {
+	Namespace string `json:"namespace"`
+	GVR       string `json:"gvr"`
+	Synced    bool   `json:"synced"`
+	Objects   int    `json:"objects"`
+	Occupancy string `json:"occupancy,omitempty"`
+	Idle      string `json:"idle"`
+}
+
+// Stats returns all started informers states and cache sizes.
+func (f *Factory) Stats() []WatchStat {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	ss := make([]WatchStat, 0, len(f.watchers))
+	for _, w := range f.watchers {
+		ns := w.ns
+		if ns == client.BlankNamespace {
+			ns = client.NamespaceAll
+		}
+		gvr := toGVR(w.gvr)
+		st := WatchStat{
+			Namespace: ns,
+			GVR:       client.FromGVAndR(gvr.GroupVersion().String(), gvr.Resource).String(),
+			Synced:    w.Informer().HasSynced(),
+			Objects:   w.size(),
+			Idle:      w.idle().Round(time.Second).String(),
 		}
+		if f.budget.MaxObjects > 0 {
+			st.Occupancy = fmt.Sprintf("%d%%", st.Objects*100/f.budget.MaxObjects)
+		}
+		ss = append(ss, st)
 	}
+	sort.Slice(ss, func(i, j int) bool {
+		if ss[i].Namespace == ss[j].Namespace {
+			return ss[i].GVR < ss[j].GVR
+		}
+		return ss[i].Namespace < ss[j].Namespace
+	})
+
+	return ss
 }
 
 // Client return the factory connection.
@@ -167,28 +245,14 @@ func (f *Factory) Client() client.Connection {
 	return f.client
 }
 
-// FactoryFor returns a factory for a given namespace.
-func (f *Factory) FactoryFor(ns string) di.DynamicSharedInformerFactory {
-	return f.factories[ns]
-}
+// SetActiveNS sets the active namespace. Informers are created on demand
+// so this only checks the api server is reachable.
+func (f *Factory) SetActiveNS(string) error {
+	_, err := f.client.DynDial()
 
-// SetActiveNS sets the active namespace.
-func (f *Factory) SetActiveNS(ns string) error {
-	if f.isClusterWide() {
-		return nil
-	}
-	_, err := f.ensureFactory(ns)
 	return err
 }
 
-func (f *Factory) isClusterWide() bool {
-	f.mx.RLock()
-	defer f.mx.RUnlock()
-	_, ok := f.factories[client.BlankNamespace]
-
-	return ok
-}
-
 // CanForResource return an informer is user has access.
 func (f *Factory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
 	auth, err := f.Client().CanI(ns, gvr, "", verbs)
@@ -202,47 +266,130 @@ func (f *Factory) CanForResource(ns, gvr string, verbs []string) (informers.Gene
 	return f.ForResource(ns, gvr)
 }
 
+// SetMaxWatches caps the number of concurrent informers. The least recently
+// used informers are evicted past the cap. Zero means no limits.
+func (f *Factory) SetMaxWatches(n int) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.maxWatches = n
+}
+
 // ForResource returns an informer for a given resource.
 func (f *Factory) ForResource(ns, gvr string) (informers.GenericInformer, error) {
-	fact, err := f.ensureFactory(ns)
+	key := watchKey(ns, gvr)
+	if w, ok := f.watcher(key); ok {
+		w.touch()
+		return w.GenericInformer, nil
+	}
+	if err := f.checkCacheBudget(ns, gvr); err != nil {
+		return nil, err
+	}
+	dial, err := f.client.DynDial()
 	if err != nil {
 		return nil, err
 	}
-	inf := fact.ForResource(toGVR(gvr))
-	if inf == nil {
-		log.Error().Err(fmt.Errorf("MEOW! No informer for %q:%q", ns, gvr))
-		return inf, nil
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if w, ok := f.watchers[key]; ok {
+		w.touch()
+		return w.GenericInformer, nil
+	}
+	if f.maxWatches > 0 && len(f.watchers) >= f.maxWatches {
+		f.evictLRU()
+	}
+	w := newWatcher(ns, gvr, di.NewFilteredDynamicInformer(
+		dial,
+		toGVR(gvr),
+		nsKey(ns),
+		defaultResync,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		nil,
+	))
+	f.trackWatch(ns, gvr, w.Informer())
+	f.trackChanges(ns, gvr, w.Informer())
+	f.watchers[key] = w
+	if f.stopChan != nil {
+		w.start()
 	}
 
+	return w.GenericInformer, nil
+}
+
+func (f *Factory) watcher(key string) (*watcher, bool) {
 	f.mx.RLock()
 	defer f.mx.RUnlock()
-	fact.Start(f.stopChan)
+	w, ok := f.watchers[key]
 
-	return inf, nil
+	return w, ok
 }
 
-func (f *Factory) ensureFactory(ns string) (di.DynamicSharedInformerFactory, error) {
-	if client.IsClusterWide(ns) {
-		ns = client.BlankNamespace
+// trackWatch records watch drops. The reflector relists with exponential
+// backoff once the api server is reachable again. The handler can only be
+// set prior to the informer start.
+func (f *Factory) trackWatch(ns, gvr string, inf cache.SharedIndexInformer) {
+	key := watchKey(ns, gvr)
+	_ = inf.SetWatchErrorHandler(func(r *cache.Reflector, err error) {
+		cache.DefaultWatchErrorHandler(r, err)
+		f.mx.Lock()
+		defer f.mx.Unlock()
+		if _, ok := f.stales[key]; ok {
+			return
+		}
+		log.Warn().Err(err).Msgf("Watch dropped for %q", key)
+		f.stales[key] = staleWatch{since: time.Now(), rv: inf.LastSyncResourceVersion()}
+	})
+}
+
+// trackChanges records the resource changes observed by an informer.
+func (f *Factory) trackChanges(ns, gvr string, inf cache.SharedIndexInformer) {
+	if !f.changes.track(watchKey(ns, gvr)) {
+		return
 	}
-	f.mx.Lock()
-	defer f.mx.Unlock()
-	if fac, ok := f.factories[ns]; ok {
-		return fac, nil
+	if _, err := inf.AddEventHandler(f.changes.handler(gvr)); err != nil {
+		log.Warn().Err(err).Msgf("Change tracking failed for %q", gvr)
 	}
+}
 
-	dial, err := f.client.DynDial()
-	if err != nil {
-		return nil, err
+// Changes returns the recent resource changes, most recent first.
+func (f *Factory) Changes() []Change {
+	return f.changes.List()
+}
+
+// StaleSince checks if a resource watch dropped and returns when. Once the
+// informer resyncs, the resource is deemed fresh again.
+func (f *Factory) StaleSince(ns, gvr string) (time.Time, bool) {
+	key := watchKey(ns, gvr)
+	f.mx.RLock()
+	st, ok := f.stales[key]
+	w, wok := f.watchers[key]
+	f.mx.RUnlock()
+	if !ok || !wok {
+		return time.Time{}, false
 	}
-	f.factories[ns] = di.NewFilteredDynamicSharedInformerFactory(
-		dial,
-		defaultResync,
-		ns,
-		nil,
-	)
+	if w.Informer().LastSyncResourceVersion() == st.rv {
+		return st.since, true
+	}
+
+	f.mx.Lock()
+	delete(f.stales, key)
+	f.mx.Unlock()
+	log.Info().Msgf("Watch recovered for %q", key)
+
+	return time.Time{}, false
+}
+
+func nsKey(ns string) string {
+	if client.IsClusterWide(ns) {
+		return client.BlankNamespace
+	}
+
+	return ns
+}
 
-	return f.factories[ns], nil
+func watchKey(ns, gvr string) string {
+	return nsKey(ns) + ":" + gvr
 }
 
 // AddForwarder registers a new portforward for a given container.
@@ -33,8 +33,8 @@ func namespaced(n string) (string, string) {
 // DumpFactory for debug.
 func DumpFactory(f *Factory) {
 	log.Debug().Msgf("----------- FACTORIES -------------")
-	for ns := range f.factories {
-		log.Debug().Msgf("  Factory for NS %q", ns)
+	for key := range f.watchers {
+		log.Debug().Msgf("  Informer for %q", key)
 	}
 	log.Debug().Msgf("-----------------------------------")
 }
@@ -42,12 +42,11 @@ func DumpFactory(f *Factory) {
 // DebugFactory for debug.
 func DebugFactory(f *Factory, ns string, gvr string) {
 	log.Debug().Msgf("----------- DEBUG FACTORY (%s) -------------", gvr)
-	fac, ok := f.factories[ns]
+	w, ok := f.watchers[watchKey(ns, gvr)]
 	if !ok {
 		return
 	}
-	inf := fac.ForResource(toGVR(gvr))
-	for i, k := range inf.Informer().GetStore().ListKeys() {
+	for i, k := range w.Informer().GetStore().ListKeys() {
 		log.Debug().Msgf("%d -- %s", i, k)
 	}
 }